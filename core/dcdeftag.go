@@ -3262,10 +3262,12 @@ var (
 
 // DICOM Pixel Data
 var (
-	DCMPixelData                 = DcmTag{0x7fe0, 0x0010}
-	DCMACRNEMA2CCoefficientsSDVN = DcmTag{0x7fe0, 0x0020}
-	DCMACRNEMA2CCoefficientsSDHN = DcmTag{0x7fe0, 0x0030}
-	DCMACRNEMA2CCoefficientsSDDN = DcmTag{0x7fe0, 0x0040}
+	DCMExtendedOffsetTable        = DcmTag{0x7fe0, 0x0001}
+	DCMExtendedOffsetTableLengths = DcmTag{0x7fe0, 0x0002}
+	DCMPixelData                  = DcmTag{0x7fe0, 0x0010}
+	DCMACRNEMA2CCoefficientsSDVN  = DcmTag{0x7fe0, 0x0020}
+	DCMACRNEMA2CCoefficientsSDHN  = DcmTag{0x7fe0, 0x0030}
+	DCMACRNEMA2CCoefficientsSDDN  = DcmTag{0x7fe0, 0x0040}
 )
 
 // DICOM Sequence Items
@@ -0,0 +1,534 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:generate python3 dcmcharsettable_gen.py
+
+// dcmCodeElement is a character set which is designated to G0 or G1 by the escape
+// sequence of ISO 2022. The single-byte G1 sets map the bytes 0xA0-0xFF by upper, and
+// the double-byte sets map the codes of two bytes 0x21-0x7E by double.
+type dcmCodeElement struct {
+	escape string
+	isG1   bool
+	upper  *[96]uint16
+	double func(b1 byte, b2 byte) uint16
+
+	once    sync.Once
+	reverse map[rune]uint16
+}
+
+// encode gets the code of the rune in the set.
+func (ce *dcmCodeElement) encode(r rune) (uint16, bool) {
+	ce.once.Do(func() {
+		ce.reverse = make(map[rune]uint16)
+		switch {
+		case ce.upper != nil:
+			for i, v := range ce.upper {
+				if v != 0 {
+					ce.reverse[rune(v)] = uint16(0xA0 + i)
+				}
+			}
+		case ce.double != nil:
+			for b1 := byte(0x21); b1 < 0x7F; b1++ {
+				for b2 := byte(0x21); b2 < 0x7F; b2++ {
+					v := ce.double(b1, b2)
+					if _, ok := ce.reverse[rune(v)]; v != 0 && !ok {
+						ce.reverse[rune(v)] = uint16(b1)<<8 | uint16(b2)
+					}
+				}
+			}
+		}
+	})
+	if ce.upper == nil && ce.double == nil {
+		// ASCII
+		return uint16(r), r < 0x80
+	}
+	code, ok := ce.reverse[r]
+	return code, ok
+}
+
+func table94(table *[8836]uint16) func(b1 byte, b2 byte) uint16 {
+	return func(b1 byte, b2 byte) uint16 {
+		return table[(int(b1)-0x21)*94+int(b2)-0x21]
+	}
+}
+
+// gb2312Code gets the code of GB2312, which is the area 0xA1A1-0xFEFE of GB18030.
+func gb2312Code(b1 byte, b2 byte) uint16 {
+	return gb18030Table[(int(b1|0x80)-0x81)*190+int(b2|0x80)-0x41]
+}
+
+// katakanaUpper maps the bytes 0xA1-0xDF of JIS X 0201 to the half-width katakana.
+var katakanaUpper [96]uint16
+
+func init() {
+	for b := 0xA1; b <= 0xDF; b++ {
+		katakanaUpper[b-0xA0] = uint16(0xFF61 + b - 0xA1)
+	}
+}
+
+// the code elements of ISO 2022 supported by DICOM, see PS3.3 C.12.1.1.2. The Romaji
+// of JIS X 0201 is decoded as ASCII, since 0x5C is still the delimiter of the values.
+var (
+	dcmASCII    = &dcmCodeElement{escape: "\x1b(B"}
+	dcmRomaji   = &dcmCodeElement{escape: "\x1b(J"}
+	dcmLatin1   = &dcmCodeElement{escape: "\x1b-A", isG1: true, upper: &latin1Upper}
+	dcmLatin2   = &dcmCodeElement{escape: "\x1b-B", isG1: true, upper: &latin2Upper}
+	dcmLatin3   = &dcmCodeElement{escape: "\x1b-C", isG1: true, upper: &latin3Upper}
+	dcmLatin4   = &dcmCodeElement{escape: "\x1b-D", isG1: true, upper: &latin4Upper}
+	dcmCyrillic = &dcmCodeElement{escape: "\x1b-L", isG1: true, upper: &cyrillicUpper}
+	dcmArabic   = &dcmCodeElement{escape: "\x1b-G", isG1: true, upper: &arabicUpper}
+	dcmGreek    = &dcmCodeElement{escape: "\x1b-F", isG1: true, upper: &greekUpper}
+	dcmHebrew   = &dcmCodeElement{escape: "\x1b-H", isG1: true, upper: &hebrewUpper}
+	dcmLatin5   = &dcmCodeElement{escape: "\x1b-M", isG1: true, upper: &latin5Upper}
+	dcmThai     = &dcmCodeElement{escape: "\x1b-T", isG1: true, upper: &thaiUpper}
+	dcmLatin9   = &dcmCodeElement{escape: "\x1b-b", isG1: true, upper: &latin9Upper}
+	dcmKatakana = &dcmCodeElement{escape: "\x1b)I", isG1: true, upper: &katakanaUpper}
+	dcmJISX0208 = &dcmCodeElement{escape: "\x1b$B", double: table94(&jisx0208Table)}
+	dcmJISX0212 = &dcmCodeElement{escape: "\x1b$(D", double: table94(&jisx0212Table)}
+	dcmKSX1001  = &dcmCodeElement{escape: "\x1b$)C", isG1: true, double: table94(&ksx1001Table)}
+	dcmGB2312   = &dcmCodeElement{escape: "\x1b$)A", isG1: true, double: gb2312Code}
+)
+
+var dcmCodeElements = []*dcmCodeElement{
+	dcmASCII, dcmRomaji, dcmLatin1, dcmLatin2, dcmLatin3, dcmLatin4, dcmCyrillic, dcmArabic, dcmGreek,
+	dcmHebrew, dcmLatin5, dcmThai, dcmLatin9, dcmKatakana, dcmJISX0208, dcmJISX0212, dcmKSX1001, dcmGB2312,
+}
+
+// dcmCharsetTerm contains the code elements of a defined term of Specific Character Set.
+type dcmCharsetTerm struct {
+	g0 *dcmCodeElement
+	g1 *dcmCodeElement
+}
+
+// dcmCharsetTerms maps the defined terms without the prefix "ISO_IR " or "ISO 2022 ".
+var dcmCharsetTerms = map[string]dcmCharsetTerm{
+	"IR 6":   {dcmASCII, nil},
+	"IR 100": {dcmASCII, dcmLatin1},
+	"IR 101": {dcmASCII, dcmLatin2},
+	"IR 109": {dcmASCII, dcmLatin3},
+	"IR 110": {dcmASCII, dcmLatin4},
+	"IR 144": {dcmASCII, dcmCyrillic},
+	"IR 127": {dcmASCII, dcmArabic},
+	"IR 126": {dcmASCII, dcmGreek},
+	"IR 138": {dcmASCII, dcmHebrew},
+	"IR 148": {dcmASCII, dcmLatin5},
+	"IR 166": {dcmASCII, dcmThai},
+	"IR 203": {dcmASCII, dcmLatin9},
+	"IR 13":  {dcmRomaji, dcmKatakana},
+	"IR 87":  {dcmJISX0208, nil},
+	"IR 159": {dcmJISX0212, nil},
+	"IR 149": {nil, dcmKSX1001},
+	"IR 58":  {nil, dcmGB2312},
+}
+
+// ECharsetMode is the kind of the encoding of a DcmCharset.
+type ECharsetMode int
+
+const (
+	// ECMDefault is the default repertoire, the values are kept as they are
+	ECMDefault ECharsetMode = iota
+	// ECMISO2022 is the single-byte character sets and the code extensions of ISO 2022
+	ECMISO2022
+	// ECMUTF8 is ISO_IR 192
+	ECMUTF8
+	// ECMGB18030 is GB18030
+	ECMGB18030
+	// ECMGBK is GBK
+	ECMGBK
+)
+
+// DcmCharset decodes and encodes the values of the VRs SH, LO, ST, PN, LT, UC and UT
+// by (0008,0005) Specific Character Set, see PS3.5 6.1.
+type DcmCharset struct {
+	// Terms are the values of Specific Character Set.
+	Terms []string
+	Mode  ECharsetMode
+
+	// the code elements designated initially, and all the declared ones
+	g0       *dcmCodeElement
+	g1       *dcmCodeElement
+	declared []*dcmCodeElement
+}
+
+// NewDcmCharset parses the value of Specific Character Set. The unknown terms are
+// reported by the error, and the charset with the other terms is still returned.
+func NewDcmCharset(specificCharacterSet string) (*DcmCharset, error) {
+	cs := &DcmCharset{g0: dcmASCII}
+	for _, v := range strings.Split(specificCharacterSet, "\\") {
+		cs.Terms = append(cs.Terms, strings.TrimSpace(v))
+	}
+
+	var err error
+	for i, v := range cs.Terms {
+		var term dcmCharsetTerm
+		var ok bool
+		switch {
+		case v == "" || v == "ISO_IR 6":
+			term, ok = dcmCharsetTerm{dcmASCII, nil}, true
+		case i == 0 && v == "ISO_IR 192":
+			cs.Mode = ECMUTF8
+			continue
+		case i == 0 && v == "GB18030":
+			cs.Mode = ECMGB18030
+			continue
+		case i == 0 && v == "GBK":
+			cs.Mode = ECMGBK
+			continue
+		case strings.HasPrefix(v, "ISO_IR "):
+			term, ok = dcmCharsetTerms["IR "+strings.TrimPrefix(v, "ISO_IR ")]
+		case strings.HasPrefix(v, "ISO 2022 "):
+			term, ok = dcmCharsetTerms[strings.TrimPrefix(v, "ISO 2022 ")]
+		}
+		if !ok {
+			if err == nil {
+				err = errors.New("DcmCharset: the term '" + v + "' of Specific Character Set is not supported")
+			}
+			continue
+		}
+		if i == 0 {
+			if term.g0 != nil && term.g0.double == nil {
+				cs.g0 = term.g0
+			}
+			cs.g1 = term.g1
+		}
+		for _, ce := range []*dcmCodeElement{term.g0, term.g1} {
+			if ce != nil && ce != dcmASCII {
+				cs.declared = append(cs.declared, ce)
+			}
+		}
+	}
+	if cs.Mode == ECMDefault && (len(cs.Terms) > 1 || cs.g0 != dcmASCII || cs.g1 != nil) {
+		cs.Mode = ECMISO2022
+	}
+	return cs, err
+}
+
+// isCharsetVR is to check whether the values of the VR are affected by Specific
+// Character Set.
+func isCharsetVR(vr string) bool {
+	switch vr {
+	case "SH", "LO", "ST", "PN", "LT", "UC", "UT":
+		return true
+	}
+	return false
+}
+
+// isDelimiter is to check whether the code elements are reset to the initial ones
+// after the byte, see PS3.5 6.1.2.5.3.
+func isDelimiter(b byte, vr string) bool {
+	switch b {
+	case '\\', '\r', '\n', '\t', '\f':
+		return true
+	case '^', '=':
+		return vr == "PN"
+	}
+	return false
+}
+
+// Decode converts the value of the VR to UTF-8. The bytes which can not be decoded are
+// replaced by U+FFFD, and reported by the error.
+func (cs DcmCharset) Decode(value []byte, vr string) (string, error) {
+	switch cs.Mode {
+	case ECMISO2022:
+		return cs.decodeISO2022(value, vr)
+	case ECMGB18030, ECMGBK:
+		return decodeGB18030(value)
+	case ECMUTF8:
+		if !utf8.Valid(value) {
+			return strings.ToValidUTF8(string(value), string(utf8.RuneError)), errors.New("DcmCharset: the value is not valid UTF-8")
+		}
+	}
+	return string(value), nil
+}
+
+func errorDecode(b byte) error {
+	str := fmt.Sprintf("DcmCharset: cannot decode the byte 0x%02X", b)
+	return errors.New(str)
+}
+
+func (cs DcmCharset) decodeISO2022(value []byte, vr string) (string, error) {
+	var buf bytes.Buffer
+	var err error
+	invalid := func(b byte) {
+		buf.WriteRune(utf8.RuneError)
+		if err == nil {
+			err = errorDecode(b)
+		}
+	}
+	g0, g1 := cs.g0, cs.g1
+	for i := 0; i < len(value); i++ {
+		b := value[i]
+		if b == 0x1B {
+			ce := findEscape(value[i:])
+			if ce == nil {
+				invalid(b)
+				continue
+			}
+			if ce.isG1 {
+				g1 = ce
+			} else {
+				g0 = ce
+			}
+			i += len(ce.escape) - 1
+			continue
+		}
+		if b < 0x80 {
+			if g0.double != nil && b > 0x20 && b < 0x7F && i+1 < len(value) {
+				v := g0.double(b, value[i+1]&0x7F)
+				i++
+				if v == 0 {
+					invalid(b)
+					continue
+				}
+				buf.WriteRune(rune(v))
+				continue
+			}
+			buf.WriteByte(b)
+			if isDelimiter(b, vr) {
+				g0, g1 = cs.g0, cs.g1
+			}
+			continue
+		}
+		var v uint16
+		switch {
+		case g1 == nil:
+		case g1.double != nil:
+			if b > 0xA0 && b < 0xFF && i+1 < len(value) {
+				v = g1.double(b&0x7F, value[i+1]&0x7F)
+				i++
+			}
+		case b >= 0xA0:
+			v = g1.upper[b-0xA0]
+		}
+		if v == 0 {
+			invalid(b)
+			continue
+		}
+		buf.WriteRune(rune(v))
+	}
+	return buf.String(), err
+}
+
+// findEscape gets the code element of the escape sequence at the beginning of b.
+func findEscape(b []byte) *dcmCodeElement {
+	for _, ce := range dcmCodeElements {
+		if bytes.HasPrefix(b, []byte(ce.escape)) {
+			return ce
+		}
+	}
+	return nil
+}
+
+// Encode converts the value of the VR from UTF-8. With the code extensions of ISO
+// 2022, the escape sequences are inserted for the characters which are not in the
+// initial code elements, and the initial G0 is designated again before each delimiter
+// and at the end of the value.
+func (cs DcmCharset) Encode(value string, vr string) ([]byte, error) {
+	switch cs.Mode {
+	case ECMISO2022:
+		return cs.encodeISO2022(value, vr)
+	case ECMGB18030, ECMGBK:
+		return cs.encodeGB18030(value)
+	case ECMUTF8:
+		return []byte(value), nil
+	}
+	for _, r := range value {
+		if r >= 0x80 {
+			return nil, errorEncode(r, cs.Terms)
+		}
+	}
+	return []byte(value), nil
+}
+
+func errorEncode(r rune, terms []string) error {
+	str := "DcmCharset: cannot encode '" + string(r) + "' with the Specific Character Set '" + strings.Join(terms, "\\") + "'"
+	return errors.New(str)
+}
+
+func (cs DcmCharset) encodeISO2022(value string, vr string) ([]byte, error) {
+	var buf bytes.Buffer
+	g0, g1 := cs.g0, cs.g1
+	for _, r := range value {
+		if r < 0x80 {
+			if g0.double != nil {
+				buf.WriteString(cs.g0.escape)
+				g0 = cs.g0
+			}
+			buf.WriteByte(byte(r))
+			if isDelimiter(byte(r), vr) {
+				g0, g1 = cs.g0, cs.g1
+			}
+			continue
+		}
+
+		var ce *dcmCodeElement
+		var code uint16
+		candidates := append([]*dcmCodeElement{g1, g0}, cs.declared...)
+		for _, c := range candidates {
+			if c == nil || (c.upper == nil && c.double == nil) {
+				continue
+			}
+			var ok bool
+			code, ok = c.encode(r)
+			if ok {
+				ce = c
+				break
+			}
+		}
+		if ce == nil {
+			return nil, errorEncode(r, cs.Terms)
+		}
+		if ce.isG1 && ce != g1 {
+			buf.WriteString(ce.escape)
+			g1 = ce
+		} else if !ce.isG1 && ce != g0 {
+			buf.WriteString(ce.escape)
+			g0 = ce
+		}
+		switch {
+		case ce.double != nil && ce.isG1:
+			buf.WriteByte(byte(code>>8) | 0x80)
+			buf.WriteByte(byte(code) | 0x80)
+		case ce.double != nil:
+			buf.WriteByte(byte(code >> 8))
+			buf.WriteByte(byte(code))
+		default:
+			buf.WriteByte(byte(code))
+		}
+	}
+	if g0 != cs.g0 {
+		buf.WriteString(cs.g0.escape)
+	}
+	return buf.Bytes(), nil
+}
+
+// the linear index of the first four-byte code of GB18030 outside the BMP, 0x90308130
+const gb18030SupplementaryIndex = 189000
+
+func decodeGB18030(value []byte) (string, error) {
+	var buf bytes.Buffer
+	var err error
+	for i := 0; i < len(value); i++ {
+		b := value[i]
+		if b < 0x80 {
+			buf.WriteByte(b)
+			continue
+		}
+		var r rune
+		if b != 0x80 && b != 0xFF && i+1 < len(value) {
+			t := value[i+1]
+			switch {
+			case t >= 0x40 && t <= 0xFE && t != 0x7F:
+				index := (int(b)-0x81)*190 + int(t) - 0x40
+				if t > 0x7F {
+					index--
+				}
+				r = rune(gb18030Table[index])
+				i++
+			case t >= 0x30 && t <= 0x39 && i+3 < len(value):
+				b3, b4 := value[i+2], value[i+3]
+				if b3 >= 0x81 && b3 <= 0xFE && b4 >= 0x30 && b4 <= 0x39 {
+					index := (((int(b)-0x81)*10+int(t)-0x30)*126+int(b3)-0x81)*10 + int(b4) - 0x30
+					r = gb18030FourByte(index)
+					i += 3
+				}
+			}
+		}
+		if r == 0 {
+			buf.WriteRune(utf8.RuneError)
+			if err == nil {
+				err = errorDecode(b)
+			}
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String(), err
+}
+
+// gb18030FourByte gets the code point of the linear index of a four-byte code.
+func gb18030FourByte(index int) rune {
+	if index >= gb18030SupplementaryIndex {
+		r := rune(0x10000 + index - gb18030SupplementaryIndex)
+		if r > utf8.MaxRune {
+			return 0
+		}
+		return r
+	}
+	n := sort.Search(len(gb18030Ranges), func(i int) bool {
+		return int(gb18030Ranges[i][0]) > index
+	}) - 1
+	if n < 0 || index > int(gb18030Ranges[len(gb18030Ranges)-1][0])+0xFFFF-int(gb18030Ranges[len(gb18030Ranges)-1][1]) {
+		return 0
+	}
+	return rune(int(gb18030Ranges[n][1]) + index - int(gb18030Ranges[n][0]))
+}
+
+var (
+	gb18030Once    sync.Once
+	gb18030Reverse map[rune]uint16
+)
+
+// encodeGB18030 encodes with GB18030, or with GBK which has no four-byte code.
+func (cs DcmCharset) encodeGB18030(value string) ([]byte, error) {
+	gb18030Once.Do(func() {
+		gb18030Reverse = make(map[rune]uint16)
+		for i, v := range gb18030Table {
+			if _, ok := gb18030Reverse[rune(v)]; v != 0 && !ok {
+				lead, trail := i/190+0x81, i%190+0x40
+				if trail >= 0x7F {
+					trail++
+				}
+				gb18030Reverse[rune(v)] = uint16(lead<<8 | trail)
+			}
+		}
+	})
+	var buf bytes.Buffer
+	for _, r := range value {
+		if r < 0x80 {
+			buf.WriteByte(byte(r))
+			continue
+		}
+		if code, ok := gb18030Reverse[r]; ok {
+			buf.WriteByte(byte(code >> 8))
+			buf.WriteByte(byte(code))
+			continue
+		}
+		index := -1
+		switch {
+		case cs.Mode == ECMGBK || (r >= 0xD800 && r <= 0xDFFF):
+		case r >= 0x10000:
+			index = gb18030SupplementaryIndex + int(r) - 0x10000
+		default:
+			n := sort.Search(len(gb18030Ranges), func(i int) bool {
+				return rune(gb18030Ranges[i][1]) > r
+			}) - 1
+			if n >= 0 {
+				index = int(gb18030Ranges[n][0]) + int(r) - int(gb18030Ranges[n][1])
+				if n+1 < len(gb18030Ranges) && index >= int(gb18030Ranges[n+1][0]) {
+					index = -1
+				}
+			}
+		}
+		if index < 0 {
+			return nil, errorEncode(r, cs.Terms)
+		}
+		b4 := index%10 + 0x30
+		index /= 10
+		b3 := index%126 + 0x81
+		index /= 126
+		b2 := index%10 + 0x30
+		b1 := index/10 + 0x81
+		buf.Write([]byte{byte(b1), byte(b2), byte(b3), byte(b4)})
+	}
+	return buf.Bytes(), nil
+}
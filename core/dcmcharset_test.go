@@ -0,0 +1,123 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+// the examples of PS3.5 Annex H, I, J and K
+var charsetCases = []struct {
+	charset string
+	in      []byte
+	want    string
+}{
+	{"", []byte("Doe^John"), "Doe^John"},
+	{"ISO_IR 100", []byte("Buc^J\xe9r\xf4me"), "Buc^Jérôme"},
+	{"ISO_IR 126", []byte("\xc4\xe9\xef\xed\xf5\xf3\xe9\xef\xf2"), "Διονυσιος"},
+	{"ISO_IR 144", []byte("\xbb\xee\xdace\xdc\xd1yp\xd3"), "Люкceмбypг"},
+	{"ISO_IR 192", []byte("Wang^XiaoDong=\xe7\x8e\x8b^\xe5\xb0\x8f\xe6\x9d\xb1="), "Wang^XiaoDong=王^小東="},
+	{"GB18030", []byte("Wang^XiaoDong=\xcd\xf5^\xd0\xa1\xb6\xab="), "Wang^XiaoDong=王^小东="},
+	{"GB18030", []byte("\x81\x30\x81\x30\x95\x32\x82\x36\xa2\xe3"), "\u0080𠀀€"},
+	{"\\ISO 2022 IR 87",
+		[]byte("Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B"),
+		"Yamada^Tarou=山田^太郎=やまだ^たろう"},
+	{"ISO 2022 IR 13\\ISO 2022 IR 87",
+		[]byte("\xd4\xcf\xc0\xde^\xc0\xdb\xb3=\x1b$B;3ED\x1b(J^\x1b$BB@O:\x1b(J=\x1b$B$d$^$@\x1b(J^\x1b$B$?$m$&\x1b(J"),
+		"ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう"},
+	{"\\ISO 2022 IR 149",
+		[]byte("Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf"),
+		"Hong^Gildong=洪^吉洞=홍^길동"},
+	{"\\ISO 2022 IR 58",
+		[]byte("Zhang^XiaoDong=\x1b$)A\xd5\xc5^\x1b$)A\xd0\xa1\xb6\xab="),
+		"Zhang^XiaoDong=张^小东="},
+}
+
+func TestDcmCharsetDecode(t *testing.T) {
+	for _, c := range charsetCases {
+		cs, err := NewDcmCharset(c.charset)
+		if err != nil {
+			t.Errorf("NewDcmCharset(%s): %s", c.charset, err.Error())
+			continue
+		}
+		got, err := cs.Decode(c.in, "PN")
+		if err != nil {
+			t.Errorf("Decode(%s): %s", c.charset, err.Error())
+		}
+		if got != c.want {
+			t.Errorf("Decode(%s), want '%s' got '%s'", c.charset, c.want, got)
+		}
+	}
+}
+
+func TestDcmCharsetEncode(t *testing.T) {
+	for _, c := range charsetCases {
+		cs, _ := NewDcmCharset(c.charset)
+		got, err := cs.Encode(c.want, "PN")
+		if err != nil {
+			t.Errorf("Encode(%s): %s", c.charset, err.Error())
+			continue
+		}
+		if !bytes.Equal(got, c.in) {
+			t.Errorf("Encode(%s), want '%q' got '%q'", c.charset, c.in, got)
+		}
+	}
+
+	cs, _ := NewDcmCharset("ISO_IR 100")
+	_, err := cs.Encode("山田", "PN")
+	if err == nil {
+		t.Errorf("Encode(ISO_IR 100) should fail to encode Japanese")
+	}
+}
+
+func TestDcmCharsetInvalid(t *testing.T) {
+	_, err := NewDcmCharset("ISO_IR 999")
+	if err == nil {
+		t.Errorf("NewDcmCharset(ISO_IR 999) should fail")
+	}
+	cs, _ := NewDcmCharset("\\ISO 2022 IR 87")
+	got, err := cs.Decode([]byte("A\xffB"), "LO")
+	if err == nil || got != "A\ufffdB" {
+		t.Errorf("Decode(), want 'A\ufffdB' with error got '%s' (%v)", got, err)
+	}
+}
+
+func TestDcmDatasetCharset(t *testing.T) {
+	c := charsetCases[7]
+	var dataset DcmDataset
+	err := dataset.Set(DCMSpecificCharacterSet, "", c.charset)
+	if err != nil {
+		t.Fatalf("Set(SpecificCharacterSet): %s", err.Error())
+	}
+	err = dataset.Set(DCMPatientName, "", c.want)
+	if err != nil {
+		t.Fatalf("Set(PatientName): %s", err.Error())
+	}
+	var elem DcmElement
+	elem.Tag = DCMPatientName
+	dataset.FindElement(&elem)
+	if !bytes.Equal(bytes.TrimRight(elem.Value, " "), c.in) {
+		t.Errorf("Set(PatientName), want '%q' got '%q'", c.in, elem.Value)
+	}
+	if dataset.PatientName() != c.want {
+		t.Errorf("PatientName(), want '%s' got '%s'", c.want, dataset.PatientName())
+	}
+
+	// the charset is used by the elements read after it
+	enc := dcmEncoder{isExplicitVR: true, byteOrder: byteOrderOf(EBOLittleEndian)}
+	value, err := enc.encodeDataset(dataset.Elements)
+	if err != nil {
+		t.Fatalf("encodeDataset(): %s", err.Error())
+	}
+	var result DcmDataset
+	err = result.Read(newDcmBufferStream(value), true, EBOLittleEndian, true, true)
+	if err != nil {
+		t.Fatalf("DcmDataset.Read(): %s", err.Error())
+	}
+	if result.PatientName() != c.want {
+		t.Errorf("PatientName() after reading, want '%s' got '%s'", c.want, result.PatientName())
+	}
+	names, _ := result.GetStrings(DCMPatientName)
+	if len(names) != 1 || names[0] != c.want {
+		t.Errorf("GetStrings(PatientName), want '%s' got %v", c.want, names)
+	}
+}
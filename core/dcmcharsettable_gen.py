@@ -0,0 +1,111 @@
+#!/usr/bin/env python3
+# This program generates dcmcharsettable.go from the codecs of Python. It is run by
+# go generate in the core folder.
+
+UPPER = [
+    ("latin1Upper", "iso8859_1", "ISO 8859-1, ISO-IR 100"),
+    ("latin2Upper", "iso8859_2", "ISO 8859-2, ISO-IR 101"),
+    ("latin3Upper", "iso8859_3", "ISO 8859-3, ISO-IR 109"),
+    ("latin4Upper", "iso8859_4", "ISO 8859-4, ISO-IR 110"),
+    ("cyrillicUpper", "iso8859_5", "ISO 8859-5, ISO-IR 144"),
+    ("arabicUpper", "iso8859_6", "ISO 8859-6, ISO-IR 127"),
+    ("greekUpper", "iso8859_7", "ISO 8859-7, ISO-IR 126"),
+    ("hebrewUpper", "iso8859_8", "ISO 8859-8, ISO-IR 138"),
+    ("latin5Upper", "iso8859_9", "ISO 8859-9, ISO-IR 148"),
+    ("thaiUpper", "iso8859_11", "TIS 620-2533, ISO-IR 166"),
+    ("latin9Upper", "iso8859_15", "ISO 8859-15, ISO-IR 203"),
+]
+
+
+def decode(b, codec):
+    """Returns the code point of the bytes b, or 0 if it is undefined."""
+    try:
+        s = b.decode(codec)
+    except UnicodeDecodeError:
+        return 0
+    if len(s) != 1 or ord(s) > 0xFFFF:
+        return 0
+    return ord(s)
+
+
+def table(name, values, comment, per_line=16):
+    lines = [comment, "var %s = [%d]uint16{" % (name, len(values))]
+    for i in range(0, len(values), per_line):
+        lines.append("\t" + " ".join("0x%04X," % v for v in values[i:i + per_line]))
+    lines.append("}")
+    return "\n".join(lines)
+
+
+def upper(name, codec, charset):
+    values = [decode(bytes([b]), codec) for b in range(0xA0, 0x100)]
+    comment = "// %s maps the bytes 0xA0-0xFF of %s." % (name, charset)
+    return table(name, values, comment)
+
+
+def table94(prefix, codec):
+    """Decodes the 94x94 codes, the bytes of a code are prefixed with prefix."""
+    values = []
+    for row in range(0x21, 0x7F):
+        for column in range(0x21, 0x7F):
+            values.append(decode(prefix + bytes([row | 0x80, column | 0x80]), codec))
+    return values
+
+
+def gb18030_two_bytes():
+    values = []
+    for lead in range(0x81, 0xFF):
+        for trail in range(0x40, 0xFF):
+            if trail == 0x7F:
+                continue
+            values.append(decode(bytes([lead, trail]), "gb18030"))
+    return values
+
+
+def gb18030_ranges():
+    """Returns the linear index and the code point of the first four-byte code of each
+    range of the consecutive code points in the BMP."""
+    ranges = []
+    last = None
+    index = 0
+    for b1 in range(0x81, 0x85):
+        for b2 in range(0x30, 0x3A):
+            for b3 in range(0x81, 0xFF):
+                for b4 in range(0x30, 0x3A):
+                    cp = decode(bytes([b1, b2, b3, b4]), "gb18030")
+                    if cp != 0 and (last is None or cp != last + 1):
+                        ranges.append((index, cp))
+                    last = cp if cp != 0 else None
+                    index += 1
+    return ranges
+
+
+def main():
+    parts = ["// Code generated by dcmcharsettable_gen.py from the codecs of Python; DO NOT EDIT.\n\n" +
+             "package core\n\n// Undefined codes are 0. TIS 620 is decoded as ISO 8859-11, which maps 0xA0 to NBSP."]
+    for name, codec, charset in UPPER:
+        parts.append(upper(name, codec, charset))
+    parts.append(table("jisx0208Table", table94(b"", "euc_jp"),
+                       "// jisx0208Table maps the 94x94 codes of JIS X 0208, ISO-IR 87, by (row-0x21)*94+(column-0x21)."))
+    parts.append(table("jisx0212Table", table94(b"\x8f", "euc_jp"),
+                       "// jisx0212Table maps the 94x94 codes of JIS X 0212, ISO-IR 159."))
+    parts.append(table("ksx1001Table", table94(b"", "euc_kr"),
+                       "// ksx1001Table maps the 94x94 codes of KS X 1001, ISO-IR 149."))
+    parts.append(table("gb18030Table", gb18030_two_bytes(),
+                       "// gb18030Table maps the two-byte codes of GB18030 by (lead-0x81)*190+trail-(0x40 or 0x41).\n" +
+                       "// GB2312, ISO-IR 58, and GBK are subsets of it."))
+
+    ranges = gb18030_ranges()
+    lines = ["// gb18030Ranges contains the linear index of the first four-byte code of each range of",
+             "// the code points in the BMP, and the first code point of the range.",
+             "var gb18030Ranges = [%d][2]uint32{" % len(ranges)]
+    for i in range(0, len(ranges), 6):
+        lines.append("\t" + " ".join("{%d, 0x%04X}," % r for r in ranges[i:i + 6]))
+    lines.append("}")
+    parts.append("\n".join(lines))
+
+    with open("dcmcharsettable.go", "w") as f:
+        f.write("\n\n".join(parts) + "\n")
+
+
+if __name__ == "__main__":
+    main()
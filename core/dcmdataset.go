@@ -1,17 +1,31 @@
 package core
 
 import (
+	"bytes"
 	"errors"
-	_ "log" // for debug
+	"log"
 	"strings"
 )
 
 // DcmDataset is to contain the DICOM dataset from file
 type DcmDataset struct {
 	Elements []DcmElement
+
+	// the positions of the elements by tag, built by Read, Set and Delete
+	index map[DcmTag]int
+
+	// the specific character set of the text elements, nil for the default repertoire
+	charset *DcmCharset
 }
 
 func (dataset *DcmDataset) Read(stream *DcmFileStream, isExplicitVR bool, byteOrder EByteOrder, isReadValue bool, isReadPixel bool) error {
+	return dataset.readUntil(stream, isExplicitVR, byteOrder, isReadValue, isReadPixel, nil)
+}
+
+// readUntil reads the elements until the end of the stream, or until the element
+// for which stop returns true. The element is put back to the stream.
+func (dataset *DcmDataset) readUntil(stream *DcmFileStream, isExplicitVR bool, byteOrder EByteOrder, isReadValue bool, isReadPixel bool, stop func(tag DcmTag) bool) error {
+	creators := make(dcmPrivateCreators)
 	for !stream.Eos() {
 		//	for range [12]int{} {
 		var elem DcmElement
@@ -19,19 +33,70 @@ func (dataset *DcmDataset) Read(stream *DcmFileStream, isExplicitVR bool, byteOr
 		elem.byteOrder = byteOrder
 		elem.isReadValue = isReadValue
 		elem.isReadPixel = isReadPixel
+		elem.privateCreators = creators
+		elem.charset = dataset.charset
+
+		if stop != nil {
+			err := elem.ReadDcmTag(stream)
+			if err != nil {
+				return err
+			}
+			err = stream.Putback(4)
+			if err != nil {
+				return err
+			}
+			if stop(elem.Tag) {
+				break
+			}
+		}
 
 		err := elem.ReadDcmElement(stream)
 		if err != nil {
 			return err
 		}
+		if elem.Tag == DCMSpecificCharacterSet {
+			dataset.setCharset(elem)
+		}
 		//		log.Println(elem)
 		dataset.Elements = append(dataset.Elements, elem)
 	}
+	dataset.reindex()
 	return nil
 }
 
+// setCharset sets the specific character set of the elements following the element.
+func (dataset *DcmDataset) setCharset(elem DcmElement) {
+	var err error
+	dataset.charset, err = NewDcmCharset(string(bytes.Trim(elem.Value, "\x00 ")))
+	if err != nil {
+		log.Println(err.Error())
+	}
+}
+
+// Charset gets the specific character set of the data set, which is nil for the
+// default repertoire.
+func (dataset DcmDataset) Charset() *DcmCharset {
+	return dataset.charset
+}
+
+// reindex builds the index of the elements by tag.
+func (dataset *DcmDataset) reindex() {
+	dataset.index = make(map[DcmTag]int, len(dataset.Elements))
+	for i, v := range dataset.Elements {
+		if _, ok := dataset.index[v.Tag]; !ok {
+			dataset.index[v.Tag] = i
+		}
+	}
+}
+
 // FindElement find the element information from the data set
 func (dataset DcmDataset) FindElement(e *DcmElement) error {
+	// the index is checked, since the elements may be changed without updating it
+	i, ok := dataset.index[e.Tag]
+	if ok && i < len(dataset.Elements) && dataset.Elements[i].Tag == e.Tag {
+		*e = dataset.Elements[i]
+		return nil
+	}
 	for _, v := range dataset.Elements {
 		if e.Tag == v.Tag {
 			*e = v
@@ -164,6 +229,8 @@ func (dataset DcmDataset) RescaleSlope() string {
 }
 
 // PixelData get the pixel data of the dicom image.
+// The fragments of encapsulated pixel data are concatenated, use
+// EncapsulatedPixelData to get the fragments of each frame.
 func (dataset DcmDataset) PixelData() []byte {
 	var elem DcmElement
 	elem.Tag = DCMPixelData
@@ -172,15 +239,144 @@ func (dataset DcmDataset) PixelData() []byte {
 		return nil
 	}
 	if elem.Squence != nil {
-		var maxLengthIndex int
-		var maxLength int64
-		for i, v := range elem.Squence.Item {
-			if maxLength < v.Length {
-				maxLength = v.Length
-				maxLengthIndex = i
-			}
+		pd, err := dataset.EncapsulatedPixelData()
+		if err != nil || len(pd.Fragments) == 0 {
+			return nil
+		}
+		if len(pd.Fragments) == 1 {
+			return pd.Fragments[0]
 		}
-		return elem.Squence.Item[maxLengthIndex].Value
+		return bytes.Join(pd.Fragments, nil)
 	}
 	return elem.Value
 }
+
+// LoadElement reads the value of the element of the tag, if it is not read, e.g. the
+// pixel data of a file read without IsReadPixel.
+func (dataset *DcmDataset) LoadElement(tag DcmTag) error {
+	for i, v := range dataset.Elements {
+		if v.Tag == tag {
+			return dataset.Elements[i].Load()
+		}
+	}
+	str := "not find the tag '" + tag.String() + "' in the data set"
+	return errors.New(str)
+}
+
+// Set replaces the value of the element of the tag, or inserts a new element in the
+// ascending order of the tags. If vr is empty, the VR of the data dictionary is used,
+// and an ambiguous VR, e.g. "OB or OW" of the pixel data, is resolved with BitsAllocated
+// and PixelRepresentation of the data set, which are to be set first.
+// The value is encoded with encodeValue, or is a []DcmDataset containing the items of
+// a SQ element.
+func (dataset *DcmDataset) Set(tag DcmTag, vr string, value interface{}) error {
+	var elem DcmElement
+	elem.Tag = tag
+	err := FindDcmElmentByTag(&elem)
+	if vr != "" {
+		elem.VR = vr
+	} else if err != nil {
+		return err
+	} else {
+		elem.VR = newDcmEncoder(DcmXfer{}, *dataset).resolveVR(elem)
+	}
+	if _, ok := vrUnitSizes[elem.VR]; !ok {
+		str := "DcmDataset: the VR '" + elem.VR + "' of the tag '" + tag.String() + "' is not valid"
+		return errors.New(str)
+	}
+	elem.isExplicitVR = true
+	elem.byteOrder = EBOLittleEndian
+	elem.charset = dataset.charset
+
+	if isCharsetVR(elem.VR) && dataset.charset != nil {
+		value, err = dataset.encodeText(elem.VR, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if elem.VR == "SQ" {
+		items, ok := value.([]DcmDataset)
+		if !ok && value != nil {
+			return errorEncodeValue(elem.VR, value)
+		}
+		elem.Squence = &DcmSQElement{isExplicitVR: true, byteOrder: EBOLittleEndian}
+		for i, item := range items {
+			err = elem.Squence.InsertItem(i, item)
+			if err != nil {
+				return err
+			}
+		}
+		elem.Length = 0xFFFFFFFF
+	} else {
+		elem.Value, err = encodeValue(elem.VR, value)
+		if err != nil {
+			return err
+		}
+		elem.Length = int64(len(elem.Value))
+	}
+	if tag == DCMSpecificCharacterSet {
+		dataset.setCharset(elem)
+	}
+	dataset.Elements = setElement(dataset.Elements, elem)
+	dataset.reindex()
+	return nil
+}
+
+// encodeText encodes the string values of a text VR with the specific character set.
+func (dataset DcmDataset) encodeText(vr string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return dataset.charset.Encode(v, vr)
+	case []string:
+		return dataset.charset.Encode(strings.Join(v, "\\"), vr)
+	case PersonName, []PersonName:
+		pn, err := encodeValue(vr, v)
+		if err != nil {
+			return nil, err
+		}
+		return dataset.charset.Encode(strings.TrimRight(string(pn), " "), vr)
+	}
+	return value, nil
+}
+
+// Delete removes the element of the tag from the data set.
+func (dataset *DcmDataset) Delete(tag DcmTag) error {
+	for _, v := range dataset.Elements {
+		if v.Tag == tag {
+			dataset.Elements = removeElement(dataset.Elements, tag)
+			dataset.reindex()
+			return nil
+		}
+	}
+	str := "not find the tag '" + tag.String() + "' in the data set"
+	return errors.New(str)
+}
+
+// setElement replaces the element with the same tag, or inserts the element in the
+// ascending order of the tags.
+func setElement(elements []DcmElement, elem DcmElement) []DcmElement {
+	for i, v := range elements {
+		if v.Tag == elem.Tag {
+			elements[i] = elem
+			return elements
+		}
+		if v.Tag.IsAfter(elem.Tag) {
+			elements = append(elements, DcmElement{})
+			copy(elements[i+1:], elements[i:])
+			elements[i] = elem
+			return elements
+		}
+	}
+	return append(elements, elem)
+}
+
+// removeElement removes the element with the tag if it exists.
+func removeElement(elements []DcmElement, tag DcmTag) []DcmElement {
+	for i, v := range elements {
+		if v.Tag == tag {
+			return append(elements[:i], elements[i+1:]...)
+		}
+	}
+	return elements
+}
@@ -0,0 +1,252 @@
+package core
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func TestDcmDatasetSet(t *testing.T) {
+	ts := time.Date(2016, 3, 7, 13, 5, 9, 250000000, time.FixedZone("", -5*3600))
+	cases := []struct {
+		tag   DcmTag
+		vr    string
+		value interface{}
+		want  []byte
+	}{
+		{DCMPatientName, "", "Doe^John", []byte("Doe^John")},
+		{DCMPatientID, "", "123", []byte("123 ")},
+		{DCMImageType, "", []string{"ORIGINAL", "PRIMARY"}, []byte("ORIGINAL\\PRIMARY")},
+		{DCMSOPInstanceUID, "", "1.2.3", []byte("1.2.3\x00")},
+		{DCMStudyDate, "", ts, []byte("20160307")},
+		{DCMStudyTime, "", ts, []byte("130509.25 ")},
+		{DCMAcquisitionDateTime, "", ts, []byte("20160307130509.25-0500")},
+		{DCMRows, "", 512, []byte{0x00, 0x02}},
+		{DCMColumns, "", []uint16{1, 2}, []byte{0x01, 0x00, 0x02, 0x00}},
+		{DCMWindowCenter, "", []float64{40, -1024.5}, []byte("40\\-1024.5")},
+		{DCMPixelSpacing, "", 0.1234567890123456789, []byte("0.12345678901235")},
+		{DCMNumberOfFrames, "", 16, []byte("16")},
+		{DcmTag{0x0009, 0x1001}, "FL", float32(1), []byte{0x00, 0x00, 0x80, 0x3F}},
+		{DcmTag{0x0009, 0x1002}, "SS", -2, []byte{0xFE, 0xFF}},
+		{DcmTag{0x0009, 0x1003}, "OB", []byte{0x01}, []byte{0x01, 0x00}},
+		{DCMPatientName, "", nil, []byte{}},
+	}
+	for _, c := range cases {
+		var dataset DcmDataset
+		err := dataset.Set(c.tag, c.vr, c.value)
+		if err != nil {
+			t.Errorf("Set(%s, %v): %s", c.tag, c.value, err.Error())
+			continue
+		}
+		var elem DcmElement
+		elem.Tag = c.tag
+		err = dataset.FindElement(&elem)
+		if err != nil || !bytes.Equal(elem.Value, c.want) || elem.Length != int64(len(c.want)) {
+			t.Errorf("Set(%s, %v), want '%s' got '%s'", c.tag, c.value, c.want, elem.Value)
+		}
+	}
+
+	errors := []struct {
+		tag   DcmTag
+		vr    string
+		value interface{}
+	}{
+		{DCMRows, "", "512"},
+		{DCMPatientName, "", 1.5},
+		{DCMStudyDate, "", []uint16{1}},
+		{DCMPatientName, "XX", "a"},
+		{DcmTag{0x0009, 0x1001}, "", "a"},
+		{DcmTag{0x0009, 0x1002}, "SS", struct{}{}},
+	}
+	for _, c := range errors {
+		var dataset DcmDataset
+		err := dataset.Set(c.tag, c.vr, c.value)
+		if err == nil {
+			t.Errorf("Set(%s, %s, %v) should fail", c.tag, c.vr, c.value)
+		}
+	}
+}
+
+func TestDcmDatasetSetAmbiguousVR(t *testing.T) {
+	cases := []struct {
+		bitsAllocated       int
+		pixelRepresentation int
+		tag                 DcmTag
+		value               interface{}
+		vr                  string
+		want                []byte
+	}{
+		{8, 0, DCMPixelData, []byte{0x01, 0x02}, "OB", []byte{0x01, 0x02}},
+		{16, 0, DCMPixelData, []byte{0x01, 0x02}, "OW", []byte{0x01, 0x02}},
+		{0, 0, DCMPixelData, []uint16{0x0201}, "OW", []byte{0x01, 0x02}},
+		{16, 0, DCMSmallestImagePixelValue, 65535, "US", []byte{0xFF, 0xFF}},
+		{16, 1, DCMSmallestImagePixelValue, -1, "SS", []byte{0xFF, 0xFF}},
+	}
+	for _, c := range cases {
+		var dataset DcmDataset
+		if c.bitsAllocated != 0 {
+			dataset.Set(DCMBitsAllocated, "", c.bitsAllocated)
+			dataset.Set(DCMPixelRepresentation, "", c.pixelRepresentation)
+		}
+		err := dataset.Set(c.tag, "", c.value)
+		if err != nil {
+			t.Errorf("Set(%s, %v) with BitsAllocated %d: %s", c.tag, c.value, c.bitsAllocated, err.Error())
+			continue
+		}
+		var elem DcmElement
+		elem.Tag = c.tag
+		err = dataset.FindElement(&elem)
+		if err != nil || elem.VR != c.vr || !bytes.Equal(elem.Value, c.want) {
+			t.Errorf("Set(%s, %v) with BitsAllocated %d and PixelRepresentation %d, want %s % X got %s % X", c.tag, c.value, c.bitsAllocated, c.pixelRepresentation, c.vr, c.want, elem.VR, elem.Value)
+		}
+	}
+}
+
+func TestDcmDatasetSetOrder(t *testing.T) {
+	var dataset DcmDataset
+	tags := []DcmTag{DCMRows, DCMPatientID, DCMStudyDate, DCMPatientName, DCMColumns}
+	values := []interface{}{512, "1", "20160307", "A^B", 256}
+	for i, tag := range tags {
+		err := dataset.Set(tag, "", values[i])
+		if err != nil {
+			t.Fatalf("Set(%s): %s", tag, err.Error())
+		}
+	}
+	err := dataset.Set(DCMPatientID, "", "2")
+	if err != nil {
+		t.Fatalf("Set(%s): %s", DCMPatientID, err.Error())
+	}
+	want := []DcmTag{DCMStudyDate, DCMPatientName, DCMPatientID, DCMRows, DCMColumns}
+	var got []DcmTag
+	for _, v := range dataset.Elements {
+		got = append(got, v.Tag)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Set(), want the tags %v got %v", want, got)
+	}
+	if dataset.PatientID() != "2" {
+		t.Errorf("Set(), want PatientID '2' got '%s'", dataset.PatientID())
+	}
+
+	err = dataset.Delete(DCMPatientName)
+	if err != nil || len(dataset.Elements) != 4 || dataset.PatientName() != "" {
+		t.Errorf("Delete(%s), the element is not removed (%v)", DCMPatientName, err)
+	}
+	err = dataset.Delete(DCMPatientName)
+	if err == nil {
+		t.Errorf("Delete(%s) should fail if the element does not exist", DCMPatientName)
+	}
+}
+
+func TestDcmDatasetSetWrite(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	dataset := DcmDataset{Elements: append([]DcmElement(nil), reader.Dataset.Elements...)}
+	var item DcmDataset
+	item.Set(DCMSOPInstanceUID, "", "1.2.3.4")
+	err := dataset.Set(DCMReferencedImageSequence, "", []DcmDataset{item, item})
+	if err != nil {
+		t.Fatalf("Set(SQ): %s", err.Error())
+	}
+	err = dataset.Set(DCMPatientName, "", "Anonymous")
+	if err != nil {
+		t.Fatalf("Set(%s): %s", DCMPatientName, err.Error())
+	}
+
+	for _, xfer := range []string{UIDLittleEndianImplicitTransferSyntax, UIDBigEndianExplicitTransferSyntax} {
+		var buf bytes.Buffer
+		writer := DcmWriter{Meta: reader.Meta, Dataset: dataset}
+		err = writer.Write(&buf, xfer)
+		if err != nil {
+			t.Fatalf("DcmWriter.Write(%s): %s", xfer, err.Error())
+		}
+		var result DcmReader
+		result.IsReadValue = true
+		result.IsReadPixel = true
+		err = result.Read(&buf)
+		if err != nil {
+			t.Fatalf("read %s: %s", xfer, err.Error())
+		}
+		if result.Dataset.PatientName() != "Anonymous" {
+			t.Errorf("PatientName() %s, want 'Anonymous' got '%s'", xfer, result.Dataset.PatientName())
+		}
+		var elem DcmElement
+		elem.Tag = DCMReferencedImageSequence
+		err = result.Dataset.FindElement(&elem)
+		if err != nil || elem.Squence == nil || elem.Squence.NumberOfItems() != 2 {
+			t.Errorf("read the sequence %s, want 2 items (%v)", xfer, err)
+			continue
+		}
+		got, err := elem.Squence.GetItem(1)
+		if err != nil || got.SOPInstanceUID() != "1.2.3.4" {
+			t.Errorf("GetItem(1) %s, want SOPInstanceUID '1.2.3.4' got '%s' (%v)", xfer, got.SOPInstanceUID(), err)
+		}
+	}
+}
+
+func TestDcmSQElementItems(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"DICOMDIR")
+	var elem DcmElement
+	elem.Tag = DCMDirectoryRecordSequence
+	err := reader.Dataset.FindElement(&elem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sq := elem.Squence
+	num := sq.NumberOfItems()
+	if num < 2 {
+		t.Fatalf("NumberOfItems(), want at least 2 items got %d", num)
+	}
+	first, err := sq.GetItem(0)
+	if err != nil {
+		t.Fatalf("GetItem(0): %s", err.Error())
+	}
+	second, err := sq.GetItem(1)
+	if err != nil {
+		t.Fatalf("GetItem(1): %s", err.Error())
+	}
+
+	// move the first item to the end
+	err = sq.RemoveItem(0)
+	if err != nil {
+		t.Fatalf("RemoveItem(0): %s", err.Error())
+	}
+	err = sq.InsertItem(num-1, first)
+	if err != nil {
+		t.Fatalf("InsertItem(%d): %s", num-1, err.Error())
+	}
+	if sq.NumberOfItems() != num {
+		t.Errorf("NumberOfItems(), want %d got %d", num, sq.NumberOfItems())
+	}
+
+	enc := dcmEncoder{isExplicitVR: true, byteOrder: EBOLittleEndian}
+	for i, want := range map[int]DcmDataset{0: second, num - 1: first} {
+		got, err := sq.GetItem(i)
+		if err != nil {
+			t.Errorf("GetItem(%d): %s", i, err.Error())
+			continue
+		}
+		a, _ := enc.encodeDataset(want.Elements)
+		b, _ := enc.encodeDataset(got.Elements)
+		if !bytes.Equal(a, b) {
+			t.Errorf("GetItem(%d), the item is changed", i)
+		}
+	}
+
+	for _, i := range []int{-1, num} {
+		_, err = sq.GetItem(i)
+		if err == nil {
+			t.Errorf("GetItem(%d) should fail", i)
+		}
+		err = sq.RemoveItem(i)
+		if err == nil {
+			t.Errorf("RemoveItem(%d) should fail", i)
+		}
+	}
+	err = sq.InsertItem(num+1, first)
+	if err == nil {
+		t.Errorf("InsertItem(%d) should fail", num+1)
+	}
+}
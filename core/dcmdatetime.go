@@ -0,0 +1,381 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EDateTimePrecision is the precision of a DA, TM or DT value, which may omit the
+// trailing components, e.g. "2020" or "1530".
+type EDateTimePrecision int
+
+const (
+	EDTPYear EDateTimePrecision = iota
+	EDTPMonth
+	EDTPDay
+	EDTPHour
+	EDTPMinute
+	EDTPSecond
+	EDTPFraction
+)
+
+// DcmDateTime is a parsed DA, TM or DT value. The date of a TM value is January 1 of
+// year 0.
+type DcmDateTime struct {
+	// Time is the earliest time of the value, e.g. 2020-01-01 00:00:00 for "2020".
+	Time      time.Time
+	Precision EDateTimePrecision
+
+	// HasOffset is true if the value has the offset from UTC, e.g. "+0800".
+	HasOffset bool
+
+	// fractionDigits is the number of the digits of the fractional seconds
+	fractionDigits int
+}
+
+// Latest gets the latest time of the value, e.g. 2020-12-31 23:59:59.999999999 for "2020".
+func (dt DcmDateTime) Latest() time.Time {
+	t := dt.Time
+	switch dt.Precision {
+	case EDTPYear:
+		t = t.AddDate(1, 0, 0)
+	case EDTPMonth:
+		t = t.AddDate(0, 1, 0)
+	case EDTPDay:
+		t = t.AddDate(0, 0, 1)
+	case EDTPHour:
+		t = t.Add(time.Hour)
+	case EDTPMinute:
+		t = t.Add(time.Minute)
+	case EDTPSecond:
+		t = t.Add(time.Second)
+	case EDTPFraction:
+		d := time.Nanosecond
+		for i := dt.fractionDigits; i < 9; i++ {
+			d *= 10
+		}
+		t = t.Add(d)
+	}
+	return t.Add(-time.Nanosecond)
+}
+
+// Contains is to check whether the time is in the period of the value.
+func (dt DcmDateTime) Contains(t time.Time) bool {
+	return !t.Before(dt.Time) && !t.After(dt.Latest())
+}
+
+// ParseDA parses a DA value "YYYYMMDD", or "YYYY.MM.DD" of ACR-NEMA. The date is in the
+// location loc, or in UTC if loc is nil.
+func ParseDA(value string, loc *time.Location) (DcmDateTime, error) {
+	v := strings.TrimSpace(value)
+	if len(v) == 10 && v[4] == '.' && v[7] == '.' {
+		v = v[:4] + v[5:7] + v[8:]
+	}
+	if len(v) != 8 {
+		return DcmDateTime{}, errorDateTime("DA", value)
+	}
+	return parseDateTime(v, "DA", value, loc)
+}
+
+// ParseTM parses a TM value "HH[MM[SS[.FFFFFF]]]", or "HH:MM:SS.FFFFFF" of ACR-NEMA.
+// The time is in the location loc, or in UTC if loc is nil.
+func ParseTM(value string, loc *time.Location) (DcmDateTime, error) {
+	v := strings.Replace(strings.TrimSpace(value), ":", "", -1)
+	if len(v) < 2 || strings.IndexAny(v, "+-") >= 0 {
+		return DcmDateTime{}, errorDateTime("TM", value)
+	}
+	return parseDateTime("00000101"+v, "TM", value, loc)
+}
+
+// ParseDT parses a DT value "YYYY[MM[DD[HH[MM[SS[.FFFFFF]]]]]][&ZZXX]". If the value
+// has no offset from UTC, the time is in the location loc, or in UTC if loc is nil.
+func ParseDT(value string, loc *time.Location) (DcmDateTime, error) {
+	return parseDateTime(strings.TrimSpace(value), "DT", value, loc)
+}
+
+// ParseTimezoneOffset parses the offset from UTC "&ZZXX", e.g. "-0500", as a location.
+func ParseTimezoneOffset(value string) (*time.Location, error) {
+	v := strings.TrimSpace(value)
+	if len(v) != 5 || (v[0] != '+' && v[0] != '-') || !isDigits(v[1:]) {
+		return nil, errorDateTime("offset from UTC", value)
+	}
+	hour, _ := strconv.Atoi(v[1:3])
+	minute, _ := strconv.Atoi(v[3:5])
+	if hour > 14 || minute > 59 {
+		return nil, errorDateTime("offset from UTC", value)
+	}
+	offset := hour*3600 + minute*60
+	if v[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(v, offset), nil
+}
+
+// parseDateTime parses the DT value v, the original value of the VR is for the errors.
+func parseDateTime(v string, vr string, value string, loc *time.Location) (DcmDateTime, error) {
+	var result DcmDateTime
+	if loc == nil {
+		loc = time.UTC
+	}
+	if i := strings.IndexAny(v, "+-"); i >= 0 {
+		offset, err := ParseTimezoneOffset(v[i:])
+		if err != nil {
+			return result, errorDateTime(vr, value)
+		}
+		v = v[:i]
+		loc = offset
+		result.HasOffset = true
+	}
+
+	fraction := ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		fraction = v[i+1:]
+		v = v[:i]
+		if len(v) != 14 || len(fraction) == 0 || len(fraction) > 6 || !isDigits(fraction) {
+			return result, errorDateTime(vr, value)
+		}
+	}
+	if len(v) < 4 || len(v) > 14 || len(v)%2 != 0 || !isDigits(v) {
+		return result, errorDateTime(vr, value)
+	}
+
+	// year, month, day, hour, minute and second
+	components := []int{0, 1, 1, 0, 0, 0}
+	components[0], _ = strconv.Atoi(v[:4])
+	for i := 4; i < len(v); i += 2 {
+		components[i/2-1], _ = strconv.Atoi(v[i : i+2])
+	}
+	result.Precision = EDateTimePrecision(len(v)/2 - 2)
+	if fraction != "" {
+		result.Precision = EDTPFraction
+		result.fractionDigits = len(fraction)
+	}
+	nsec := 0
+	if fraction != "" {
+		nsec, _ = strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+	}
+
+	year, month, day := components[0], components[1], components[2]
+	hour, minute, second := components[3], components[4], components[5]
+	if month < 1 || month > 12 || day < 1 || hour > 23 || minute > 59 || second > 60 {
+		return result, errorDateTime(vr, value)
+	}
+	result.Time = time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc)
+	if result.Time.Day() != day && second != 60 {
+		// e.g. February 30
+		return result, errorDateTime(vr, value)
+	}
+	return result, nil
+}
+
+// CombineDateTime combines a DA value and a TM value, e.g. StudyDate and StudyTime, as
+// a DT value. The time is optional.
+func CombineDateTime(date string, tm string, loc *time.Location) (DcmDateTime, error) {
+	da, err := ParseDA(date, loc)
+	if err != nil {
+		return da, err
+	}
+	if strings.TrimSpace(tm) == "" {
+		return da, nil
+	}
+	t, err := ParseTM(tm, loc)
+	if err != nil {
+		return t, err
+	}
+	y, m, d := da.Time.Date()
+	t.Time = time.Date(y, m, d, t.Time.Hour(), t.Time.Minute(), t.Time.Second(), t.Time.Nanosecond(), t.Time.Location())
+	return t, nil
+}
+
+// DcmAge is a parsed AS value, e.g. "045Y".
+type DcmAge struct {
+	Value int
+
+	// Unit is 'D' for days, 'W' for weeks, 'M' for months and 'Y' for years.
+	Unit byte
+}
+
+// ParseAS parses an AS value "nnnD", "nnnW", "nnnM" or "nnnY".
+func ParseAS(value string) (DcmAge, error) {
+	v := strings.TrimSpace(value)
+	if len(v) != 4 || !isDigits(v[:3]) || strings.IndexByte("DWMY", v[3]) < 0 {
+		return DcmAge{}, errorDateTime("AS", value)
+	}
+	n, _ := strconv.Atoi(v[:3])
+	return DcmAge{Value: n, Unit: v[3]}, nil
+}
+
+// String encodes the age as an AS value.
+func (a DcmAge) String() string {
+	return fmt.Sprintf("%03d%c", a.Value, a.Unit)
+}
+
+// BirthDate gets the date of birth of someone who is of the age at the time t, e.g.
+// at the StudyDate.
+func (a DcmAge) BirthDate(t time.Time) time.Time {
+	switch a.Unit {
+	case 'D':
+		return t.AddDate(0, 0, -a.Value)
+	case 'W':
+		return t.AddDate(0, 0, -7*a.Value)
+	case 'M':
+		return t.AddDate(0, -a.Value, 0)
+	}
+	return t.AddDate(-a.Value, 0, 0)
+}
+
+// DcmDateTimeRange is a range of DA, TM or DT values for query matching, see PS3.4
+// C.2.2.2.5. Start or End is nil if the range is open at that end.
+type DcmDateTimeRange struct {
+	Start *DcmDateTime
+	End   *DcmDateTime
+}
+
+// ParseDateTimeRange parses a range of the VR DA, TM or DT, "A-B", "A-" or "-B". A single
+// value is a range from the earliest to the latest time of the value.
+func ParseDateTimeRange(value string, vr string, loc *time.Location) (DcmDateTimeRange, error) {
+	var parse func(string, *time.Location) (DcmDateTime, error)
+	switch vr {
+	case "DA":
+		parse = ParseDA
+	case "TM":
+		parse = ParseTM
+	case "DT":
+		parse = ParseDT
+	default:
+		return DcmDateTimeRange{}, errors.New("DcmDateTimeRange: the VR '" + vr + "' is not DA, TM or DT")
+	}
+	v := strings.TrimSpace(value)
+
+	// the offset of a DT value may be '-', so the separator is the first one which
+	// splits the value into a valid range
+	for i := 0; i < len(v); i++ {
+		if v[i] != '-' {
+			continue
+		}
+		var r DcmDateTimeRange
+		if start := v[:i]; start != "" {
+			dt, err := parse(start, loc)
+			if err != nil {
+				continue
+			}
+			r.Start = &dt
+		}
+		if end := v[i+1:]; end != "" {
+			dt, err := parse(end, loc)
+			if err != nil {
+				continue
+			}
+			r.End = &dt
+		}
+		if r.Start == nil && r.End == nil {
+			continue
+		}
+		if r.Start != nil && r.End != nil && r.End.Latest().Before(r.Start.Time) {
+			continue
+		}
+		return r, nil
+	}
+
+	dt, err := parse(v, loc)
+	if err != nil {
+		return DcmDateTimeRange{}, errorDateTime(vr+" range", value)
+	}
+	return DcmDateTimeRange{Start: &dt, End: &dt}, nil
+}
+
+// Contains is to check whether the time matches the range.
+func (r DcmDateTimeRange) Contains(t time.Time) bool {
+	if r.Start != nil && t.Before(r.Start.Time) {
+		return false
+	}
+	if r.End != nil && t.After(r.End.Latest()) {
+		return false
+	}
+	return true
+}
+
+// TimezoneOffset gets the location of Timezone Offset From UTC (0008,0201), or nil if
+// it is not in the data set.
+func (dataset DcmDataset) TimezoneOffset() *time.Location {
+	v := dataset.GetElementValue(DCMTimezoneOffsetFromUTC)
+	if v == "" {
+		return nil
+	}
+	loc, err := ParseTimezoneOffset(v)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// GetDateTime gets the first value of the DA, TM or DT element of the tag. The values
+// without the offset from UTC are in the location of Timezone Offset From UTC.
+func (dataset DcmDataset) GetDateTime(tag DcmTag) (DcmDateTime, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return DcmDateTime{}, err
+	}
+	values, err := elem.GetStrings()
+	if err != nil {
+		return DcmDateTime{}, err
+	}
+	if len(values) == 0 {
+		str := "DcmElement: the tag '" + tag.String() + "' has no value"
+		return DcmDateTime{}, errors.New(str)
+	}
+	loc := dataset.TimezoneOffset()
+	switch elem.VR {
+	case "DA":
+		return ParseDA(values[0], loc)
+	case "TM":
+		return ParseTM(values[0], loc)
+	case "DT":
+		return ParseDT(values[0], loc)
+	}
+	return DcmDateTime{}, elem.errorVR("DcmDateTime")
+}
+
+// GetDateAndTime combines the values of a DA and a TM element, e.g. StudyDate and
+// StudyTime. The time element is optional.
+func (dataset DcmDataset) GetDateAndTime(dateTag DcmTag, timeTag DcmTag) (DcmDateTime, error) {
+	date := dataset.GetElementValue(dateTag)
+	if date == "" {
+		str := "DcmDataset: the tag '" + dateTag.String() + "' has no value"
+		return DcmDateTime{}, errors.New(str)
+	}
+	return CombineDateTime(date, dataset.GetElementValue(timeTag), dataset.TimezoneOffset())
+}
+
+// GetAge gets the value of the AS element of the tag.
+func (dataset DcmDataset) GetAge(tag DcmTag) (DcmAge, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return DcmAge{}, err
+	}
+	if elem.VR != "AS" {
+		return DcmAge{}, elem.errorVR("DcmAge")
+	}
+	return ParseAS(elem.GetValueString())
+}
+
+func errorDateTime(vr string, value string) error {
+	str := "DcmDateTime: '" + value + "' is not a valid " + vr + " value"
+	return errors.New(str)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
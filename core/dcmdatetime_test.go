@@ -0,0 +1,197 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTime(t *testing.T) {
+	est := time.FixedZone("-0500", -5*3600)
+	cases := []struct {
+		vr        string
+		in        string
+		want      time.Time
+		latest    time.Time
+		precision EDateTimePrecision
+	}{
+		{"DA", "20200229", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 29, 23, 59, 59, 999999999, time.UTC), EDTPDay},
+		{"DA", "1993.08.22", time.Date(1993, 8, 22, 0, 0, 0, 0, time.UTC), time.Date(1993, 8, 22, 23, 59, 59, 999999999, time.UTC), EDTPDay},
+		{"TM", "07", time.Date(0, 1, 1, 7, 0, 0, 0, time.UTC), time.Date(0, 1, 1, 7, 59, 59, 999999999, time.UTC), EDTPHour},
+		{"TM", "1010", time.Date(0, 1, 1, 10, 10, 0, 0, time.UTC), time.Date(0, 1, 1, 10, 10, 59, 999999999, time.UTC), EDTPMinute},
+		{"TM", "070907.0705 ", time.Date(0, 1, 1, 7, 9, 7, 70500000, time.UTC), time.Date(0, 1, 1, 7, 9, 7, 70599999, time.UTC), EDTPFraction},
+		{"TM", "07:09:07", time.Date(0, 1, 1, 7, 9, 7, 0, time.UTC), time.Date(0, 1, 1, 7, 9, 7, 999999999, time.UTC), EDTPSecond},
+		{"DT", "2020", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 12, 31, 23, 59, 59, 999999999, time.UTC), EDTPYear},
+		{"DT", "202002", time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 29, 23, 59, 59, 999999999, time.UTC), EDTPMonth},
+		{"DT", "20200101120000.123456-0500", time.Date(2020, 1, 1, 12, 0, 0, 123456000, est), time.Date(2020, 1, 1, 12, 0, 0, 123456999, est), EDTPFraction},
+		{"DT", "2020010112-0500", time.Date(2020, 1, 1, 12, 0, 0, 0, est), time.Date(2020, 1, 1, 12, 59, 59, 999999999, est), EDTPHour},
+	}
+	for _, c := range cases {
+		var got DcmDateTime
+		var err error
+		switch c.vr {
+		case "DA":
+			got, err = ParseDA(c.in, nil)
+		case "TM":
+			got, err = ParseTM(c.in, nil)
+		default:
+			got, err = ParseDT(c.in, nil)
+		}
+		if err != nil {
+			t.Errorf("Parse%s(%s): %s", c.vr, c.in, err.Error())
+			continue
+		}
+		if !got.Time.Equal(c.want) || !got.Latest().Equal(c.latest) || got.Precision != c.precision {
+			t.Errorf("Parse%s(%s), want %v-%v (%d) got %v-%v (%d)", c.vr, c.in, c.want, c.latest, c.precision, got.Time, got.Latest(), got.Precision)
+		}
+	}
+
+	invalid := []struct {
+		vr string
+		in string
+	}{
+		{"DA", "2020022"},
+		{"DA", "20200230"},
+		{"DA", "202001"},
+		{"TM", "2460"},
+		{"TM", "123"},
+		{"TM", "1200-0500"},
+		{"DT", "20200101.5"},
+		{"DT", "2020010112000.5"},
+		{"DT", "20200101+2500"},
+		{"DT", "2020ab"},
+	}
+	for _, c := range invalid {
+		var err error
+		switch c.vr {
+		case "DA":
+			_, err = ParseDA(c.in, nil)
+		case "TM":
+			_, err = ParseTM(c.in, nil)
+		default:
+			_, err = ParseDT(c.in, nil)
+		}
+		if err == nil {
+			t.Errorf("Parse%s(%s) should fail", c.vr, c.in)
+		}
+	}
+}
+
+func TestParseAS(t *testing.T) {
+	at := time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		in   string
+		want DcmAge
+		born time.Time
+	}{
+		{"045Y", DcmAge{45, 'Y'}, time.Date(1975, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"006M", DcmAge{6, 'M'}, time.Date(2019, 9, 15, 0, 0, 0, 0, time.UTC)},
+		{"002W", DcmAge{2, 'W'}, time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"010D", DcmAge{10, 'D'}, time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		got, err := ParseAS(c.in)
+		if err != nil {
+			t.Errorf("ParseAS(%s): %s", c.in, err.Error())
+			continue
+		}
+		if got != c.want || got.String() != c.in || !got.BirthDate(at).Equal(c.born) {
+			t.Errorf("ParseAS(%s), want %v born %v got %v born %v", c.in, c.want, c.born, got, got.BirthDate(at))
+		}
+	}
+	for _, in := range []string{"45Y", "045y", "0045Y", "045"} {
+		_, err := ParseAS(in)
+		if err == nil {
+			t.Errorf("ParseAS(%s) should fail", in)
+		}
+	}
+}
+
+func TestParseDateTimeRange(t *testing.T) {
+	cases := []struct {
+		vr    string
+		in    string
+		match []time.Time
+		miss  []time.Time
+	}{
+		{"DA", "20200101-20201231",
+			[]time.Time{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{"DA", "20200101-",
+			[]time.Time{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)}},
+		{"DA", "-20200101",
+			[]time.Time{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}},
+		{"DA", "20200101",
+			[]time.Time{time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}},
+		{"TM", "0800-1230",
+			[]time.Time{time.Date(0, 1, 1, 12, 30, 59, 0, time.UTC)},
+			[]time.Time{time.Date(0, 1, 1, 12, 31, 0, 0, time.UTC)}},
+		{"DT", "2020-2021",
+			[]time.Time{time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{"DT", "20200101-0500-20200102-0500",
+			[]time.Time{time.Date(2020, 1, 1, 5, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC), time.Date(2020, 1, 3, 5, 0, 0, 0, time.UTC)}},
+		{"DT", "20200101-0500",
+			[]time.Time{time.Date(2020, 1, 1, 5, 0, 0, 0, time.UTC)},
+			[]time.Time{time.Date(2020, 1, 2, 5, 0, 0, 0, time.UTC)}},
+	}
+	for _, c := range cases {
+		r, err := ParseDateTimeRange(c.in, c.vr, nil)
+		if err != nil {
+			t.Errorf("ParseDateTimeRange(%s): %s", c.in, err.Error())
+			continue
+		}
+		for _, v := range c.match {
+			if !r.Contains(v) {
+				t.Errorf("ParseDateTimeRange(%s) should contain %v", c.in, v)
+			}
+		}
+		for _, v := range c.miss {
+			if r.Contains(v) {
+				t.Errorf("ParseDateTimeRange(%s) should not contain %v", c.in, v)
+			}
+		}
+	}
+	for _, in := range []string{"-", "20200101-20191231", "2020-01-01"} {
+		_, err := ParseDateTimeRange(in, "DA", nil)
+		if err == nil {
+			t.Errorf("ParseDateTimeRange(%s) should fail", in)
+		}
+	}
+}
+
+func TestDcmDatasetGetDateTime(t *testing.T) {
+	var dataset DcmDataset
+	dataset.Set(DCMTimezoneOffsetFromUTC, "", "+0800")
+	dataset.Set(DCMStudyDate, "", "20200101")
+	dataset.Set(DCMStudyTime, "", "083000")
+	dataset.Set(DCMAcquisitionDateTime, "", "20200101083000")
+	dataset.Set(DCMPatientAge, "", "045Y")
+
+	want := time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	got, err := dataset.GetDateAndTime(DCMStudyDate, DCMStudyTime)
+	if err != nil {
+		t.Fatalf("GetDateAndTime(): %s", err.Error())
+	}
+	if !got.Time.Equal(want) || got.Precision != EDTPSecond {
+		t.Errorf("GetDateAndTime(), want %v got %v", want, got.Time)
+	}
+	got, err = dataset.GetDateTime(DCMAcquisitionDateTime)
+	if err != nil {
+		t.Fatalf("GetDateTime(): %s", err.Error())
+	}
+	if !got.Time.Equal(want) {
+		t.Errorf("GetDateTime(), want %v got %v", want, got.Time)
+	}
+	age, err := dataset.GetAge(DCMPatientAge)
+	if err != nil || age != (DcmAge{45, 'Y'}) {
+		t.Errorf("GetAge(), want 045Y got %v (%v)", age, err)
+	}
+	_, err = dataset.GetDateTime(DCMPatientAge)
+	if err == nil {
+		t.Errorf("GetDateTime(PatientAge) should fail")
+	}
+}
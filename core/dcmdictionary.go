@@ -0,0 +1,187 @@
+package core
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/grayzone/godcm/dcmdata"
+)
+
+//go:generate go run dcmvmregistry_gen.go
+//go:generate go run dcmkeyword_gen.go
+
+var (
+	registryByTag map[DcmTag]DcmElement
+	registryOnce  sync.Once
+	keywordsByTag map[DcmTag]string
+	keywordsOnce  sync.Once
+
+	// dictionaryMutex guards the indexes, dcmKeywords, dcmVMRegistry and
+	// DcmRangeElementRegistry, which may be extended at runtime by RegisterDictionaryEntry
+	dictionaryMutex sync.RWMutex
+)
+
+// registryIndex gets the entries of DcmMetaElementRegistry and DcmElementRegistry by tag.
+// The index is built from the registries on the first use, so the later changes of the
+// registries are not seen. The entries are added by RegisterDictionaryEntry instead.
+func registryIndex() map[DcmTag]DcmElement {
+	registryOnce.Do(func() {
+		registryByTag = make(map[DcmTag]DcmElement, len(DcmMetaElementRegistry)+len(DcmElementRegistry))
+		for _, v := range DcmMetaElementRegistry {
+			registryByTag[v.Tag] = v
+		}
+		for _, v := range DcmElementRegistry {
+			// the first entry wins, as with a linear search
+			if _, ok := registryByTag[v.Tag]; !ok {
+				registryByTag[v.Tag] = v
+			}
+		}
+	})
+	return registryByTag
+}
+
+// FindDcmElementByKeyword gets the registry information of the data element with the
+// keyword, e.g. "PatientName". The keywords of the retired elements may be given with
+// or without the prefix RETIRED.
+func FindDcmElementByKeyword(keyword string) (DcmElement, error) {
+	var elem DcmElement
+	dictionaryMutex.RLock()
+	tag, ok := dcmKeywords[keyword]
+	if !ok {
+		tag, ok = dcmKeywords["RETIRED"+keyword]
+	}
+	dictionaryMutex.RUnlock()
+	if !ok {
+		str := "not find the keyword '" + keyword + "' in the data dictionary"
+		return elem, errors.New(str)
+	}
+	elem.Tag = tag
+	// the name and the VR are empty if the tag has no registry information
+	FindDcmElmentByTag(&elem)
+	return elem, nil
+}
+
+// Keyword gets the keyword of the tag, or an empty string if the tag is unknown. The tags
+// with repeating groups or elements get the keyword of the lower limit of the range,
+// e.g. "OverlayData" for (6002,3000).
+func (t DcmTag) Keyword() string {
+	index := keywordIndex()
+	dictionaryMutex.RLock()
+	defer dictionaryMutex.RUnlock()
+	if k, ok := index[t]; ok {
+		return k
+	}
+	if r, ok := findDcmRangeElement(t); ok {
+		return index[r.Tag]
+	}
+	return ""
+}
+
+// VM gets the value multiplicity of the tag in the data dictionary, e.g. "1-n", or an
+// empty string if the tag is unknown.
+func (t DcmTag) VM() string {
+	dictionaryMutex.RLock()
+	defer dictionaryMutex.RUnlock()
+	if vm, ok := dcmVMRegistry[t]; ok {
+		return vm
+	}
+	if r, ok := findDcmRangeElement(t); ok {
+		return dcmVMRegistry[r.Tag]
+	}
+	return ""
+}
+
+// keywordIndex gets the keywords of dcmKeywords by tag.
+func keywordIndex() map[DcmTag]string {
+	keywordsOnce.Do(func() {
+		keywordsByTag = make(map[DcmTag]string, len(dcmKeywords))
+		for k, v := range dcmKeywords {
+			// the keywords sharing a tag are resolved in the same way on every run
+			old, ok := keywordsByTag[v]
+			if !ok || k < old {
+				keywordsByTag[v] = k
+			}
+		}
+	})
+	return keywordsByTag
+}
+
+// DcmDictionaryEntry is a data element loaded from an external data dictionary. Tag and
+// UpperTag are the limits of a repeating range, and equal for a single tag.
+type DcmDictionaryEntry struct {
+	Tag                DcmTag
+	UpperTag           DcmTag
+	GroupRestriction   dcmdata.DcmDictRangeRestriction
+	ElementRestriction dcmdata.DcmDictRangeRestriction
+	PrivateCreator     string
+	Keyword            string
+	Name               string
+	VR                 string
+	VM                 string
+	IsRetired          bool
+}
+
+// RegisterDictionaryEntry adds the entry to the data dictionary, or overrides the built-in
+// registry information of the tag. The private entries go to the private dictionary, and
+// the ranges are looked up before the built-in ranges. If Name, VR or VM is empty, e.g.
+// the VR "na" of DCMTK, the value of the existing entry is kept, or the keyword is used
+// as the name.
+func RegisterDictionaryEntry(entry DcmDictionaryEntry) {
+	if entry.PrivateCreator != "" {
+		vr := entry.VR
+		if p, err := FindDcmPrivateElement(entry.PrivateCreator, entry.Tag); err == nil && vr == "" {
+			vr = p.VR
+		}
+		RegisterPrivateElement(DcmPrivateElement{PrivateCreator: entry.PrivateCreator, Tag: entry.Tag, Name: entry.name(), VR: vr})
+		return
+	}
+	registry := registryIndex()
+	keywords := keywordIndex()
+	dictionaryMutex.Lock()
+	defer dictionaryMutex.Unlock()
+
+	name, vr, vm := entry.Name, entry.VR, entry.VM
+	if entry.UpperTag == entry.Tag {
+		if v, ok := registry[entry.Tag]; ok {
+			if name == "" {
+				name = v.Name
+			}
+			if vr == "" {
+				vr = v.VR
+			}
+		}
+	} else if r, ok := findDcmRangeElement(entry.Tag); ok {
+		if vr == "" {
+			vr = r.VR
+		}
+		if vm == "" {
+			vm = dcmVMRegistry[r.Tag]
+		}
+	}
+	if name == "" {
+		name = entry.name()
+	}
+	if entry.UpperTag == entry.Tag {
+		registry[entry.Tag] = DcmElement{Tag: entry.Tag, Name: name, VR: vr}
+	} else {
+		r := DcmRangeElement{Tag: entry.Tag, UpperTag: entry.UpperTag, GroupRestriction: entry.GroupRestriction,
+			ElementRestriction: entry.ElementRestriction, Name: name, VR: vr}
+		DcmRangeElementRegistry = append([]DcmRangeElement{r}, DcmRangeElementRegistry...)
+	}
+	if vm != "" {
+		// the VM of a range is stored by its lower limit
+		dcmVMRegistry[entry.Tag] = vm
+	}
+	if entry.Keyword != "" {
+		dcmKeywords[entry.Keyword] = entry.Tag
+		keywords[entry.Tag] = entry.Keyword
+	}
+}
+
+// name gets the name of the entry, or the keyword if the dictionary has no names.
+func (entry DcmDictionaryEntry) name() string {
+	if entry.Name != "" {
+		return entry.Name
+	}
+	return entry.Keyword
+}
@@ -0,0 +1,243 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/grayzone/godcm/dcmdata"
+	"github.com/grayzone/godcm/util"
+)
+
+// findDcmElmentByTagLinear is the linear search of the registry, used as reference.
+func findDcmElmentByTagLinear(elem *DcmElement) bool {
+	for _, v := range DcmElementRegistry {
+		if v.Tag == elem.Tag {
+			elem.Name = v.Name
+			elem.VR = v.VR
+			return true
+		}
+	}
+	return false
+}
+
+// findElementLinear is the linear search of the data set, used as reference.
+func findElementLinear(dataset DcmDataset, e *DcmElement) bool {
+	for _, v := range dataset.Elements {
+		if e.Tag == v.Tag {
+			*e = v
+			return true
+		}
+	}
+	return false
+}
+
+func TestFindDcmElmentByTagIndex(t *testing.T) {
+	for _, v := range DcmElementRegistry {
+		var want, got DcmElement
+		want.Tag = v.Tag
+		got.Tag = v.Tag
+		findDcmElmentByTagLinear(&want)
+		err := FindDcmElmentByTag(&got)
+		if err != nil || got.Name != want.Name || got.VR != want.VR {
+			t.Errorf("FindDcmElmentByTag(%s), want %s %s got %s %s", v.Tag, want.Name, want.VR, got.Name, got.VR)
+		}
+	}
+	elem := DcmElement{Tag: DCMTransferSyntaxUID}
+	if FindDcmElmentByTag(&elem) != nil || elem.VR != "UI" {
+		t.Errorf("FindDcmElmentByTag(%s), the meta element is not found", DCMTransferSyntaxUID)
+	}
+	elem = DcmElement{Tag: DcmTag{0x0009, 0x1001}}
+	if FindDcmElmentByTag(&elem) == nil {
+		t.Errorf("FindDcmElmentByTag(%s) should fail", elem.Tag)
+	}
+}
+
+func TestFindDcmElmentByTagRange(t *testing.T) {
+	cases := []struct {
+		in      DcmTag
+		name    string
+		vr      string
+		isError bool
+	}{
+		{DcmTag{0x6000, 0x3000}, "Overlay Data", "OB or OW", false},
+		{DcmTag{0x601e, 0x0010}, "Overlay Rows", "US", false},
+		{DcmTag{0x6002, 0x0050}, "Overlay Origin", "SS", false},
+		{DcmTag{0x6001, 0x3000}, "", "", true},
+		{DcmTag{0x6100, 0x0010}, "", "", true},
+		{DcmTag{0x5010, 0x3000}, "Curve Data", "OB or OW", false},
+		{DcmTag{0x7f02, 0x0010}, "Variable Pixel Data", "OB or OW", false},
+		{DcmTag{0x0020, 0x3102}, "Source Image IDs", "CS", false},
+		{DcmTag{0x0020, 0x3103}, "", "", true},
+		{DcmTag{0x0029, 0x0010}, "Private Creator", "LO", false},
+		{DcmTag{0x0029, 0x00ff}, "Private Creator", "LO", false},
+		{DcmTag{0x0029, 0x0100}, "", "", true},
+		{DcmTag{0x0003, 0x0011}, "Illegal Private Creator", "LO", false},
+	}
+	for _, c := range cases {
+		elem := DcmElement{Tag: c.in}
+		err := FindDcmElmentByTag(&elem)
+		if (err != nil) != c.isError || elem.Name != c.name || elem.VR != c.vr {
+			t.Errorf("FindDcmElmentByTag(%s), want '%s' %s got '%s' %s (%v)", c.in, c.name, c.vr, elem.Name, elem.VR, err)
+		}
+	}
+}
+
+func TestDcmRangeElementContains(t *testing.T) {
+	r := DcmRangeElement{Tag: DcmTag{0x0009, 0x0010}, UpperTag: DcmTag{0x00ff, 0x00ff}, GroupRestriction: dcmdata.DcmDictRange_Odd, ElementRestriction: dcmdata.DcmDictRange_Even}
+	cases := []struct {
+		in   DcmTag
+		want bool
+	}{
+		{DcmTag{0x0009, 0x0010}, true},
+		{DcmTag{0x00ff, 0x00fe}, true},
+		{DcmTag{0x000a, 0x0010}, false},
+		{DcmTag{0x0009, 0x0011}, false},
+		{DcmTag{0x0007, 0x0010}, false},
+		{DcmTag{0x0009, 0x0100}, false},
+	}
+	for _, c := range cases {
+		if got := r.Contains(c.in); got != c.want {
+			t.Errorf("Contains(%s), want %v got %v", c.in, c.want, got)
+		}
+	}
+}
+
+func TestFindDcmElementByKeyword(t *testing.T) {
+	cases := []struct {
+		in      string
+		tag     DcmTag
+		vr      string
+		isError bool
+	}{
+		{"PatientName", DCMPatientName, "PN", false},
+		{"TransferSyntaxUID", DCMTransferSyntaxUID, "UI", false},
+		{"PixelData", DCMPixelData, "OB or OW", false},
+		{"LossyImageCompressionRetired", DCMRETIREDLossyImageCompressionRetired, "CS", false},
+		{"patientname", DcmTag{}, "", true},
+		{"", DcmTag{}, "", true},
+	}
+	for _, c := range cases {
+		got, err := FindDcmElementByKeyword(c.in)
+		if (err != nil) != c.isError || (!c.isError && (got.Tag != c.tag || got.VR != c.vr)) {
+			t.Errorf("FindDcmElementByKeyword(%s), want %s %s got %s %s (%v)", c.in, c.tag, c.vr, got.Tag, got.VR, err)
+		}
+	}
+}
+
+func TestDcmTagKeyword(t *testing.T) {
+	cases := []struct {
+		in   DcmTag
+		want string
+	}{
+		{DCMPatientName, "PatientName"},
+		{DCMRows, "Rows"},
+		{DcmTag{0x0000, 0x0000}, "CommandGroupLength"},
+		{DcmTag{0x0009, 0x1001}, ""},
+		{DcmTag{0x6002, 0x3000}, "OverlayData"},
+		{DcmTag{0x6001, 0x3000}, ""},
+		{DcmTag{0x0011, 0x0010}, "PrivateCreator"},
+	}
+	for _, c := range cases {
+		if got := c.in.Keyword(); got != c.want {
+			t.Errorf("Keyword(%s), want '%s' got '%s'", c.in, c.want, got)
+		}
+	}
+}
+
+func TestDcmDatasetFindElementIndex(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	dataset := reader.Dataset
+	for _, v := range dataset.Elements {
+		elem := DcmElement{Tag: v.Tag}
+		if dataset.FindElement(&elem) != nil || elem.Length != v.Length {
+			t.Errorf("FindElement(%s), the element is not found", v.Tag)
+		}
+	}
+
+	// the elements changed without updating the index are found
+	dataset.Elements = append([]DcmElement{{Tag: DcmTag{0x0002, 0x0099}, VR: "UN"}}, dataset.Elements...)
+	for _, tag := range []DcmTag{DcmTag{0x0002, 0x0099}, DCMRows, DCMPixelData} {
+		elem := DcmElement{Tag: tag}
+		if dataset.FindElement(&elem) != nil || elem.Tag != tag {
+			t.Errorf("FindElement(%s), the element is not found after the change", tag)
+		}
+	}
+	elem := DcmElement{Tag: DcmTag{0x0009, 0x9999}}
+	if dataset.FindElement(&elem) == nil {
+		t.Errorf("FindElement(%s) should fail", elem.Tag)
+	}
+}
+
+var benchmarkTags = []DcmTag{
+	DCMPatientName, DCMRows, DCMColumns, DCMPixelData, DCMWindowCenter,
+	DCMSOPInstanceUID, DCMBitsAllocated, DCMTransferSyntaxUID, DcmTag{0x0009, 0x0010},
+}
+
+func BenchmarkFindDcmElmentByTag(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, tag := range benchmarkTags {
+			elem := DcmElement{Tag: tag}
+			FindDcmElmentByTag(&elem)
+		}
+	}
+}
+
+func BenchmarkFindDcmElmentByTagLinear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, tag := range benchmarkTags {
+			elem := DcmElement{Tag: tag}
+			findDcmElmentByTagLinear(&elem)
+		}
+	}
+}
+
+func benchmarkDataset(b *testing.B) DcmDataset {
+	var reader DcmReader
+	reader.IsReadValue = true
+	err := reader.ReadFile(util.GetTestDataFolder() + "CT-MONO2-16-ankle")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return reader.Dataset
+}
+
+func BenchmarkDcmDatasetFindElement(b *testing.B) {
+	dataset := benchmarkDataset(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range dataset.Elements {
+			elem := DcmElement{Tag: v.Tag}
+			dataset.FindElement(&elem)
+		}
+	}
+}
+
+func BenchmarkDcmDatasetFindElementLinear(b *testing.B) {
+	dataset := benchmarkDataset(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v := range dataset.Elements {
+			elem := DcmElement{Tag: v.Tag}
+			findElementLinear(dataset, &elem)
+		}
+	}
+}
+
+// BenchmarkDcmReaderReadFile reads files with implicit VR, which look up the VR of
+// every element in the registry.
+func BenchmarkDcmReaderReadFile(b *testing.B) {
+	files := []string{
+		"CT-MONO2-16-ankle",
+		"GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+		"MR-MONO2-8-16x-heart.dcm",
+	}
+	for i := 0; i < b.N; i++ {
+		for _, f := range files {
+			var reader DcmReader
+			reader.IsReadValue = true
+			err := reader.ReadFile(util.GetTestDataFolder() + f)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
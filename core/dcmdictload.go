@@ -0,0 +1,343 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/grayzone/godcm/dcmdata"
+)
+
+// LoadDictionary is to load an external data dictionary, and to extend or override the
+// built-in registry with its entries. The dictionary is either a DCMTK data dictionary,
+// e.g. dicom.dic or private.dic, or a XML dictionary: the DocBook tables of DICOM Part 6,
+// or a list of <tag group="0004" element="1130" keyword="FileSetID" vr="CS" vm="1">.
+// A gzip compressed dictionary is decompressed. The dictionary is not loaded if any of
+// its entries is invalid.
+func LoadDictionary(r io.Reader) error {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		br = bufio.NewReader(gz)
+	}
+	bom, _ := br.Peek(3)
+	if bytes.Equal(bom, []byte{0xef, 0xbb, 0xbf}) {
+		br.Discard(3)
+	}
+
+	var entries []DcmDictionaryEntry
+	var err error
+	head, _ := br.Peek(512)
+	if bytes.HasPrefix(bytes.TrimSpace(head), []byte("<")) {
+		entries, err = parseXMLDictionary(br)
+	} else {
+		entries, err = parseDcmtkDictionary(br)
+	}
+	if err != nil {
+		return err
+	}
+	for _, v := range entries {
+		RegisterDictionaryEntry(v)
+	}
+	return nil
+}
+
+// LoadDictionaryFile is to load an external data dictionary file, see LoadDictionary.
+func LoadDictionaryFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadDictionary(f)
+}
+
+// parseDcmtkDictionary parses the entries of a DCMTK data dictionary.
+func parseDcmtkDictionary(r io.Reader) ([]DcmDictionaryEntry, error) {
+	var entries []DcmDictionaryEntry
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		entry, err := parseDcmtkDictionaryEntry(text)
+		if err != nil {
+			str := fmt.Sprintf("line %d: %s", line, err.Error())
+			return nil, errors.New(str)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// dcmtkVRs are the VRs of the DCMTK data dictionary which are not DICOM VRs.
+var dcmtkVRs = map[string]string{
+	"ox": "OB or OW",
+	"px": "OB or OW",
+	"xs": "US or SS",
+	"lt": "US or SS or OW",
+	"up": "UL",
+	"na": "",
+}
+
+// parseDcmtkDictionaryEntry parses an entry of a DCMTK data dictionary, e.g.
+//
+//	(6000-60ff,3000)	ox	OverlayData	1	dicom
+//	(0029,"SIEMENS CSA HEADER",08)	CS	CSAImageHeaderType	1	PrivateTag
+func parseDcmtkDictionaryEntry(text string) (DcmDictionaryEntry, error) {
+	var entry DcmDictionaryEntry
+	end := -1
+	isQuoted := false
+	for i, c := range text {
+		if c == '"' {
+			isQuoted = !isQuoted
+		}
+		if c == ')' && !isQuoted {
+			end = i
+			break
+		}
+	}
+	if !strings.HasPrefix(text, "(") || end < 0 {
+		return entry, errors.New("the tag is not in parentheses: '" + text + "'")
+	}
+	fields := strings.Fields(text[end+1:])
+	if len(fields) < 2 {
+		return entry, errors.New("not a dictionary entry: '" + text + "'")
+	}
+
+	parts := strings.Split(text[1:end], ",")
+	if len(parts) < 2 {
+		return entry, errors.New("invalid tag '" + text[:end+1] + "'")
+	}
+	var err error
+	last := len(parts) - 1
+	entry.Tag.Group, entry.UpperTag.Group, entry.GroupRestriction, err = parseDcmtkRange(parts[0])
+	if err != nil {
+		return entry, err
+	}
+	entry.Tag.Element, entry.UpperTag.Element, entry.ElementRestriction, err = parseDcmtkRange(parts[last])
+	if err != nil {
+		return entry, err
+	}
+	if last > 1 {
+		// the creator may contain commas
+		creator := strings.Join(parts[1:last], ",")
+		if len(creator) < 2 || !strings.HasPrefix(creator, "\"") || !strings.HasSuffix(creator, "\"") {
+			return entry, errors.New("invalid private creator '" + creator + "'")
+		}
+		if entry.UpperTag != entry.Tag {
+			return entry, errors.New("the private tag ranges are not supported: '" + text[:end+1] + "'")
+		}
+		entry.PrivateCreator = creator[1 : len(creator)-1]
+		entry.Tag.Element &= 0x00ff
+		entry.UpperTag = entry.Tag
+	}
+
+	entry.VR = fields[0]
+	if vr, ok := dcmtkVRs[entry.VR]; ok {
+		entry.VR = vr
+	}
+	entry.Keyword = fields[1]
+	if len(fields) > 2 {
+		entry.VM = fields[2]
+	}
+	if len(fields) > 3 {
+		entry.IsRetired = strings.HasPrefix(strings.ToUpper(fields[3]), "RET")
+	}
+	return entry, nil
+}
+
+// parseDcmtkRange parses a group or element of a DCMTK data dictionary, e.g. "0010",
+// "6000-60ff" or "0009-o-ffff". A range without restriction means the even numbers.
+func parseDcmtkRange(s string) (uint16, uint16, dcmdata.DcmDictRangeRestriction, error) {
+	parts := strings.Split(s, "-")
+	restriction := dcmdata.DcmDictRangeRestriction(dcmdata.DcmDictRange_Unspecified)
+	switch len(parts) {
+	case 1:
+		parts = append(parts, parts[0])
+	case 2:
+		restriction = dcmdata.DcmDictRange_Even
+	case 3:
+		switch parts[1] {
+		case "o":
+			restriction = dcmdata.DcmDictRange_Odd
+		case "e":
+			restriction = dcmdata.DcmDictRange_Even
+		case "u":
+			restriction = dcmdata.DcmDictRange_Unspecified
+		default:
+			return 0, 0, restriction, errors.New("invalid range restriction '" + s + "'")
+		}
+		parts = []string{parts[0], parts[2]}
+	default:
+		return 0, 0, restriction, errors.New("invalid range '" + s + "'")
+	}
+	lower, err := strconv.ParseUint(parts[0], 16, 16)
+	if err != nil {
+		return 0, 0, restriction, errors.New("invalid number '" + parts[0] + "'")
+	}
+	upper, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil || upper < lower {
+		return 0, 0, restriction, errors.New("invalid range '" + s + "'")
+	}
+	return uint16(lower), uint16(upper), restriction, nil
+}
+
+// xmlTag is an entry of the XML dictionary listing <tag> elements.
+type xmlTag struct {
+	Group   string `xml:"group,attr"`
+	Element string `xml:"element,attr"`
+	Keyword string `xml:"keyword,attr"`
+	VR      string `xml:"vr,attr"`
+	VM      string `xml:"vm,attr"`
+	Retired string `xml:"retired,attr"`
+	Name    string `xml:",chardata"`
+}
+
+var (
+	xmlTagPattern = regexp.MustCompile(`^\(([0-9A-Fa-fx]{4}),([0-9A-Fa-fx]{4})\)$`)
+	xmlVRPattern  = regexp.MustCompile(`^[A-Z]{2}( or [A-Z]{2})*$`)
+)
+
+// parseXMLDictionary parses the entries of a XML dictionary, either the <tag> elements or
+// the rows of the DocBook tables of DICOM Part 6. The entries which cannot be represented,
+// e.g. (gggg,0000) or (1000,xxx0), are ignored.
+func parseXMLDictionary(r io.Reader) ([]DcmDictionaryEntry, error) {
+	var entries []DcmDictionaryEntry
+	decoder := xml.NewDecoder(r)
+	var row []string
+	var cell *bytes.Buffer
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "tag":
+				if !hasXMLAttr(t, "group") {
+					// e.g. the DocBook markup
+					continue
+				}
+				var tag xmlTag
+				err = decoder.DecodeElement(&tag, &t)
+				if err != nil {
+					return nil, err
+				}
+				entry, ok := newXMLDictionaryEntry(tag.Group, tag.Element, tag.Name, tag.Keyword, tag.VR, tag.VM)
+				if ok {
+					entry.IsRetired = tag.Retired == "true"
+					entries = append(entries, entry)
+				}
+			case "tr":
+				row = nil
+			case "td", "th":
+				cell = new(bytes.Buffer)
+			}
+		case xml.CharData:
+			if cell != nil {
+				cell.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "td", "th":
+				if cell != nil {
+					row = append(row, cell.String())
+					cell = nil
+				}
+			case "tr":
+				if len(row) < 5 {
+					continue
+				}
+				m := xmlTagPattern.FindStringSubmatch(xmlText(row[0]))
+				if m == nil {
+					continue
+				}
+				entry, ok := newXMLDictionaryEntry(m[1], m[2], row[1], row[2], row[3], row[4])
+				if ok {
+					entry.IsRetired = len(row) > 5 && strings.HasPrefix(xmlText(row[5]), "RET")
+					entries = append(entries, entry)
+				}
+			}
+		}
+	}
+	return entries, nil
+}
+
+func hasXMLAttr(t xml.StartElement, name string) bool {
+	for _, v := range t.Attr {
+		if v.Name.Local == name {
+			return true
+		}
+	}
+	return false
+}
+
+// newXMLDictionaryEntry creates an entry from the text of a XML dictionary.
+func newXMLDictionaryEntry(group, element, name, keyword, vr, vm string) (DcmDictionaryEntry, bool) {
+	var entry DcmDictionaryEntry
+	var ok bool
+	entry.Tag.Group, entry.UpperTag.Group, ok = parseXMLRange(group)
+	if !ok {
+		return entry, false
+	}
+	entry.Tag.Element, entry.UpperTag.Element, ok = parseXMLRange(element)
+	if !ok {
+		return entry, false
+	}
+	if entry.UpperTag.Group != entry.Tag.Group {
+		// the repeating groups are even
+		entry.GroupRestriction = dcmdata.DcmDictRange_Even
+	}
+	entry.Name = xmlText(name)
+	entry.Keyword = xmlText(keyword)
+	entry.VR = strings.Replace(xmlText(vr), "/", " or ", -1)
+	if !xmlVRPattern.MatchString(entry.VR) {
+		// e.g. "See Note" of the items
+		entry.VR = ""
+	}
+	entry.VM = xmlText(vm)
+	return entry, true
+}
+
+// parseXMLRange parses a group or element of a XML dictionary, e.g. "0010" or "60xx".
+// Only the trailing digits may be repeating.
+func parseXMLRange(s string) (uint16, uint16, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	digits := strings.TrimRight(s, "x")
+	if strings.Contains(digits, "x") || len(s) != 4 {
+		return 0, 0, false
+	}
+	lower, err := strconv.ParseUint(digits+strings.Repeat("0", 4-len(digits)), 16, 16)
+	if err != nil {
+		return 0, 0, false
+	}
+	upper, _ := strconv.ParseUint(digits+strings.Repeat("f", 4-len(digits)), 16, 16)
+	return uint16(lower), uint16(upper), true
+}
+
+// xmlText removes the zero width spaces and the line breaks of the DocBook text.
+func xmlText(s string) string {
+	s = strings.Replace(s, "\u200b", "", -1)
+	return strings.Join(strings.Fields(s), " ")
+}
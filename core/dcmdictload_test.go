@@ -0,0 +1,177 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+type dictionaryCase struct {
+	in      DcmTag
+	name    string
+	vr      string
+	keyword string
+}
+
+// restoreDictionary restores the data dictionary after the test, as the entries loaded
+// by the test must not be seen by the other tests.
+func restoreDictionary(t *testing.T) {
+	registry := make(map[DcmTag]DcmElement)
+	for k, v := range registryIndex() {
+		registry[k] = v
+	}
+	keywords := make(map[DcmTag]string)
+	for k, v := range keywordIndex() {
+		keywords[k] = v
+	}
+	private := make(map[dcmPrivateKey]DcmPrivateElement)
+	for k, v := range privateRegistryIndex() {
+		private[k] = v
+	}
+	tags := make(map[string]DcmTag)
+	for k, v := range dcmKeywords {
+		tags[k] = v
+	}
+	vms := make(map[DcmTag]string)
+	for k, v := range dcmVMRegistry {
+		vms[k] = v
+	}
+	ranges := append([]DcmRangeElement(nil), DcmRangeElementRegistry...)
+	t.Cleanup(func() {
+		dictionaryMutex.Lock()
+		registryByTag, keywordsByTag, dcmKeywords, dcmVMRegistry = registry, keywords, tags, vms
+		DcmRangeElementRegistry = ranges
+		dictionaryMutex.Unlock()
+		privateRegistryMutex.Lock()
+		privateRegistryByKey = private
+		privateRegistryMutex.Unlock()
+	})
+}
+
+func checkDictionary(t *testing.T, source string, cases []dictionaryCase) {
+	for _, c := range cases {
+		elem := DcmElement{Tag: c.in}
+		err := FindDcmElmentByTag(&elem)
+		if err != nil || elem.Name != c.name || elem.VR != c.vr {
+			t.Errorf("%s: FindDcmElmentByTag(%s), want '%s' %s got '%s' %s (%v)", source, c.in, c.name, c.vr, elem.Name, elem.VR, err)
+		}
+		if got := c.in.Keyword(); got != c.keyword {
+			t.Errorf("%s: Keyword(%s), want '%s' got '%s'", source, c.in, c.keyword, got)
+		}
+	}
+}
+
+func TestLoadDictionaryFile(t *testing.T) {
+	restoreDictionary(t)
+	for _, name := range []string{"minimumdict.xml", "minimumdict.xml.gz"} {
+		err := LoadDictionaryFile(util.GetTestDataFolder() + name)
+		if err != nil {
+			t.Errorf("LoadDictionaryFile(%s): %s", name, err.Error())
+			continue
+		}
+		checkDictionary(t, name, []dictionaryCase{
+			{DCMFileSetID, "File-set ID", "CS", "FileSetID"},
+		})
+	}
+	if LoadDictionaryFile(util.GetTestDataFolder()+"notexist.dic") == nil {
+		t.Errorf("LoadDictionaryFile() should fail if the file does not exist")
+	}
+}
+
+func TestLoadDictionaryDcmtk(t *testing.T) {
+	restoreDictionary(t)
+	dict := `# test dictionary
+(0098,0010)	LO	TestElement	1	dicom
+(7000-70ff,0010)	ox	TestRepeatingElement	1	dicom
+(7101-o-71ff,0020)	xs	TestOddElement	1	dicom
+(0011,"TEST CREATOR",20)	US	TestPrivateElement	1	PrivateTag
+(0008,0005)	na	SpecificCharacterSet	1-n	dicom
+(6000-60ff,3000)	na	OverlayData	1	dicom`
+	overlay := DcmElement{Tag: DcmTag{0x6002, 0x3000}}
+	FindDcmElmentByTag(&overlay)
+	err := LoadDictionary(strings.NewReader(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDictionary(t, "dicom.dic", []dictionaryCase{
+		{DcmTag{0x0098, 0x0010}, "TestElement", "LO", "TestElement"},
+		{DcmTag{0x7002, 0x0010}, "TestRepeatingElement", "OB or OW", "TestRepeatingElement"},
+		{DcmTag{0x7103, 0x0020}, "TestOddElement", "US or SS", "TestOddElement"},
+		{DCMSpecificCharacterSet, "Specific Character Set", "CS", "SpecificCharacterSet"},
+		{DcmTag{0x6002, 0x3000}, "OverlayData", overlay.VR, "OverlayData"},
+	})
+	elem := DcmElement{Tag: DcmTag{0x7001, 0x0010}}
+	if FindDcmElmentByTag(&elem) == nil && elem.Name == "TestRepeatingElement" {
+		t.Errorf("FindDcmElmentByTag(%s), the odd group is not in the range", elem.Tag)
+	}
+	p, err := FindDcmPrivateElement("TEST CREATOR", DcmTag{0x0011, 0x1020})
+	if err != nil || p.VR != "US" {
+		t.Errorf("FindDcmPrivateElement(), want US got %s (%v)", p.VR, err)
+	}
+
+	for _, in := range []string{
+		"(0098,0012)\tLO\tGoodElement\t1\tdicom\n(0098)\tLO\tBadElement\t1\tdicom",
+		"(0098,0012)\tLO",
+		"0098,0012\tLO\tBadElement\t1\tdicom",
+		"(7200-x-72ff,0010)\tLO\tBadElement\t1\tdicom",
+		"(72ff-7200,0010)\tLO\tBadElement\t1\tdicom",
+		"(0011-o-00ff,\"TEST CREATOR\",20)\tUS\tBadElement\t1\tPrivateTag",
+	} {
+		if LoadDictionary(strings.NewReader(in)) == nil {
+			t.Errorf("LoadDictionary(%s) should fail", in)
+		}
+	}
+	elem = DcmElement{Tag: DcmTag{0x0098, 0x0012}}
+	if FindDcmElmentByTag(&elem) == nil {
+		t.Errorf("LoadDictionary() should not load any entry of an invalid dictionary")
+	}
+}
+
+func TestLoadDictionaryDocBook(t *testing.T) {
+	restoreDictionary(t)
+	dict := `<?xml version="1.0" encoding="utf-8"?>
+<book xmlns="http://docbook.org/ns/docbook">
+<table xml:id="table_6-1"><tbody>
+<tr><td><para>(0098,0030)</para></td><td><para>Test Book  Element</para></td>
+<td><para>TestBook&#x200B;Element</para></td><td><para>SQ</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para><emphasis role="italic">(0098,0032)</emphasis></para></td><td><para><emphasis role="italic">Test Retired Element</emphasis></para></td>
+<td><para>TestRetiredElement</para></td><td><para>OB or OW</para></td><td><para>1</para></td><td><para>RET</para></td></tr>
+<tr><td><para>(72xx,0040)</para></td><td><para>Test Book Repeating Element</para></td>
+<td><para>TestBookRepeatingElement</para></td><td><para>US</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(FFFE,0098)</para></td><td><para>Test Item</para></td>
+<td><para>TestItem</para></td><td><para>See Note 2</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(0008,0016)</para></td><td><para>SOP Class UID</para></td>
+<td><para>SOPClassUID</para></td><td><para>See Note</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(gggg,0000)</para></td><td><para>Group Length</para></td>
+<td><para>GroupLength</para></td><td><para>UL</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(1000,xxx0)</para></td><td><para>Escape Triplet</para></td>
+<td><para>EscapeTriplet</para></td><td><para>US</para></td><td><para>3</para></td><td><para>RET</para></td></tr>
+</tbody></table>
+</book>`
+	entries, err := parseXMLDictionary(strings.NewReader(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 {
+		t.Errorf("parseXMLDictionary(), want 5 entries got %d", len(entries))
+	}
+	err = LoadDictionary(strings.NewReader(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDictionary(t, "DocBook", []dictionaryCase{
+		{DcmTag{0x0098, 0x0030}, "Test Book Element", "SQ", "TestBookElement"},
+		{DcmTag{0x0098, 0x0032}, "Test Retired Element", "OB or OW", "TestRetiredElement"},
+		{DcmTag{0x7202, 0x0040}, "Test Book Repeating Element", "US", "TestBookRepeatingElement"},
+		{DcmTag{0xfffe, 0x0098}, "Test Item", "", "TestItem"},
+		{DCMSOPClassUID, "SOP Class UID", "UI", "SOPClassUID"},
+	})
+	if !entries[1].IsRetired || entries[0].IsRetired {
+		t.Errorf("parseXMLDictionary(), the retired entries are not recognized")
+	}
+
+	if LoadDictionary(strings.NewReader("<book><table>")) == nil {
+		t.Errorf("LoadDictionary() should fail if the XML is truncated")
+	}
+}
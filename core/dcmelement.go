@@ -3,23 +3,38 @@ package core
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"strings"
 )
 
 // DcmElement indentified the data element tag.
 type DcmElement struct {
-	Tag          DcmTag
-	Name         string
-	VR           string
-	Length       int64
-	Value        []byte
-	Squence      *DcmSQElement
-	isExplicitVR bool
-	byteOrder    EByteOrder
-	isReadValue  bool
-	isReadPixel  bool
+	Tag     DcmTag
+	Name    string
+	VR      string
+	Length  int64
+	Value   []byte
+	Squence *DcmSQElement
+
+	// PrivateCreator is the creator reserving the block of a private element, resolved
+	// from the Private Creator elements read before it in the same data set.
+	PrivateCreator string
+
+	// Offset is the position of the value in the file or the reader, which is used
+	// to load the value later if it is not read. See Open and Load.
+	Offset int64
+
+	isExplicitVR    bool
+	byteOrder       EByteOrder
+	isReadValue     bool
+	isReadPixel     bool
+	privateCreators dcmPrivateCreators
+	source          dcmValueSource
+	charset         *DcmCharset
 }
 
 // GetValueString convert value to string according to VR
@@ -33,7 +48,15 @@ func (e DcmElement) GetValueString() string {
 		return result
 	}
 	switch e.VR {
-	case "FL", "FD", "OD", "OF":
+	case "FL", "OF":
+		var f float32
+		if e.byteOrder == EBOBigEndian {
+			binary.Read(buf, binary.BigEndian, &f)
+		} else {
+			binary.Read(buf, binary.LittleEndian, &f)
+		}
+		result = fmt.Sprintf("%f", f)
+	case "FD", "OD":
 		var f float64
 		if e.byteOrder == EBOBigEndian {
 			binary.Read(buf, binary.BigEndian, &f)
@@ -59,6 +82,9 @@ func (e DcmElement) GetValueString() string {
 		result = fmt.Sprintf("%d", i)
 	case "AE", "AS", "CS", "DA", "DS", "DT", "IS", "LO", "LT", "PN", "ST", "UI", "UT", "TM", "SH":
 		result = string(bytes.Trim(e.Value, "\x00"))
+		if e.charset != nil && isCharsetVR(e.VR) {
+			result, _ = e.charset.Decode(bytes.Trim(e.Value, "\x00"), e.VR)
+		}
 	default:
 		result = fmt.Sprintf("%x", e.Value)
 	}
@@ -198,6 +224,8 @@ func (e *DcmElement) ReadValueLengthUint32(s *DcmFileStream) error {
 // ReadValue get or skip the element value.
 func (e *DcmElement) ReadValue(s *DcmFileStream) error {
 	var err error
+	e.Offset = s.Position
+	e.source = s.source
 	if !e.isReadPixel {
 		if e.Tag.Group == 0x7fe0 {
 			_, err = s.Skip(e.Length)
@@ -205,15 +233,88 @@ func (e *DcmElement) ReadValue(s *DcmFileStream) error {
 		}
 	}
 
-	if e.isReadValue {
-		// read element value
+	if e.isReadValue || e.Tag.IsPrivateCreator() || e.Tag == DCMSpecificCharacterSet {
+		// read element value, the private creators are needed by the private elements,
+		// and the specific character set by the text elements
 		e.Value, err = s.Read(e.Length)
+		if err == nil {
+			e.privateCreators.update(*e)
+		}
 	} else {
 		_, err = s.Skip(e.Length)
 	}
 	return err
 }
 
+// IsValueLoaded is to check whether the value is in Value, or need to be loaded. The
+// value of a sequence, e.g. encapsulated pixel data, is loaded if the values of its
+// items of defined length are.
+func (e DcmElement) IsValueLoaded() bool {
+	if e.Squence != nil {
+		for _, item := range e.Squence.Item {
+			if item.Length != 0xFFFFFFFF && !item.IsValueLoaded() {
+				return false
+			}
+		}
+		return true
+	}
+	return e.Value != nil || e.Length == 0
+}
+
+// Open returns a reader of the value. If the value is not read, it is read from the
+// file or the reader the element is read from, which must not be closed yet.
+func (e DcmElement) Open() (io.ReadCloser, error) {
+	if e.IsValueLoaded() {
+		return ioutil.NopCloser(bytes.NewReader(e.Value)), nil
+	}
+	if e.Squence != nil {
+		return nil, errorSequenceNotLoaded(e.Tag)
+	}
+	if e.source == nil {
+		str := "DcmElement: the value of the tag '" + e.Tag.String() + "' can not be loaded"
+		return nil, errors.New(str)
+	}
+	return e.source.openValue(e.Offset, e.Length)
+}
+
+// Load reads the value into Value if it is not read. The items of a sequence, e.g. the
+// fragments of encapsulated pixel data, are read into the Value of each item.
+func (e *DcmElement) Load() error {
+	if e.IsValueLoaded() {
+		return nil
+	}
+	if e.Squence != nil {
+		for i := range e.Squence.Item {
+			item := &e.Squence.Item[i]
+			if item.Length == 0xFFFFFFFF {
+				continue
+			}
+			err := item.Load()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	r, err := e.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	value := make([]byte, e.Length)
+	_, err = io.ReadFull(r, value)
+	if err != nil {
+		return err
+	}
+	e.Value = value
+	return nil
+}
+
+func errorSequenceNotLoaded(tag DcmTag) error {
+	str := "DcmElement: the items of the sequence '" + tag.String() + "' are not read, use Load"
+	return errors.New(str)
+}
+
 // ReadDcmElement read one dicom element.
 func (e *DcmElement) ReadDcmElement(s *DcmFileStream) error {
 
@@ -225,7 +326,13 @@ func (e *DcmElement) ReadDcmElement(s *DcmFileStream) error {
 
 func (e *DcmElement) readDcmSQElement(s *DcmFileStream) error {
 	e.Squence = new(DcmSQElement)
-	err := e.Squence.Read(s, e.Length, e.isExplicitVR, e.isReadValue)
+	e.Squence.charset = e.charset
+	isExplicitVR, byteOrder := e.isExplicitVR, e.byteOrder
+	if e.VR == "UN" {
+		// the items of UN with undefined length are always implicit VR little endian
+		isExplicitVR, byteOrder = false, EBOLittleEndian
+	}
+	err := e.Squence.Read(s, e.Length, isExplicitVR, byteOrder, e.isReadValue)
 	if err != nil {
 		return err
 	}
@@ -241,6 +348,8 @@ func (e *DcmElement) ReadDcmElementWithExplicitVR(s *DcmFileStream) error {
 		return err
 	}
 
+	e.PrivateCreator = e.privateCreators.find(e.Tag)
+
 	// read VR
 	err = e.ReadDcmVR(s)
 	if err != nil {
@@ -291,7 +400,8 @@ func (e *DcmElement) ReadDcmElementWithImplicitVR(s *DcmFileStream) error {
 		return err
 	}
 
-	// get VR from Dicom Element registry
+	// get VR from Dicom Element registry, or the private dictionary
+	e.PrivateCreator = e.privateCreators.find(e.Tag)
 	err = FindDcmElmentByTag(e)
 	if err != nil {
 		log.Println(err.Error())
@@ -302,6 +412,15 @@ func (e *DcmElement) ReadDcmElementWithImplicitVR(s *DcmFileStream) error {
 	if err != nil {
 		return err
 	}
+	// skip reading value if length is zero
+	if e.Length == 0 {
+		return nil
+	}
+
+	// read sequence items, or any element with undefined length
+	if e.VR == "SQ" || e.Length == 0xFFFFFFFF {
+		return e.readDcmSQElement(s)
+	}
 
 	err = e.ReadValue(s)
 	if err != nil {
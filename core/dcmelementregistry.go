@@ -4,19 +4,38 @@ import (
 	"errors"
 )
 
-// FindDcmElmentByTag find the registry information
+// FindDcmElmentByTag find the registry information. The private elements are looked up
+// in the private dictionary by the PrivateCreator of the element.
 func FindDcmElmentByTag(elem *DcmElement) error {
 	if elem.Tag.Element == 0x0000 {
 		return nil
 	}
 
-	for _, v := range DcmElementRegistry {
-		if v.Tag == elem.Tag {
-			elem.Name = v.Name
-			elem.VR = v.VR
+	registry := registryIndex()
+	dictionaryMutex.RLock()
+	v, ok := registry[elem.Tag]
+	dictionaryMutex.RUnlock()
+	if ok {
+		elem.Name = v.Name
+		elem.VR = v.VR
+		return nil
+	}
+	if elem.PrivateCreator != "" {
+		p, err := FindDcmPrivateElement(elem.PrivateCreator, elem.Tag)
+		if err == nil {
+			elem.Name = p.Name
+			elem.VR = p.VR
 			return nil
 		}
 	}
+	dictionaryMutex.RLock()
+	r, ok := findDcmRangeElement(elem.Tag)
+	dictionaryMutex.RUnlock()
+	if ok {
+		elem.Name = r.Name
+		elem.VR = r.VR
+		return nil
+	}
 	err := "Warning: not find the tag '" + elem.Tag.String() + "' from DcmElementRegistry"
 	return errors.New(err)
 }
@@ -4041,6 +4060,8 @@ var DcmElementRegistry = []DcmElement{
 	// DcmElement{Tag:DcmTag{0x60xx, 0x1500}, Name:"Overlay Label", VR:"LO"},
 	// DcmElement{Tag:DcmTag{0x60xx, 0x3000}, Name:"Overlay Data", VR:"OB or OW"},
 
+	DcmElement{Tag: DcmTag{0x7FE0, 0x0001}, Name: "Extended Offset Table", VR: "OV"},
+	DcmElement{Tag: DcmTag{0x7FE0, 0x0002}, Name: "Extended Offset Table Lengths", VR: "OV"},
 	DcmElement{Tag: DcmTag{0x7FE0, 0x0008}, Name: "Float Pixel Data", VR: "OF"},
 	DcmElement{Tag: DcmTag{0x7FE0, 0x0009}, Name: "Double Float Pixel Data", VR: "OD"},
 	DcmElement{Tag: DcmTag{0x7FE0, 0x0010}, Name: "Pixel Data", VR: "OB or OW"},
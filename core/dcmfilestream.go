@@ -1,17 +1,32 @@
 package core
 
 import (
+	"bytes"
 	"errors"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 )
 
-// DcmFileStream is to read binary file to bytes.
+// DcmFileStream is to read binary file to bytes. Besides files, it can read
+// from any io.Reader or io.ReaderAt, see OpenReader and OpenReaderAt.
+// Size is -1 if the size of the stream is unknown.
 type DcmFileStream struct {
 	FileName    string
 	fileHandler *os.File
+	handler     io.ReadSeeker
 	Size        int64
 	Position    int64
+
+	// the values which are not read can be loaded from the source later
+	source dcmValueSource
+}
+
+// newDcmBufferStream wraps an in-memory buffer, e.g. the value of a sequence item.
+func newDcmBufferStream(b []byte) *DcmFileStream {
+	r := bytes.NewReader(b)
+	return &DcmFileStream{handler: r, Size: int64(len(b)), source: dcmReaderAtSource{r}}
 }
 
 // Open the dicom file
@@ -21,11 +36,54 @@ func (s *DcmFileStream) Open() error {
 	if err != nil {
 		return err
 	}
-	s.Size, err = s.fileHandler.Seek(0, os.SEEK_END)
+	err = s.openReadSeeker(s.fileHandler)
+	s.source = dcmFileSource{s.FileName}
+	return err
+}
+
+// OpenReader is to read the stream from r. If r is not an io.ReadSeeker, or it cannot
+// seek like a pipe, the bytes read from r are kept in memory to support Skip and Putback.
+func (s *DcmFileStream) OpenReader(r io.Reader) error {
+	if rs, ok := r.(io.ReadSeeker); ok && isSeekable(rs) {
+		err := s.openReadSeeker(rs)
+		s.source = nil
+		if ra, ok := r.(io.ReaderAt); ok {
+			s.source = dcmReaderAtSource{ra}
+		}
+		return err
+	}
+	buf := &dcmStreamBuffer{reader: r}
+	s.handler = buf
+	s.Size = -1
+	s.Position = 0
+	s.source = dcmReaderAtSource{buf}
+	return nil
+}
+
+// isSeekable is to check whether rs can seek, e.g. os.Stdin is an io.ReadSeeker, but
+// Seek fails if it is a pipe.
+func isSeekable(rs io.ReadSeeker) bool {
+	_, err := rs.Seek(0, io.SeekCurrent)
+	return err == nil
+}
+
+// OpenReaderAt is to read the stream from the first size bytes of r.
+func (s *DcmFileStream) OpenReaderAt(r io.ReaderAt, size int64) error {
+	sr := io.NewSectionReader(r, 0, size)
+	err := s.openReadSeeker(sr)
+	s.source = dcmReaderAtSource{sr}
+	return err
+}
+
+func (s *DcmFileStream) openReadSeeker(rs io.ReadSeeker) error {
+	var err error
+	s.handler = rs
+	s.Position = 0
+	s.Size, err = s.handler.Seek(0, os.SEEK_END)
 	if err != nil {
 		return err
 	}
-	_, err = s.fileHandler.Seek(0, os.SEEK_SET)
+	_, err = s.handler.Seek(0, os.SEEK_SET)
 	return err
 }
 
@@ -40,20 +98,24 @@ func (s *DcmFileStream) Close() error {
 // Skip the bytes by the given length
 func (s *DcmFileStream) Skip(skiplength int64) (int64, error) {
 	var result int64
-	if s.fileHandler == nil {
+	if s.handler == nil {
 		return result, errors.New("The file is not opened yet.")
 	}
 	if skiplength == 0 {
 		return result, nil
 	}
-	pos, _ := s.fileHandler.Seek(0, os.SEEK_CUR)
+	pos, _ := s.handler.Seek(0, os.SEEK_CUR)
 
-	if s.Size-pos < skiplength {
+	if s.Size >= 0 && s.Size-pos < skiplength {
 		result = s.Size - pos
 	} else {
 		result = skiplength
 	}
-	_, err := s.fileHandler.Seek(skiplength, os.SEEK_CUR)
+	newpos, err := s.handler.Seek(result, os.SEEK_CUR)
+	if s.Size < 0 {
+		// the stream buffer stops at the end of the stream
+		result = newpos - pos
+	}
 
 	s.Position += result
 	return result, err
@@ -61,7 +123,7 @@ func (s *DcmFileStream) Skip(skiplength int64) (int64, error) {
 
 // SeekToBegin set the handler to the beginning of the file.
 func (s *DcmFileStream) SeekToBegin() error {
-	_, err := s.fileHandler.Seek(0, os.SEEK_SET)
+	_, err := s.handler.Seek(0, os.SEEK_SET)
 
 	s.Position = 0
 	return err
@@ -72,11 +134,11 @@ func (s *DcmFileStream) Putback(num int64) error {
 	if num == 0 {
 		return nil
 	}
-	pos, _ := s.fileHandler.Seek(0, os.SEEK_CUR)
+	pos, _ := s.handler.Seek(0, os.SEEK_CUR)
 	if num > pos {
 		return errors.New("Parser failure: Putback operation failed")
 	}
-	_, err := s.fileHandler.Seek(-num, os.SEEK_CUR)
+	_, err := s.handler.Seek(-num, os.SEEK_CUR)
 	if err != nil {
 		return err
 	}
@@ -86,25 +148,39 @@ func (s *DcmFileStream) Putback(num int64) error {
 
 // Eos is to check the end of the DICOM file.
 func (s DcmFileStream) Eos() bool {
-	if s.fileHandler == nil {
+	if s.handler == nil {
 		return true
 	}
-	size, _ := s.fileHandler.Seek(0, os.SEEK_CUR)
+	if s.Size < 0 {
+		// the size is unknown, try to read one more byte
+		b := make([]byte, 1)
+		n, _ := s.handler.Read(b)
+		if n == 0 {
+			return true
+		}
+		s.handler.Seek(-1, os.SEEK_CUR)
+		return false
+	}
+	size, _ := s.handler.Seek(0, os.SEEK_CUR)
 	//	log.Println(size, s.Size)
 	return size == s.Size
 
 }
 
-// Read is to read bytes by given length
+// Read is to read bytes by given length. It returns the bytes read and
+// io.ErrUnexpectedEOF if the stream ends before length bytes.
 func (s *DcmFileStream) Read(length int64) ([]byte, error) {
 	if length == 0 {
 		return []byte{}, nil
 	}
 	b := make([]byte, length)
-	_, err := s.fileHandler.Read(b)
+	n, err := io.ReadFull(s.handler, b)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
 
-	s.Position += length
-	return b, err
+	s.Position += int64(n)
+	return b[:n], err
 }
 
 // ReadString is to read a string from the file.
@@ -116,3 +192,185 @@ func (s *DcmFileStream) ReadString(slen int64) (string, error) {
 	str := strings.TrimRight(string(v), "\x00")
 	return str, nil
 }
+
+// dcmStreamBuffer adapts an io.Reader to io.ReadSeeker. The bytes read from
+// the reader are kept, so that the stream can seek back to them. If window is
+// positive, only the last window bytes before the position are kept, so that a
+// forward-only reader does not keep the whole stream in memory.
+type dcmStreamBuffer struct {
+	reader io.Reader
+	buf    []byte
+	base   int64 // the position of buf[0]
+	pos    int64
+	eof    bool
+	window int64
+}
+
+// the minimum and maximum number of bytes read from the reader at a time
+const (
+	dcmStreamMinChunk = 32 * 1024
+	dcmStreamMaxChunk = 1024 * 1024
+)
+
+// end returns the position after the last buffered byte.
+func (b *dcmStreamBuffer) end() int64 {
+	return b.base + int64(len(b.buf))
+}
+
+// discard drops the bytes more than window bytes before keep.
+func (b *dcmStreamBuffer) discard(keep int64) {
+	if b.window <= 0 {
+		return
+	}
+	if keep > b.end() {
+		keep = b.end()
+	}
+	n := keep - b.window - b.base
+	if n <= 0 {
+		return
+	}
+	if n > int64(len(b.buf)) {
+		n = int64(len(b.buf))
+	}
+	b.buf = b.buf[n:]
+	b.base += n
+}
+
+// fill reads from the reader until the bytes up to n are buffered or the end is
+// reached. The bytes more than window bytes before keep are dropped meanwhile.
+func (b *dcmStreamBuffer) fill(n int64, keep int64) error {
+	for b.end() < n && !b.eof {
+		b.discard(keep)
+		size := n - b.end()
+		if size < dcmStreamMinChunk {
+			size = dcmStreamMinChunk
+		} else if size > dcmStreamMaxChunk {
+			size = dcmStreamMaxChunk
+		}
+		chunk := make([]byte, size)
+		num, err := b.reader.Read(chunk)
+		b.buf = append(b.buf, chunk[:num]...)
+		if err == io.EOF {
+			b.eof = true
+		} else if err != nil {
+			return err
+		}
+	}
+	b.discard(keep)
+	return nil
+}
+
+func (b *dcmStreamBuffer) Read(p []byte) (int, error) {
+	err := b.fill(b.pos+int64(len(p)), b.pos)
+	if err != nil {
+		return 0, err
+	}
+	if b.pos >= b.end() {
+		return 0, io.EOF
+	}
+	n := copy(p, b.buf[b.pos-b.base:])
+	b.pos += int64(n)
+	return n, nil
+}
+
+// ReadAt reads from the bytes kept in the buffer, and reads more from the reader if
+// needed.
+func (b *dcmStreamBuffer) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("dcmStreamBuffer.ReadAt: negative offset")
+	}
+	if off < b.base {
+		return 0, errors.New("dcmStreamBuffer.ReadAt: the bytes are discarded")
+	}
+	err := b.fill(off+int64(len(p)), b.pos)
+	if err != nil {
+		return 0, err
+	}
+	if off < b.base {
+		return 0, errors.New("dcmStreamBuffer.ReadAt: the bytes are discarded")
+	}
+	if off >= b.end() {
+		return 0, io.EOF
+	}
+	n := copy(p, b.buf[off-b.base:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek sets the offset for the next Read. Seeking beyond the end of the
+// stream stops at the end.
+func (b *dcmStreamBuffer) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case os.SEEK_SET:
+		abs = offset
+	case os.SEEK_CUR:
+		abs = b.pos + offset
+	case os.SEEK_END:
+		for !b.eof {
+			err := b.fill(b.end()+1, b.pos)
+			if err != nil {
+				return b.pos, err
+			}
+		}
+		abs = b.end() + offset
+	default:
+		return b.pos, errors.New("dcmStreamBuffer.Seek: invalid whence")
+	}
+	if abs < 0 {
+		return b.pos, errors.New("dcmStreamBuffer.Seek: negative position")
+	}
+	if abs < b.base {
+		return b.pos, errors.New("dcmStreamBuffer.Seek: the bytes are discarded")
+	}
+	err := b.fill(abs, abs)
+	if err != nil {
+		return b.pos, err
+	}
+	if abs > b.end() {
+		abs = b.end()
+	}
+	b.pos = abs
+	return abs, nil
+}
+
+// dcmValueSource opens the value of an element by the position in the stream.
+type dcmValueSource interface {
+	openValue(offset int64, length int64) (io.ReadCloser, error)
+	mapValue(offset int64, length int64) (*DcmMappedValue, error)
+}
+
+// dcmFileSource opens the file again to read the value.
+type dcmFileSource struct {
+	filename string
+}
+
+func (src dcmFileSource) openValue(offset int64, length int64) (io.ReadCloser, error) {
+	f, err := os.Open(src.filename)
+	if err != nil {
+		return nil, err
+	}
+	_, err = f.Seek(offset, os.SEEK_SET)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return dcmFileValue{io.LimitReader(f, length), f}, nil
+}
+
+// dcmFileValue closes the file after reading the value.
+type dcmFileValue struct {
+	io.Reader
+	io.Closer
+}
+
+// dcmReaderAtSource reads the value from the io.ReaderAt the stream is read from.
+type dcmReaderAtSource struct {
+	reader io.ReaderAt
+}
+
+func (src dcmReaderAtSource) openValue(offset int64, length int64) (io.ReadCloser, error) {
+	return ioutil.NopCloser(io.NewSectionReader(src.reader, offset, length)), nil
+}
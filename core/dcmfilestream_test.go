@@ -1,5 +1,143 @@
 package core
 
 import (
-	_ "testing"
+	"bytes"
+	"io"
+	"os"
+	"testing"
 )
+
+// onlyReader hides the Seek method of the underlying reader.
+type onlyReader struct {
+	io.Reader
+}
+
+// shortReader returns at most 3 bytes for each Read.
+type shortReader struct {
+	*bytes.Reader
+}
+
+func (r shortReader) Read(p []byte) (int, error) {
+	if len(p) > 3 {
+		p = p[:3]
+	}
+	return r.Reader.Read(p)
+}
+
+func testDcmFileStreamOperations(t *testing.T, name string, s *DcmFileStream) {
+	b, err := s.Read(4)
+	if err != nil || string(b) != "0123" {
+		t.Errorf("%s: Read(4), want '0123' got '%s' (%v)", name, b, err)
+	}
+	n, err := s.Skip(2)
+	if err != nil || n != 2 || s.Position != 6 {
+		t.Errorf("%s: Skip(2), want 2 at position 6 got %d at position %d (%v)", name, n, s.Position, err)
+	}
+	err = s.Putback(3)
+	if err != nil || s.Position != 3 {
+		t.Errorf("%s: Putback(3), want position 3 got %d (%v)", name, s.Position, err)
+	}
+	str, err := s.ReadString(3)
+	if err != nil || str != "345" {
+		t.Errorf("%s: ReadString(3), want '345' got '%s' (%v)", name, str, err)
+	}
+	if s.Eos() {
+		t.Errorf("%s: Eos() at position %d, want false", name, s.Position)
+	}
+	err = s.Putback(7)
+	if err == nil {
+		t.Errorf("%s: Putback(7) at position 6 should fail", name)
+	}
+	n, _ = s.Skip(10)
+	if n != 4 || s.Position != 10 {
+		t.Errorf("%s: Skip(10), want 4 at position 10 got %d at position %d", name, n, s.Position)
+	}
+	if !s.Eos() {
+		t.Errorf("%s: Eos() at position %d, want true", name, s.Position)
+	}
+	err = s.SeekToBegin()
+	if err != nil || s.Position != 0 {
+		t.Errorf("%s: SeekToBegin(), want position 0 got %d (%v)", name, s.Position, err)
+	}
+	b, _ = s.Read(10)
+	if string(b) != "0123456789" {
+		t.Errorf("%s: Read(10) after SeekToBegin(), want '0123456789' got '%s'", name, b)
+	}
+}
+
+func TestDcmFileStreamOpenReader(t *testing.T) {
+	data := []byte("0123456789")
+
+	var seeker DcmFileStream
+	err := seeker.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("OpenReader(): %s", err.Error())
+	}
+	if seeker.Size != 10 {
+		t.Errorf("OpenReader(), want size 10 got %d", seeker.Size)
+	}
+	testDcmFileStreamOperations(t, "io.ReadSeeker", &seeker)
+
+	var reader DcmFileStream
+	err = reader.OpenReader(onlyReader{bytes.NewReader(data)})
+	if err != nil {
+		t.Fatalf("OpenReader(): %s", err.Error())
+	}
+	if reader.Size != -1 {
+		t.Errorf("OpenReader(), want size -1 got %d", reader.Size)
+	}
+	testDcmFileStreamOperations(t, "io.Reader", &reader)
+
+	// a pipe is an io.ReadSeeker, but Seek fails
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe(): %s", err.Error())
+	}
+	defer pr.Close()
+	go func() {
+		pw.Write(data)
+		pw.Close()
+	}()
+	var pipe DcmFileStream
+	err = pipe.OpenReader(pr)
+	if err != nil {
+		t.Fatalf("OpenReader(): %s", err.Error())
+	}
+	if pipe.Size != -1 {
+		t.Errorf("OpenReader(), want size -1 got %d", pipe.Size)
+	}
+	testDcmFileStreamOperations(t, "pipe", &pipe)
+}
+
+func TestDcmFileStreamOpenReaderAt(t *testing.T) {
+	var s DcmFileStream
+	err := s.OpenReaderAt(bytes.NewReader([]byte("0123456789abcdef")), 10)
+	if err != nil {
+		t.Fatalf("OpenReaderAt(): %s", err.Error())
+	}
+	if s.Size != 10 {
+		t.Errorf("OpenReaderAt(), want size 10 got %d", s.Size)
+	}
+	testDcmFileStreamOperations(t, "io.ReaderAt", &s)
+}
+
+func TestDcmFileStreamShortRead(t *testing.T) {
+	var s DcmFileStream
+	err := s.OpenReader(shortReader{bytes.NewReader([]byte("0123456789"))})
+	if err != nil {
+		t.Fatalf("OpenReader(): %s", err.Error())
+	}
+	testDcmFileStreamOperations(t, "short reads", &s)
+
+	// a truncated value
+	s.SeekToBegin()
+	s.Skip(6)
+	b, err := s.Read(8)
+	if err != io.ErrUnexpectedEOF || string(b) != "6789" || s.Position != 10 {
+		t.Errorf("Read(8) at position 6, want '6789' at position 10 with io.ErrUnexpectedEOF got '%s' at position %d (%v)", b, s.Position, err)
+	}
+	b, err = s.Read(2)
+	if err != io.ErrUnexpectedEOF || len(b) != 0 || s.Position != 10 {
+		t.Errorf("Read(2) at the end, want no bytes with io.ErrUnexpectedEOF got '%s' at position %d (%v)", b, s.Position, err)
+	}
+}
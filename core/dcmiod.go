@@ -0,0 +1,523 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// EAttributeType is the type of an attribute of a module, see PS3.5 7.4.
+type EAttributeType int
+
+const (
+	// EATType1 is required with a value.
+	EATType1 EAttributeType = iota
+	// EATType1C is required with a value if the condition is true.
+	EATType1C
+	// EATType2 is required, the value may be empty.
+	EATType2
+	// EATType2C is required if the condition is true, the value may be empty.
+	EATType2C
+	// EATType3 is optional.
+	EATType3
+)
+
+var attributeTypeNames = []string{"1", "1C", "2", "2C", "3"}
+
+func (t EAttributeType) String() string {
+	if t < 0 || int(t) >= len(attributeTypeNames) {
+		return "?"
+	}
+	return attributeTypeNames[t]
+}
+
+// DcmModuleAttribute is an attribute of a module. The attributes of the types 1C and
+// 2C are required if Condition returns true.
+type DcmModuleAttribute struct {
+	Tag       DcmTag
+	Type      EAttributeType
+	Condition func(dataset DcmDataset) bool
+}
+
+// DcmModule is a module of PS3.3 Annex C. Only the attributes of the types 1, 1C, 2 and
+// 2C are listed, since the others are not checked.
+type DcmModule struct {
+	Name       string
+	Attributes []DcmModuleAttribute
+}
+
+// EModuleUsage is the usage of a module in an IOD, see PS3.3 A.1.3.
+type EModuleUsage int
+
+const (
+	// EMUMandatory is a module which is always checked.
+	EMUMandatory EModuleUsage = iota
+	// EMUConditional is a module which is checked if the condition is true, or if any
+	// of its attributes is in the data set if there is no condition.
+	EMUConditional
+	// EMUUserOption is a module which is checked if any of its attributes is in the
+	// data set.
+	EMUUserOption
+)
+
+// DcmIODModule is a module of an IOD.
+type DcmIODModule struct {
+	Module    DcmModule
+	Usage     EModuleUsage
+	Condition func(dataset DcmDataset) bool
+}
+
+// DcmIOD is an Information Object Definition of PS3.3 Annex A.
+type DcmIOD struct {
+	Name    string
+	Modules []DcmIODModule
+}
+
+// the conditions of the attributes and the modules
+
+func isPresent(tag DcmTag) func(DcmDataset) bool {
+	return func(dataset DcmDataset) bool {
+		var elem DcmElement
+		elem.Tag = tag
+		return dataset.FindElement(&elem) == nil
+	}
+}
+
+func isAbsent(tag DcmTag) func(DcmDataset) bool {
+	present := isPresent(tag)
+	return func(dataset DcmDataset) bool {
+		return !present(dataset)
+	}
+}
+
+// hasValue is to check whether any value of the element is one of the values.
+func hasValue(tag DcmTag, values ...string) func(DcmDataset) bool {
+	return func(dataset DcmDataset) bool {
+		v, _ := dataset.GetStrings(tag)
+		for _, s := range v {
+			for _, want := range values {
+				if s == want {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+func isGreaterThan(tag DcmTag, n int) func(DcmDataset) bool {
+	return func(dataset DcmDataset) bool {
+		v, err := dataset.GetInt(tag)
+		return err == nil && v > n
+	}
+}
+
+// isFrameIncrementPointer is to check whether Frame Increment Pointer points to the tag.
+func isFrameIncrementPointer(tag DcmTag) func(DcmDataset) bool {
+	return func(dataset DcmDataset) bool {
+		v, _ := dataset.GetUint16s(DCMFrameIncrementPointer)
+		for i := 0; i+1 < len(v); i += 2 {
+			if v[i] == tag.Group && v[i+1] == tag.Element {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isPatientPositionRequired is the condition of Patient Position of CT and MR.
+func isPatientPositionRequired(dataset DcmDataset) bool {
+	switch dataset.GetElementValue(DCMSOPClassUID) {
+	case UIDCTImageStorage, UIDMRImageStorage:
+		return isAbsent(DCMPatientOrientationCodeSequence)(dataset)
+	}
+	return false
+}
+
+// usesExtendedCharacters is to check whether any text value is not of the default
+// character repertoire, which requires Specific Character Set.
+func usesExtendedCharacters(dataset DcmDataset) bool {
+	for _, e := range dataset.Elements {
+		if !isCharsetVR(e.VR) {
+			continue
+		}
+		for _, b := range e.Value {
+			if b >= 0x80 || b == 0x1b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// the modules of PS3.3 Annex C
+var (
+	DcmPatientModule = DcmModule{Name: "Patient", Attributes: []DcmModuleAttribute{
+		{Tag: DCMPatientName, Type: EATType2},
+		{Tag: DCMPatientID, Type: EATType2},
+		{Tag: DCMPatientBirthDate, Type: EATType2},
+		{Tag: DCMPatientSex, Type: EATType2},
+	}}
+
+	DcmGeneralStudyModule = DcmModule{Name: "General Study", Attributes: []DcmModuleAttribute{
+		{Tag: DCMStudyInstanceUID, Type: EATType1},
+		{Tag: DCMStudyDate, Type: EATType2},
+		{Tag: DCMStudyTime, Type: EATType2},
+		{Tag: DCMReferringPhysicianName, Type: EATType2},
+		{Tag: DCMStudyID, Type: EATType2},
+		{Tag: DCMAccessionNumber, Type: EATType2},
+	}}
+
+	DcmGeneralSeriesModule = DcmModule{Name: "General Series", Attributes: []DcmModuleAttribute{
+		{Tag: DCMModality, Type: EATType1},
+		{Tag: DCMSeriesInstanceUID, Type: EATType1},
+		{Tag: DCMSeriesNumber, Type: EATType2},
+		{Tag: DCMPatientPosition, Type: EATType2C, Condition: isPatientPositionRequired},
+	}}
+
+	DcmFrameOfReferenceModule = DcmModule{Name: "Frame of Reference", Attributes: []DcmModuleAttribute{
+		{Tag: DCMFrameOfReferenceUID, Type: EATType1},
+		{Tag: DCMPositionReferenceIndicator, Type: EATType2},
+	}}
+
+	DcmGeneralEquipmentModule = DcmModule{Name: "General Equipment", Attributes: []DcmModuleAttribute{
+		{Tag: DCMManufacturer, Type: EATType2},
+	}}
+
+	DcmGeneralImageModule = DcmModule{Name: "General Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMInstanceNumber, Type: EATType2},
+		{Tag: DCMPatientOrientation, Type: EATType2C, Condition: isAbsent(DCMImageOrientationPatient)},
+	}}
+
+	DcmImagePlaneModule = DcmModule{Name: "Image Plane", Attributes: []DcmModuleAttribute{
+		{Tag: DCMPixelSpacing, Type: EATType1},
+		{Tag: DCMImageOrientationPatient, Type: EATType1},
+		{Tag: DCMImagePositionPatient, Type: EATType1},
+		{Tag: DCMSliceThickness, Type: EATType2},
+	}}
+
+	DcmImagePixelModule = DcmModule{Name: "Image Pixel", Attributes: []DcmModuleAttribute{
+		{Tag: DCMSamplesPerPixel, Type: EATType1},
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+		{Tag: DCMRows, Type: EATType1},
+		{Tag: DCMColumns, Type: EATType1},
+		{Tag: DCMBitsAllocated, Type: EATType1},
+		{Tag: DCMBitsStored, Type: EATType1},
+		{Tag: DCMHighBit, Type: EATType1},
+		{Tag: DCMPixelRepresentation, Type: EATType1},
+		{Tag: DCMPixelData, Type: EATType1C, Condition: isAbsent(DCMPixelDataProviderURL)},
+		{Tag: DCMPlanarConfiguration, Type: EATType1C, Condition: isGreaterThan(DCMSamplesPerPixel, 1)},
+		{Tag: DCMRedPaletteColorLookupTableDescriptor, Type: EATType1C, Condition: hasValue(DCMPhotometricInterpretation, "PALETTE COLOR")},
+		{Tag: DCMGreenPaletteColorLookupTableDescriptor, Type: EATType1C, Condition: hasValue(DCMPhotometricInterpretation, "PALETTE COLOR")},
+		{Tag: DCMBluePaletteColorLookupTableDescriptor, Type: EATType1C, Condition: hasValue(DCMPhotometricInterpretation, "PALETTE COLOR")},
+	}}
+
+	DcmContrastBolusModule = DcmModule{Name: "Contrast/Bolus", Attributes: []DcmModuleAttribute{
+		{Tag: DCMContrastBolusAgent, Type: EATType2},
+	}}
+
+	DcmCineModule = DcmModule{Name: "Cine", Attributes: []DcmModuleAttribute{
+		{Tag: DCMFrameTime, Type: EATType1C, Condition: isFrameIncrementPointer(DCMFrameTime)},
+		{Tag: DCMFrameTimeVector, Type: EATType1C, Condition: isFrameIncrementPointer(DCMFrameTimeVector)},
+	}}
+
+	DcmMultiFrameModule = DcmModule{Name: "Multi-frame", Attributes: []DcmModuleAttribute{
+		{Tag: DCMNumberOfFrames, Type: EATType1},
+		{Tag: DCMFrameIncrementPointer, Type: EATType1},
+	}}
+
+	DcmCRSeriesModule = DcmModule{Name: "CR Series", Attributes: []DcmModuleAttribute{
+		{Tag: DCMBodyPartExamined, Type: EATType2},
+		{Tag: DCMViewPosition, Type: EATType2},
+	}}
+
+	DcmCRImageModule = DcmModule{Name: "CR Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+	}}
+
+	DcmCTImageModule = DcmModule{Name: "CT Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMImageType, Type: EATType1},
+		{Tag: DCMSamplesPerPixel, Type: EATType1},
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+		{Tag: DCMBitsAllocated, Type: EATType1},
+		{Tag: DCMBitsStored, Type: EATType1},
+		{Tag: DCMHighBit, Type: EATType1},
+		{Tag: DCMRescaleIntercept, Type: EATType1},
+		{Tag: DCMRescaleSlope, Type: EATType1},
+		{Tag: DCMKVP, Type: EATType2},
+		{Tag: DCMAcquisitionNumber, Type: EATType2},
+	}}
+
+	DcmMRImageModule = DcmModule{Name: "MR Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMImageType, Type: EATType1},
+		{Tag: DCMSamplesPerPixel, Type: EATType1},
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+		{Tag: DCMBitsAllocated, Type: EATType1},
+		{Tag: DCMScanningSequence, Type: EATType1},
+		{Tag: DCMSequenceVariant, Type: EATType1},
+		{Tag: DCMScanOptions, Type: EATType2},
+		{Tag: DCMMRAcquisitionType, Type: EATType2},
+		{Tag: DCMRepetitionTime, Type: EATType2C, Condition: func(dataset DcmDataset) bool {
+			// required except when Scanning Sequence is EP and Sequence Variant is not SK
+			return !hasValue(DCMScanningSequence, "EP")(dataset) || hasValue(DCMSequenceVariant, "SK")(dataset)
+		}},
+		{Tag: DCMEchoTime, Type: EATType2},
+		{Tag: DCMEchoTrainLength, Type: EATType2},
+		{Tag: DCMInversionTime, Type: EATType2C, Condition: hasValue(DCMScanningSequence, "IR")},
+	}}
+
+	DcmUSImageModule = DcmModule{Name: "US Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMSamplesPerPixel, Type: EATType1},
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+		{Tag: DCMBitsAllocated, Type: EATType1},
+		{Tag: DCMBitsStored, Type: EATType1},
+		{Tag: DCMHighBit, Type: EATType1},
+		{Tag: DCMPlanarConfiguration, Type: EATType1C, Condition: isGreaterThan(DCMSamplesPerPixel, 1)},
+		{Tag: DCMPixelRepresentation, Type: EATType1},
+		{Tag: DCMImageType, Type: EATType2},
+	}}
+
+	DcmSCEquipmentModule = DcmModule{Name: "SC Equipment", Attributes: []DcmModuleAttribute{
+		{Tag: DCMConversionType, Type: EATType1},
+	}}
+
+	DcmPETSeriesModule = DcmModule{Name: "PET Series", Attributes: []DcmModuleAttribute{
+		{Tag: DCMSeriesDate, Type: EATType1},
+		{Tag: DCMSeriesTime, Type: EATType1},
+		{Tag: DCMUnits, Type: EATType1},
+		{Tag: DCMCountsSource, Type: EATType1},
+		{Tag: DCMSeriesType, Type: EATType1},
+		{Tag: DCMCorrectedImage, Type: EATType2},
+		{Tag: DCMDecayCorrection, Type: EATType1},
+		{Tag: DCMCollimatorType, Type: EATType2},
+		{Tag: DCMNumberOfSlices, Type: EATType1},
+	}}
+
+	DcmPETIsotopeModule = DcmModule{Name: "PET Isotope", Attributes: []DcmModuleAttribute{
+		{Tag: DCMRadiopharmaceuticalInformationSequence, Type: EATType2},
+	}}
+
+	DcmNMPETPatientOrientationModule = DcmModule{Name: "NM/PET Patient Orientation", Attributes: []DcmModuleAttribute{
+		{Tag: DCMPatientOrientationCodeSequence, Type: EATType2},
+		{Tag: DCMPatientGantryRelationshipCodeSequence, Type: EATType2},
+	}}
+
+	DcmPETImageModule = DcmModule{Name: "PET Image", Attributes: []DcmModuleAttribute{
+		{Tag: DCMImageType, Type: EATType1},
+		{Tag: DCMSamplesPerPixel, Type: EATType1},
+		{Tag: DCMPhotometricInterpretation, Type: EATType1},
+		{Tag: DCMBitsAllocated, Type: EATType1},
+		{Tag: DCMBitsStored, Type: EATType1},
+		{Tag: DCMHighBit, Type: EATType1},
+		{Tag: DCMRescaleIntercept, Type: EATType1},
+		{Tag: DCMRescaleSlope, Type: EATType1},
+		{Tag: DCMFrameReferenceTime, Type: EATType1},
+		{Tag: DCMImageIndex, Type: EATType1},
+		{Tag: DCMAcquisitionDate, Type: EATType2},
+		{Tag: DCMAcquisitionTime, Type: EATType2},
+		{Tag: DCMActualFrameDuration, Type: EATType2},
+		{Tag: DCMDecayFactor, Type: EATType1C, Condition: func(dataset DcmDataset) bool {
+			return isPresent(DCMDecayCorrection)(dataset) && !hasValue(DCMDecayCorrection, "NONE")(dataset)
+		}},
+	}}
+
+	DcmSOPCommonModule = DcmModule{Name: "SOP Common", Attributes: []DcmModuleAttribute{
+		{Tag: DCMSOPClassUID, Type: EATType1},
+		{Tag: DCMSOPInstanceUID, Type: EATType1},
+		{Tag: DCMSpecificCharacterSet, Type: EATType1C, Condition: usesExtendedCharacters},
+	}}
+)
+
+// the modules shared by the image IODs
+var (
+	patientStudySeriesModules = []DcmIODModule{
+		{Module: DcmPatientModule},
+		{Module: DcmGeneralStudyModule},
+		{Module: DcmGeneralSeriesModule},
+	}
+)
+
+// DcmIODRegistry contains the IODs of the Storage SOP Classes by SOP Class UID.
+var DcmIODRegistry = map[string]DcmIOD{
+	UIDComputedRadiographyImageStorage: {Name: "CR Image", Modules: iodModules(
+		DcmIODModule{Module: DcmCRSeriesModule},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmContrastBolusModule, Usage: EMUConditional},
+		DcmIODModule{Module: DcmCRImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)},
+	UIDCTImageStorage: {Name: "CT Image", Modules: iodModules(
+		DcmIODModule{Module: DcmFrameOfReferenceModule},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePlaneModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmContrastBolusModule, Usage: EMUConditional},
+		DcmIODModule{Module: DcmCTImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)},
+	UIDMRImageStorage: {Name: "MR Image", Modules: iodModules(
+		DcmIODModule{Module: DcmFrameOfReferenceModule},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePlaneModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmContrastBolusModule, Usage: EMUConditional},
+		DcmIODModule{Module: DcmMRImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)},
+	UIDUltrasoundImageStorage:                  usImageIOD,
+	UIDRetiredUltrasoundImageStorage:           usImageIOD,
+	UIDUltrasoundMultiframeImageStorage:        usMultiFrameImageIOD,
+	UIDRetiredUltrasoundMultiframeImageStorage: usMultiFrameImageIOD,
+	UIDSecondaryCaptureImageStorage: {Name: "SC Image", Modules: iodModules(
+		DcmIODModule{Module: DcmGeneralEquipmentModule, Usage: EMUUserOption},
+		DcmIODModule{Module: DcmSCEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)},
+	UIDPositronEmissionTomographyImageStorage: {Name: "PET Image", Modules: iodModules(
+		DcmIODModule{Module: DcmPETSeriesModule},
+		DcmIODModule{Module: DcmPETIsotopeModule},
+		DcmIODModule{Module: DcmNMPETPatientOrientationModule},
+		DcmIODModule{Module: DcmFrameOfReferenceModule},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePlaneModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmPETImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)},
+}
+
+var (
+	usImageIOD = DcmIOD{Name: "US Image", Modules: iodModules(
+		DcmIODModule{Module: DcmFrameOfReferenceModule, Usage: EMUUserOption},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmContrastBolusModule, Usage: EMUConditional},
+		DcmIODModule{Module: DcmUSImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)}
+
+	usMultiFrameImageIOD = DcmIOD{Name: "US Multi-frame Image", Modules: iodModules(
+		DcmIODModule{Module: DcmFrameOfReferenceModule, Usage: EMUUserOption},
+		DcmIODModule{Module: DcmGeneralEquipmentModule},
+		DcmIODModule{Module: DcmGeneralImageModule},
+		DcmIODModule{Module: DcmImagePixelModule},
+		DcmIODModule{Module: DcmContrastBolusModule, Usage: EMUConditional},
+		DcmIODModule{Module: DcmCineModule},
+		DcmIODModule{Module: DcmMultiFrameModule},
+		DcmIODModule{Module: DcmUSImageModule},
+		DcmIODModule{Module: DcmSOPCommonModule},
+	)}
+)
+
+// iodModules gets the modules of an image IOD following the Patient, General Study and
+// General Series modules.
+func iodModules(modules ...DcmIODModule) []DcmIODModule {
+	result := make([]DcmIODModule, 0, len(patientStudySeriesModules)+len(modules))
+	result = append(result, patientStudySeriesModules...)
+	return append(result, modules...)
+}
+
+// FindDcmIOD gets the IOD of the SOP Class.
+func FindDcmIOD(sopClassUID string) (DcmIOD, error) {
+	iod, ok := DcmIODRegistry[sopClassUID]
+	if !ok {
+		str := "not find the IOD of the SOP Class '" + sopClassUID + "'"
+		return iod, errors.New(str)
+	}
+	return iod, nil
+}
+
+// ValidateIOD checks the attributes of the modules of the IOD against their types. The
+// SOP Class is MediaStorageSOPClassUID of the meta information, or SOPClassUID of the
+// data set if the meta information has none.
+func ValidateIOD(meta DcmMetaInfo, dataset DcmDataset) (DcmValidationReport, error) {
+	var report DcmValidationReport
+	uid := strings.TrimRight(meta.MediaStorageSOPClassUID(), "\x00 ")
+	if uid == "" {
+		uid = strings.TrimRight(dataset.GetElementValue(DCMSOPClassUID), "\x00 ")
+	}
+	iod, err := FindDcmIOD(uid)
+	if err != nil {
+		return report, err
+	}
+	for _, m := range iod.Modules {
+		if !m.isUsed(dataset) {
+			continue
+		}
+		for _, attr := range m.Module.Attributes {
+			validateAttribute(dataset, m.Module, attr, &report)
+		}
+	}
+	return report, nil
+}
+
+// isUsed is to check whether the module is checked.
+func (m DcmIODModule) isUsed(dataset DcmDataset) bool {
+	if m.Usage == EMUMandatory {
+		return true
+	}
+	if m.Usage == EMUConditional && m.Condition != nil {
+		return m.Condition(dataset)
+	}
+	for _, attr := range m.Module.Attributes {
+		if isPresent(attr.Tag)(dataset) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAttribute(dataset DcmDataset, module DcmModule, attr DcmModuleAttribute, report *DcmValidationReport) {
+	switch attr.Type {
+	case EATType1C, EATType2C:
+		if attr.Condition == nil || !attr.Condition(dataset) {
+			return
+		}
+	case EATType3:
+		return
+	}
+
+	var elem DcmElement
+	elem.Tag = attr.Tag
+	err := dataset.FindElement(&elem)
+	code, state := EVCMissingAttribute, "missing"
+	if err == nil {
+		if attr.Type != EATType1 && attr.Type != EATType1C || !isEmptyElement(elem) {
+			return
+		}
+		code, state = EVCEmptyAttribute, "empty"
+	}
+	registry := DcmElement{Tag: attr.Tag}
+	FindDcmElmentByTag(&registry)
+	if elem.VR == "" {
+		elem.VR = registry.VR
+	}
+	message := "the Type " + attr.Type.String() + " attribute '" + registry.Name + "' of the " + module.Name + " module is " + state
+	issue := DcmValidationIssue{Tag: attr.Tag, VR: elem.VR, Path: attr.Tag.String(), Module: module.Name, Code: code, Message: message}
+	report.Issues = append(report.Issues, issue)
+}
+
+// isEmptyElement is to check whether the element has no value, or a sequence has no item.
+func isEmptyElement(e DcmElement) bool {
+	if e.Squence != nil {
+		return e.Tag != DCMPixelData && e.Squence.NumberOfItems() == 0
+	}
+	if e.Length == 0 {
+		return true
+	}
+	if e.Value == nil || e.VR == "OB" || e.VR == "UN" {
+		return false
+	}
+	if unit, ok := vrUnitSizes[e.VR]; ok && unit == 1 {
+		return len(bytes.TrimRight(e.Value, "\x00 ")) == 0
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func TestValidateIODFile(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []DcmTag
+	}{
+		{"CT1_J2KI", nil},
+		{"IM0.dcm", nil},
+		{"GH064.dcm", nil},
+		{"GH178.dcm", []DcmTag{DCMPixelData}},
+		{"CT-MONO2-16-ankle", []DcmTag{DCMPatientID, DCMPatientBirthDate, DCMPatientSex, DCMStudyID, DCMAccessionNumber, DCMPatientOrientation}},
+		{"MR-MONO2-8-16x-heart.dcm", []DcmTag{DCMFrameOfReferenceUID, DCMPixelSpacing, DCMScanningSequence, DCMSequenceVariant}},
+	}
+	for _, c := range cases {
+		var reader DcmReader
+		reader.IsReadValue = true
+		err := reader.ReadFile(util.GetTestDataFolder() + c.in)
+		if err != nil {
+			t.Errorf("DcmReader.ReadFile(%s): %s", c.in, err.Error())
+			continue
+		}
+		report, err := ValidateIOD(reader.Meta, reader.Dataset)
+		if err != nil {
+			t.Errorf("ValidateIOD(%s): %s", c.in, err.Error())
+			continue
+		}
+		if c.want == nil && !report.IsValid() {
+			t.Errorf("ValidateIOD(%s), want no issue got:\n%s", c.in, report)
+		}
+		for _, tag := range c.want {
+			issues := report.IssuesOf(tag)
+			if len(issues) == 0 || issues[0].Code != EVCMissingAttribute {
+				t.Errorf("ValidateIOD(%s), want '%s' missing got:\n%s", c.in, tag, report)
+			}
+		}
+	}
+}
+
+func TestValidateIOD(t *testing.T) {
+	var dataset DcmDataset
+	dataset.Set(DCMSOPClassUID, "", UIDUltrasoundImageStorage)
+	dataset.Set(DCMSOPInstanceUID, "", "1.2.3.4")
+	dataset.Set(DCMPatientName, "", "")
+	dataset.Set(DCMPatientID, "", "")
+	dataset.Set(DCMPatientBirthDate, "", "")
+	dataset.Set(DCMPatientSex, "", "")
+	dataset.Set(DCMStudyInstanceUID, "", "")
+	dataset.Set(DCMModality, "", "US")
+	dataset.Set(DCMSamplesPerPixel, "", 3)
+	dataset.Set(DCMPhotometricInterpretation, "", "RGB")
+
+	var meta DcmMetaInfo
+	report, err := ValidateIOD(meta, dataset)
+	if err != nil {
+		t.Fatalf("ValidateIOD(): %s", err.Error())
+	}
+	cases := []struct {
+		tag    DcmTag
+		code   EValidationCode
+		module string
+	}{
+		{DCMStudyInstanceUID, EVCEmptyAttribute, "General Study"},
+		{DCMStudyDate, EVCMissingAttribute, "General Study"},
+		{DCMSeriesInstanceUID, EVCMissingAttribute, "General Series"},
+		{DCMPlanarConfiguration, EVCMissingAttribute, "Image Pixel"},
+		{DCMPixelData, EVCMissingAttribute, "Image Pixel"},
+	}
+	for _, c := range cases {
+		issues := report.IssuesOf(c.tag)
+		if len(issues) == 0 || issues[0].Code != c.code || issues[0].Module != c.module {
+			t.Errorf("ValidateIOD(), want %s of '%s' in %s got:\n%s", c.code, c.tag, c.module, report)
+		}
+	}
+	// Type 2 and the conditions which are false
+	for _, tag := range []DcmTag{DCMPatientName, DCMRedPaletteColorLookupTableDescriptor, DCMFrameOfReferenceUID, DCMSpecificCharacterSet} {
+		if issues := report.IssuesOf(tag); len(issues) != 0 {
+			t.Errorf("ValidateIOD(), want no issue of '%s' got %v", tag, issues)
+		}
+	}
+
+	dataset.Set(DCMSOPClassUID, "", "1.2.3")
+	_, err = ValidateIOD(meta, dataset)
+	if err == nil {
+		t.Errorf("ValidateIOD() should fail with an unknown SOP Class")
+	}
+}
+
+func TestValidateIODRepetitionTime(t *testing.T) {
+	cases := []struct {
+		sequence string
+		variant  string
+		required bool
+	}{
+		{"SE", "NONE", true},
+		{"EP", "SK", true},
+		{"EP", "SP\\SK", true},
+		{"EP", "NONE", false},
+		{"EP\\IR", "SP", false},
+	}
+	for _, c := range cases {
+		var dataset DcmDataset
+		dataset.Set(DCMSOPClassUID, "", UIDMRImageStorage)
+		dataset.Set(DCMScanningSequence, "", c.sequence)
+		dataset.Set(DCMSequenceVariant, "", c.variant)
+
+		var meta DcmMetaInfo
+		report, err := ValidateIOD(meta, dataset)
+		if err != nil {
+			t.Fatalf("ValidateIOD(): %s", err.Error())
+		}
+		issues := report.IssuesOf(DCMRepetitionTime)
+		if c.required && (len(issues) == 0 || issues[0].Code != EVCMissingAttribute) {
+			t.Errorf("ValidateIOD(%s, %s), want '%s' missing got %v", c.sequence, c.variant, DCMRepetitionTime, issues)
+		}
+		if !c.required && len(issues) != 0 {
+			t.Errorf("ValidateIOD(%s, %s), want no issue of '%s' got %v", c.sequence, c.variant, DCMRepetitionTime, issues)
+		}
+	}
+}
@@ -0,0 +1,3340 @@
+// Code generated by dcmkeyword_gen.go from dcdeftag.go; DO NOT EDIT.
+
+package core
+
+// dcmKeywords maps the keywords of the data elements to the tags. The keywords are the
+// names of the tags in dcdeftag.go without the prefix DCM.
+var dcmKeywords = map[string]DcmTag{
+	"CommandGroupLength":                          DCMCommandGroupLength,
+	"ACRNEMACommandGroupLengthToEnd":              DCMACRNEMACommandGroupLengthToEnd,
+	"AffectedSOPClassUID":                         DCMAffectedSOPClassUID,
+	"RequestedSOPClassUID":                        DCMRequestedSOPClassUID,
+	"ACRNEMACommandRecognitionCode":               DCMACRNEMACommandRecognitionCode,
+	"CommandField":                                DCMCommandField,
+	"MessageID":                                   DCMMessageID,
+	"MessageIDBeingRespondedTo":                   DCMMessageIDBeingRespondedTo,
+	"ACRNEMAInitiator":                            DCMACRNEMAInitiator,
+	"ACRNEMAReceiver":                             DCMACRNEMAReceiver,
+	"ACRNEMAFindLocation":                         DCMACRNEMAFindLocation,
+	"MoveDestination":                             DCMMoveDestination,
+	"Priority":                                    DCMPriority,
+	"DataSetType":                                 DCMDataSetType,
+	"ACRNEMANumberOfMatches":                      DCMACRNEMANumberOfMatches,
+	"ACRNEMAResponseSequenceNumber":               DCMACRNEMAResponseSequenceNumber,
+	"Status":                                      DCMStatus,
+	"OffendingElement":                            DCMOffendingElement,
+	"ErrorComment":                                DCMErrorComment,
+	"ErrorID":                                     DCMErrorID,
+	"AffectedSOPInstanceUID":                      DCMAffectedSOPInstanceUID,
+	"RequestedSOPInstanceUID":                     DCMRequestedSOPInstanceUID,
+	"EventTypeID":                                 DCMEventTypeID,
+	"AttributeIdentifierList":                     DCMAttributeIdentifierList,
+	"ActionTypeID":                                DCMActionTypeID,
+	"NumberOfRemainingSubOperations":              DCMNumberOfRemainingSubOperations,
+	"NumberOfCompletedSubOperations":              DCMNumberOfCompletedSubOperations,
+	"NumberOfFailedSubOperations":                 DCMNumberOfFailedSubOperations,
+	"NumberOfWarningSubOperations":                DCMNumberOfWarningSubOperations,
+	"MoveOriginatorApplicationEntityTitle":        DCMMoveOriginatorApplicationEntityTitle,
+	"MoveOriginatorMessageID":                     DCMMoveOriginatorMessageID,
+	"ACRNEMADialogReceiver":                       DCMACRNEMADialogReceiver,
+	"ACRNEMATerminalType":                         DCMACRNEMATerminalType,
+	"ACRNEMAMessageSetID":                         DCMACRNEMAMessageSetID,
+	"ACRNEMAEndMessageSet":                        DCMACRNEMAEndMessageSet,
+	"ACRNEMADisplayFormat":                        DCMACRNEMADisplayFormat,
+	"ACRNEMAPagePositionID":                       DCMACRNEMAPagePositionID,
+	"ACRNEMATextFormatID":                         DCMACRNEMATextFormatID,
+	"ACRNEMANormalReverse":                        DCMACRNEMANormalReverse,
+	"ACRNEMAAddGrayScale":                         DCMACRNEMAAddGrayScale,
+	"ACRNEMABorders":                              DCMACRNEMABorders,
+	"ACRNEMACopies":                               DCMACRNEMACopies,
+	"ACRNEMAMagnificationType":                    DCMACRNEMAMagnificationType,
+	"ACRNEMAErase":                                DCMACRNEMAErase,
+	"ACRNEMAPrint":                                DCMACRNEMAPrint,
+	"ACRNEMAOverlays":                             DCMACRNEMAOverlays,
+	"FileMetaInformationGroupLength":              DCMFileMetaInformationGroupLength,
+	"FileMetaInformationVersion":                  DCMFileMetaInformationVersion,
+	"MediaStorageSOPClassUID":                     DCMMediaStorageSOPClassUID,
+	"MediaStorageSOPInstanceUID":                  DCMMediaStorageSOPInstanceUID,
+	"TransferSyntaxUID":                           DCMTransferSyntaxUID,
+	"ImplementationClassUID":                      DCMImplementationClassUID,
+	"ImplementationVersionName":                   DCMImplementationVersionName,
+	"SourceApplicationEntityTitle":                DCMSourceApplicationEntityTitle,
+	"SendingApplicationEntityTitle":               DCMSendingApplicationEntityTitle,
+	"ReceivingApplicationEntityTitle":             DCMReceivingApplicationEntityTitle,
+	"PrivateInformationCreatorUID":                DCMPrivateInformationCreatorUID,
+	"PrivateInformation":                          DCMPrivateInformation,
+	"FileSetID":                                   DCMFileSetID,
+	"FileSetDescriptorFileID":                     DCMFileSetDescriptorFileID,
+	"SpecificCharacterSetOfFileSetDescriptorFile": DCMSpecificCharacterSetOfFileSetDescriptorFile,
+	"OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity": DCMOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity,
+	"OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity":  DCMOffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity,
+	"FileSetConsistencyFlag":                                  DCMFileSetConsistencyFlag,
+	"DirectoryRecordSequence":                                 DCMDirectoryRecordSequence,
+	"OffsetOfTheNextDirectoryRecord":                          DCMOffsetOfTheNextDirectoryRecord,
+	"RecordInUseFlag":                                         DCMRecordInUseFlag,
+	"OffsetOfReferencedLowerLevelDirectoryEntity":             DCMOffsetOfReferencedLowerLevelDirectoryEntity,
+	"DirectoryRecordType":                                     DCMDirectoryRecordType,
+	"PrivateRecordUID":                                        DCMPrivateRecordUID,
+	"ReferencedFileID":                                        DCMReferencedFileID,
+	"RETIREDMRDRDirectoryRecordOffset":                        DCMRETIREDMRDRDirectoryRecordOffset,
+	"ReferencedSOPClassUIDInFile":                             DCMReferencedSOPClassUIDInFile,
+	"ReferencedSOPInstanceUIDInFile":                          DCMReferencedSOPInstanceUIDInFile,
+	"ReferencedTransferSyntaxUIDInFile":                       DCMReferencedTransferSyntaxUIDInFile,
+	"ReferencedRelatedGeneralSOPClassUIDInFile":               DCMReferencedRelatedGeneralSOPClassUIDInFile,
+	"RETIREDNumberOfReferences":                               DCMRETIREDNumberOfReferences,
+	"ACRNEMAIdentifyingGroupLengthToEnd":                      DCMACRNEMAIdentifyingGroupLengthToEnd,
+	"SpecificCharacterSet":                                    DCMSpecificCharacterSet,
+	"LanguageCodeSequence":                                    DCMLanguageCodeSequence,
+	"ImageType":                                               DCMImageType,
+	"ACRNEMARecognitionCode":                                  DCMACRNEMARecognitionCode,
+	"InstanceCreationDate":                                    DCMInstanceCreationDate,
+	"InstanceCreationTime":                                    DCMInstanceCreationTime,
+	"InstanceCreatorUID":                                      DCMInstanceCreatorUID,
+	"SOPClassUID":                                             DCMSOPClassUID,
+	"SOPInstanceUID":                                          DCMSOPInstanceUID,
+	"RelatedGeneralSOPClassUID":                               DCMRelatedGeneralSOPClassUID,
+	"OriginalSpecializedSOPClassUID":                          DCMOriginalSpecializedSOPClassUID,
+	"StudyDate":                                               DCMStudyDate,
+	"SeriesDate":                                              DCMSeriesDate,
+	"AcquisitionDate":                                         DCMAcquisitionDate,
+	"ContentDate":                                             DCMContentDate,
+	"RETIREDOverlayDate":                                      DCMRETIREDOverlayDate,
+	"RETIREDCurveDate":                                        DCMRETIREDCurveDate,
+	"AcquisitionDateTime":                                     DCMAcquisitionDateTime,
+	"StudyTime":                                               DCMStudyTime,
+	"SeriesTime":                                              DCMSeriesTime,
+	"AcquisitionTime":                                         DCMAcquisitionTime,
+	"ContentTime":                                             DCMContentTime,
+	"RETIREDOverlayTime":                                      DCMRETIREDOverlayTime,
+	"RETIREDCurveTime":                                        DCMRETIREDCurveTime,
+	"ACRNEMAOldDataSetType":                                   DCMACRNEMAOldDataSetType,
+	"ACRNEMADataSetSubtype":                                   DCMACRNEMADataSetSubtype,
+	"RETIREDNuclearMedicineSeriesType":                        DCMRETIREDNuclearMedicineSeriesType,
+	"AccessionNumber":                                         DCMAccessionNumber,
+	"IssuerOfAccessionNumberSequence":                         DCMIssuerOfAccessionNumberSequence,
+	"QueryRetrieveLevel":                                      DCMQueryRetrieveLevel,
+	"RetrieveAETitle":                                         DCMRetrieveAETitle,
+	"InstanceAvailability":                                    DCMInstanceAvailability,
+	"FailedSOPInstanceUIDList":                                DCMFailedSOPInstanceUIDList,
+	"Modality":                                                DCMModality,
+	"ModalitiesInStudy":                                       DCMModalitiesInStudy,
+	"SOPClassesInStudy":                                       DCMSOPClassesInStudy,
+	"ConversionType":                                          DCMConversionType,
+	"PresentationIntentType":                                  DCMPresentationIntentType,
+	"Manufacturer":                                            DCMManufacturer,
+	"InstitutionName":                                         DCMInstitutionName,
+	"InstitutionAddress":                                      DCMInstitutionAddress,
+	"InstitutionCodeSequence":                                 DCMInstitutionCodeSequence,
+	"ReferringPhysicianName":                                  DCMReferringPhysicianName,
+	"ReferringPhysicianAddress":                               DCMReferringPhysicianAddress,
+	"ReferringPhysicianTelephoneNumbers":                      DCMReferringPhysicianTelephoneNumbers,
+	"ReferringPhysicianIdentificationSequence":                DCMReferringPhysicianIdentificationSequence,
+	"CodeValue":                                               DCMCodeValue,
+	"CodingSchemeDesignator":                                  DCMCodingSchemeDesignator,
+	"CodingSchemeVersion":                                     DCMCodingSchemeVersion,
+	"CodeMeaning":                                             DCMCodeMeaning,
+	"MappingResource":                                         DCMMappingResource,
+	"ContextGroupVersion":                                     DCMContextGroupVersion,
+	"ContextGroupLocalVersion":                                DCMContextGroupLocalVersion,
+	"ContextGroupExtensionFlag":                               DCMContextGroupExtensionFlag,
+	"CodingSchemeUID":                                         DCMCodingSchemeUID,
+	"ContextGroupExtensionCreatorUID":                         DCMContextGroupExtensionCreatorUID,
+	"ContextIdentifier":                                       DCMContextIdentifier,
+	"CodingSchemeIdentificationSequence":                      DCMCodingSchemeIdentificationSequence,
+	"CodingSchemeRegistry":                                    DCMCodingSchemeRegistry,
+	"CodingSchemeExternalID":                                  DCMCodingSchemeExternalID,
+	"CodingSchemeName":                                        DCMCodingSchemeName,
+	"CodingSchemeResponsibleOrganization":                     DCMCodingSchemeResponsibleOrganization,
+	"ContextUID":                                              DCMContextUID,
+	"TimezoneOffsetFromUTC":                                   DCMTimezoneOffsetFromUTC,
+	"ACRNEMANetworkID":                                        DCMACRNEMANetworkID,
+	"StationName":                                             DCMStationName,
+	"StudyDescription":                                        DCMStudyDescription,
+	"ProcedureCodeSequence":                                   DCMProcedureCodeSequence,
+	"SeriesDescription":                                       DCMSeriesDescription,
+	"SeriesDescriptionCodeSequence":                           DCMSeriesDescriptionCodeSequence,
+	"InstitutionalDepartmentName":                             DCMInstitutionalDepartmentName,
+	"PhysiciansOfRecord":                                      DCMPhysiciansOfRecord,
+	"PhysiciansOfRecordIdentificationSequence":                DCMPhysiciansOfRecordIdentificationSequence,
+	"PerformingPhysicianName":                                 DCMPerformingPhysicianName,
+	"PerformingPhysicianIdentificationSequence":               DCMPerformingPhysicianIdentificationSequence,
+	"NameOfPhysiciansReadingStudy":                            DCMNameOfPhysiciansReadingStudy,
+	"PhysiciansReadingStudyIdentificationSequence":            DCMPhysiciansReadingStudyIdentificationSequence,
+	"OperatorsName":                                           DCMOperatorsName,
+	"OperatorIdentificationSequence":                          DCMOperatorIdentificationSequence,
+	"AdmittingDiagnosesDescription":                           DCMAdmittingDiagnosesDescription,
+	"AdmittingDiagnosesCodeSequence":                          DCMAdmittingDiagnosesCodeSequence,
+	"ManufacturerModelName":                                   DCMManufacturerModelName,
+	"RETIREDReferencedResultsSequence":                        DCMRETIREDReferencedResultsSequence,
+	"ReferencedStudySequence":                                 DCMReferencedStudySequence,
+	"ReferencedPerformedProcedureStepSequence":                DCMReferencedPerformedProcedureStepSequence,
+	"ReferencedSeriesSequence":                                DCMReferencedSeriesSequence,
+	"ReferencedPatientSequence":                               DCMReferencedPatientSequence,
+	"ReferencedVisitSequence":                                 DCMReferencedVisitSequence,
+	"RETIREDReferencedOverlaySequence":                        DCMRETIREDReferencedOverlaySequence,
+	"ReferencedStereometricInstanceSequence":                  DCMReferencedStereometricInstanceSequence,
+	"ReferencedWaveformSequence":                              DCMReferencedWaveformSequence,
+	"ReferencedImageSequence":                                 DCMReferencedImageSequence,
+	"RETIREDReferencedCurveSequence":                          DCMRETIREDReferencedCurveSequence,
+	"ReferencedInstanceSequence":                              DCMReferencedInstanceSequence,
+	"ReferencedRealWorldValueMappingInstanceSequence":         DCMReferencedRealWorldValueMappingInstanceSequence,
+	"ReferencedSOPClassUID":                                   DCMReferencedSOPClassUID,
+	"ReferencedSOPInstanceUID":                                DCMReferencedSOPInstanceUID,
+	"SOPClassesSupported":                                     DCMSOPClassesSupported,
+	"ReferencedFrameNumber":                                   DCMReferencedFrameNumber,
+	"SimpleFrameList":                                         DCMSimpleFrameList,
+	"CalculatedFrameList":                                     DCMCalculatedFrameList,
+	"TimeRange":                                               DCMTimeRange,
+	"FrameExtractionSequence":                                 DCMFrameExtractionSequence,
+	"MultiFrameSourceSOPInstanceUID":                          DCMMultiFrameSourceSOPInstanceUID,
+	"TransactionUID":                                          DCMTransactionUID,
+	"FailureReason":                                           DCMFailureReason,
+	"FailedSOPSequence":                                       DCMFailedSOPSequence,
+	"ReferencedSOPSequence":                                   DCMReferencedSOPSequence,
+	"StudiesContainingOtherReferencedInstancesSequence":       DCMStudiesContainingOtherReferencedInstancesSequence,
+	"RelatedSeriesSequence":                                   DCMRelatedSeriesSequence,
+	"RETIREDLossyImageCompressionRetired":                     DCMRETIREDLossyImageCompressionRetired,
+	"DerivationDescription":                                   DCMDerivationDescription,
+	"SourceImageSequence":                                     DCMSourceImageSequence,
+	"StageName":                                               DCMStageName,
+	"StageNumber":                                             DCMStageNumber,
+	"NumberOfStages":                                          DCMNumberOfStages,
+	"ViewName":                                                DCMViewName,
+	"ViewNumber":                                              DCMViewNumber,
+	"NumberOfEventTimers":                                     DCMNumberOfEventTimers,
+	"NumberOfViewsInStage":                                    DCMNumberOfViewsInStage,
+	"EventElapsedTimes":                                       DCMEventElapsedTimes,
+	"EventTimerNames":                                         DCMEventTimerNames,
+	"EventTimerSequence":                                      DCMEventTimerSequence,
+	"EventTimeOffset":                                         DCMEventTimeOffset,
+	"EventCodeSequence":                                       DCMEventCodeSequence,
+	"StartTrim":                                               DCMStartTrim,
+	"StopTrim":                                                DCMStopTrim,
+	"RecommendedDisplayFrameRate":                             DCMRecommendedDisplayFrameRate,
+	"RETIREDTransducerPosition":                               DCMRETIREDTransducerPosition,
+	"RETIREDTransducerOrientation":                            DCMRETIREDTransducerOrientation,
+	"RETIREDAnatomicStructure":                                DCMRETIREDAnatomicStructure,
+	"AnatomicRegionSequence":                                  DCMAnatomicRegionSequence,
+	"AnatomicRegionModifierSequence":                          DCMAnatomicRegionModifierSequence,
+	"PrimaryAnatomicStructureSequence":                        DCMPrimaryAnatomicStructureSequence,
+	"AnatomicStructureSpaceOrRegionSequence":                  DCMAnatomicStructureSpaceOrRegionSequence,
+	"PrimaryAnatomicStructureModifierSequence":                DCMPrimaryAnatomicStructureModifierSequence,
+	"RETIREDTransducerPositionSequence":                       DCMRETIREDTransducerPositionSequence,
+	"RETIREDTransducerPositionModifierSequence":               DCMRETIREDTransducerPositionModifierSequence,
+	"RETIREDTransducerOrientationSequence":                    DCMRETIREDTransducerOrientationSequence,
+	"RETIREDTransducerOrientationModifierSequence":            DCMRETIREDTransducerOrientationModifierSequence,
+	"RETIREDAnatomicStructureSpaceOrRegionCodeSequenceTrial":  DCMRETIREDAnatomicStructureSpaceOrRegionCodeSequenceTrial,
+	"RETIREDAnatomicPortalOfEntranceCodeSequenceTrial":        DCMRETIREDAnatomicPortalOfEntranceCodeSequenceTrial,
+	"RETIREDAnatomicApproachDirectionCodeSequenceTrial":       DCMRETIREDAnatomicApproachDirectionCodeSequenceTrial,
+	"RETIREDAnatomicPerspectiveDescriptionTrial":              DCMRETIREDAnatomicPerspectiveDescriptionTrial,
+	"RETIREDAnatomicPerspectiveCodeSequenceTrial":             DCMRETIREDAnatomicPerspectiveCodeSequenceTrial,
+	"RETIREDAnatomicLocationOfExaminingInstrumentDescriptionTrial":   DCMRETIREDAnatomicLocationOfExaminingInstrumentDescriptionTrial,
+	"RETIREDAnatomicLocationOfExaminingInstrumentCodeSequenceTrial":  DCMRETIREDAnatomicLocationOfExaminingInstrumentCodeSequenceTrial,
+	"RETIREDAnatomicStructureSpaceOrRegionModifierCodeSequenceTrial": DCMRETIREDAnatomicStructureSpaceOrRegionModifierCodeSequenceTrial,
+	"RETIREDOnAxisBackgroundAnatomicStructureCodeSequenceTrial":      DCMRETIREDOnAxisBackgroundAnatomicStructureCodeSequenceTrial,
+	"AlternateRepresentationSequence":                                DCMAlternateRepresentationSequence,
+	"IrradiationEventUID":                                            DCMIrradiationEventUID,
+	"ACRNEMAIdentifyingComments":                                     DCMACRNEMAIdentifyingComments,
+	"FrameType":                                                      DCMFrameType,
+	"ReferencedImageEvidenceSequence":                                DCMReferencedImageEvidenceSequence,
+	"ReferencedRawDataSequence":                                      DCMReferencedRawDataSequence,
+	"CreatorVersionUID":                                              DCMCreatorVersionUID,
+	"DerivationImageSequence":                                        DCMDerivationImageSequence,
+	"SourceImageEvidenceSequence":                                    DCMSourceImageEvidenceSequence,
+	"PixelPresentation":                                              DCMPixelPresentation,
+	"VolumetricProperties":                                           DCMVolumetricProperties,
+	"VolumeBasedCalculationTechnique":                                DCMVolumeBasedCalculationTechnique,
+	"ComplexImageComponent":                                          DCMComplexImageComponent,
+	"AcquisitionContrast":                                            DCMAcquisitionContrast,
+	"DerivationCodeSequence":                                         DCMDerivationCodeSequence,
+	"ReferencedPresentationStateSequence":                            DCMReferencedPresentationStateSequence,
+	"ReferencedOtherPlaneSequence":                                   DCMReferencedOtherPlaneSequence,
+	"FrameDisplaySequence":                                           DCMFrameDisplaySequence,
+	"RecommendedDisplayFrameRateInFloat":                             DCMRecommendedDisplayFrameRateInFloat,
+	"SkipFrameRangeFlag":                                             DCMSkipFrameRangeFlag,
+	"PatientName":                                                    DCMPatientName,
+	"PatientID":                                                      DCMPatientID,
+	"IssuerOfPatientID":                                              DCMIssuerOfPatientID,
+	"TypeOfPatientID":                                                DCMTypeOfPatientID,
+	"IssuerOfPatientIDQualifiersSequence":                            DCMIssuerOfPatientIDQualifiersSequence,
+	"PatientBirthDate":                                               DCMPatientBirthDate,
+	"PatientBirthTime":                                               DCMPatientBirthTime,
+	"PatientSex":                                                     DCMPatientSex,
+	"PatientInsurancePlanCodeSequence":                               DCMPatientInsurancePlanCodeSequence,
+	"PatientPrimaryLanguageCodeSequence":                             DCMPatientPrimaryLanguageCodeSequence,
+	"PatientPrimaryLanguageModifierCodeSequence":                     DCMPatientPrimaryLanguageModifierCodeSequence,
+	"OtherPatientIDs":                                                DCMOtherPatientIDs,
+	"OtherPatientNames":                                              DCMOtherPatientNames,
+	"OtherPatientIDsSequence":                                        DCMOtherPatientIDsSequence,
+	"PatientBirthName":                                               DCMPatientBirthName,
+	"PatientAge":                                                     DCMPatientAge,
+	"PatientSize":                                                    DCMPatientSize,
+	"PatientSizeCodeSequence":                                        DCMPatientSizeCodeSequence,
+	"PatientWeight":                                                  DCMPatientWeight,
+	"PatientAddress":                                                 DCMPatientAddress,
+	"ACRNEMAInsurancePlanIdentification":                             DCMACRNEMAInsurancePlanIdentification,
+	"PatientMotherBirthName":                                         DCMPatientMotherBirthName,
+	"MilitaryRank":                                                   DCMMilitaryRank,
+	"BranchOfService":                                                DCMBranchOfService,
+	"MedicalRecordLocator":                                           DCMMedicalRecordLocator,
+	"MedicalAlerts":                                                  DCMMedicalAlerts,
+	"Allergies":                                                      DCMAllergies,
+	"CountryOfResidence":                                             DCMCountryOfResidence,
+	"RegionOfResidence":                                              DCMRegionOfResidence,
+	"PatientTelephoneNumbers":                                        DCMPatientTelephoneNumbers,
+	"EthnicGroup":                                                    DCMEthnicGroup,
+	"Occupation":                                                     DCMOccupation,
+	"SmokingStatus":                                                  DCMSmokingStatus,
+	"AdditionalPatientHistory":                                       DCMAdditionalPatientHistory,
+	"PregnancyStatus":                                                DCMPregnancyStatus,
+	"LastMenstrualDate":                                              DCMLastMenstrualDate,
+	"PatientReligiousPreference":                                     DCMPatientReligiousPreference,
+	"PatientSpeciesDescription":                                      DCMPatientSpeciesDescription,
+	"PatientSpeciesCodeSequence":                                     DCMPatientSpeciesCodeSequence,
+	"PatientSexNeutered":                                             DCMPatientSexNeutered,
+	"AnatomicalOrientationType":                                      DCMAnatomicalOrientationType,
+	"PatientBreedDescription":                                        DCMPatientBreedDescription,
+	"PatientBreedCodeSequence":                                       DCMPatientBreedCodeSequence,
+	"BreedRegistrationSequence":                                      DCMBreedRegistrationSequence,
+	"BreedRegistrationNumber":                                        DCMBreedRegistrationNumber,
+	"BreedRegistryCodeSequence":                                      DCMBreedRegistryCodeSequence,
+	"ResponsiblePerson":                                              DCMResponsiblePerson,
+	"ResponsiblePersonRole":                                          DCMResponsiblePersonRole,
+	"ResponsibleOrganization":                                        DCMResponsibleOrganization,
+	"PatientComments":                                                DCMPatientComments,
+	"ExaminedBodyThickness":                                          DCMExaminedBodyThickness,
+	"ClinicalTrialSponsorName":                                       DCMClinicalTrialSponsorName,
+	"ClinicalTrialProtocolID":                                        DCMClinicalTrialProtocolID,
+	"ClinicalTrialProtocolName":                                      DCMClinicalTrialProtocolName,
+	"ClinicalTrialSiteID":                                            DCMClinicalTrialSiteID,
+	"ClinicalTrialSiteName":                                          DCMClinicalTrialSiteName,
+	"ClinicalTrialSubjectID":                                         DCMClinicalTrialSubjectID,
+	"ClinicalTrialSubjectReadingID":                                  DCMClinicalTrialSubjectReadingID,
+	"ClinicalTrialTimePointID":                                       DCMClinicalTrialTimePointID,
+	"ClinicalTrialTimePointDescription":                              DCMClinicalTrialTimePointDescription,
+	"ClinicalTrialCoordinatingCenterName":                            DCMClinicalTrialCoordinatingCenterName,
+	"PatientIdentityRemoved":                                         DCMPatientIdentityRemoved,
+	"DeidentificationMethod":                                         DCMDeidentificationMethod,
+	"DeidentificationMethodCodeSequence":                             DCMDeidentificationMethodCodeSequence,
+	"ClinicalTrialSeriesID":                                          DCMClinicalTrialSeriesID,
+	"ClinicalTrialSeriesDescription":                                 DCMClinicalTrialSeriesDescription,
+	"ClinicalTrialProtocolEthicsCommitteeName":                       DCMClinicalTrialProtocolEthicsCommitteeName,
+	"ClinicalTrialProtocolEthicsCommitteeApprovalNumber":             DCMClinicalTrialProtocolEthicsCommitteeApprovalNumber,
+	"ConsentForClinicalTrialUseSequence":                             DCMConsentForClinicalTrialUseSequence,
+	"DistributionType":                                               DCMDistributionType,
+	"ConsentForDistributionFlag":                                     DCMConsentForDistributionFlag,
+	"ContrastBolusAgent":                                             DCMContrastBolusAgent,
+	"ContrastBolusAgentSequence":                                     DCMContrastBolusAgentSequence,
+	"ContrastBolusAdministrationRouteSequence":                       DCMContrastBolusAdministrationRouteSequence,
+	"BodyPartExamined":                                               DCMBodyPartExamined,
+	"ScanningSequence":                                               DCMScanningSequence,
+	"SequenceVariant":                                                DCMSequenceVariant,
+	"ScanOptions":                                                    DCMScanOptions,
+	"MRAcquisitionType":                                              DCMMRAcquisitionType,
+	"SequenceName":                                                   DCMSequenceName,
+	"AngioFlag":                                                      DCMAngioFlag,
+	"InterventionDrugInformationSequence":                            DCMInterventionDrugInformationSequence,
+	"InterventionDrugStopTime":                                       DCMInterventionDrugStopTime,
+	"InterventionDrugDose":                                           DCMInterventionDrugDose,
+	"InterventionDrugCodeSequence":                                   DCMInterventionDrugCodeSequence,
+	"AdditionalDrugSequence":                                         DCMAdditionalDrugSequence,
+	"RETIREDRadionuclide":                                            DCMRETIREDRadionuclide,
+	"Radiopharmaceutical":                                            DCMRadiopharmaceutical,
+	"RETIREDEnergyWindowCenterline":                                  DCMRETIREDEnergyWindowCenterline,
+	"RETIREDEnergyWindowTotalWidth":                                  DCMRETIREDEnergyWindowTotalWidth,
+	"InterventionDrugName":                                           DCMInterventionDrugName,
+	"InterventionDrugStartTime":                                      DCMInterventionDrugStartTime,
+	"InterventionSequence":                                           DCMInterventionSequence,
+	"RETIREDTherapyType":                                             DCMRETIREDTherapyType,
+	"InterventionStatus":                                             DCMInterventionStatus,
+	"RETIREDTherapyDescription":                                      DCMRETIREDTherapyDescription,
+	"InterventionDescription":                                        DCMInterventionDescription,
+	"CineRate":                                                       DCMCineRate,
+	"InitialCineRunState":                                            DCMInitialCineRunState,
+	"SliceThickness":                                                 DCMSliceThickness,
+	"KVP":                                                            DCMKVP,
+	"CountsAccumulated":                                              DCMCountsAccumulated,
+	"AcquisitionTerminationCondition":                                DCMAcquisitionTerminationCondition,
+	"EffectiveDuration":                                              DCMEffectiveDuration,
+	"AcquisitionStartCondition":                                      DCMAcquisitionStartCondition,
+	"AcquisitionStartConditionData":                                  DCMAcquisitionStartConditionData,
+	"AcquisitionTerminationConditionData":                            DCMAcquisitionTerminationConditionData,
+	"RepetitionTime":                                                 DCMRepetitionTime,
+	"EchoTime":                                                       DCMEchoTime,
+	"InversionTime":                                                  DCMInversionTime,
+	"NumberOfAverages":                                               DCMNumberOfAverages,
+	"ImagingFrequency":                                               DCMImagingFrequency,
+	"ImagedNucleus":                                                  DCMImagedNucleus,
+	"EchoNumbers":                                                    DCMEchoNumbers,
+	"MagneticFieldStrength":                                          DCMMagneticFieldStrength,
+	"SpacingBetweenSlices":                                           DCMSpacingBetweenSlices,
+	"NumberOfPhaseEncodingSteps":                                     DCMNumberOfPhaseEncodingSteps,
+	"DataCollectionDiameter":                                         DCMDataCollectionDiameter,
+	"EchoTrainLength":                                                DCMEchoTrainLength,
+	"PercentSampling":                                                DCMPercentSampling,
+	"PercentPhaseFieldOfView":                                        DCMPercentPhaseFieldOfView,
+	"PixelBandwidth":                                                 DCMPixelBandwidth,
+	"DeviceSerialNumber":                                             DCMDeviceSerialNumber,
+	"DeviceUID":                                                      DCMDeviceUID,
+	"DeviceID":                                                       DCMDeviceID,
+	"PlateID":                                                        DCMPlateID,
+	"GeneratorID":                                                    DCMGeneratorID,
+	"GridID":                                                         DCMGridID,
+	"CassetteID":                                                     DCMCassetteID,
+	"GantryID":                                                       DCMGantryID,
+	"SecondaryCaptureDeviceID":                                       DCMSecondaryCaptureDeviceID,
+	"RETIREDHardcopyCreationDeviceID":                                DCMRETIREDHardcopyCreationDeviceID,
+	"DateOfSecondaryCapture":                                         DCMDateOfSecondaryCapture,
+	"TimeOfSecondaryCapture":                                         DCMTimeOfSecondaryCapture,
+	"SecondaryCaptureDeviceManufacturer":                             DCMSecondaryCaptureDeviceManufacturer,
+	"RETIREDHardcopyDeviceManufacturer":                              DCMRETIREDHardcopyDeviceManufacturer,
+	"SecondaryCaptureDeviceManufacturerModelName":                    DCMSecondaryCaptureDeviceManufacturerModelName,
+	"SecondaryCaptureDeviceSoftwareVersions":                         DCMSecondaryCaptureDeviceSoftwareVersions,
+	"RETIREDHardcopyDeviceSoftwareVersion":                           DCMRETIREDHardcopyDeviceSoftwareVersion,
+	"RETIREDHardcopyDeviceManufacturerModelName":                     DCMRETIREDHardcopyDeviceManufacturerModelName,
+	"SoftwareVersions":                                               DCMSoftwareVersions,
+	"VideoImageFormatAcquired":                                       DCMVideoImageFormatAcquired,
+	"DigitalImageFormatAcquired":                                     DCMDigitalImageFormatAcquired,
+	"ProtocolName":                                                   DCMProtocolName,
+	"ContrastBolusRoute":                                             DCMContrastBolusRoute,
+	"ContrastBolusVolume":                                            DCMContrastBolusVolume,
+	"ContrastBolusStartTime":                                         DCMContrastBolusStartTime,
+	"ContrastBolusStopTime":                                          DCMContrastBolusStopTime,
+	"ContrastBolusTotalDose":                                         DCMContrastBolusTotalDose,
+	"SyringeCounts":                                                  DCMSyringeCounts,
+	"ContrastFlowRate":                                               DCMContrastFlowRate,
+	"ContrastFlowDuration":                                           DCMContrastFlowDuration,
+	"ContrastBolusIngredient":                                        DCMContrastBolusIngredient,
+	"ContrastBolusIngredientConcentration":                           DCMContrastBolusIngredientConcentration,
+	"SpatialResolution":                                              DCMSpatialResolution,
+	"TriggerTime":                                                    DCMTriggerTime,
+	"TriggerSourceOrType":                                            DCMTriggerSourceOrType,
+	"NominalInterval":                                                DCMNominalInterval,
+	"FrameTime":                                                      DCMFrameTime,
+	"CardiacFramingType":                                             DCMCardiacFramingType,
+	"FrameTimeVector":                                                DCMFrameTimeVector,
+	"FrameDelay":                                                     DCMFrameDelay,
+	"ImageTriggerDelay":                                              DCMImageTriggerDelay,
+	"MultiplexGroupTimeOffset":                                       DCMMultiplexGroupTimeOffset,
+	"TriggerTimeOffset":                                              DCMTriggerTimeOffset,
+	"SynchronizationTrigger":                                         DCMSynchronizationTrigger,
+	"SynchronizationChannel":                                         DCMSynchronizationChannel,
+	"TriggerSamplePosition":                                          DCMTriggerSamplePosition,
+	"RadiopharmaceuticalRoute":                                       DCMRadiopharmaceuticalRoute,
+	"RadiopharmaceuticalVolume":                                      DCMRadiopharmaceuticalVolume,
+	"RadiopharmaceuticalStartTime":                                   DCMRadiopharmaceuticalStartTime,
+	"RadiopharmaceuticalStopTime":                                    DCMRadiopharmaceuticalStopTime,
+	"RadionuclideTotalDose":                                          DCMRadionuclideTotalDose,
+	"RadionuclideHalfLife":                                           DCMRadionuclideHalfLife,
+	"RadionuclidePositronFraction":                                   DCMRadionuclidePositronFraction,
+	"RadiopharmaceuticalSpecificActivity":                            DCMRadiopharmaceuticalSpecificActivity,
+	"RadiopharmaceuticalStartDateTime":                               DCMRadiopharmaceuticalStartDateTime,
+	"RadiopharmaceuticalStopDateTime":                                DCMRadiopharmaceuticalStopDateTime,
+	"BeatRejectionFlag":                                              DCMBeatRejectionFlag,
+	"LowRRValue":                                                     DCMLowRRValue,
+	"HighRRValue":                                                    DCMHighRRValue,
+	"IntervalsAcquired":                                              DCMIntervalsAcquired,
+	"IntervalsRejected":                                              DCMIntervalsRejected,
+	"PVCRejection":                                                   DCMPVCRejection,
+	"SkipBeats":                                                      DCMSkipBeats,
+	"HeartRate":                                                      DCMHeartRate,
+	"CardiacNumberOfImages":                                          DCMCardiacNumberOfImages,
+	"TriggerWindow":                                                  DCMTriggerWindow,
+	"ReconstructionDiameter":                                         DCMReconstructionDiameter,
+	"DistanceSourceToDetector":                                       DCMDistanceSourceToDetector,
+	"DistanceSourceToPatient":                                        DCMDistanceSourceToPatient,
+	"EstimatedRadiographicMagnificationFactor":                       DCMEstimatedRadiographicMagnificationFactor,
+	"GantryDetectorTilt":                                             DCMGantryDetectorTilt,
+	"GantryDetectorSlew":                                             DCMGantryDetectorSlew,
+	"TableHeight":                                                    DCMTableHeight,
+	"TableTraverse":                                                  DCMTableTraverse,
+	"TableMotion":                                                    DCMTableMotion,
+	"TableVerticalIncrement":                                         DCMTableVerticalIncrement,
+	"TableLateralIncrement":                                          DCMTableLateralIncrement,
+	"TableLongitudinalIncrement":                                     DCMTableLongitudinalIncrement,
+	"TableAngle":                                                     DCMTableAngle,
+	"TableType":                                                      DCMTableType,
+	"RotationDirection":                                              DCMRotationDirection,
+	"RETIREDAngularPosition":                                         DCMRETIREDAngularPosition,
+	"RadialPosition":                                                 DCMRadialPosition,
+	"ScanArc":                                                        DCMScanArc,
+	"AngularStep":                                                    DCMAngularStep,
+	"CenterOfRotationOffset":                                         DCMCenterOfRotationOffset,
+	"RETIREDRotationOffset":                                          DCMRETIREDRotationOffset,
+	"FieldOfViewShape":                                               DCMFieldOfViewShape,
+	"FieldOfViewDimensions":                                          DCMFieldOfViewDimensions,
+	"ExposureTime":                                                   DCMExposureTime,
+	"XRayTubeCurrent":                                                DCMXRayTubeCurrent,
+	"Exposure":                                                       DCMExposure,
+	"ExposureInuAs":                                                  DCMExposureInuAs,
+	"AveragePulseWidth":                                              DCMAveragePulseWidth,
+	"RadiationSetting":                                               DCMRadiationSetting,
+	"RectificationType":                                              DCMRectificationType,
+	"RadiationMode":                                                  DCMRadiationMode,
+	"ImageAndFluoroscopyAreaDoseProduct":                             DCMImageAndFluoroscopyAreaDoseProduct,
+	"FilterType":                                                     DCMFilterType,
+	"TypeOfFilters":                                                  DCMTypeOfFilters,
+	"IntensifierSize":                                                DCMIntensifierSize,
+	"ImagerPixelSpacing":                                             DCMImagerPixelSpacing,
+	"Grid":                                                           DCMGrid,
+	"GeneratorPower":                                                 DCMGeneratorPower,
+	"CollimatorGridName":                                             DCMCollimatorGridName,
+	"CollimatorType":                                                 DCMCollimatorType,
+	"FocalDistance":                                                  DCMFocalDistance,
+	"XFocusCenter":                                                   DCMXFocusCenter,
+	"YFocusCenter":                                                   DCMYFocusCenter,
+	"FocalSpots":                                                     DCMFocalSpots,
+	"AnodeTargetMaterial":                                            DCMAnodeTargetMaterial,
+	"BodyPartThickness":                                              DCMBodyPartThickness,
+	"CompressionForce":                                               DCMCompressionForce,
+	"DateOfLastCalibration":                                          DCMDateOfLastCalibration,
+	"TimeOfLastCalibration":                                          DCMTimeOfLastCalibration,
+	"ConvolutionKernel":                                              DCMConvolutionKernel,
+	"ACRNEMAUpperLowerPixelValues":                                   DCMACRNEMAUpperLowerPixelValues,
+	"ActualFrameDuration":                                            DCMActualFrameDuration,
+	"CountRate":                                                      DCMCountRate,
+	"PreferredPlaybackSequencing":                                    DCMPreferredPlaybackSequencing,
+	"ReceiveCoilName":                                                DCMReceiveCoilName,
+	"TransmitCoilName":                                               DCMTransmitCoilName,
+	"PlateType":                                                      DCMPlateType,
+	"PhosphorType":                                                   DCMPhosphorType,
+	"ScanVelocity":                                                   DCMScanVelocity,
+	"WholeBodyTechnique":                                             DCMWholeBodyTechnique,
+	"ScanLength":                                                     DCMScanLength,
+	"AcquisitionMatrix":                                              DCMAcquisitionMatrix,
+	"InPlanePhaseEncodingDirection":                                  DCMInPlanePhaseEncodingDirection,
+	"FlipAngle":                                                      DCMFlipAngle,
+	"VariableFlipAngleFlag":                                          DCMVariableFlipAngleFlag,
+	"SAR":                                                            DCMSAR,
+	"dBdt":                                                           DCMdBdt,
+	"AcquisitionDeviceProcessingDescription":                         DCMAcquisitionDeviceProcessingDescription,
+	"AcquisitionDeviceProcessingCode":                                DCMAcquisitionDeviceProcessingCode,
+	"CassetteOrientation":                                            DCMCassetteOrientation,
+	"CassetteSize":                                                   DCMCassetteSize,
+	"ExposuresOnPlate":                                               DCMExposuresOnPlate,
+	"RelativeXRayExposure":                                           DCMRelativeXRayExposure,
+	"ExposureIndex":                                                  DCMExposureIndex,
+	"TargetExposureIndex":                                            DCMTargetExposureIndex,
+	"DeviationIndex":                                                 DCMDeviationIndex,
+	"ColumnAngulation":                                               DCMColumnAngulation,
+	"TomoLayerHeight":                                                DCMTomoLayerHeight,
+	"TomoAngle":                                                      DCMTomoAngle,
+	"TomoTime":                                                       DCMTomoTime,
+	"TomoType":                                                       DCMTomoType,
+	"TomoClass":                                                      DCMTomoClass,
+	"NumberOfTomosynthesisSourceImages":                              DCMNumberOfTomosynthesisSourceImages,
+	"PositionerMotion":                                               DCMPositionerMotion,
+	"PositionerType":                                                 DCMPositionerType,
+	"PositionerPrimaryAngle":                                         DCMPositionerPrimaryAngle,
+	"PositionerSecondaryAngle":                                       DCMPositionerSecondaryAngle,
+	"PositionerPrimaryAngleIncrement":                                DCMPositionerPrimaryAngleIncrement,
+	"PositionerSecondaryAngleIncrement":                              DCMPositionerSecondaryAngleIncrement,
+	"DetectorPrimaryAngle":                                           DCMDetectorPrimaryAngle,
+	"DetectorSecondaryAngle":                                         DCMDetectorSecondaryAngle,
+	"ShutterShape":                                                   DCMShutterShape,
+	"ShutterLeftVerticalEdge":                                        DCMShutterLeftVerticalEdge,
+	"ShutterRightVerticalEdge":                                       DCMShutterRightVerticalEdge,
+	"ShutterUpperHorizontalEdge":                                     DCMShutterUpperHorizontalEdge,
+	"ShutterLowerHorizontalEdge":                                     DCMShutterLowerHorizontalEdge,
+	"CenterOfCircularShutter":                                        DCMCenterOfCircularShutter,
+	"RadiusOfCircularShutter":                                        DCMRadiusOfCircularShutter,
+	"VerticesOfThePolygonalShutter":                                  DCMVerticesOfThePolygonalShutter,
+	"ShutterPresentationValue":                                       DCMShutterPresentationValue,
+	"ShutterOverlayGroup":                                            DCMShutterOverlayGroup,
+	"ShutterPresentationColorCIELabValue":                            DCMShutterPresentationColorCIELabValue,
+	"CollimatorShape":                                                DCMCollimatorShape,
+	"CollimatorLeftVerticalEdge":                                     DCMCollimatorLeftVerticalEdge,
+	"CollimatorRightVerticalEdge":                                    DCMCollimatorRightVerticalEdge,
+	"CollimatorUpperHorizontalEdge":                                  DCMCollimatorUpperHorizontalEdge,
+	"CollimatorLowerHorizontalEdge":                                  DCMCollimatorLowerHorizontalEdge,
+	"CenterOfCircularCollimator":                                     DCMCenterOfCircularCollimator,
+	"RadiusOfCircularCollimator":                                     DCMRadiusOfCircularCollimator,
+	"VerticesOfThePolygonalCollimator":                               DCMVerticesOfThePolygonalCollimator,
+	"AcquisitionTimeSynchronized":                                    DCMAcquisitionTimeSynchronized,
+	"TimeSource":                                                     DCMTimeSource,
+	"TimeDistributionProtocol":                                       DCMTimeDistributionProtocol,
+	"NTPSourceAddress":                                               DCMNTPSourceAddress,
+	"PageNumberVector":                                               DCMPageNumberVector,
+	"FrameLabelVector":                                               DCMFrameLabelVector,
+	"FramePrimaryAngleVector":                                        DCMFramePrimaryAngleVector,
+	"FrameSecondaryAngleVector":                                      DCMFrameSecondaryAngleVector,
+	"SliceLocationVector":                                            DCMSliceLocationVector,
+	"DisplayWindowLabelVector":                                       DCMDisplayWindowLabelVector,
+	"NominalScannedPixelSpacing":                                     DCMNominalScannedPixelSpacing,
+	"DigitizingDeviceTransportDirection":                             DCMDigitizingDeviceTransportDirection,
+	"RotationOfScannedFilm":                                          DCMRotationOfScannedFilm,
+	"IVUSAcquisition":                                                DCMIVUSAcquisition,
+	"IVUSPullbackRate":                                               DCMIVUSPullbackRate,
+	"IVUSGatedRate":                                                  DCMIVUSGatedRate,
+	"IVUSPullbackStartFrameNumber":                                   DCMIVUSPullbackStartFrameNumber,
+	"IVUSPullbackStopFrameNumber":                                    DCMIVUSPullbackStopFrameNumber,
+	"LesionNumber":                                                   DCMLesionNumber,
+	"ACRNEMAAcquisitionComments":                                     DCMACRNEMAAcquisitionComments,
+	"OutputPower":                                                    DCMOutputPower,
+	"TransducerData":                                                 DCMTransducerData,
+	"FocusDepth":                                                     DCMFocusDepth,
+	"ProcessingFunction":                                             DCMProcessingFunction,
+	"RETIREDPostprocessingFunction":                                  DCMRETIREDPostprocessingFunction,
+	"MechanicalIndex":                                                DCMMechanicalIndex,
+	"BoneThermalIndex":                                               DCMBoneThermalIndex,
+	"CranialThermalIndex":                                            DCMCranialThermalIndex,
+	"SoftTissueThermalIndex":                                         DCMSoftTissueThermalIndex,
+	"SoftTissueFocusThermalIndex":                                    DCMSoftTissueFocusThermalIndex,
+	"SoftTissueSurfaceThermalIndex":                                  DCMSoftTissueSurfaceThermalIndex,
+	"ACRNEMADynamicRange":                                            DCMACRNEMADynamicRange,
+	"ACRNEMATotalGain":                                               DCMACRNEMATotalGain,
+	"DepthOfScanField":                                               DCMDepthOfScanField,
+	"PatientPosition":                                                DCMPatientPosition,
+	"ViewPosition":                                                   DCMViewPosition,
+	"ProjectionEponymousNameCodeSequence":                            DCMProjectionEponymousNameCodeSequence,
+	"RETIREDImageTransformationMatrix":                               DCMRETIREDImageTransformationMatrix,
+	"RETIREDImageTranslationVector":                                  DCMRETIREDImageTranslationVector,
+	"Sensitivity":                                                    DCMSensitivity,
+	"SequenceOfUltrasoundRegions":                                    DCMSequenceOfUltrasoundRegions,
+	"RegionSpatialFormat":                                            DCMRegionSpatialFormat,
+	"RegionDataType":                                                 DCMRegionDataType,
+	"RegionFlags":                                                    DCMRegionFlags,
+	"RegionLocationMinX0":                                            DCMRegionLocationMinX0,
+	"RegionLocationMinY0":                                            DCMRegionLocationMinY0,
+	"RegionLocationMaxX1":                                            DCMRegionLocationMaxX1,
+	"RegionLocationMaxY1":                                            DCMRegionLocationMaxY1,
+	"ReferencePixelX0":                                               DCMReferencePixelX0,
+	"ReferencePixelY0":                                               DCMReferencePixelY0,
+	"PhysicalUnitsXDirection":                                        DCMPhysicalUnitsXDirection,
+	"PhysicalUnitsYDirection":                                        DCMPhysicalUnitsYDirection,
+	"ReferencePixelPhysicalValueX":                                   DCMReferencePixelPhysicalValueX,
+	"ReferencePixelPhysicalValueY":                                   DCMReferencePixelPhysicalValueY,
+	"PhysicalDeltaX":                                                 DCMPhysicalDeltaX,
+	"PhysicalDeltaY":                                                 DCMPhysicalDeltaY,
+	"TransducerFrequency":                                            DCMTransducerFrequency,
+	"TransducerType":                                                 DCMTransducerType,
+	"PulseRepetitionFrequency":                                       DCMPulseRepetitionFrequency,
+	"DopplerCorrectionAngle":                                         DCMDopplerCorrectionAngle,
+	"SteeringAngle":                                                  DCMSteeringAngle,
+	"RETIREDDopplerSampleVolumeXPositionRetired":                     DCMRETIREDDopplerSampleVolumeXPositionRetired,
+	"DopplerSampleVolumeXPosition":                                   DCMDopplerSampleVolumeXPosition,
+	"RETIREDDopplerSampleVolumeYPositionRetired":                     DCMRETIREDDopplerSampleVolumeYPositionRetired,
+	"DopplerSampleVolumeYPosition":                                   DCMDopplerSampleVolumeYPosition,
+	"RETIREDTMLinePositionX0Retired":                                 DCMRETIREDTMLinePositionX0Retired,
+	"TMLinePositionX0":                                               DCMTMLinePositionX0,
+	"RETIREDTMLinePositionY0Retired":                                 DCMRETIREDTMLinePositionY0Retired,
+	"TMLinePositionY0":                                               DCMTMLinePositionY0,
+	"RETIREDTMLinePositionX1Retired":                                 DCMRETIREDTMLinePositionX1Retired,
+	"TMLinePositionX1":                                               DCMTMLinePositionX1,
+	"RETIREDTMLinePositionY1Retired":                                 DCMRETIREDTMLinePositionY1Retired,
+	"TMLinePositionY1":                                               DCMTMLinePositionY1,
+	"PixelComponentOrganization":                                     DCMPixelComponentOrganization,
+	"PixelComponentMask":                                             DCMPixelComponentMask,
+	"PixelComponentRangeStart":                                       DCMPixelComponentRangeStart,
+	"PixelComponentRangeStop":                                        DCMPixelComponentRangeStop,
+	"PixelComponentPhysicalUnits":                                    DCMPixelComponentPhysicalUnits,
+	"PixelComponentDataType":                                         DCMPixelComponentDataType,
+	"NumberOfTableBreakPoints":                                       DCMNumberOfTableBreakPoints,
+	"TableOfXBreakPoints":                                            DCMTableOfXBreakPoints,
+	"TableOfYBreakPoints":                                            DCMTableOfYBreakPoints,
+	"NumberOfTableEntries":                                           DCMNumberOfTableEntries,
+	"TableOfPixelValues":                                             DCMTableOfPixelValues,
+	"TableOfParameterValues":                                         DCMTableOfParameterValues,
+	"RWaveTimeVector":                                                DCMRWaveTimeVector,
+	"DetectorConditionsNominalFlag":                                  DCMDetectorConditionsNominalFlag,
+	"DetectorTemperature":                                            DCMDetectorTemperature,
+	"DetectorType":                                                   DCMDetectorType,
+	"DetectorConfiguration":                                          DCMDetectorConfiguration,
+	"DetectorDescription":                                            DCMDetectorDescription,
+	"DetectorMode":                                                   DCMDetectorMode,
+	"DetectorID":                                                     DCMDetectorID,
+	"DateOfLastDetectorCalibration":                                  DCMDateOfLastDetectorCalibration,
+	"TimeOfLastDetectorCalibration":                                  DCMTimeOfLastDetectorCalibration,
+	"ExposuresOnDetectorSinceLastCalibration":                        DCMExposuresOnDetectorSinceLastCalibration,
+	"ExposuresOnDetectorSinceManufactured":                           DCMExposuresOnDetectorSinceManufactured,
+	"DetectorTimeSinceLastExposure":                                  DCMDetectorTimeSinceLastExposure,
+	"DetectorActiveTime":                                             DCMDetectorActiveTime,
+	"DetectorActivationOffsetFromExposure":                           DCMDetectorActivationOffsetFromExposure,
+	"DetectorBinning":                                                DCMDetectorBinning,
+	"DetectorElementPhysicalSize":                                    DCMDetectorElementPhysicalSize,
+	"DetectorElementSpacing":                                         DCMDetectorElementSpacing,
+	"DetectorActiveShape":                                            DCMDetectorActiveShape,
+	"DetectorActiveDimensions":                                       DCMDetectorActiveDimensions,
+	"DetectorActiveOrigin":                                           DCMDetectorActiveOrigin,
+	"DetectorManufacturerName":                                       DCMDetectorManufacturerName,
+	"DetectorManufacturerModelName":                                  DCMDetectorManufacturerModelName,
+	"FieldOfViewOrigin":                                              DCMFieldOfViewOrigin,
+	"FieldOfViewRotation":                                            DCMFieldOfViewRotation,
+	"FieldOfViewHorizontalFlip":                                      DCMFieldOfViewHorizontalFlip,
+	"GridAbsorbingMaterial":                                          DCMGridAbsorbingMaterial,
+	"GridSpacingMaterial":                                            DCMGridSpacingMaterial,
+	"GridThickness":                                                  DCMGridThickness,
+	"GridPitch":                                                      DCMGridPitch,
+	"GridAspectRatio":                                                DCMGridAspectRatio,
+	"GridPeriod":                                                     DCMGridPeriod,
+	"GridFocalDistance":                                              DCMGridFocalDistance,
+	"FilterMaterial":                                                 DCMFilterMaterial,
+	"FilterThicknessMinimum":                                         DCMFilterThicknessMinimum,
+	"FilterThicknessMaximum":                                         DCMFilterThicknessMaximum,
+	"FilterBeamPathLengthMinimum":                                    DCMFilterBeamPathLengthMinimum,
+	"FilterBeamPathLengthMaximum":                                    DCMFilterBeamPathLengthMaximum,
+	"ExposureControlMode":                                            DCMExposureControlMode,
+	"ExposureControlModeDescription":                                 DCMExposureControlModeDescription,
+	"ExposureStatus":                                                 DCMExposureStatus,
+	"PhototimerSetting":                                              DCMPhototimerSetting,
+	"ExposureTimeInuS":                                               DCMExposureTimeInuS,
+	"XRayTubeCurrentInuA":                                            DCMXRayTubeCurrentInuA,
+	"ContentQualification":                                           DCMContentQualification,
+	"PulseSequenceName":                                              DCMPulseSequenceName,
+	"MRImagingModifierSequence":                                      DCMMRImagingModifierSequence,
+	"EchoPulseSequence":                                              DCMEchoPulseSequence,
+	"InversionRecovery":                                              DCMInversionRecovery,
+	"FlowCompensation":                                               DCMFlowCompensation,
+	"MultipleSpinEcho":                                               DCMMultipleSpinEcho,
+	"MultiPlanarExcitation":                                          DCMMultiPlanarExcitation,
+	"PhaseContrast":                                                  DCMPhaseContrast,
+	"TimeOfFlightContrast":                                           DCMTimeOfFlightContrast,
+	"Spoiling":                                                       DCMSpoiling,
+	"SteadyStatePulseSequence":                                       DCMSteadyStatePulseSequence,
+	"EchoPlanarPulseSequence":                                        DCMEchoPlanarPulseSequence,
+	"TagAngleFirstAxis":                                              DCMTagAngleFirstAxis,
+	"MagnetizationTransfer":                                          DCMMagnetizationTransfer,
+	"T2Preparation":                                                  DCMT2Preparation,
+	"BloodSignalNulling":                                             DCMBloodSignalNulling,
+	"SaturationRecovery":                                             DCMSaturationRecovery,
+	"SpectrallySelectedSuppression":                                  DCMSpectrallySelectedSuppression,
+	"SpectrallySelectedExcitation":                                   DCMSpectrallySelectedExcitation,
+	"SpatialPresaturation":                                           DCMSpatialPresaturation,
+	"Tagging":                                                        DCMTagging,
+	"OversamplingPhase":                                              DCMOversamplingPhase,
+	"TagSpacingFirstDimension":                                       DCMTagSpacingFirstDimension,
+	"GeometryOfKSpaceTraversal":                                      DCMGeometryOfKSpaceTraversal,
+	"SegmentedKSpaceTraversal":                                       DCMSegmentedKSpaceTraversal,
+	"RectilinearPhaseEncodeReordering":                               DCMRectilinearPhaseEncodeReordering,
+	"TagThickness":                                                   DCMTagThickness,
+	"PartialFourierDirection":                                        DCMPartialFourierDirection,
+	"CardiacSynchronizationTechnique":                                DCMCardiacSynchronizationTechnique,
+	"ReceiveCoilManufacturerName":                                    DCMReceiveCoilManufacturerName,
+	"MRReceiveCoilSequence":                                          DCMMRReceiveCoilSequence,
+	"ReceiveCoilType":                                                DCMReceiveCoilType,
+	"QuadratureReceiveCoil":                                          DCMQuadratureReceiveCoil,
+	"MultiCoilDefinitionSequence":                                    DCMMultiCoilDefinitionSequence,
+	"MultiCoilConfiguration":                                         DCMMultiCoilConfiguration,
+	"MultiCoilElementName":                                           DCMMultiCoilElementName,
+	"MultiCoilElementUsed":                                           DCMMultiCoilElementUsed,
+	"MRTransmitCoilSequence":                                         DCMMRTransmitCoilSequence,
+	"TransmitCoilManufacturerName":                                   DCMTransmitCoilManufacturerName,
+	"TransmitCoilType":                                               DCMTransmitCoilType,
+	"SpectralWidth":                                                  DCMSpectralWidth,
+	"ChemicalShiftReference":                                         DCMChemicalShiftReference,
+	"VolumeLocalizationTechnique":                                    DCMVolumeLocalizationTechnique,
+	"MRAcquisitionFrequencyEncodingSteps":                            DCMMRAcquisitionFrequencyEncodingSteps,
+	"Decoupling":                                                     DCMDecoupling,
+	"DecoupledNucleus":                                               DCMDecoupledNucleus,
+	"DecouplingFrequency":                                            DCMDecouplingFrequency,
+	"DecouplingMethod":                                               DCMDecouplingMethod,
+	"DecouplingChemicalShiftReference":                               DCMDecouplingChemicalShiftReference,
+	"KSpaceFiltering":                                                DCMKSpaceFiltering,
+	"TimeDomainFiltering":                                            DCMTimeDomainFiltering,
+	"NumberOfZeroFills":                                              DCMNumberOfZeroFills,
+	"BaselineCorrection":                                             DCMBaselineCorrection,
+	"ParallelReductionFactorInPlane":                                 DCMParallelReductionFactorInPlane,
+	"CardiacRRIntervalSpecified":                                     DCMCardiacRRIntervalSpecified,
+	"AcquisitionDuration":                                            DCMAcquisitionDuration,
+	"FrameAcquisitionDateTime":                                       DCMFrameAcquisitionDateTime,
+	"DiffusionDirectionality":                                        DCMDiffusionDirectionality,
+	"DiffusionGradientDirectionSequence":                             DCMDiffusionGradientDirectionSequence,
+	"ParallelAcquisition":                                            DCMParallelAcquisition,
+	"ParallelAcquisitionTechnique":                                   DCMParallelAcquisitionTechnique,
+	"InversionTimes":                                                 DCMInversionTimes,
+	"MetaboliteMapDescription":                                       DCMMetaboliteMapDescription,
+	"PartialFourier":                                                 DCMPartialFourier,
+	"EffectiveEchoTime":                                              DCMEffectiveEchoTime,
+	"MetaboliteMapCodeSequence":                                      DCMMetaboliteMapCodeSequence,
+	"ChemicalShiftSequence":                                          DCMChemicalShiftSequence,
+	"CardiacSignalSource":                                            DCMCardiacSignalSource,
+	"DiffusionBValue":                                                DCMDiffusionBValue,
+	"DiffusionGradientOrientation":                                   DCMDiffusionGradientOrientation,
+	"VelocityEncodingDirection":                                      DCMVelocityEncodingDirection,
+	"VelocityEncodingMinimumValue":                                   DCMVelocityEncodingMinimumValue,
+	"VelocityEncodingAcquisitionSequence":                            DCMVelocityEncodingAcquisitionSequence,
+	"NumberOfKSpaceTrajectories":                                     DCMNumberOfKSpaceTrajectories,
+	"CoverageOfKSpace":                                               DCMCoverageOfKSpace,
+	"SpectroscopyAcquisitionPhaseRows":                               DCMSpectroscopyAcquisitionPhaseRows,
+	"RETIREDParallelReductionFactorInPlaneRetired":                   DCMRETIREDParallelReductionFactorInPlaneRetired,
+	"TransmitterFrequency":                                           DCMTransmitterFrequency,
+	"ResonantNucleus":                                                DCMResonantNucleus,
+	"FrequencyCorrection":                                            DCMFrequencyCorrection,
+	"MRSpectroscopyFOVGeometrySequence":                              DCMMRSpectroscopyFOVGeometrySequence,
+	"SlabThickness":                                                  DCMSlabThickness,
+	"SlabOrientation":                                                DCMSlabOrientation,
+	"MidSlabPosition":                                                DCMMidSlabPosition,
+	"MRSpatialSaturationSequence":                                    DCMMRSpatialSaturationSequence,
+	"MRTimingAndRelatedParametersSequence":                           DCMMRTimingAndRelatedParametersSequence,
+	"MREchoSequence":                                                 DCMMREchoSequence,
+	"MRModifierSequence":                                             DCMMRModifierSequence,
+	"MRDiffusionSequence":                                            DCMMRDiffusionSequence,
+	"CardiacSynchronizationSequence":                                 DCMCardiacSynchronizationSequence,
+	"MRAveragesSequence":                                             DCMMRAveragesSequence,
+	"MRFOVGeometrySequence":                                          DCMMRFOVGeometrySequence,
+	"VolumeLocalizationSequence":                                     DCMVolumeLocalizationSequence,
+	"SpectroscopyAcquisitionDataColumns":                             DCMSpectroscopyAcquisitionDataColumns,
+	"DiffusionAnisotropyType":                                        DCMDiffusionAnisotropyType,
+	"FrameReferenceDateTime":                                         DCMFrameReferenceDateTime,
+	"MRMetaboliteMapSequence":                                        DCMMRMetaboliteMapSequence,
+	"ParallelReductionFactorOutOfPlane":                              DCMParallelReductionFactorOutOfPlane,
+	"SpectroscopyAcquisitionOutOfPlanePhaseSteps":                    DCMSpectroscopyAcquisitionOutOfPlanePhaseSteps,
+	"RETIREDBulkMotionStatus":                                        DCMRETIREDBulkMotionStatus,
+	"ParallelReductionFactorSecondInPlane":                           DCMParallelReductionFactorSecondInPlane,
+	"CardiacBeatRejectionTechnique":                                  DCMCardiacBeatRejectionTechnique,
+	"RespiratoryMotionCompensationTechnique":                         DCMRespiratoryMotionCompensationTechnique,
+	"RespiratorySignalSource":                                        DCMRespiratorySignalSource,
+	"BulkMotionCompensationTechnique":                                DCMBulkMotionCompensationTechnique,
+	"BulkMotionSignalSource":                                         DCMBulkMotionSignalSource,
+	"ApplicableSafetyStandardAgency":                                 DCMApplicableSafetyStandardAgency,
+	"ApplicableSafetyStandardDescription":                            DCMApplicableSafetyStandardDescription,
+	"OperatingModeSequence":                                          DCMOperatingModeSequence,
+	"OperatingModeType":                                              DCMOperatingModeType,
+	"OperatingMode":                                                  DCMOperatingMode,
+	"SpecificAbsorptionRateDefinition":                               DCMSpecificAbsorptionRateDefinition,
+	"GradientOutputType":                                             DCMGradientOutputType,
+	"SpecificAbsorptionRateValue":                                    DCMSpecificAbsorptionRateValue,
+	"GradientOutput":                                                 DCMGradientOutput,
+	"FlowCompensationDirection":                                      DCMFlowCompensationDirection,
+	"TaggingDelay":                                                   DCMTaggingDelay,
+	"RespiratoryMotionCompensationTechniqueDescription":              DCMRespiratoryMotionCompensationTechniqueDescription,
+	"RespiratorySignalSourceID":                                      DCMRespiratorySignalSourceID,
+	"RETIREDChemicalShiftMinimumIntegrationLimitInHz":                DCMRETIREDChemicalShiftMinimumIntegrationLimitInHz,
+	"RETIREDChemicalShiftMaximumIntegrationLimitInHz":                DCMRETIREDChemicalShiftMaximumIntegrationLimitInHz,
+	"MRVelocityEncodingSequence":                                     DCMMRVelocityEncodingSequence,
+	"FirstOrderPhaseCorrection":                                      DCMFirstOrderPhaseCorrection,
+	"WaterReferencedPhaseCorrection":                                 DCMWaterReferencedPhaseCorrection,
+	"MRSpectroscopyAcquisitionType":                                  DCMMRSpectroscopyAcquisitionType,
+	"RespiratoryCyclePosition":                                       DCMRespiratoryCyclePosition,
+	"VelocityEncodingMaximumValue":                                   DCMVelocityEncodingMaximumValue,
+	"TagSpacingSecondDimension":                                      DCMTagSpacingSecondDimension,
+	"TagAngleSecondAxis":                                             DCMTagAngleSecondAxis,
+	"FrameAcquisitionDuration":                                       DCMFrameAcquisitionDuration,
+	"MRImageFrameTypeSequence":                                       DCMMRImageFrameTypeSequence,
+	"MRSpectroscopyFrameTypeSequence":                                DCMMRSpectroscopyFrameTypeSequence,
+	"MRAcquisitionPhaseEncodingStepsInPlane":                         DCMMRAcquisitionPhaseEncodingStepsInPlane,
+	"MRAcquisitionPhaseEncodingStepsOutOfPlane":                      DCMMRAcquisitionPhaseEncodingStepsOutOfPlane,
+	"SpectroscopyAcquisitionPhaseColumns":                            DCMSpectroscopyAcquisitionPhaseColumns,
+	"CardiacCyclePosition":                                           DCMCardiacCyclePosition,
+	"SpecificAbsorptionRateSequence":                                 DCMSpecificAbsorptionRateSequence,
+	"RFEchoTrainLength":                                              DCMRFEchoTrainLength,
+	"GradientEchoTrainLength":                                        DCMGradientEchoTrainLength,
+	"ChemicalShiftMinimumIntegrationLimitInppm":                      DCMChemicalShiftMinimumIntegrationLimitInppm,
+	"ChemicalShiftMaximumIntegrationLimitInppm":                      DCMChemicalShiftMaximumIntegrationLimitInppm,
+	"CTAcquisitionTypeSequence":                                      DCMCTAcquisitionTypeSequence,
+	"AcquisitionType":                                                DCMAcquisitionType,
+	"TubeAngle":                                                      DCMTubeAngle,
+	"CTAcquisitionDetailsSequence":                                   DCMCTAcquisitionDetailsSequence,
+	"RevolutionTime":                                                 DCMRevolutionTime,
+	"SingleCollimationWidth":                                         DCMSingleCollimationWidth,
+	"TotalCollimationWidth":                                          DCMTotalCollimationWidth,
+	"CTTableDynamicsSequence":                                        DCMCTTableDynamicsSequence,
+	"TableSpeed":                                                     DCMTableSpeed,
+	"TableFeedPerRotation":                                           DCMTableFeedPerRotation,
+	"SpiralPitchFactor":                                              DCMSpiralPitchFactor,
+	"CTGeometrySequence":                                             DCMCTGeometrySequence,
+	"DataCollectionCenterPatient":                                    DCMDataCollectionCenterPatient,
+	"CTReconstructionSequence":                                       DCMCTReconstructionSequence,
+	"ReconstructionAlgorithm":                                        DCMReconstructionAlgorithm,
+	"ConvolutionKernelGroup":                                         DCMConvolutionKernelGroup,
+	"ReconstructionFieldOfView":                                      DCMReconstructionFieldOfView,
+	"ReconstructionTargetCenterPatient":                              DCMReconstructionTargetCenterPatient,
+	"ReconstructionAngle":                                            DCMReconstructionAngle,
+	"ImageFilter":                                                    DCMImageFilter,
+	"CTExposureSequence":                                             DCMCTExposureSequence,
+	"ReconstructionPixelSpacing":                                     DCMReconstructionPixelSpacing,
+	"ExposureModulationType":                                         DCMExposureModulationType,
+	"EstimatedDoseSaving":                                            DCMEstimatedDoseSaving,
+	"CTXRayDetailsSequence":                                          DCMCTXRayDetailsSequence,
+	"CTPositionSequence":                                             DCMCTPositionSequence,
+	"TablePosition":                                                  DCMTablePosition,
+	"ExposureTimeInms":                                               DCMExposureTimeInms,
+	"CTImageFrameTypeSequence":                                       DCMCTImageFrameTypeSequence,
+	"XRayTubeCurrentInmA":                                            DCMXRayTubeCurrentInmA,
+	"ExposureInmAs":                                                  DCMExposureInmAs,
+	"ConstantVolumeFlag":                                             DCMConstantVolumeFlag,
+	"FluoroscopyFlag":                                                DCMFluoroscopyFlag,
+	"DistanceSourceToDataCollectionCenter":                           DCMDistanceSourceToDataCollectionCenter,
+	"ContrastBolusAgentNumber":                                       DCMContrastBolusAgentNumber,
+	"ContrastBolusIngredientCodeSequence":                            DCMContrastBolusIngredientCodeSequence,
+	"ContrastAdministrationProfileSequence":                          DCMContrastAdministrationProfileSequence,
+	"ContrastBolusUsageSequence":                                     DCMContrastBolusUsageSequence,
+	"ContrastBolusAgentAdministered":                                 DCMContrastBolusAgentAdministered,
+	"ContrastBolusAgentDetected":                                     DCMContrastBolusAgentDetected,
+	"ContrastBolusAgentPhase":                                        DCMContrastBolusAgentPhase,
+	"CTDIvol":                                                        DCMCTDIvol,
+	"CTDIPhantomTypeCodeSequence":                                    DCMCTDIPhantomTypeCodeSequence,
+	"CalciumScoringMassFactorPatient":                                DCMCalciumScoringMassFactorPatient,
+	"CalciumScoringMassFactorDevice":                                 DCMCalciumScoringMassFactorDevice,
+	"EnergyWeightingFactor":                                          DCMEnergyWeightingFactor,
+	"CTAdditionalXRaySourceSequence":                                 DCMCTAdditionalXRaySourceSequence,
+	"ProjectionPixelCalibrationSequence":                             DCMProjectionPixelCalibrationSequence,
+	"DistanceSourceToIsocenter":                                      DCMDistanceSourceToIsocenter,
+	"DistanceObjectToTableTop":                                       DCMDistanceObjectToTableTop,
+	"ObjectPixelSpacingInCenterOfBeam":                               DCMObjectPixelSpacingInCenterOfBeam,
+	"PositionerPositionSequence":                                     DCMPositionerPositionSequence,
+	"TablePositionSequence":                                          DCMTablePositionSequence,
+	"CollimatorShapeSequence":                                        DCMCollimatorShapeSequence,
+	"XAXRFFrameCharacteristicsSequence":                              DCMXAXRFFrameCharacteristicsSequence,
+	"FrameAcquisitionSequence":                                       DCMFrameAcquisitionSequence,
+	"XRayReceptorType":                                               DCMXRayReceptorType,
+	"AcquisitionProtocolName":                                        DCMAcquisitionProtocolName,
+	"AcquisitionProtocolDescription":                                 DCMAcquisitionProtocolDescription,
+	"ContrastBolusIngredientOpaque":                                  DCMContrastBolusIngredientOpaque,
+	"DistanceReceptorPlaneToDetectorHousing":                         DCMDistanceReceptorPlaneToDetectorHousing,
+	"IntensifierActiveShape":                                         DCMIntensifierActiveShape,
+	"IntensifierActiveDimensions":                                    DCMIntensifierActiveDimensions,
+	"PhysicalDetectorSize":                                           DCMPhysicalDetectorSize,
+	"PositionOfIsocenterProjection":                                  DCMPositionOfIsocenterProjection,
+	"FieldOfViewSequence":                                            DCMFieldOfViewSequence,
+	"FieldOfViewDescription":                                         DCMFieldOfViewDescription,
+	"ExposureControlSensingRegionsSequence":                          DCMExposureControlSensingRegionsSequence,
+	"ExposureControlSensingRegionShape":                              DCMExposureControlSensingRegionShape,
+	"ExposureControlSensingRegionLeftVerticalEdge":                   DCMExposureControlSensingRegionLeftVerticalEdge,
+	"ExposureControlSensingRegionRightVerticalEdge":                  DCMExposureControlSensingRegionRightVerticalEdge,
+	"ExposureControlSensingRegionUpperHorizontalEdge":                DCMExposureControlSensingRegionUpperHorizontalEdge,
+	"ExposureControlSensingRegionLowerHorizontalEdge":                DCMExposureControlSensingRegionLowerHorizontalEdge,
+	"CenterOfCircularExposureControlSensingRegion":                   DCMCenterOfCircularExposureControlSensingRegion,
+	"RadiusOfCircularExposureControlSensingRegion":                   DCMRadiusOfCircularExposureControlSensingRegion,
+	"VerticesOfThePolygonalExposureControlSensingRegion":             DCMVerticesOfThePolygonalExposureControlSensingRegion,
+	"ColumnAngulationPatient":                                        DCMColumnAngulationPatient,
+	"BeamAngle":                                                      DCMBeamAngle,
+	"FrameDetectorParametersSequence":                                DCMFrameDetectorParametersSequence,
+	"CalculatedAnatomyThickness":                                     DCMCalculatedAnatomyThickness,
+	"CalibrationSequence":                                            DCMCalibrationSequence,
+	"ObjectThicknessSequence":                                        DCMObjectThicknessSequence,
+	"PlaneIdentification":                                            DCMPlaneIdentification,
+	"FieldOfViewDimensionsInFloat":                                   DCMFieldOfViewDimensionsInFloat,
+	"IsocenterReferenceSystemSequence":                               DCMIsocenterReferenceSystemSequence,
+	"PositionerIsocenterPrimaryAngle":                                DCMPositionerIsocenterPrimaryAngle,
+	"PositionerIsocenterSecondaryAngle":                              DCMPositionerIsocenterSecondaryAngle,
+	"PositionerIsocenterDetectorRotationAngle":                       DCMPositionerIsocenterDetectorRotationAngle,
+	"TableXPositionToIsocenter":                                      DCMTableXPositionToIsocenter,
+	"TableYPositionToIsocenter":                                      DCMTableYPositionToIsocenter,
+	"TableZPositionToIsocenter":                                      DCMTableZPositionToIsocenter,
+	"TableHorizontalRotationAngle":                                   DCMTableHorizontalRotationAngle,
+	"TableHeadTiltAngle":                                             DCMTableHeadTiltAngle,
+	"TableCradleTiltAngle":                                           DCMTableCradleTiltAngle,
+	"FrameDisplayShutterSequence":                                    DCMFrameDisplayShutterSequence,
+	"AcquiredImageAreaDoseProduct":                                   DCMAcquiredImageAreaDoseProduct,
+	"CArmPositionerTabletopRelationship":                             DCMCArmPositionerTabletopRelationship,
+	"XRayGeometrySequence":                                           DCMXRayGeometrySequence,
+	"IrradiationEventIdentificationSequence":                         DCMIrradiationEventIdentificationSequence,
+	"XRay3DFrameTypeSequence":                                        DCMXRay3DFrameTypeSequence,
+	"ContributingSourcesSequence":                                    DCMContributingSourcesSequence,
+	"XRay3DAcquisitionSequence":                                      DCMXRay3DAcquisitionSequence,
+	"PrimaryPositionerScanArc":                                       DCMPrimaryPositionerScanArc,
+	"SecondaryPositionerScanArc":                                     DCMSecondaryPositionerScanArc,
+	"PrimaryPositionerScanStartAngle":                                DCMPrimaryPositionerScanStartAngle,
+	"SecondaryPositionerScanStartAngle":                              DCMSecondaryPositionerScanStartAngle,
+	"PrimaryPositionerIncrement":                                     DCMPrimaryPositionerIncrement,
+	"SecondaryPositionerIncrement":                                   DCMSecondaryPositionerIncrement,
+	"StartAcquisitionDateTime":                                       DCMStartAcquisitionDateTime,
+	"EndAcquisitionDateTime":                                         DCMEndAcquisitionDateTime,
+	"ApplicationName":                                                DCMApplicationName,
+	"ApplicationVersion":                                             DCMApplicationVersion,
+	"ApplicationManufacturer":                                        DCMApplicationManufacturer,
+	"AlgorithmType":                                                  DCMAlgorithmType,
+	"AlgorithmDescription":                                           DCMAlgorithmDescription,
+	"XRay3DReconstructionSequence":                                   DCMXRay3DReconstructionSequence,
+	"ReconstructionDescription":                                      DCMReconstructionDescription,
+	"PerProjectionAcquisitionSequence":                               DCMPerProjectionAcquisitionSequence,
+	"DiffusionBMatrixSequence":                                       DCMDiffusionBMatrixSequence,
+	"DiffusionBValueXX":                                              DCMDiffusionBValueXX,
+	"DiffusionBValueXY":                                              DCMDiffusionBValueXY,
+	"DiffusionBValueXZ":                                              DCMDiffusionBValueXZ,
+	"DiffusionBValueYY":                                              DCMDiffusionBValueYY,
+	"DiffusionBValueYZ":                                              DCMDiffusionBValueYZ,
+	"DiffusionBValueZZ":                                              DCMDiffusionBValueZZ,
+	"DecayCorrectionDateTime":                                        DCMDecayCorrectionDateTime,
+	"StartDensityThreshold":                                          DCMStartDensityThreshold,
+	"StartRelativeDensityDifferenceThreshold":                        DCMStartRelativeDensityDifferenceThreshold,
+	"StartCardiacTriggerCountThreshold":                              DCMStartCardiacTriggerCountThreshold,
+	"StartRespiratoryTriggerCountThreshold":                          DCMStartRespiratoryTriggerCountThreshold,
+	"TerminationCountsThreshold":                                     DCMTerminationCountsThreshold,
+	"TerminationDensityThreshold":                                    DCMTerminationDensityThreshold,
+	"TerminationRelativeDensityThreshold":                            DCMTerminationRelativeDensityThreshold,
+	"TerminationTimeThreshold":                                       DCMTerminationTimeThreshold,
+	"TerminationCardiacTriggerCountThreshold":                        DCMTerminationCardiacTriggerCountThreshold,
+	"TerminationRespiratoryTriggerCountThreshold":                    DCMTerminationRespiratoryTriggerCountThreshold,
+	"DetectorGeometry":                                               DCMDetectorGeometry,
+	"TransverseDetectorSeparation":                                   DCMTransverseDetectorSeparation,
+	"AxialDetectorDimension":                                         DCMAxialDetectorDimension,
+	"RadiopharmaceuticalAgentNumber":                                 DCMRadiopharmaceuticalAgentNumber,
+	"PETFrameAcquisitionSequence":                                    DCMPETFrameAcquisitionSequence,
+	"PETDetectorMotionDetailsSequence":                               DCMPETDetectorMotionDetailsSequence,
+	"PETTableDynamicsSequence":                                       DCMPETTableDynamicsSequence,
+	"PETPositionSequence":                                            DCMPETPositionSequence,
+	"PETFrameCorrectionFactorsSequence":                              DCMPETFrameCorrectionFactorsSequence,
+	"RadiopharmaceuticalUsageSequence":                               DCMRadiopharmaceuticalUsageSequence,
+	"AttenuationCorrectionSource":                                    DCMAttenuationCorrectionSource,
+	"NumberOfIterations":                                             DCMNumberOfIterations,
+	"NumberOfSubsets":                                                DCMNumberOfSubsets,
+	"PETReconstructionSequence":                                      DCMPETReconstructionSequence,
+	"PETFrameTypeSequence":                                           DCMPETFrameTypeSequence,
+	"TimeOfFlightInformationUsed":                                    DCMTimeOfFlightInformationUsed,
+	"ReconstructionType":                                             DCMReconstructionType,
+	"DecayCorrected":                                                 DCMDecayCorrected,
+	"AttenuationCorrected":                                           DCMAttenuationCorrected,
+	"ScatterCorrected":                                               DCMScatterCorrected,
+	"DeadTimeCorrected":                                              DCMDeadTimeCorrected,
+	"GantryMotionCorrected":                                          DCMGantryMotionCorrected,
+	"PatientMotionCorrected":                                         DCMPatientMotionCorrected,
+	"CountLossNormalizationCorrected":                                DCMCountLossNormalizationCorrected,
+	"RandomsCorrected":                                               DCMRandomsCorrected,
+	"NonUniformRadialSamplingCorrected":                              DCMNonUniformRadialSamplingCorrected,
+	"SensitivityCalibrated":                                          DCMSensitivityCalibrated,
+	"DetectorNormalizationCorrection":                                DCMDetectorNormalizationCorrection,
+	"IterativeReconstructionMethod":                                  DCMIterativeReconstructionMethod,
+	"AttenuationCorrectionTemporalRelationship":                      DCMAttenuationCorrectionTemporalRelationship,
+	"PatientPhysiologicalStateSequence":                              DCMPatientPhysiologicalStateSequence,
+	"PatientPhysiologicalStateCodeSequence":                          DCMPatientPhysiologicalStateCodeSequence,
+	"DepthsOfFocus":                                                  DCMDepthsOfFocus,
+	"ExcludedIntervalsSequence":                                      DCMExcludedIntervalsSequence,
+	"ExclusionStartDatetime":                                         DCMExclusionStartDatetime,
+	"ExclusionDuration":                                              DCMExclusionDuration,
+	"USImageDescriptionSequence":                                     DCMUSImageDescriptionSequence,
+	"ImageDataTypeSequence":                                          DCMImageDataTypeSequence,
+	"DataType":                                                       DCMDataType,
+	"TransducerScanPatternCodeSequence":                              DCMTransducerScanPatternCodeSequence,
+	"AliasedDataType":                                                DCMAliasedDataType,
+	"PositionMeasuringDeviceUsed":                                    DCMPositionMeasuringDeviceUsed,
+	"TransducerGeometryCodeSequence":                                 DCMTransducerGeometryCodeSequence,
+	"TransducerBeamSteeringCodeSequence":                             DCMTransducerBeamSteeringCodeSequence,
+	"TransducerApplicationCodeSequence":                              DCMTransducerApplicationCodeSequence,
+	"ContributingEquipmentSequence":                                  DCMContributingEquipmentSequence,
+	"ContributionDateTime":                                           DCMContributionDateTime,
+	"ContributionDescription":                                        DCMContributionDescription,
+	"StudyInstanceUID":                                               DCMStudyInstanceUID,
+	"SeriesInstanceUID":                                              DCMSeriesInstanceUID,
+	"StudyID":                                                        DCMStudyID,
+	"SeriesNumber":                                                   DCMSeriesNumber,
+	"AcquisitionNumber":                                              DCMAcquisitionNumber,
+	"InstanceNumber":                                                 DCMInstanceNumber,
+	"RETIREDIsotopeNumber":                                           DCMRETIREDIsotopeNumber,
+	"RETIREDPhaseNumber":                                             DCMRETIREDPhaseNumber,
+	"RETIREDIntervalNumber":                                          DCMRETIREDIntervalNumber,
+	"RETIREDTimeSlotNumber":                                          DCMRETIREDTimeSlotNumber,
+	"RETIREDAngleNumber":                                             DCMRETIREDAngleNumber,
+	"ItemNumber":                                                     DCMItemNumber,
+	"PatientOrientation":                                             DCMPatientOrientation,
+	"RETIREDOverlayNumber":                                           DCMRETIREDOverlayNumber,
+	"RETIREDCurveNumber":                                             DCMRETIREDCurveNumber,
+	"RETIREDLUTNumber":                                               DCMRETIREDLUTNumber,
+	"ACRNEMAImagePosition":                                           DCMACRNEMAImagePosition,
+	"ImagePositionPatient":                                           DCMImagePositionPatient,
+	"ACRNEMAImageOrientation":                                        DCMACRNEMAImageOrientation,
+	"ImageOrientationPatient":                                        DCMImageOrientationPatient,
+	"ACRNEMALocation":                                                DCMACRNEMALocation,
+	"FrameOfReferenceUID":                                            DCMFrameOfReferenceUID,
+	"Laterality":                                                     DCMLaterality,
+	"ImageLaterality":                                                DCMImageLaterality,
+	"ACRNEMAImageGeometryType":                                       DCMACRNEMAImageGeometryType,
+	"ACRNEMAMaskingImage":                                            DCMACRNEMAMaskingImage,
+	"TemporalPositionIdentifier":                                     DCMTemporalPositionIdentifier,
+	"NumberOfTemporalPositions":                                      DCMNumberOfTemporalPositions,
+	"TemporalResolution":                                             DCMTemporalResolution,
+	"SynchronizationFrameOfReferenceUID":                             DCMSynchronizationFrameOfReferenceUID,
+	"SOPInstanceUIDOfConcatenationSource":                            DCMSOPInstanceUIDOfConcatenationSource,
+	"RETIREDSeriesInStudy":                                           DCMRETIREDSeriesInStudy,
+	"ACRNEMAAcquisitionsInSeries":                                    DCMACRNEMAAcquisitionsInSeries,
+	"ImagesInAcquisition":                                            DCMImagesInAcquisition,
+	"ACRNEMAImagesInSeries":                                          DCMACRNEMAImagesInSeries,
+	"RETIREDAcquisitionsInStudy":                                     DCMRETIREDAcquisitionsInStudy,
+	"ACRNEMAImagesInStudy":                                           DCMACRNEMAImagesInStudy,
+	"ACRNEMAReference":                                               DCMACRNEMAReference,
+	"PositionReferenceIndicator":                                     DCMPositionReferenceIndicator,
+	"SliceLocation":                                                  DCMSliceLocation,
+	"RETIREDOtherStudyNumbers":                                       DCMRETIREDOtherStudyNumbers,
+	"NumberOfPatientRelatedStudies":                                  DCMNumberOfPatientRelatedStudies,
+	"NumberOfPatientRelatedSeries":                                   DCMNumberOfPatientRelatedSeries,
+	"NumberOfPatientRelatedInstances":                                DCMNumberOfPatientRelatedInstances,
+	"NumberOfStudyRelatedSeries":                                     DCMNumberOfStudyRelatedSeries,
+	"NumberOfStudyRelatedInstances":                                  DCMNumberOfStudyRelatedInstances,
+	"NumberOfSeriesRelatedInstances":                                 DCMNumberOfSeriesRelatedInstances,
+	"ACRNEMAModifyingDeviceID":                                       DCMACRNEMAModifyingDeviceID,
+	"ACRNEMAModifiedImageID":                                         DCMACRNEMAModifiedImageID,
+	"ACRNEMAModifiedImageDate":                                       DCMACRNEMAModifiedImageDate,
+	"ACRNEMAModifyingDeviceManufacturer":                             DCMACRNEMAModifyingDeviceManufacturer,
+	"ACRNEMAModifiedImageTime":                                       DCMACRNEMAModifiedImageTime,
+	"ACRNEMAModifiedImageDescription":                                DCMACRNEMAModifiedImageDescription,
+	"ImageComments":                                                  DCMImageComments,
+	"ACRNEMAOriginalImageIdentification":                             DCMACRNEMAOriginalImageIdentification,
+	"ACRNEMAOriginalImageIdentificationNomenclature":                 DCMACRNEMAOriginalImageIdentificationNomenclature,
+	"StackID":                                     DCMStackID,
+	"InStackPositionNumber":                       DCMInStackPositionNumber,
+	"FrameAnatomySequence":                        DCMFrameAnatomySequence,
+	"FrameLaterality":                             DCMFrameLaterality,
+	"FrameContentSequence":                        DCMFrameContentSequence,
+	"PlanePositionSequence":                       DCMPlanePositionSequence,
+	"PlaneOrientationSequence":                    DCMPlaneOrientationSequence,
+	"TemporalPositionIndex":                       DCMTemporalPositionIndex,
+	"NominalCardiacTriggerDelayTime":              DCMNominalCardiacTriggerDelayTime,
+	"FrameAcquisitionNumber":                      DCMFrameAcquisitionNumber,
+	"DimensionIndexValues":                        DCMDimensionIndexValues,
+	"FrameComments":                               DCMFrameComments,
+	"ConcatenationUID":                            DCMConcatenationUID,
+	"InConcatenationNumber":                       DCMInConcatenationNumber,
+	"InConcatenationTotalNumber":                  DCMInConcatenationTotalNumber,
+	"DimensionOrganizationUID":                    DCMDimensionOrganizationUID,
+	"DimensionIndexPointer":                       DCMDimensionIndexPointer,
+	"FunctionalGroupPointer":                      DCMFunctionalGroupPointer,
+	"DimensionIndexPrivateCreator":                DCMDimensionIndexPrivateCreator,
+	"DimensionOrganizationSequence":               DCMDimensionOrganizationSequence,
+	"DimensionIndexSequence":                      DCMDimensionIndexSequence,
+	"ConcatenationFrameOffsetNumber":              DCMConcatenationFrameOffsetNumber,
+	"FunctionalGroupPrivateCreator":               DCMFunctionalGroupPrivateCreator,
+	"NominalPercentageOfCardiacPhase":             DCMNominalPercentageOfCardiacPhase,
+	"NominalPercentageOfRespiratoryPhase":         DCMNominalPercentageOfRespiratoryPhase,
+	"StartingRespiratoryAmplitude":                DCMStartingRespiratoryAmplitude,
+	"StartingRespiratoryPhase":                    DCMStartingRespiratoryPhase,
+	"EndingRespiratoryAmplitude":                  DCMEndingRespiratoryAmplitude,
+	"EndingRespiratoryPhase":                      DCMEndingRespiratoryPhase,
+	"RespiratoryTriggerType":                      DCMRespiratoryTriggerType,
+	"RRIntervalTimeNominal":                       DCMRRIntervalTimeNominal,
+	"ActualCardiacTriggerDelayTime":               DCMActualCardiacTriggerDelayTime,
+	"RespiratorySynchronizationSequence":          DCMRespiratorySynchronizationSequence,
+	"RespiratoryIntervalTime":                     DCMRespiratoryIntervalTime,
+	"NominalRespiratoryTriggerDelayTime":          DCMNominalRespiratoryTriggerDelayTime,
+	"RespiratoryTriggerDelayThreshold":            DCMRespiratoryTriggerDelayThreshold,
+	"ActualRespiratoryTriggerDelayTime":           DCMActualRespiratoryTriggerDelayTime,
+	"ImagePositionVolume":                         DCMImagePositionVolume,
+	"ImageOrientationVolume":                      DCMImageOrientationVolume,
+	"UltrasoundAcquisitionGeometry":               DCMUltrasoundAcquisitionGeometry,
+	"ApexPosition":                                DCMApexPosition,
+	"VolumeToTransducerMappingMatrix":             DCMVolumeToTransducerMappingMatrix,
+	"VolumeToTableMappingMatrix":                  DCMVolumeToTableMappingMatrix,
+	"PatientFrameOfReferenceSource":               DCMPatientFrameOfReferenceSource,
+	"TemporalPositionTimeOffset":                  DCMTemporalPositionTimeOffset,
+	"PlanePositionVolumeSequence":                 DCMPlanePositionVolumeSequence,
+	"PlaneOrientationVolumeSequence":              DCMPlaneOrientationVolumeSequence,
+	"TemporalPositionSequence":                    DCMTemporalPositionSequence,
+	"DimensionOrganizationType":                   DCMDimensionOrganizationType,
+	"VolumeFrameOfReferenceUID":                   DCMVolumeFrameOfReferenceUID,
+	"TableFrameOfReferenceUID":                    DCMTableFrameOfReferenceUID,
+	"DimensionDescriptionLabel":                   DCMDimensionDescriptionLabel,
+	"PatientOrientationInFrameSequence":           DCMPatientOrientationInFrameSequence,
+	"FrameLabel":                                  DCMFrameLabel,
+	"AcquisitionIndex":                            DCMAcquisitionIndex,
+	"ContributingSOPInstancesReferenceSequence":   DCMContributingSOPInstancesReferenceSequence,
+	"ReconstructionIndex":                         DCMReconstructionIndex,
+	"LightPathFilterPassThroughWavelength":        DCMLightPathFilterPassThroughWavelength,
+	"LightPathFilterPassBand":                     DCMLightPathFilterPassBand,
+	"ImagePathFilterPassThroughWavelength":        DCMImagePathFilterPassThroughWavelength,
+	"ImagePathFilterPassBand":                     DCMImagePathFilterPassBand,
+	"PatientEyeMovementCommanded":                 DCMPatientEyeMovementCommanded,
+	"PatientEyeMovementCommandCodeSequence":       DCMPatientEyeMovementCommandCodeSequence,
+	"SphericalLensPower":                          DCMSphericalLensPower,
+	"CylinderLensPower":                           DCMCylinderLensPower,
+	"CylinderAxis":                                DCMCylinderAxis,
+	"EmmetropicMagnification":                     DCMEmmetropicMagnification,
+	"IntraOcularPressure":                         DCMIntraOcularPressure,
+	"HorizontalFieldOfView":                       DCMHorizontalFieldOfView,
+	"PupilDilated":                                DCMPupilDilated,
+	"DegreeOfDilation":                            DCMDegreeOfDilation,
+	"StereoBaselineAngle":                         DCMStereoBaselineAngle,
+	"StereoBaselineDisplacement":                  DCMStereoBaselineDisplacement,
+	"StereoHorizontalPixelOffset":                 DCMStereoHorizontalPixelOffset,
+	"StereoVerticalPixelOffset":                   DCMStereoVerticalPixelOffset,
+	"StereoRotation":                              DCMStereoRotation,
+	"AcquisitionDeviceTypeCodeSequence":           DCMAcquisitionDeviceTypeCodeSequence,
+	"IlluminationTypeCodeSequence":                DCMIlluminationTypeCodeSequence,
+	"LightPathFilterTypeStackCodeSequence":        DCMLightPathFilterTypeStackCodeSequence,
+	"ImagePathFilterTypeStackCodeSequence":        DCMImagePathFilterTypeStackCodeSequence,
+	"LensesCodeSequence":                          DCMLensesCodeSequence,
+	"ChannelDescriptionCodeSequence":              DCMChannelDescriptionCodeSequence,
+	"RefractiveStateSequence":                     DCMRefractiveStateSequence,
+	"MydriaticAgentCodeSequence":                  DCMMydriaticAgentCodeSequence,
+	"RelativeImagePositionCodeSequence":           DCMRelativeImagePositionCodeSequence,
+	"StereoPairsSequence":                         DCMStereoPairsSequence,
+	"LeftImageSequence":                           DCMLeftImageSequence,
+	"RightImageSequence":                          DCMRightImageSequence,
+	"AxialLengthOfTheEye":                         DCMAxialLengthOfTheEye,
+	"OphthalmicFrameLocationSequence":             DCMOphthalmicFrameLocationSequence,
+	"ReferenceCoordinates":                        DCMReferenceCoordinates,
+	"DepthSpatialResolution":                      DCMDepthSpatialResolution,
+	"MaximumDepthDistortion":                      DCMMaximumDepthDistortion,
+	"AlongScanSpatialResolution":                  DCMAlongScanSpatialResolution,
+	"MaximumAlongScanDistortion":                  DCMMaximumAlongScanDistortion,
+	"OphthalmicImageOrientation":                  DCMOphthalmicImageOrientation,
+	"DepthOfTransverseImage":                      DCMDepthOfTransverseImage,
+	"MydriaticAgentConcentrationUnitsSequence":    DCMMydriaticAgentConcentrationUnitsSequence,
+	"AcrossScanSpatialResolution":                 DCMAcrossScanSpatialResolution,
+	"MaximumAcrossScanDistortion":                 DCMMaximumAcrossScanDistortion,
+	"MydriaticAgentConcentration":                 DCMMydriaticAgentConcentration,
+	"IlluminationWaveLength":                      DCMIlluminationWaveLength,
+	"IlluminationPower":                           DCMIlluminationPower,
+	"IlluminationBandwidth":                       DCMIlluminationBandwidth,
+	"MydriaticAgentSequence":                      DCMMydriaticAgentSequence,
+	"OphthalmicAxialMeasurementsRightEyeSequence": DCMOphthalmicAxialMeasurementsRightEyeSequence,
+	"OphthalmicAxialMeasurementsLeftEyeSequence":  DCMOphthalmicAxialMeasurementsLeftEyeSequence,
+	"OphthalmicAxialMeasurementsDeviceType":       DCMOphthalmicAxialMeasurementsDeviceType,
+	"OphthalmicAxialLengthMeasurementsType":       DCMOphthalmicAxialLengthMeasurementsType,
+	"OphthalmicAxialLengthSequence":               DCMOphthalmicAxialLengthSequence,
+	"OphthalmicAxialLength":                       DCMOphthalmicAxialLength,
+	"LensStatusCodeSequence":                      DCMLensStatusCodeSequence,
+	"VitreousStatusCodeSequence":                  DCMVitreousStatusCodeSequence,
+	"IOLFormulaCodeSequence":                      DCMIOLFormulaCodeSequence,
+	"IOLFormulaDetail":                            DCMIOLFormulaDetail,
+	"KeratometerIndex":                            DCMKeratometerIndex,
+	"SourceofOphthalmicAxialLengthCodeSequence":   DCMSourceofOphthalmicAxialLengthCodeSequence,
+	"TargetRefraction":                            DCMTargetRefraction,
+	"RefractiveProcedureOccurred":                 DCMRefractiveProcedureOccurred,
+	"RefractiveSurgeryTypeCodeSequence":           DCMRefractiveSurgeryTypeCodeSequence,
+	"OphthalmicUltrasoundMethodCodeSequence":      DCMOphthalmicUltrasoundMethodCodeSequence,
+	"OphthalmicAxialLengthMeasurementsSequence":   DCMOphthalmicAxialLengthMeasurementsSequence,
+	"IOLPower":                                      DCMIOLPower,
+	"PredictedRefractiveError":                      DCMPredictedRefractiveError,
+	"OphthalmicAxialLengthVelocity":                 DCMOphthalmicAxialLengthVelocity,
+	"LensStatusDescription":                         DCMLensStatusDescription,
+	"VitreousStatusDescription":                     DCMVitreousStatusDescription,
+	"IOLPowerSequence":                              DCMIOLPowerSequence,
+	"LensConstantSequence":                          DCMLensConstantSequence,
+	"IOLManufacturer":                               DCMIOLManufacturer,
+	"ImplantName":                                   DCMImplantName,
+	"KeratometryMeasurementTypeCodeSequence":        DCMKeratometryMeasurementTypeCodeSequence,
+	"ImplantPartNumber":                             DCMImplantPartNumber,
+	"ReferencedOphthalmicAxialMeasurementsSequence": DCMReferencedOphthalmicAxialMeasurementsSequence,
+	"OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence":  DCMOphthalmicAxialLengthMeasurementsSegmentNameCodeSequence,
+	"RefractiveErrorBeforeRefractiveSurgeryCodeSequence":        DCMRefractiveErrorBeforeRefractiveSurgeryCodeSequence,
+	"IOLPowerForExactEmmetropia":                                DCMIOLPowerForExactEmmetropia,
+	"IOLPowerForExactTargetRefraction":                          DCMIOLPowerForExactTargetRefraction,
+	"AnteriorChamberDepthDefinitionCodeSequence":                DCMAnteriorChamberDepthDefinitionCodeSequence,
+	"LensThicknessSequence":                                     DCMLensThicknessSequence,
+	"AnteriorChamberDepthSequence":                              DCMAnteriorChamberDepthSequence,
+	"LensThickness":                                             DCMLensThickness,
+	"AnteriorChamberDepth":                                      DCMAnteriorChamberDepth,
+	"SourceofLensThicknessDataCodeSequence":                     DCMSourceofLensThicknessDataCodeSequence,
+	"SourceofAnteriorChamberDepthDataCodeSequence":              DCMSourceofAnteriorChamberDepthDataCodeSequence,
+	"SourceofRefractiveMeasurementsSequence":                    DCMSourceofRefractiveMeasurementsSequence,
+	"SourceofRefractiveMeasurementsCodeSequence":                DCMSourceofRefractiveMeasurementsCodeSequence,
+	"OphthalmicAxialLengthMeasurementModified":                  DCMOphthalmicAxialLengthMeasurementModified,
+	"OphthalmicAxialLengthDataSourceCodeSequence":               DCMOphthalmicAxialLengthDataSourceCodeSequence,
+	"SignaltoNoiseRatio":                                        DCMSignaltoNoiseRatio,
+	"OphthalmicAxialLengthDataSourceDescription":                DCMOphthalmicAxialLengthDataSourceDescription,
+	"OphthalmicAxialLengthMeasurementsTotalLengthSequence":      DCMOphthalmicAxialLengthMeasurementsTotalLengthSequence,
+	"OphthalmicAxialLengthMeasurementsSegmentalLengthSequence":  DCMOphthalmicAxialLengthMeasurementsSegmentalLengthSequence,
+	"OphthalmicAxialLengthMeasurementsLengthSummationSequence":  DCMOphthalmicAxialLengthMeasurementsLengthSummationSequence,
+	"UltrasoundOphthalmicAxialLengthMeasurementsSequence":       DCMUltrasoundOphthalmicAxialLengthMeasurementsSequence,
+	"OpticalOphthalmicAxialLengthMeasurementsSequence":          DCMOpticalOphthalmicAxialLengthMeasurementsSequence,
+	"UltrasoundSelectedOphthalmicAxialLengthSequence":           DCMUltrasoundSelectedOphthalmicAxialLengthSequence,
+	"OphthalmicAxialLengthSelectionMethodCodeSequence":          DCMOphthalmicAxialLengthSelectionMethodCodeSequence,
+	"OpticalSelectedOphthalmicAxialLengthSequence":              DCMOpticalSelectedOphthalmicAxialLengthSequence,
+	"SelectedSegmentalOphthalmicAxialLengthSequence":            DCMSelectedSegmentalOphthalmicAxialLengthSequence,
+	"SelectedTotalOphthalmicAxialLengthSequence":                DCMSelectedTotalOphthalmicAxialLengthSequence,
+	"OphthalmicAxialLengthQualityMetricSequence":                DCMOphthalmicAxialLengthQualityMetricSequence,
+	"OphthalmicAxialLengthQualityMetricTypeCodeSequence":        DCMOphthalmicAxialLengthQualityMetricTypeCodeSequence,
+	"IntraocularLensCalculationsRightEyeSequence":               DCMIntraocularLensCalculationsRightEyeSequence,
+	"IntraocularLensCalculationsLeftEyeSequence":                DCMIntraocularLensCalculationsLeftEyeSequence,
+	"ReferencedOphthalmicAxialLengthMeasurementQCImageSequence": DCMReferencedOphthalmicAxialLengthMeasurementQCImageSequence,
+	"VisualFieldHorizontalExtent":                               DCMVisualFieldHorizontalExtent,
+	"VisualFieldVerticalExtent":                                 DCMVisualFieldVerticalExtent,
+	"VisualFieldShape":                                          DCMVisualFieldShape,
+	"ScreeningTestModeCodeSequence":                             DCMScreeningTestModeCodeSequence,
+	"MaximumStimulusLuminance":                                  DCMMaximumStimulusLuminance,
+	"BackgroundLuminance":                                       DCMBackgroundLuminance,
+	"StimulusColorCodeSequence":                                 DCMStimulusColorCodeSequence,
+	"BackgroundIlluminationColorCodeSequence":                   DCMBackgroundIlluminationColorCodeSequence,
+	"StimulusArea":                                                   DCMStimulusArea,
+	"StimulusPresentationTime":                                       DCMStimulusPresentationTime,
+	"FixationSequence":                                               DCMFixationSequence,
+	"FixationMonitoringCodeSequence":                                 DCMFixationMonitoringCodeSequence,
+	"VisualFieldCatchTrialSequence":                                  DCMVisualFieldCatchTrialSequence,
+	"FixationCheckedQuantity":                                        DCMFixationCheckedQuantity,
+	"PatientNotProperlyFixatedQuantity":                              DCMPatientNotProperlyFixatedQuantity,
+	"PresentedVisualStimuliDataFlag":                                 DCMPresentedVisualStimuliDataFlag,
+	"NumberOfVisualStimuli":                                          DCMNumberOfVisualStimuli,
+	"ExcessiveFixationLossesDataFlag":                                DCMExcessiveFixationLossesDataFlag,
+	"ExcessiveFixationLosses":                                        DCMExcessiveFixationLosses,
+	"StimuliRetestingQuantity":                                       DCMStimuliRetestingQuantity,
+	"CommentsOnPatientPerformanceOfVisualField":                      DCMCommentsOnPatientPerformanceOfVisualField,
+	"FalseNegativesEstimateFlag":                                     DCMFalseNegativesEstimateFlag,
+	"FalseNegativesEstimate":                                         DCMFalseNegativesEstimate,
+	"NegativeCatchTrialsQuantity":                                    DCMNegativeCatchTrialsQuantity,
+	"FalseNegativesQuantity":                                         DCMFalseNegativesQuantity,
+	"ExcessiveFalseNegativesDataFlag":                                DCMExcessiveFalseNegativesDataFlag,
+	"ExcessiveFalseNegatives":                                        DCMExcessiveFalseNegatives,
+	"FalsePositivesEstimateFlag":                                     DCMFalsePositivesEstimateFlag,
+	"FalsePositivesEstimate":                                         DCMFalsePositivesEstimate,
+	"CatchTrialsDataFlag":                                            DCMCatchTrialsDataFlag,
+	"PositiveCatchTrialsQuantity":                                    DCMPositiveCatchTrialsQuantity,
+	"TestPointNormalsDataFlag":                                       DCMTestPointNormalsDataFlag,
+	"TestPointNormalsSequence":                                       DCMTestPointNormalsSequence,
+	"GlobalDeviationProbabilityNormalsFlag":                          DCMGlobalDeviationProbabilityNormalsFlag,
+	"FalsePositivesQuantity":                                         DCMFalsePositivesQuantity,
+	"ExcessiveFalsePositivesDataFlag":                                DCMExcessiveFalsePositivesDataFlag,
+	"ExcessiveFalsePositives":                                        DCMExcessiveFalsePositives,
+	"VisualFieldTestNormalsFlag":                                     DCMVisualFieldTestNormalsFlag,
+	"ResultsNormalsSequence":                                         DCMResultsNormalsSequence,
+	"AgeCorrectedSensitivityDeviationAlgorithmSequence":              DCMAgeCorrectedSensitivityDeviationAlgorithmSequence,
+	"GlobalDeviationFromNormal":                                      DCMGlobalDeviationFromNormal,
+	"GeneralizedDefectSensitivityDeviationAlgorithmSequence":         DCMGeneralizedDefectSensitivityDeviationAlgorithmSequence,
+	"LocalizedDeviationfromNormal":                                   DCMLocalizedDeviationfromNormal,
+	"PatientReliabilityIndicator":                                    DCMPatientReliabilityIndicator,
+	"VisualFieldMeanSensitivity":                                     DCMVisualFieldMeanSensitivity,
+	"GlobalDeviationProbability":                                     DCMGlobalDeviationProbability,
+	"LocalDeviationProbabilityNormalsFlag":                           DCMLocalDeviationProbabilityNormalsFlag,
+	"LocalizedDeviationProbability":                                  DCMLocalizedDeviationProbability,
+	"ShortTermFluctuationCalculated":                                 DCMShortTermFluctuationCalculated,
+	"ShortTermFluctuation":                                           DCMShortTermFluctuation,
+	"ShortTermFluctuationProbabilityCalculated":                      DCMShortTermFluctuationProbabilityCalculated,
+	"ShortTermFluctuationProbability":                                DCMShortTermFluctuationProbability,
+	"CorrectedLocalizedDeviationFromNormalCalculated":                DCMCorrectedLocalizedDeviationFromNormalCalculated,
+	"CorrectedLocalizedDeviationFromNormal":                          DCMCorrectedLocalizedDeviationFromNormal,
+	"CorrectedLocalizedDeviationFromNormalProbabilityCalculated":     DCMCorrectedLocalizedDeviationFromNormalProbabilityCalculated,
+	"CorrectedLocalizedDeviationFromNormalProbability":               DCMCorrectedLocalizedDeviationFromNormalProbability,
+	"GlobalDeviationProbabilitySequence":                             DCMGlobalDeviationProbabilitySequence,
+	"LocalizedDeviationProbabilitySequence":                          DCMLocalizedDeviationProbabilitySequence,
+	"FovealSensitivityMeasured":                                      DCMFovealSensitivityMeasured,
+	"FovealSensitivity":                                              DCMFovealSensitivity,
+	"VisualFieldTestDuration":                                        DCMVisualFieldTestDuration,
+	"VisualFieldTestPointSequence":                                   DCMVisualFieldTestPointSequence,
+	"VisualFieldTestPointXCoordinate":                                DCMVisualFieldTestPointXCoordinate,
+	"VisualFieldTestPointYCoordinate":                                DCMVisualFieldTestPointYCoordinate,
+	"AgeCorrectedSensitivityDeviationValue":                          DCMAgeCorrectedSensitivityDeviationValue,
+	"StimulusResults":                                                DCMStimulusResults,
+	"SensitivityValue":                                               DCMSensitivityValue,
+	"RetestStimulusSeen":                                             DCMRetestStimulusSeen,
+	"RetestSensitivityValue":                                         DCMRetestSensitivityValue,
+	"VisualFieldTestPointNormalsSequence":                            DCMVisualFieldTestPointNormalsSequence,
+	"QuantifiedDefect":                                               DCMQuantifiedDefect,
+	"GeneralizedDefectCorrectedSensitivityDeviationFlag":             DCMGeneralizedDefectCorrectedSensitivityDeviationFlag,
+	"GeneralizedDefectCorrectedSensitivityDeviationValue":            DCMGeneralizedDefectCorrectedSensitivityDeviationValue,
+	"GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue": DCMGeneralizedDefectCorrectedSensitivityDeviationProbabilityValue,
+	"MinimumSensitivityValue":                                        DCMMinimumSensitivityValue,
+	"BlindSpotLocalized":                                             DCMBlindSpotLocalized,
+	"BlindSpotXCoordinate":                                           DCMBlindSpotXCoordinate,
+	"BlindSpotYCoordinate":                                           DCMBlindSpotYCoordinate,
+	"VisualAcuityMeasurementSequence":                                DCMVisualAcuityMeasurementSequence,
+	"RefractiveParametersUsedOnPatientSequence":                      DCMRefractiveParametersUsedOnPatientSequence,
+	"MeasurementLaterality":                                          DCMMeasurementLaterality,
+	"OphthalmicPatientClinicalInformationLeftEyeSequence":            DCMOphthalmicPatientClinicalInformationLeftEyeSequence,
+	"OphthalmicPatientClinicalInformationRightEyeSequence":           DCMOphthalmicPatientClinicalInformationRightEyeSequence,
+	"FovealPointNormativeDataFlag":                                   DCMFovealPointNormativeDataFlag,
+	"FovealPointProbabilityValue":                                    DCMFovealPointProbabilityValue,
+	"ScreeningBaselineMeasured":                                      DCMScreeningBaselineMeasured,
+	"ScreeningBaselineMeasuredSequence":                              DCMScreeningBaselineMeasuredSequence,
+	"ScreeningBaselineType":                                          DCMScreeningBaselineType,
+	"ScreeningBaselineValue":                                         DCMScreeningBaselineValue,
+	"AlgorithmSource":                                                DCMAlgorithmSource,
+	"DataSetName":                                                    DCMDataSetName,
+	"DataSetVersion":                                                 DCMDataSetVersion,
+	"DataSetSource":                                                  DCMDataSetSource,
+	"DataSetDescription":                                             DCMDataSetDescription,
+	"VisualFieldTestReliabilityGlobalIndexSequence":                  DCMVisualFieldTestReliabilityGlobalIndexSequence,
+	"VisualFieldGlobalResultsIndexSequence":                          DCMVisualFieldGlobalResultsIndexSequence,
+	"DataObservationSequence":                                        DCMDataObservationSequence,
+	"IndexNormalsFlag":                                               DCMIndexNormalsFlag,
+	"IndexProbability":                                               DCMIndexProbability,
+	"IndexProbabilitySequence":                                       DCMIndexProbabilitySequence,
+	"SamplesPerPixel":                                                DCMSamplesPerPixel,
+	"SamplesPerPixelUsed":                                            DCMSamplesPerPixelUsed,
+	"PhotometricInterpretation":                                      DCMPhotometricInterpretation,
+	"ACRNEMAImageDimensions":                                         DCMACRNEMAImageDimensions,
+	"PlanarConfiguration":                                            DCMPlanarConfiguration,
+	"NumberOfFrames":                                                 DCMNumberOfFrames,
+	"FrameIncrementPointer":                                          DCMFrameIncrementPointer,
+	"FrameDimensionPointer":                                          DCMFrameDimensionPointer,
+	"Rows":                                                           DCMRows,
+	"Columns":                                                        DCMColumns,
+	"RETIREDPlanes":                                                  DCMRETIREDPlanes,
+	"UltrasoundColorDataPresent":                                     DCMUltrasoundColorDataPresent,
+	"PixelSpacing":                                                   DCMPixelSpacing,
+	"ZoomFactor":                                                     DCMZoomFactor,
+	"ZoomCenter":                                                     DCMZoomCenter,
+	"PixelAspectRatio":                                               DCMPixelAspectRatio,
+	"ACRNEMAImageFormat":                                             DCMACRNEMAImageFormat,
+	"ACRNEMAManipulatedImage":                                        DCMACRNEMAManipulatedImage,
+	"CorrectedImage":                                                 DCMCorrectedImage,
+	"ACRNEMA2CCompressionRecognitionCode":                            DCMACRNEMA2CCompressionRecognitionCode,
+	"ACRNEMACompressionCode":                                         DCMACRNEMACompressionCode,
+	"ACRNEMA2CCompressionOriginator":                                 DCMACRNEMA2CCompressionOriginator,
+	"ACRNEMA2CCompressionLabel":                                      DCMACRNEMA2CCompressionLabel,
+	"ACRNEMA2CCompressionDescription":                                DCMACRNEMA2CCompressionDescription,
+	"ACRNEMA2CCompressionSequence":                                   DCMACRNEMA2CCompressionSequence,
+	"ACRNEMA2CCompressionStepPointers":                               DCMACRNEMA2CCompressionStepPointers,
+	"ACRNEMA2CRepeatInterval":                                        DCMACRNEMA2CRepeatInterval,
+	"ACRNEMA2CBitsGrouped":                                           DCMACRNEMA2CBitsGrouped,
+	"ACRNEMA2CPerimeterTable":                                        DCMACRNEMA2CPerimeterTable,
+	"ACRNEMA2CPerimeterValue":                                        DCMACRNEMA2CPerimeterValue,
+	"ACRNEMA2CPredictorRows":                                         DCMACRNEMA2CPredictorRows,
+	"ACRNEMA2CPredictorColumns":                                      DCMACRNEMA2CPredictorColumns,
+	"ACRNEMA2CPredictorConstants":                                    DCMACRNEMA2CPredictorConstants,
+	"ACRNEMA2CBlockedPixels":                                         DCMACRNEMA2CBlockedPixels,
+	"ACRNEMA2CBlockRows":                                             DCMACRNEMA2CBlockRows,
+	"ACRNEMA2CBlockColumns":                                          DCMACRNEMA2CBlockColumns,
+	"ACRNEMA2CRowOverlap":                                            DCMACRNEMA2CRowOverlap,
+	"ACRNEMA2CColumnOverlap":                                         DCMACRNEMA2CColumnOverlap,
+	"BitsAllocated":                                                  DCMBitsAllocated,
+	"BitsStored":                                                     DCMBitsStored,
+	"HighBit":                                                        DCMHighBit,
+	"PixelRepresentation":                                            DCMPixelRepresentation,
+	"ACRNEMASmallestValidPixelValue":                                 DCMACRNEMASmallestValidPixelValue,
+	"ACRNEMALargestValidPixelValue":                                  DCMACRNEMALargestValidPixelValue,
+	"SmallestImagePixelValue":                                        DCMSmallestImagePixelValue,
+	"LargestImagePixelValue":                                         DCMLargestImagePixelValue,
+	"SmallestPixelValueInSeries":                                     DCMSmallestPixelValueInSeries,
+	"LargestPixelValueInSeries":                                      DCMLargestPixelValueInSeries,
+	"RETIREDSmallestImagePixelValueInPlane":                          DCMRETIREDSmallestImagePixelValueInPlane,
+	"RETIREDLargestImagePixelValueInPlane":                           DCMRETIREDLargestImagePixelValueInPlane,
+	"PixelPaddingValue":                                              DCMPixelPaddingValue,
+	"PixelPaddingRangeLimit":                                         DCMPixelPaddingRangeLimit,
+	"ACRNEMAImageLocation":                                           DCMACRNEMAImageLocation,
+	"QualityControlImage":                                            DCMQualityControlImage,
+	"BurnedInAnnotation":                                             DCMBurnedInAnnotation,
+	"ACRNEMA2CTransformLabel":                                        DCMACRNEMA2CTransformLabel,
+	"ACRNEMA2CTransformVersionNumber":                                DCMACRNEMA2CTransformVersionNumber,
+	"ACRNEMA2CNumberOfTransformSteps":                                DCMACRNEMA2CNumberOfTransformSteps,
+	"ACRNEMA2CSequenceOfCompressedData":                              DCMACRNEMA2CSequenceOfCompressedData,
+	"ACRNEMA2CDetailsOfCoefficients":                                 DCMACRNEMA2CDetailsOfCoefficients,
+	"ACRNEMA2CRowsForNthOrderCoefficients":                           DCMACRNEMA2CRowsForNthOrderCoefficients,
+	"ACRNEMA2CColumnsForNthOrderCoefficients":                        DCMACRNEMA2CColumnsForNthOrderCoefficients,
+	"ACRNEMA2CCoefficientCoding":                                     DCMACRNEMA2CCoefficientCoding,
+	"ACRNEMA2CCoefficientCodingPointers":                             DCMACRNEMA2CCoefficientCodingPointers,
+	"ACRNEMA2CDCTLabel":                                              DCMACRNEMA2CDCTLabel,
+	"ACRNEMA2CDataBlockDescription":                                  DCMACRNEMA2CDataBlockDescription,
+	"ACRNEMA2CDataBlock":                                             DCMACRNEMA2CDataBlock,
+	"ACRNEMA2CNormalizationFactorFormat":                             DCMACRNEMA2CNormalizationFactorFormat,
+	"ACRNEMA2CZonalMapNumberFormat":                                  DCMACRNEMA2CZonalMapNumberFormat,
+	"ACRNEMA2CZonalMapLocation":                                      DCMACRNEMA2CZonalMapLocation,
+	"ACRNEMA2CZonalMapFormat":                                        DCMACRNEMA2CZonalMapFormat,
+	"ACRNEMA2CAdaptiveMapFormat":                                     DCMACRNEMA2CAdaptiveMapFormat,
+	"ACRNEMA2CCodeNumberFormat":                                      DCMACRNEMA2CCodeNumberFormat,
+	"ACRNEMA2CCodeLabel":                                             DCMACRNEMA2CCodeLabel,
+	"ACRNEMA2CNumberOfTables":                                        DCMACRNEMA2CNumberOfTables,
+	"ACRNEMA2CCodeTableLocation":                                     DCMACRNEMA2CCodeTableLocation,
+	"ACRNEMA2CBitsForCodeWord":                                       DCMACRNEMA2CBitsForCodeWord,
+	"ACRNEMA2CImageDataLocation":                                     DCMACRNEMA2CImageDataLocation,
+	"PixelSpacingCalibrationType":                                    DCMPixelSpacingCalibrationType,
+	"PixelSpacingCalibrationDescription":                             DCMPixelSpacingCalibrationDescription,
+	"PixelIntensityRelationship":                                     DCMPixelIntensityRelationship,
+	"PixelIntensityRelationshipSign":                                 DCMPixelIntensityRelationshipSign,
+	"WindowCenter":                                                   DCMWindowCenter,
+	"WindowWidth":                                                    DCMWindowWidth,
+	"RescaleIntercept":                                               DCMRescaleIntercept,
+	"RescaleSlope":                                                   DCMRescaleSlope,
+	"RescaleType":                                                    DCMRescaleType,
+	"WindowCenterWidthExplanation":                                   DCMWindowCenterWidthExplanation,
+	"VOILUTFunction":                                                 DCMVOILUTFunction,
+	"ACRNEMAGrayScale":                                               DCMACRNEMAGrayScale,
+	"RecommendedViewingMode":                                         DCMRecommendedViewingMode,
+	"ACRNEMAGrayLookupTableDescriptor":                               DCMACRNEMAGrayLookupTableDescriptor,
+	"RedPaletteColorLookupTableDescriptor":                           DCMRedPaletteColorLookupTableDescriptor,
+	"GreenPaletteColorLookupTableDescriptor":                         DCMGreenPaletteColorLookupTableDescriptor,
+	"BluePaletteColorLookupTableDescriptor":                          DCMBluePaletteColorLookupTableDescriptor,
+	"AlphaPaletteColorLookupTableDescriptor":                         DCMAlphaPaletteColorLookupTableDescriptor,
+	"RETIREDLargeRedPaletteColorLookupTableDescriptor":               DCMRETIREDLargeRedPaletteColorLookupTableDescriptor,
+	"RETIREDLargeGreenPaletteColorLookupTableDescriptor":             DCMRETIREDLargeGreenPaletteColorLookupTableDescriptor,
+	"RETIREDLargeBluePaletteColorLookupTableDescriptor":              DCMRETIREDLargeBluePaletteColorLookupTableDescriptor,
+	"PaletteColorLookupTableUID":                                     DCMPaletteColorLookupTableUID,
+	"ACRNEMAGrayLookupTableData":                                     DCMACRNEMAGrayLookupTableData,
+	"RedPaletteColorLookupTableData":                                 DCMRedPaletteColorLookupTableData,
+	"GreenPaletteColorLookupTableData":                               DCMGreenPaletteColorLookupTableData,
+	"BluePaletteColorLookupTableData":                                DCMBluePaletteColorLookupTableData,
+	"AlphaPaletteColorLookupTableData":                               DCMAlphaPaletteColorLookupTableData,
+	"RETIREDLargeRedPaletteColorLookupTableData":                     DCMRETIREDLargeRedPaletteColorLookupTableData,
+	"RETIREDLargeGreenPaletteColorLookupTableData":                   DCMRETIREDLargeGreenPaletteColorLookupTableData,
+	"RETIREDLargeBluePaletteColorLookupTableData":                    DCMRETIREDLargeBluePaletteColorLookupTableData,
+	"RETIREDLargePaletteColorLookupTableUID":                         DCMRETIREDLargePaletteColorLookupTableUID,
+	"SegmentedRedPaletteColorLookupTableData":                        DCMSegmentedRedPaletteColorLookupTableData,
+	"SegmentedGreenPaletteColorLookupTableData":                      DCMSegmentedGreenPaletteColorLookupTableData,
+	"SegmentedBluePaletteColorLookupTableData":                       DCMSegmentedBluePaletteColorLookupTableData,
+	"BreastImplantPresent":                                           DCMBreastImplantPresent,
+	"PartialView":                                                    DCMPartialView,
+	"PartialViewDescription":                                         DCMPartialViewDescription,
+	"PartialViewCodeSequence":                                        DCMPartialViewCodeSequence,
+	"SpatialLocationsPreserved":                                      DCMSpatialLocationsPreserved,
+	"DataFrameAssignmentSequence":                                    DCMDataFrameAssignmentSequence,
+	"DataPathAssignment":                                             DCMDataPathAssignment,
+	"BitsMappedToColorLookupTable":                                   DCMBitsMappedToColorLookupTable,
+	"BlendingLUT1Sequence":                                           DCMBlendingLUT1Sequence,
+	"BlendingLUT1TransferFunction":                                   DCMBlendingLUT1TransferFunction,
+	"BlendingWeightConstant":                                         DCMBlendingWeightConstant,
+	"BlendingLookupTableDescriptor":                                  DCMBlendingLookupTableDescriptor,
+	"BlendingLookupTableData":                                        DCMBlendingLookupTableData,
+	"EnhancedPaletteColorLookupTableSequence":                        DCMEnhancedPaletteColorLookupTableSequence,
+	"BlendingLUT2Sequence":                                           DCMBlendingLUT2Sequence,
+	"BlendingLUT2TransferFunction":                                   DCMBlendingLUT2TransferFunction,
+	"DataPathID":                                                     DCMDataPathID,
+	"RGBLUTTransferFunction":                                         DCMRGBLUTTransferFunction,
+	"AlphaLUTTransferFunction":                                       DCMAlphaLUTTransferFunction,
+	"ICCProfile":                                                     DCMICCProfile,
+	"LossyImageCompression":                                          DCMLossyImageCompression,
+	"LossyImageCompressionRatio":                                     DCMLossyImageCompressionRatio,
+	"LossyImageCompressionMethod":                                    DCMLossyImageCompressionMethod,
+	"ModalityLUTSequence":                                            DCMModalityLUTSequence,
+	"LUTDescriptor":                                                  DCMLUTDescriptor,
+	"LUTExplanation":                                                 DCMLUTExplanation,
+	"ModalityLUTType":                                                DCMModalityLUTType,
+	"LUTData":                                                        DCMLUTData,
+	"VOILUTSequence":                                                 DCMVOILUTSequence,
+	"SoftcopyVOILUTSequence":                                         DCMSoftcopyVOILUTSequence,
+	"ACRNEMAImagePresentationComments":                               DCMACRNEMAImagePresentationComments,
+	"RETIREDBiPlaneAcquisitionSequence":                              DCMRETIREDBiPlaneAcquisitionSequence,
+	"RepresentativeFrameNumber":                                      DCMRepresentativeFrameNumber,
+	"FrameNumbersOfInterest":                                         DCMFrameNumbersOfInterest,
+	"FrameOfInterestDescription":                                     DCMFrameOfInterestDescription,
+	"FrameOfInterestType":                                            DCMFrameOfInterestType,
+	"RETIREDMaskPointers":                                            DCMRETIREDMaskPointers,
+	"RWavePointer":                                                   DCMRWavePointer,
+	"MaskSubtractionSequence":                                        DCMMaskSubtractionSequence,
+	"MaskOperation":                                                  DCMMaskOperation,
+	"ApplicableFrameRange":                                           DCMApplicableFrameRange,
+	"MaskFrameNumbers":                                               DCMMaskFrameNumbers,
+	"ContrastFrameAveraging":                                         DCMContrastFrameAveraging,
+	"MaskSubPixelShift":                                              DCMMaskSubPixelShift,
+	"TIDOffset":                                                      DCMTIDOffset,
+	"MaskOperationExplanation":                                       DCMMaskOperationExplanation,
+	"PixelDataProviderURL":                                           DCMPixelDataProviderURL,
+	"DataPointRows":                                                  DCMDataPointRows,
+	"DataPointColumns":                                               DCMDataPointColumns,
+	"SignalDomainColumns":                                            DCMSignalDomainColumns,
+	"RETIREDLargestMonochromePixelValue":                             DCMRETIREDLargestMonochromePixelValue,
+	"DataRepresentation":                                             DCMDataRepresentation,
+	"PixelMeasuresSequence":                                          DCMPixelMeasuresSequence,
+	"FrameVOILUTSequence":                                            DCMFrameVOILUTSequence,
+	"PixelValueTransformationSequence":                               DCMPixelValueTransformationSequence,
+	"SignalDomainRows":                                               DCMSignalDomainRows,
+	"DisplayFilterPercentage":                                        DCMDisplayFilterPercentage,
+	"FramePixelShiftSequence":                                        DCMFramePixelShiftSequence,
+	"SubtractionItemID":                                              DCMSubtractionItemID,
+	"PixelIntensityRelationshipLUTSequence":                          DCMPixelIntensityRelationshipLUTSequence,
+	"FramePixelDataPropertiesSequence":                               DCMFramePixelDataPropertiesSequence,
+	"GeometricalProperties":                                          DCMGeometricalProperties,
+	"GeometricMaximumDistortion":                                     DCMGeometricMaximumDistortion,
+	"ImageProcessingApplied":                                         DCMImageProcessingApplied,
+	"MaskSelectionMode":                                              DCMMaskSelectionMode,
+	"LUTFunction":                                                    DCMLUTFunction,
+	"MaskVisibilityPercentage":                                       DCMMaskVisibilityPercentage,
+	"PixelShiftSequence":                                             DCMPixelShiftSequence,
+	"RegionPixelShiftSequence":                                       DCMRegionPixelShiftSequence,
+	"VerticesOfTheRegion":                                            DCMVerticesOfTheRegion,
+	"MultiFramePresentationSequence":                                 DCMMultiFramePresentationSequence,
+	"PixelShiftFrameRange":                                           DCMPixelShiftFrameRange,
+	"LUTFrameRange":                                                  DCMLUTFrameRange,
+	"ImageToEquipmentMappingMatrix":                                  DCMImageToEquipmentMappingMatrix,
+	"EquipmentCoordinateSystemIdentification":                        DCMEquipmentCoordinateSystemIdentification,
+	"RETIREDStudyStatusID":                                           DCMRETIREDStudyStatusID,
+	"RETIREDStudyPriorityID":                                         DCMRETIREDStudyPriorityID,
+	"RETIREDStudyIDIssuer":                                           DCMRETIREDStudyIDIssuer,
+	"RETIREDStudyVerifiedDate":                                       DCMRETIREDStudyVerifiedDate,
+	"RETIREDStudyVerifiedTime":                                       DCMRETIREDStudyVerifiedTime,
+	"RETIREDStudyReadDate":                                           DCMRETIREDStudyReadDate,
+	"RETIREDStudyReadTime":                                           DCMRETIREDStudyReadTime,
+	"RETIREDScheduledStudyStartDate":                                 DCMRETIREDScheduledStudyStartDate,
+	"RETIREDScheduledStudyStartTime":                                 DCMRETIREDScheduledStudyStartTime,
+	"RETIREDScheduledStudyStopDate":                                  DCMRETIREDScheduledStudyStopDate,
+	"RETIREDScheduledStudyStopTime":                                  DCMRETIREDScheduledStudyStopTime,
+	"RETIREDScheduledStudyLocation":                                  DCMRETIREDScheduledStudyLocation,
+	"RETIREDScheduledStudyLocationAETitle":                           DCMRETIREDScheduledStudyLocationAETitle,
+	"RETIREDReasonForStudy":                                          DCMRETIREDReasonForStudy,
+	"RequestingPhysicianIdentificationSequence":                      DCMRequestingPhysicianIdentificationSequence,
+	"RequestingPhysician":                                            DCMRequestingPhysician,
+	"RequestingService":                                              DCMRequestingService,
+	"RequestingServiceCodeSequence":                                  DCMRequestingServiceCodeSequence,
+	"RETIREDStudyArrivalDate":                                        DCMRETIREDStudyArrivalDate,
+	"RETIREDStudyArrivalTime":                                        DCMRETIREDStudyArrivalTime,
+	"RETIREDStudyCompletionDate":                                     DCMRETIREDStudyCompletionDate,
+	"RETIREDStudyCompletionTime":                                     DCMRETIREDStudyCompletionTime,
+	"RETIREDStudyComponentStatusID":                                  DCMRETIREDStudyComponentStatusID,
+	"RequestedProcedureDescription":                                  DCMRequestedProcedureDescription,
+	"RequestedProcedureCodeSequence":                                 DCMRequestedProcedureCodeSequence,
+	"RequestedContrastAgent":                                         DCMRequestedContrastAgent,
+	"RETIREDStudyComments":                                           DCMRETIREDStudyComments,
+	"ReferencedPatientAliasSequence":                                 DCMReferencedPatientAliasSequence,
+	"VisitStatusID":                                                  DCMVisitStatusID,
+	"AdmissionID":                                                    DCMAdmissionID,
+	"RETIREDIssuerOfAdmissionID":                                     DCMRETIREDIssuerOfAdmissionID,
+	"IssuerOfAdmissionIDSequence":                                    DCMIssuerOfAdmissionIDSequence,
+	"RouteOfAdmissions":                                              DCMRouteOfAdmissions,
+	"RETIREDScheduledAdmissionDate":                                  DCMRETIREDScheduledAdmissionDate,
+	"RETIREDScheduledAdmissionTime":                                  DCMRETIREDScheduledAdmissionTime,
+	"RETIREDScheduledDischargeDate":                                  DCMRETIREDScheduledDischargeDate,
+	"RETIREDScheduledDischargeTime":                                  DCMRETIREDScheduledDischargeTime,
+	"RETIREDScheduledPatientInstitutionResidence":                    DCMRETIREDScheduledPatientInstitutionResidence,
+	"AdmittingDate":                                                  DCMAdmittingDate,
+	"AdmittingTime":                                                  DCMAdmittingTime,
+	"RETIREDDischargeDate":                                           DCMRETIREDDischargeDate,
+	"RETIREDDischargeTime":                                           DCMRETIREDDischargeTime,
+	"RETIREDDischargeDiagnosisDescription":                           DCMRETIREDDischargeDiagnosisDescription,
+	"RETIREDDischargeDiagnosisCodeSequence":                          DCMRETIREDDischargeDiagnosisCodeSequence,
+	"SpecialNeeds":                                                   DCMSpecialNeeds,
+	"ServiceEpisodeID":                                               DCMServiceEpisodeID,
+	"RETIREDIssuerOfServiceEpisodeID":                                DCMRETIREDIssuerOfServiceEpisodeID,
+	"ServiceEpisodeDescription":                                      DCMServiceEpisodeDescription,
+	"IssuerOfServiceEpisodeIDSequence":                               DCMIssuerOfServiceEpisodeIDSequence,
+	"PertinentDocumentsSequence":                                     DCMPertinentDocumentsSequence,
+	"CurrentPatientLocation":                                         DCMCurrentPatientLocation,
+	"PatientInstitutionResidence":                                    DCMPatientInstitutionResidence,
+	"PatientState":                                                   DCMPatientState,
+	"PatientClinicalTrialParticipationSequence":                      DCMPatientClinicalTrialParticipationSequence,
+	"VisitComments":                                                  DCMVisitComments,
+	"WaveformOriginality":                                            DCMWaveformOriginality,
+	"NumberOfWaveformChannels":                                       DCMNumberOfWaveformChannels,
+	"NumberOfWaveformSamples":                                        DCMNumberOfWaveformSamples,
+	"SamplingFrequency":                                              DCMSamplingFrequency,
+	"MultiplexGroupLabel":                                            DCMMultiplexGroupLabel,
+	"ChannelDefinitionSequence":                                      DCMChannelDefinitionSequence,
+	"WaveformChannelNumber":                                          DCMWaveformChannelNumber,
+	"ChannelLabel":                                                   DCMChannelLabel,
+	"ChannelStatus":                                                  DCMChannelStatus,
+	"ChannelSourceSequence":                                          DCMChannelSourceSequence,
+	"ChannelSourceModifiersSequence":                                 DCMChannelSourceModifiersSequence,
+	"SourceWaveformSequence":                                         DCMSourceWaveformSequence,
+	"ChannelDerivationDescription":                                   DCMChannelDerivationDescription,
+	"ChannelSensitivity":                                             DCMChannelSensitivity,
+	"ChannelSensitivityUnitsSequence":                                DCMChannelSensitivityUnitsSequence,
+	"ChannelSensitivityCorrectionFactor":                             DCMChannelSensitivityCorrectionFactor,
+	"ChannelBaseline":                                                DCMChannelBaseline,
+	"ChannelTimeSkew":                                                DCMChannelTimeSkew,
+	"ChannelSampleSkew":                                              DCMChannelSampleSkew,
+	"ChannelOffset":                                                  DCMChannelOffset,
+	"WaveformBitsStored":                                             DCMWaveformBitsStored,
+	"FilterLowFrequency":                                             DCMFilterLowFrequency,
+	"FilterHighFrequency":                                            DCMFilterHighFrequency,
+	"NotchFilterFrequency":                                           DCMNotchFilterFrequency,
+	"NotchFilterBandwidth":                                           DCMNotchFilterBandwidth,
+	"WaveformDataDisplayScale":                                       DCMWaveformDataDisplayScale,
+	"WaveformDisplayBackgroundCIELabValue":                           DCMWaveformDisplayBackgroundCIELabValue,
+	"WaveformPresentationGroupSequence":                              DCMWaveformPresentationGroupSequence,
+	"PresentationGroupNumber":                                        DCMPresentationGroupNumber,
+	"ChannelDisplaySequence":                                         DCMChannelDisplaySequence,
+	"ChannelRecommendedDisplayCIELabValue":                           DCMChannelRecommendedDisplayCIELabValue,
+	"ChannelPosition":                                                DCMChannelPosition,
+	"DisplayShadingFlag":                                             DCMDisplayShadingFlag,
+	"FractionalChannelDisplayScale":                                  DCMFractionalChannelDisplayScale,
+	"AbsoluteChannelDisplayScale":                                    DCMAbsoluteChannelDisplayScale,
+	"MultiplexedAudioChannelsDescriptionCodeSequence":                DCMMultiplexedAudioChannelsDescriptionCodeSequence,
+	"ChannelIdentificationCode":                                      DCMChannelIdentificationCode,
+	"ChannelMode":                                                    DCMChannelMode,
+	"ScheduledStationAETitle":                                        DCMScheduledStationAETitle,
+	"ScheduledProcedureStepStartDate":                                DCMScheduledProcedureStepStartDate,
+	"ScheduledProcedureStepStartTime":                                DCMScheduledProcedureStepStartTime,
+	"ScheduledProcedureStepEndDate":                                  DCMScheduledProcedureStepEndDate,
+	"ScheduledProcedureStepEndTime":                                  DCMScheduledProcedureStepEndTime,
+	"ScheduledPerformingPhysicianName":                               DCMScheduledPerformingPhysicianName,
+	"ScheduledProcedureStepDescription":                              DCMScheduledProcedureStepDescription,
+	"ScheduledProtocolCodeSequence":                                  DCMScheduledProtocolCodeSequence,
+	"ScheduledProcedureStepID":                                       DCMScheduledProcedureStepID,
+	"StageCodeSequence":                                              DCMStageCodeSequence,
+	"ScheduledPerformingPhysicianIdentificationSequence":             DCMScheduledPerformingPhysicianIdentificationSequence,
+	"ScheduledStationName":                                           DCMScheduledStationName,
+	"ScheduledProcedureStepLocation":                                 DCMScheduledProcedureStepLocation,
+	"PreMedication":                                                  DCMPreMedication,
+	"ScheduledProcedureStepStatus":                                   DCMScheduledProcedureStepStatus,
+	"OrderPlacerIdentifierSequence":                                  DCMOrderPlacerIdentifierSequence,
+	"OrderFillerIdentifierSequence":                                  DCMOrderFillerIdentifierSequence,
+	"LocalNamespaceEntityID":                                         DCMLocalNamespaceEntityID,
+	"UniversalEntityID":                                              DCMUniversalEntityID,
+	"UniversalEntityIDType":                                          DCMUniversalEntityIDType,
+	"IdentifierTypeCode":                                             DCMIdentifierTypeCode,
+	"AssigningFacilitySequence":                                      DCMAssigningFacilitySequence,
+	"AssigningJurisdictionCodeSequence":                              DCMAssigningJurisdictionCodeSequence,
+	"AssigningAgencyOrDepartmentCodeSequence":                        DCMAssigningAgencyOrDepartmentCodeSequence,
+	"ScheduledProcedureStepSequence":                                 DCMScheduledProcedureStepSequence,
+	"ReferencedNonImageCompositeSOPInstanceSequence":                 DCMReferencedNonImageCompositeSOPInstanceSequence,
+	"PerformedStationAETitle":                                        DCMPerformedStationAETitle,
+	"PerformedStationName":                                           DCMPerformedStationName,
+	"PerformedLocation":                                              DCMPerformedLocation,
+	"PerformedProcedureStepStartDate":                                DCMPerformedProcedureStepStartDate,
+	"PerformedProcedureStepStartTime":                                DCMPerformedProcedureStepStartTime,
+	"PerformedProcedureStepEndDate":                                  DCMPerformedProcedureStepEndDate,
+	"PerformedProcedureStepEndTime":                                  DCMPerformedProcedureStepEndTime,
+	"PerformedProcedureStepStatus":                                   DCMPerformedProcedureStepStatus,
+	"PerformedProcedureStepID":                                       DCMPerformedProcedureStepID,
+	"PerformedProcedureStepDescription":                              DCMPerformedProcedureStepDescription,
+	"PerformedProcedureTypeDescription":                              DCMPerformedProcedureTypeDescription,
+	"PerformedProtocolCodeSequence":                                  DCMPerformedProtocolCodeSequence,
+	"PerformedProtocolType":                                          DCMPerformedProtocolType,
+	"ScheduledStepAttributesSequence":                                DCMScheduledStepAttributesSequence,
+	"RequestAttributesSequence":                                      DCMRequestAttributesSequence,
+	"CommentsOnThePerformedProcedureStep":                            DCMCommentsOnThePerformedProcedureStep,
+	"PerformedProcedureStepDiscontinuationReasonCodeSequence":        DCMPerformedProcedureStepDiscontinuationReasonCodeSequence,
+	"QuantitySequence":                                               DCMQuantitySequence,
+	"Quantity":                                                       DCMQuantity,
+	"MeasuringUnitsSequence":                                         DCMMeasuringUnitsSequence,
+	"BillingItemSequence":                                            DCMBillingItemSequence,
+	"TotalTimeOfFluoroscopy":                                         DCMTotalTimeOfFluoroscopy,
+	"TotalNumberOfExposures":                                         DCMTotalNumberOfExposures,
+	"EntranceDose":                                                   DCMEntranceDose,
+	"ExposedArea":                                                    DCMExposedArea,
+	"DistanceSourceToEntrance":                                       DCMDistanceSourceToEntrance,
+	"RETIREDDistanceSourceToSupport":                                 DCMRETIREDDistanceSourceToSupport,
+	"ExposureDoseSequence":                                           DCMExposureDoseSequence,
+	"CommentsOnRadiationDose":                                        DCMCommentsOnRadiationDose,
+	"XRayOutput":                                                     DCMXRayOutput,
+	"HalfValueLayer":                                                 DCMHalfValueLayer,
+	"OrganDose":                                                      DCMOrganDose,
+	"OrganExposed":                                                   DCMOrganExposed,
+	"BillingProcedureStepSequence":                                   DCMBillingProcedureStepSequence,
+	"FilmConsumptionSequence":                                        DCMFilmConsumptionSequence,
+	"BillingSuppliesAndDevicesSequence":                              DCMBillingSuppliesAndDevicesSequence,
+	"RETIREDReferencedProcedureStepSequence":                         DCMRETIREDReferencedProcedureStepSequence,
+	"PerformedSeriesSequence":                                        DCMPerformedSeriesSequence,
+	"CommentsOnTheScheduledProcedureStep":                            DCMCommentsOnTheScheduledProcedureStep,
+	"ProtocolContextSequence":                                        DCMProtocolContextSequence,
+	"ContentItemModifierSequence":                                    DCMContentItemModifierSequence,
+	"ScheduledSpecimenSequence":                                      DCMScheduledSpecimenSequence,
+	"RETIREDSpecimenAccessionNumber":                                 DCMRETIREDSpecimenAccessionNumber,
+	"ContainerIdentifier":                                            DCMContainerIdentifier,
+	"IssuerOfTheContainerIdentifierSequence":                         DCMIssuerOfTheContainerIdentifierSequence,
+	"AlternateContainerIdentifierSequence":                           DCMAlternateContainerIdentifierSequence,
+	"ContainerTypeCodeSequence":                                      DCMContainerTypeCodeSequence,
+	"ContainerDescription":                                           DCMContainerDescription,
+	"ContainerComponentSequence":                                     DCMContainerComponentSequence,
+	"RETIREDSpecimenSequence":                                        DCMRETIREDSpecimenSequence,
+	"SpecimenIdentifier":                                             DCMSpecimenIdentifier,
+	"RETIREDSpecimenDescriptionSequenceTrial":                        DCMRETIREDSpecimenDescriptionSequenceTrial,
+	"RETIREDSpecimenDescriptionTrial":                                DCMRETIREDSpecimenDescriptionTrial,
+	"SpecimenUID":                                                    DCMSpecimenUID,
+	"AcquisitionContextSequence":                                     DCMAcquisitionContextSequence,
+	"AcquisitionContextDescription":                                  DCMAcquisitionContextDescription,
+	"SpecimenDescriptionSequence":                                    DCMSpecimenDescriptionSequence,
+	"IssuerOfTheSpecimenIdentifierSequence":                          DCMIssuerOfTheSpecimenIdentifierSequence,
+	"SpecimenTypeCodeSequence":                                       DCMSpecimenTypeCodeSequence,
+	"SpecimenShortDescription":                                       DCMSpecimenShortDescription,
+	"SpecimenDetailedDescription":                                    DCMSpecimenDetailedDescription,
+	"SpecimenPreparationSequence":                                    DCMSpecimenPreparationSequence,
+	"SpecimenPreparationStepContentItemSequence":                     DCMSpecimenPreparationStepContentItemSequence,
+	"SpecimenLocalizationContentItemSequence":                        DCMSpecimenLocalizationContentItemSequence,
+	"RETIREDSlideIdentifier":                                         DCMRETIREDSlideIdentifier,
+	"ImageCenterPointCoordinatesSequence":                            DCMImageCenterPointCoordinatesSequence,
+	"XOffsetInSlideCoordinateSystem":                                 DCMXOffsetInSlideCoordinateSystem,
+	"YOffsetInSlideCoordinateSystem":                                 DCMYOffsetInSlideCoordinateSystem,
+	"ZOffsetInSlideCoordinateSystem":                                 DCMZOffsetInSlideCoordinateSystem,
+	"RETIREDPixelSpacingSequence":                                    DCMRETIREDPixelSpacingSequence,
+	"RETIREDCoordinateSystemAxisCodeSequence":                        DCMRETIREDCoordinateSystemAxisCodeSequence,
+	"MeasurementUnitsCodeSequence":                                   DCMMeasurementUnitsCodeSequence,
+	"RETIREDVitalStainCodeSequenceTrial":                             DCMRETIREDVitalStainCodeSequenceTrial,
+	"RequestedProcedureID":                                           DCMRequestedProcedureID,
+	"ReasonForTheRequestedProcedure":                                 DCMReasonForTheRequestedProcedure,
+	"RequestedProcedurePriority":                                     DCMRequestedProcedurePriority,
+	"PatientTransportArrangements":                                   DCMPatientTransportArrangements,
+	"RequestedProcedureLocation":                                     DCMRequestedProcedureLocation,
+	"RETIREDPlacerOrderNumberProcedure":                              DCMRETIREDPlacerOrderNumberProcedure,
+	"RETIREDFillerOrderNumberProcedure":                              DCMRETIREDFillerOrderNumberProcedure,
+	"ConfidentialityCode":                                            DCMConfidentialityCode,
+	"ReportingPriority":                                              DCMReportingPriority,
+	"ReasonForRequestedProcedureCodeSequence":                        DCMReasonForRequestedProcedureCodeSequence,
+	"NamesOfIntendedRecipientsOfResults":                             DCMNamesOfIntendedRecipientsOfResults,
+	"IntendedRecipientsOfResultsIdentificationSequence":              DCMIntendedRecipientsOfResultsIdentificationSequence,
+	"ReasonForPerformedProcedureCodeSequence":                        DCMReasonForPerformedProcedureCodeSequence,
+	"PersonIdentificationCodeSequence":                               DCMPersonIdentificationCodeSequence,
+	"PersonAddress":                                                  DCMPersonAddress,
+	"PersonTelephoneNumbers":                                         DCMPersonTelephoneNumbers,
+	"RequestedProcedureComments":                                     DCMRequestedProcedureComments,
+	"RETIREDReasonForTheImagingServiceRequest":                       DCMRETIREDReasonForTheImagingServiceRequest,
+	"IssueDateOfImagingServiceRequest":                               DCMIssueDateOfImagingServiceRequest,
+	"IssueTimeOfImagingServiceRequest":                               DCMIssueTimeOfImagingServiceRequest,
+	"RETIREDPlacerOrderNumberImagingServiceRequestRetired":           DCMRETIREDPlacerOrderNumberImagingServiceRequestRetired,
+	"RETIREDFillerOrderNumberImagingServiceRequestRetired":           DCMRETIREDFillerOrderNumberImagingServiceRequestRetired,
+	"OrderEnteredBy":                                                 DCMOrderEnteredBy,
+	"OrderEntererLocation":                                           DCMOrderEntererLocation,
+	"OrderCallbackPhoneNumber":                                       DCMOrderCallbackPhoneNumber,
+	"PlacerOrderNumberImagingServiceRequest":                         DCMPlacerOrderNumberImagingServiceRequest,
+	"FillerOrderNumberImagingServiceRequest":                         DCMFillerOrderNumberImagingServiceRequest,
+	"ImagingServiceRequestComments":                                  DCMImagingServiceRequestComments,
+	"ConfidentialityConstraintOnPatientDataDescription":              DCMConfidentialityConstraintOnPatientDataDescription,
+	"GeneralPurposeScheduledProcedureStepStatus":                     DCMGeneralPurposeScheduledProcedureStepStatus,
+	"GeneralPurposePerformedProcedureStepStatus":                     DCMGeneralPurposePerformedProcedureStepStatus,
+	"GeneralPurposeScheduledProcedureStepPriority":                   DCMGeneralPurposeScheduledProcedureStepPriority,
+	"ScheduledProcessingApplicationsCodeSequence":                    DCMScheduledProcessingApplicationsCodeSequence,
+	"ScheduledProcedureStepStartDateTime":                            DCMScheduledProcedureStepStartDateTime,
+	"MultipleCopiesFlag":                                             DCMMultipleCopiesFlag,
+	"PerformedProcessingApplicationsCodeSequence":                    DCMPerformedProcessingApplicationsCodeSequence,
+	"HumanPerformerCodeSequence":                                     DCMHumanPerformerCodeSequence,
+	"ScheduledProcedureStepModificationDateTime":                     DCMScheduledProcedureStepModificationDateTime,
+	"ExpectedCompletionDateTime":                                     DCMExpectedCompletionDateTime,
+	"ResultingGeneralPurposePerformedProcedureStepsSequence":         DCMResultingGeneralPurposePerformedProcedureStepsSequence,
+	"ReferencedGeneralPurposeScheduledProcedureStepSequence":         DCMReferencedGeneralPurposeScheduledProcedureStepSequence,
+	"ScheduledWorkitemCodeSequence":                                  DCMScheduledWorkitemCodeSequence,
+	"PerformedWorkitemCodeSequence":                                  DCMPerformedWorkitemCodeSequence,
+	"InputAvailabilityFlag":                                          DCMInputAvailabilityFlag,
+	"InputInformationSequence":                                       DCMInputInformationSequence,
+	"RelevantInformationSequence":                                    DCMRelevantInformationSequence,
+	"ReferencedGeneralPurposeScheduledProcedureStepTransactionUID":   DCMReferencedGeneralPurposeScheduledProcedureStepTransactionUID,
+	"ScheduledStationNameCodeSequence":                               DCMScheduledStationNameCodeSequence,
+	"ScheduledStationClassCodeSequence":                              DCMScheduledStationClassCodeSequence,
+	"ScheduledStationGeographicLocationCodeSequence":                 DCMScheduledStationGeographicLocationCodeSequence,
+	"PerformedStationNameCodeSequence":                               DCMPerformedStationNameCodeSequence,
+	"PerformedStationClassCodeSequence":                              DCMPerformedStationClassCodeSequence,
+	"PerformedStationGeographicLocationCodeSequence":                 DCMPerformedStationGeographicLocationCodeSequence,
+	"RequestedSubsequentWorkitemCodeSequence":                        DCMRequestedSubsequentWorkitemCodeSequence,
+	"NonDICOMOutputCodeSequence":                                     DCMNonDICOMOutputCodeSequence,
+	"OutputInformationSequence":                                      DCMOutputInformationSequence,
+	"ScheduledHumanPerformersSequence":                               DCMScheduledHumanPerformersSequence,
+	"ActualHumanPerformersSequence":                                  DCMActualHumanPerformersSequence,
+	"HumanPerformerOrganization":                                     DCMHumanPerformerOrganization,
+	"HumanPerformerName":                                             DCMHumanPerformerName,
+	"RawDataHandling":                                                DCMRawDataHandling,
+	"EntranceDoseInmGy":                                              DCMEntranceDoseInmGy,
+	"ReferencedImageRealWorldValueMappingSequence":                   DCMReferencedImageRealWorldValueMappingSequence,
+	"RealWorldValueMappingSequence":                                  DCMRealWorldValueMappingSequence,
+	"PixelValueMappingCodeSequence":                                  DCMPixelValueMappingCodeSequence,
+	"LUTLabel":                                                       DCMLUTLabel,
+	"RealWorldValueLastValueMapped":                                  DCMRealWorldValueLastValueMapped,
+	"RealWorldValueLUTData":                                          DCMRealWorldValueLUTData,
+	"RealWorldValueFirstValueMapped":                                 DCMRealWorldValueFirstValueMapped,
+	"RealWorldValueIntercept":                                        DCMRealWorldValueIntercept,
+	"RealWorldValueSlope":                                            DCMRealWorldValueSlope,
+	"RelationshipType":                                               DCMRelationshipType,
+	"VerifyingOrganization":                                          DCMVerifyingOrganization,
+	"VerificationDateTime":                                           DCMVerificationDateTime,
+	"ObservationDateTime":                                            DCMObservationDateTime,
+	"ValueType":                                                      DCMValueType,
+	"ConceptNameCodeSequence":                                        DCMConceptNameCodeSequence,
+	"ContinuityOfContent":                                            DCMContinuityOfContent,
+	"VerifyingObserverSequence":                                      DCMVerifyingObserverSequence,
+	"VerifyingObserverName":                                          DCMVerifyingObserverName,
+	"AuthorObserverSequence":                                         DCMAuthorObserverSequence,
+	"ParticipantSequence":                                            DCMParticipantSequence,
+	"CustodialOrganizationSequence":                                  DCMCustodialOrganizationSequence,
+	"ParticipationType":                                              DCMParticipationType,
+	"ParticipationDateTime":                                          DCMParticipationDateTime,
+	"ObserverType":                                                   DCMObserverType,
+	"VerifyingObserverIdentificationCodeSequence":                    DCMVerifyingObserverIdentificationCodeSequence,
+	"RETIREDEquivalentCDADocumentSequence":                           DCMRETIREDEquivalentCDADocumentSequence,
+	"ReferencedWaveformChannels":                                     DCMReferencedWaveformChannels,
+	"DateTime":                                                       DCMDateTime,
+	"Date":                                                           DCMDate,
+	"Time":                                                           DCMTime,
+	"PersonName":                                                     DCMPersonName,
+	"UID":                                                            DCMUID,
+	"TemporalRangeType":                                              DCMTemporalRangeType,
+	"ReferencedSamplePositions":                                      DCMReferencedSamplePositions,
+	"ReferencedFrameNumbers":                                         DCMReferencedFrameNumbers,
+	"ReferencedTimeOffsets":                                          DCMReferencedTimeOffsets,
+	"ReferencedDateTime":                                             DCMReferencedDateTime,
+	"TextValue":                                                      DCMTextValue,
+	"ConceptCodeSequence":                                            DCMConceptCodeSequence,
+	"PurposeOfReferenceCodeSequence":                                 DCMPurposeOfReferenceCodeSequence,
+	"AnnotationGroupNumber":                                          DCMAnnotationGroupNumber,
+	"ModifierCodeSequence":                                           DCMModifierCodeSequence,
+	"MeasuredValueSequence":                                          DCMMeasuredValueSequence,
+	"NumericValueQualifierCodeSequence":                              DCMNumericValueQualifierCodeSequence,
+	"NumericValue":                                                   DCMNumericValue,
+	"RETIREDAddressTrial":                                            DCMRETIREDAddressTrial,
+	"RETIREDTelephoneNumberTrial":                                    DCMRETIREDTelephoneNumberTrial,
+	"PredecessorDocumentsSequence":                                   DCMPredecessorDocumentsSequence,
+	"ReferencedRequestSequence":                                      DCMReferencedRequestSequence,
+	"PerformedProcedureCodeSequence":                                 DCMPerformedProcedureCodeSequence,
+	"CurrentRequestedProcedureEvidenceSequence":                      DCMCurrentRequestedProcedureEvidenceSequence,
+	"PertinentOtherEvidenceSequence":                                 DCMPertinentOtherEvidenceSequence,
+	"HL7StructuredDocumentReferenceSequence":                         DCMHL7StructuredDocumentReferenceSequence,
+	"CompletionFlag":                                                 DCMCompletionFlag,
+	"CompletionFlagDescription":                                      DCMCompletionFlagDescription,
+	"VerificationFlag":                                               DCMVerificationFlag,
+	"ArchiveRequested":                                               DCMArchiveRequested,
+	"PreliminaryFlag":                                                DCMPreliminaryFlag,
+	"ContentTemplateSequence":                                        DCMContentTemplateSequence,
+	"IdenticalDocumentsSequence":                                     DCMIdenticalDocumentsSequence,
+	"ContentSequence":                                                DCMContentSequence,
+	"WaveformAnnotationSequence":                                     DCMWaveformAnnotationSequence,
+	"TemplateIdentifier":                                             DCMTemplateIdentifier,
+	"RETIREDTemplateVersion":                                         DCMRETIREDTemplateVersion,
+	"RETIREDTemplateLocalVersion":                                    DCMRETIREDTemplateLocalVersion,
+	"RETIREDTemplateExtensionFlag":                                   DCMRETIREDTemplateExtensionFlag,
+	"RETIREDTemplateExtensionOrganizationUID":                        DCMRETIREDTemplateExtensionOrganizationUID,
+	"RETIREDTemplateExtensionCreatorUID":                             DCMRETIREDTemplateExtensionCreatorUID,
+	"ReferencedContentItemIdentifier":                                DCMReferencedContentItemIdentifier,
+	"HL7InstanceIdentifier":                                          DCMHL7InstanceIdentifier,
+	"HL7DocumentEffectiveTime":                                       DCMHL7DocumentEffectiveTime,
+	"HL7DocumentTypeCodeSequence":                                    DCMHL7DocumentTypeCodeSequence,
+	"RetrieveURI":                                                    DCMRetrieveURI,
+	"RetrieveLocationUID":                                            DCMRetrieveLocationUID,
+	"DocumentTitle":                                                  DCMDocumentTitle,
+	"EncapsulatedDocument":                                           DCMEncapsulatedDocument,
+	"MIMETypeOfEncapsulatedDocument":                                 DCMMIMETypeOfEncapsulatedDocument,
+	"SourceInstanceSequence":                                         DCMSourceInstanceSequence,
+	"ListOfMIMETypes":                                                DCMListOfMIMETypes,
+	"ProductPackageIdentifier":                                       DCMProductPackageIdentifier,
+	"SubstanceAdministrationApproval":                                DCMSubstanceAdministrationApproval,
+	"ApprovalStatusFurtherDescription":                               DCMApprovalStatusFurtherDescription,
+	"ApprovalStatusDateTime":                                         DCMApprovalStatusDateTime,
+	"ProductTypeCodeSequence":                                        DCMProductTypeCodeSequence,
+	"ProductName":                                                    DCMProductName,
+	"ProductDescription":                                             DCMProductDescription,
+	"ProductLotIdentifier":                                           DCMProductLotIdentifier,
+	"ProductExpirationDateTime":                                      DCMProductExpirationDateTime,
+	"SubstanceAdministrationDateTime":                                DCMSubstanceAdministrationDateTime,
+	"SubstanceAdministrationNotes":                                   DCMSubstanceAdministrationNotes,
+	"SubstanceAdministrationDeviceID":                                DCMSubstanceAdministrationDeviceID,
+	"ProductParameterSequence":                                       DCMProductParameterSequence,
+	"SubstanceAdministrationParameterSequence":                       DCMSubstanceAdministrationParameterSequence,
+	"LensDescription":                                                DCMLensDescription,
+	"RightLensSequence":                                              DCMRightLensSequence,
+	"LeftLensSequence":                                               DCMLeftLensSequence,
+	"UnspecifiedLateralityLensSequence":                              DCMUnspecifiedLateralityLensSequence,
+	"CylinderSequence":                                               DCMCylinderSequence,
+	"PrismSequence":                                                  DCMPrismSequence,
+	"HorizontalPrismPower":                                           DCMHorizontalPrismPower,
+	"HorizontalPrismBase":                                            DCMHorizontalPrismBase,
+	"VerticalPrismPower":                                             DCMVerticalPrismPower,
+	"VerticalPrismBase":                                              DCMVerticalPrismBase,
+	"LensSegmentType":                                                DCMLensSegmentType,
+	"OpticalTransmittance":                                           DCMOpticalTransmittance,
+	"ChannelWidth":                                                   DCMChannelWidth,
+	"PupilSize":                                                      DCMPupilSize,
+	"CornealSize":                                                    DCMCornealSize,
+	"AutorefractionRightEyeSequence":                                 DCMAutorefractionRightEyeSequence,
+	"AutorefractionLeftEyeSequence":                                  DCMAutorefractionLeftEyeSequence,
+	"DistancePupillaryDistance":                                      DCMDistancePupillaryDistance,
+	"NearPupillaryDistance":                                          DCMNearPupillaryDistance,
+	"IntermediatePupillaryDistance":                                  DCMIntermediatePupillaryDistance,
+	"OtherPupillaryDistance":                                         DCMOtherPupillaryDistance,
+	"KeratometryRightEyeSequence":                                    DCMKeratometryRightEyeSequence,
+	"KeratometryLeftEyeSequence":                                     DCMKeratometryLeftEyeSequence,
+	"SteepKeratometricAxisSequence":                                  DCMSteepKeratometricAxisSequence,
+	"RadiusOfCurvature":                                              DCMRadiusOfCurvature,
+	"KeratometricPower":                                              DCMKeratometricPower,
+	"KeratometricAxis":                                               DCMKeratometricAxis,
+	"FlatKeratometricAxisSequence":                                   DCMFlatKeratometricAxisSequence,
+	"BackgroundColor":                                                DCMBackgroundColor,
+	"Optotype":                                                       DCMOptotype,
+	"OptotypePresentation":                                           DCMOptotypePresentation,
+	"SubjectiveRefractionRightEyeSequence":                           DCMSubjectiveRefractionRightEyeSequence,
+	"SubjectiveRefractionLeftEyeSequence":                            DCMSubjectiveRefractionLeftEyeSequence,
+	"AddNearSequence":                                                DCMAddNearSequence,
+	"AddIntermediateSequence":                                        DCMAddIntermediateSequence,
+	"AddOtherSequence":                                               DCMAddOtherSequence,
+	"AddPower":                                                       DCMAddPower,
+	"ViewingDistance":                                                DCMViewingDistance,
+	"VisualAcuityTypeCodeSequence":                                   DCMVisualAcuityTypeCodeSequence,
+	"VisualAcuityRightEyeSequence":                                   DCMVisualAcuityRightEyeSequence,
+	"VisualAcuityLeftEyeSequence":                                    DCMVisualAcuityLeftEyeSequence,
+	"VisualAcuityBothEyesOpenSequence":                               DCMVisualAcuityBothEyesOpenSequence,
+	"ViewingDistanceType":                                            DCMViewingDistanceType,
+	"VisualAcuityModifiers":                                          DCMVisualAcuityModifiers,
+	"DecimalVisualAcuity":                                            DCMDecimalVisualAcuity,
+	"OptotypeDetailedDefinition":                                     DCMOptotypeDetailedDefinition,
+	"ReferencedRefractiveMeasurementsSequence":                       DCMReferencedRefractiveMeasurementsSequence,
+	"SpherePower":                                                    DCMSpherePower,
+	"CylinderPower":                                                  DCMCylinderPower,
+	"ImagedVolumeWidth":                                              DCMImagedVolumeWidth,
+	"ImagedVolumeHeight":                                             DCMImagedVolumeHeight,
+	"ImagedVolumeDepth":                                              DCMImagedVolumeDepth,
+	"TotalPixelMatrixColumns":                                        DCMTotalPixelMatrixColumns,
+	"TotalPixelMatrixRows":                                           DCMTotalPixelMatrixRows,
+	"TotalPixelMatrixOriginSequence":                                 DCMTotalPixelMatrixOriginSequence,
+	"SpecimenLabelInImage":                                           DCMSpecimenLabelInImage,
+	"FocusMethod":                                                    DCMFocusMethod,
+	"ExtendedDepthOfField":                                           DCMExtendedDepthOfField,
+	"NumberOfFocalPlanes":                                            DCMNumberOfFocalPlanes,
+	"DistanceBetweenFocalPlanes":                                     DCMDistanceBetweenFocalPlanes,
+	"RecommendedAbsentPixelCIELabValue":                              DCMRecommendedAbsentPixelCIELabValue,
+	"IlluminatorTypeCodeSequence":                                    DCMIlluminatorTypeCodeSequence,
+	"ImageOrientationSlide":                                          DCMImageOrientationSlide,
+	"OpticalPathSequence":                                            DCMOpticalPathSequence,
+	"OpticalPathIdentifier":                                          DCMOpticalPathIdentifier,
+	"OpticalPathDescription":                                         DCMOpticalPathDescription,
+	"IlluminationColorCodeSequence":                                  DCMIlluminationColorCodeSequence,
+	"SpecimenReferenceSequence":                                      DCMSpecimenReferenceSequence,
+	"CondenserLensPower":                                             DCMCondenserLensPower,
+	"ObjectiveLensPower":                                             DCMObjectiveLensPower,
+	"ObjectiveLensNumericalAperture":                                 DCMObjectiveLensNumericalAperture,
+	"PaletteColorLookupTableSequence":                                DCMPaletteColorLookupTableSequence,
+	"ReferencedImageNavigationSequence":                              DCMReferencedImageNavigationSequence,
+	"TopLeftHandCornerOfLocalizerArea":                               DCMTopLeftHandCornerOfLocalizerArea,
+	"BottomRightHandCornerOfLocalizerArea":                           DCMBottomRightHandCornerOfLocalizerArea,
+	"OpticalPathIdentificationSequence":                              DCMOpticalPathIdentificationSequence,
+	"PlanePositionSlideSequence":                                     DCMPlanePositionSlideSequence,
+	"ColumnPositionInTotalImagePixelMatrix":                          DCMColumnPositionInTotalImagePixelMatrix,
+	"RowPositionInTotalImagePixelMatrix":                             DCMRowPositionInTotalImagePixelMatrix,
+	"PixelOriginInterpretation":                                      DCMPixelOriginInterpretation,
+	"CalibrationImage":                                               DCMCalibrationImage,
+	"DeviceSequence":                                                 DCMDeviceSequence,
+	"ContainerComponentTypeCodeSequence":                             DCMContainerComponentTypeCodeSequence,
+	"ContainerComponentThickness":                                    DCMContainerComponentThickness,
+	"DeviceLength":                                                   DCMDeviceLength,
+	"ContainerComponentWidth":                                        DCMContainerComponentWidth,
+	"DeviceDiameter":                                                 DCMDeviceDiameter,
+	"DeviceDiameterUnits":                                            DCMDeviceDiameterUnits,
+	"DeviceVolume":                                                   DCMDeviceVolume,
+	"InterMarkerDistance":                                            DCMInterMarkerDistance,
+	"ContainerComponentMaterial":                                     DCMContainerComponentMaterial,
+	"ContainerComponentID":                                           DCMContainerComponentID,
+	"ContainerComponentLength":                                       DCMContainerComponentLength,
+	"ContainerComponentDiameter":                                     DCMContainerComponentDiameter,
+	"ContainerComponentDescription":                                  DCMContainerComponentDescription,
+	"DeviceDescription":                                              DCMDeviceDescription,
+	"EnergyWindowVector":                                             DCMEnergyWindowVector,
+	"NumberOfEnergyWindows":                                          DCMNumberOfEnergyWindows,
+	"EnergyWindowInformationSequence":                                DCMEnergyWindowInformationSequence,
+	"EnergyWindowRangeSequence":                                      DCMEnergyWindowRangeSequence,
+	"EnergyWindowLowerLimit":                                         DCMEnergyWindowLowerLimit,
+	"EnergyWindowUpperLimit":                                         DCMEnergyWindowUpperLimit,
+	"RadiopharmaceuticalInformationSequence":                         DCMRadiopharmaceuticalInformationSequence,
+	"ResidualSyringeCounts":                                          DCMResidualSyringeCounts,
+	"EnergyWindowName":                                               DCMEnergyWindowName,
+	"DetectorVector":                                                 DCMDetectorVector,
+	"NumberOfDetectors":                                              DCMNumberOfDetectors,
+	"DetectorInformationSequence":                                    DCMDetectorInformationSequence,
+	"PhaseVector":                                                    DCMPhaseVector,
+	"NumberOfPhases":                                                 DCMNumberOfPhases,
+	"PhaseInformationSequence":                                       DCMPhaseInformationSequence,
+	"NumberOfFramesInPhase":                                          DCMNumberOfFramesInPhase,
+	"PhaseDelay":                                                     DCMPhaseDelay,
+	"PauseBetweenFrames":                                             DCMPauseBetweenFrames,
+	"PhaseDescription":                                               DCMPhaseDescription,
+	"RotationVector":                                                 DCMRotationVector,
+	"NumberOfRotations":                                              DCMNumberOfRotations,
+	"RotationInformationSequence":                                    DCMRotationInformationSequence,
+	"NumberOfFramesInRotation":                                       DCMNumberOfFramesInRotation,
+	"RRIntervalVector":                                               DCMRRIntervalVector,
+	"NumberOfRRIntervals":                                            DCMNumberOfRRIntervals,
+	"GatedInformationSequence":                                       DCMGatedInformationSequence,
+	"DataInformationSequence":                                        DCMDataInformationSequence,
+	"TimeSlotVector":                                                 DCMTimeSlotVector,
+	"NumberOfTimeSlots":                                              DCMNumberOfTimeSlots,
+	"TimeSlotInformationSequence":                                    DCMTimeSlotInformationSequence,
+	"TimeSlotTime":                                                   DCMTimeSlotTime,
+	"SliceVector":                                                    DCMSliceVector,
+	"NumberOfSlices":                                                 DCMNumberOfSlices,
+	"AngularViewVector":                                              DCMAngularViewVector,
+	"TimeSliceVector":                                                DCMTimeSliceVector,
+	"NumberOfTimeSlices":                                             DCMNumberOfTimeSlices,
+	"StartAngle":                                                     DCMStartAngle,
+	"TypeOfDetectorMotion":                                           DCMTypeOfDetectorMotion,
+	"TriggerVector":                                                  DCMTriggerVector,
+	"NumberOfTriggersInPhase":                                        DCMNumberOfTriggersInPhase,
+	"ViewCodeSequence":                                               DCMViewCodeSequence,
+	"ViewModifierCodeSequence":                                       DCMViewModifierCodeSequence,
+	"RadionuclideCodeSequence":                                       DCMRadionuclideCodeSequence,
+	"AdministrationRouteCodeSequence":                                DCMAdministrationRouteCodeSequence,
+	"RadiopharmaceuticalCodeSequence":                                DCMRadiopharmaceuticalCodeSequence,
+	"CalibrationDataSequence":                                        DCMCalibrationDataSequence,
+	"EnergyWindowNumber":                                             DCMEnergyWindowNumber,
+	"ImageID":                                                        DCMImageID,
+	"PatientOrientationCodeSequence":                                 DCMPatientOrientationCodeSequence,
+	"PatientOrientationModifierCodeSequence":                         DCMPatientOrientationModifierCodeSequence,
+	"PatientGantryRelationshipCodeSequence":                          DCMPatientGantryRelationshipCodeSequence,
+	"SliceProgressionDirection":                                      DCMSliceProgressionDirection,
+	"SeriesType":                                                     DCMSeriesType,
+	"Units":                                                          DCMUnits,
+	"CountsSource":                                                   DCMCountsSource,
+	"ReprojectionMethod":                                             DCMReprojectionMethod,
+	"RandomsCorrectionMethod":                                        DCMRandomsCorrectionMethod,
+	"AttenuationCorrectionMethod":                                    DCMAttenuationCorrectionMethod,
+	"DecayCorrection":                                                DCMDecayCorrection,
+	"ReconstructionMethod":                                           DCMReconstructionMethod,
+	"DetectorLinesOfResponseUsed":                                    DCMDetectorLinesOfResponseUsed,
+	"ScatterCorrectionMethod":                                        DCMScatterCorrectionMethod,
+	"AxialAcceptance":                                                DCMAxialAcceptance,
+	"AxialMash":                                                      DCMAxialMash,
+	"TransverseMash":                                                 DCMTransverseMash,
+	"DetectorElementSize":                                            DCMDetectorElementSize,
+	"CoincidenceWindowWidth":                                         DCMCoincidenceWindowWidth,
+	"SecondaryCountsType":                                            DCMSecondaryCountsType,
+	"FrameReferenceTime":                                             DCMFrameReferenceTime,
+	"PrimaryPromptsCountsAccumulated":                                DCMPrimaryPromptsCountsAccumulated,
+	"SecondaryCountsAccumulated":                                     DCMSecondaryCountsAccumulated,
+	"SliceSensitivityFactor":                                         DCMSliceSensitivityFactor,
+	"DecayFactor":                                                    DCMDecayFactor,
+	"DoseCalibrationFactor":                                          DCMDoseCalibrationFactor,
+	"ScatterFractionFactor":                                          DCMScatterFractionFactor,
+	"DeadTimeFactor":                                                 DCMDeadTimeFactor,
+	"ImageIndex":                                                     DCMImageIndex,
+	"RETIREDCountsIncluded":                                          DCMRETIREDCountsIncluded,
+	"RETIREDDeadTimeCorrectionFlag":                                  DCMRETIREDDeadTimeCorrectionFlag,
+	"HistogramSequence":                                              DCMHistogramSequence,
+	"HistogramNumberOfBins":                                          DCMHistogramNumberOfBins,
+	"HistogramFirstBinValue":                                         DCMHistogramFirstBinValue,
+	"HistogramLastBinValue":                                          DCMHistogramLastBinValue,
+	"HistogramBinWidth":                                              DCMHistogramBinWidth,
+	"HistogramExplanation":                                           DCMHistogramExplanation,
+	"HistogramData":                                                  DCMHistogramData,
+	"SegmentationType":                                               DCMSegmentationType,
+	"SegmentSequence":                                                DCMSegmentSequence,
+	"SegmentedPropertyCategoryCodeSequence":                          DCMSegmentedPropertyCategoryCodeSequence,
+	"SegmentNumber":                                                  DCMSegmentNumber,
+	"SegmentLabel":                                                   DCMSegmentLabel,
+	"SegmentDescription":                                             DCMSegmentDescription,
+	"SegmentAlgorithmType":                                           DCMSegmentAlgorithmType,
+	"SegmentAlgorithmName":                                           DCMSegmentAlgorithmName,
+	"SegmentIdentificationSequence":                                  DCMSegmentIdentificationSequence,
+	"ReferencedSegmentNumber":                                        DCMReferencedSegmentNumber,
+	"RecommendedDisplayGrayscaleValue":                               DCMRecommendedDisplayGrayscaleValue,
+	"RecommendedDisplayCIELabValue":                                  DCMRecommendedDisplayCIELabValue,
+	"MaximumFractionalValue":                                         DCMMaximumFractionalValue,
+	"SegmentedPropertyTypeCodeSequence":                              DCMSegmentedPropertyTypeCodeSequence,
+	"SegmentationFractionalType":                                     DCMSegmentationFractionalType,
+	"DeformableRegistrationSequence":                                 DCMDeformableRegistrationSequence,
+	"SourceFrameOfReferenceUID":                                      DCMSourceFrameOfReferenceUID,
+	"DeformableRegistrationGridSequence":                             DCMDeformableRegistrationGridSequence,
+	"GridDimensions":                                                 DCMGridDimensions,
+	"GridResolution":                                                 DCMGridResolution,
+	"VectorGridData":                                                 DCMVectorGridData,
+	"PreDeformationMatrixRegistrationSequence":                       DCMPreDeformationMatrixRegistrationSequence,
+	"PostDeformationMatrixRegistrationSequence":                      DCMPostDeformationMatrixRegistrationSequence,
+	"NumberOfSurfaces":                                               DCMNumberOfSurfaces,
+	"SurfaceSequence":                                                DCMSurfaceSequence,
+	"SurfaceNumber":                                                  DCMSurfaceNumber,
+	"SurfaceComments":                                                DCMSurfaceComments,
+	"SurfaceProcessing":                                              DCMSurfaceProcessing,
+	"SurfaceProcessingRatio":                                         DCMSurfaceProcessingRatio,
+	"SurfaceProcessingDescription":                                   DCMSurfaceProcessingDescription,
+	"RecommendedPresentationOpacity":                                 DCMRecommendedPresentationOpacity,
+	"RecommendedPresentationType":                                    DCMRecommendedPresentationType,
+	"FiniteVolume":                                                   DCMFiniteVolume,
+	"Manifold":                                                       DCMManifold,
+	"SurfacePointsSequence":                                          DCMSurfacePointsSequence,
+	"SurfacePointsNormalsSequence":                                   DCMSurfacePointsNormalsSequence,
+	"SurfaceMeshPrimitivesSequence":                                  DCMSurfaceMeshPrimitivesSequence,
+	"NumberOfSurfacePoints":                                          DCMNumberOfSurfacePoints,
+	"PointCoordinatesData":                                           DCMPointCoordinatesData,
+	"PointPositionAccuracy":                                          DCMPointPositionAccuracy,
+	"MeanPointDistance":                                              DCMMeanPointDistance,
+	"MaximumPointDistance":                                           DCMMaximumPointDistance,
+	"PointsBoundingBoxCoordinates":                                   DCMPointsBoundingBoxCoordinates,
+	"AxisOfRotation":                                                 DCMAxisOfRotation,
+	"CenterOfRotation":                                               DCMCenterOfRotation,
+	"NumberOfVectors":                                                DCMNumberOfVectors,
+	"VectorDimensionality":                                           DCMVectorDimensionality,
+	"VectorAccuracy":                                                 DCMVectorAccuracy,
+	"VectorCoordinateData":                                           DCMVectorCoordinateData,
+	"TrianglePointIndexList":                                         DCMTrianglePointIndexList,
+	"EdgePointIndexList":                                             DCMEdgePointIndexList,
+	"VertexPointIndexList":                                           DCMVertexPointIndexList,
+	"TriangleStripSequence":                                          DCMTriangleStripSequence,
+	"TriangleFanSequence":                                            DCMTriangleFanSequence,
+	"LineSequence":                                                   DCMLineSequence,
+	"PrimitivePointIndexList":                                        DCMPrimitivePointIndexList,
+	"SurfaceCount":                                                   DCMSurfaceCount,
+	"ReferencedSurfaceSequence":                                      DCMReferencedSurfaceSequence,
+	"ReferencedSurfaceNumber":                                        DCMReferencedSurfaceNumber,
+	"SegmentSurfaceGenerationAlgorithmIdentificationSequence":        DCMSegmentSurfaceGenerationAlgorithmIdentificationSequence,
+	"SegmentSurfaceSourceInstanceSequence":                           DCMSegmentSurfaceSourceInstanceSequence,
+	"AlgorithmFamilyCodeSequence":                                    DCMAlgorithmFamilyCodeSequence,
+	"AlgorithmNameCodeSequence":                                      DCMAlgorithmNameCodeSequence,
+	"AlgorithmVersion":                                               DCMAlgorithmVersion,
+	"AlgorithmParameters":                                            DCMAlgorithmParameters,
+	"FacetSequence":                                                  DCMFacetSequence,
+	"SurfaceProcessingAlgorithmIdentificationSequence":               DCMSurfaceProcessingAlgorithmIdentificationSequence,
+	"AlgorithmName":                                                  DCMAlgorithmName,
+	"ImplantSize":                                                    DCMImplantSize,
+	"ImplantTemplateVersion":                                         DCMImplantTemplateVersion,
+	"ReplacedImplantTemplateSequence":                                DCMReplacedImplantTemplateSequence,
+	"ImplantType":                                                    DCMImplantType,
+	"DerivationImplantTemplateSequence":                              DCMDerivationImplantTemplateSequence,
+	"OriginalImplantTemplateSequence":                                DCMOriginalImplantTemplateSequence,
+	"EffectiveDateTime":                                              DCMEffectiveDateTime,
+	"ImplantTargetAnatomySequence":                                   DCMImplantTargetAnatomySequence,
+	"InformationFromManufacturerSequence":                            DCMInformationFromManufacturerSequence,
+	"NotificationFromManufacturerSequence":                           DCMNotificationFromManufacturerSequence,
+	"InformationIssueDateTime":                                       DCMInformationIssueDateTime,
+	"InformationSummary":                                             DCMInformationSummary,
+	"ImplantRegulatoryDisapprovalCodeSequence":                       DCMImplantRegulatoryDisapprovalCodeSequence,
+	"OverallTemplateSpatialTolerance":                                DCMOverallTemplateSpatialTolerance,
+	"HPGLDocumentSequence":                                           DCMHPGLDocumentSequence,
+	"HPGLDocumentID":                                                 DCMHPGLDocumentID,
+	"HPGLDocumentLabel":                                              DCMHPGLDocumentLabel,
+	"ViewOrientationCodeSequence":                                    DCMViewOrientationCodeSequence,
+	"ViewOrientationModifier":                                        DCMViewOrientationModifier,
+	"HPGLDocumentScaling":                                            DCMHPGLDocumentScaling,
+	"HPGLDocument":                                                   DCMHPGLDocument,
+	"HPGLContourPenNumber":                                           DCMHPGLContourPenNumber,
+	"HPGLPenSequence":                                                DCMHPGLPenSequence,
+	"HPGLPenNumber":                                                  DCMHPGLPenNumber,
+	"HPGLPenLabel":                                                   DCMHPGLPenLabel,
+	"HPGLPenDescription":                                             DCMHPGLPenDescription,
+	"RecommendedRotationPoint":                                       DCMRecommendedRotationPoint,
+	"BoundingRectangle":                                              DCMBoundingRectangle,
+	"ImplantTemplate3DModelSurfaceNumber":                            DCMImplantTemplate3DModelSurfaceNumber,
+	"SurfaceModelDescriptionSequence":                                DCMSurfaceModelDescriptionSequence,
+	"SurfaceModelLabel":                                              DCMSurfaceModelLabel,
+	"SurfaceModelScalingFactor":                                      DCMSurfaceModelScalingFactor,
+	"MaterialsCodeSequence":                                          DCMMaterialsCodeSequence,
+	"CoatingMaterialsCodeSequence":                                   DCMCoatingMaterialsCodeSequence,
+	"ImplantTypeCodeSequence":                                        DCMImplantTypeCodeSequence,
+	"FixationMethodCodeSequence":                                     DCMFixationMethodCodeSequence,
+	"MatingFeatureSetsSequence":                                      DCMMatingFeatureSetsSequence,
+	"MatingFeatureSetID":                                             DCMMatingFeatureSetID,
+	"MatingFeatureSetLabel":                                          DCMMatingFeatureSetLabel,
+	"MatingFeatureSequence":                                          DCMMatingFeatureSequence,
+	"MatingFeatureID":                                                DCMMatingFeatureID,
+	"MatingFeatureDegreeOfFreedomSequence":                           DCMMatingFeatureDegreeOfFreedomSequence,
+	"DegreeOfFreedomID":                                              DCMDegreeOfFreedomID,
+	"DegreeOfFreedomType":                                            DCMDegreeOfFreedomType,
+	"TwoDMatingFeatureCoordinatesSequence":                           DCMTwoDMatingFeatureCoordinatesSequence,
+	"ReferencedHPGLDocumentID":                                       DCMReferencedHPGLDocumentID,
+	"TwoDMatingPoint":                                                DCMTwoDMatingPoint,
+	"TwoDMatingAxes":                                                 DCMTwoDMatingAxes,
+	"TwoDDegreeOfFreedomSequence":                                    DCMTwoDDegreeOfFreedomSequence,
+	"ThreeDDegreeOfFreedomAxis":                                      DCMThreeDDegreeOfFreedomAxis,
+	"RangeOfFreedom":                                                 DCMRangeOfFreedom,
+	"ThreeDMatingPoint":                                              DCMThreeDMatingPoint,
+	"ThreeDMatingAxes":                                               DCMThreeDMatingAxes,
+	"TwoDDegreeOfFreedomAxis":                                        DCMTwoDDegreeOfFreedomAxis,
+	"PlanningLandmarkPointSequence":                                  DCMPlanningLandmarkPointSequence,
+	"PlanningLandmarkLineSequence":                                   DCMPlanningLandmarkLineSequence,
+	"PlanningLandmarkPlaneSequence":                                  DCMPlanningLandmarkPlaneSequence,
+	"PlanningLandmarkID":                                             DCMPlanningLandmarkID,
+	"PlanningLandmarkDescription":                                    DCMPlanningLandmarkDescription,
+	"PlanningLandmarkIdentificationCodeSequence":                     DCMPlanningLandmarkIdentificationCodeSequence,
+	"TwoDPointCoordinatesSequence":                                   DCMTwoDPointCoordinatesSequence,
+	"TwoDPointCoordinates":                                           DCMTwoDPointCoordinates,
+	"ThreeDPointCoordinates":                                         DCMThreeDPointCoordinates,
+	"TwoDLineCoordinatesSequence":                                    DCMTwoDLineCoordinatesSequence,
+	"TwoDLineCoordinates":                                            DCMTwoDLineCoordinates,
+	"ThreeDLineCoordinates":                                          DCMThreeDLineCoordinates,
+	"TwoDPlaneCoordinatesSequence":                                   DCMTwoDPlaneCoordinatesSequence,
+	"TwoDPlaneIntersection":                                          DCMTwoDPlaneIntersection,
+	"ThreeDPlaneOrigin":                                              DCMThreeDPlaneOrigin,
+	"ThreeDPlaneNormal":                                              DCMThreeDPlaneNormal,
+	"GraphicAnnotationSequence":                                      DCMGraphicAnnotationSequence,
+	"GraphicLayer":                                                   DCMGraphicLayer,
+	"BoundingBoxAnnotationUnits":                                     DCMBoundingBoxAnnotationUnits,
+	"AnchorPointAnnotationUnits":                                     DCMAnchorPointAnnotationUnits,
+	"GraphicAnnotationUnits":                                         DCMGraphicAnnotationUnits,
+	"UnformattedTextValue":                                           DCMUnformattedTextValue,
+	"TextObjectSequence":                                             DCMTextObjectSequence,
+	"GraphicObjectSequence":                                          DCMGraphicObjectSequence,
+	"BoundingBoxTopLeftHandCorner":                                   DCMBoundingBoxTopLeftHandCorner,
+	"BoundingBoxBottomRightHandCorner":                               DCMBoundingBoxBottomRightHandCorner,
+	"BoundingBoxTextHorizontalJustification":                         DCMBoundingBoxTextHorizontalJustification,
+	"AnchorPoint":                                                    DCMAnchorPoint,
+	"AnchorPointVisibility":                                          DCMAnchorPointVisibility,
+	"GraphicDimensions":                                              DCMGraphicDimensions,
+	"NumberOfGraphicPoints":                                          DCMNumberOfGraphicPoints,
+	"GraphicData":                                                    DCMGraphicData,
+	"GraphicType":                                                    DCMGraphicType,
+	"GraphicFilled":                                                  DCMGraphicFilled,
+	"RETIREDImageRotationRetired":                                    DCMRETIREDImageRotationRetired,
+	"ImageHorizontalFlip":                                            DCMImageHorizontalFlip,
+	"ImageRotation":                                                  DCMImageRotation,
+	"RETIREDDisplayedAreaTopLeftHandCornerTrial":                     DCMRETIREDDisplayedAreaTopLeftHandCornerTrial,
+	"RETIREDDisplayedAreaBottomRightHandCornerTrial":                 DCMRETIREDDisplayedAreaBottomRightHandCornerTrial,
+	"DisplayedAreaTopLeftHandCorner":                                 DCMDisplayedAreaTopLeftHandCorner,
+	"DisplayedAreaBottomRightHandCorner":                             DCMDisplayedAreaBottomRightHandCorner,
+	"DisplayedAreaSelectionSequence":                                 DCMDisplayedAreaSelectionSequence,
+	"GraphicLayerSequence":                                           DCMGraphicLayerSequence,
+	"GraphicLayerOrder":                                              DCMGraphicLayerOrder,
+	"GraphicLayerRecommendedDisplayGrayscaleValue":                   DCMGraphicLayerRecommendedDisplayGrayscaleValue,
+	"RETIREDGraphicLayerRecommendedDisplayRGBValue":                  DCMRETIREDGraphicLayerRecommendedDisplayRGBValue,
+	"GraphicLayerDescription":                                        DCMGraphicLayerDescription,
+	"ContentLabel":                                                   DCMContentLabel,
+	"ContentDescription":                                             DCMContentDescription,
+	"PresentationCreationDate":                                       DCMPresentationCreationDate,
+	"PresentationCreationTime":                                       DCMPresentationCreationTime,
+	"ContentCreatorName":                                             DCMContentCreatorName,
+	"ContentCreatorIdentificationCodeSequence":                       DCMContentCreatorIdentificationCodeSequence,
+	"AlternateContentDescriptionSequence":                            DCMAlternateContentDescriptionSequence,
+	"PresentationSizeMode":                                           DCMPresentationSizeMode,
+	"PresentationPixelSpacing":                                       DCMPresentationPixelSpacing,
+	"PresentationPixelAspectRatio":                                   DCMPresentationPixelAspectRatio,
+	"PresentationPixelMagnificationRatio":                            DCMPresentationPixelMagnificationRatio,
+	"GraphicGroupLabel":                                              DCMGraphicGroupLabel,
+	"GraphicGroupDescription":                                        DCMGraphicGroupDescription,
+	"CompoundGraphicSequence":                                        DCMCompoundGraphicSequence,
+	"CompoundGraphicInstanceID":                                      DCMCompoundGraphicInstanceID,
+	"FontName":                                                       DCMFontName,
+	"FontNameType":                                                   DCMFontNameType,
+	"CSSFontName":                                                    DCMCSSFontName,
+	"RotationAngle":                                                  DCMRotationAngle,
+	"TextStyleSequence":                                              DCMTextStyleSequence,
+	"LineStyleSequence":                                              DCMLineStyleSequence,
+	"FillStyleSequence":                                              DCMFillStyleSequence,
+	"GraphicGroupSequence":                                           DCMGraphicGroupSequence,
+	"TextColorCIELabValue":                                           DCMTextColorCIELabValue,
+	"HorizontalAlignment":                                            DCMHorizontalAlignment,
+	"VerticalAlignment":                                              DCMVerticalAlignment,
+	"ShadowStyle":                                                    DCMShadowStyle,
+	"ShadowOffsetX":                                                  DCMShadowOffsetX,
+	"ShadowOffsetY":                                                  DCMShadowOffsetY,
+	"ShadowColorCIELabValue":                                         DCMShadowColorCIELabValue,
+	"Underlined":                                                     DCMUnderlined,
+	"Bold":                                                           DCMBold,
+	"Italic":                                                         DCMItalic,
+	"PatternOnColorCIELabValue":                                      DCMPatternOnColorCIELabValue,
+	"PatternOffColorCIELabValue":                                     DCMPatternOffColorCIELabValue,
+	"LineThickness":                                                  DCMLineThickness,
+	"LineDashingStyle":                                               DCMLineDashingStyle,
+	"LinePattern":                                                    DCMLinePattern,
+	"FillPattern":                                                    DCMFillPattern,
+	"FillMode":                                                       DCMFillMode,
+	"ShadowOpacity":                                                  DCMShadowOpacity,
+	"GapLength":                                                      DCMGapLength,
+	"DiameterOfVisibility":                                           DCMDiameterOfVisibility,
+	"RotationPoint":                                                  DCMRotationPoint,
+	"TickAlignment":                                                  DCMTickAlignment,
+	"ShowTickLabel":                                                  DCMShowTickLabel,
+	"TickLabelAlignment":                                             DCMTickLabelAlignment,
+	"CompoundGraphicUnits":                                           DCMCompoundGraphicUnits,
+	"PatternOnOpacity":                                               DCMPatternOnOpacity,
+	"PatternOffOpacity":                                              DCMPatternOffOpacity,
+	"MajorTicksSequence":                                             DCMMajorTicksSequence,
+	"TickPosition":                                                   DCMTickPosition,
+	"TickLabel":                                                      DCMTickLabel,
+	"CompoundGraphicType":                                            DCMCompoundGraphicType,
+	"GraphicGroupID":                                                 DCMGraphicGroupID,
+	"ShapeType":                                                      DCMShapeType,
+	"RegistrationSequence":                                           DCMRegistrationSequence,
+	"MatrixRegistrationSequence":                                     DCMMatrixRegistrationSequence,
+	"MatrixSequence":                                                 DCMMatrixSequence,
+	"FrameOfReferenceTransformationMatrixType":                       DCMFrameOfReferenceTransformationMatrixType,
+	"RegistrationTypeCodeSequence":                                   DCMRegistrationTypeCodeSequence,
+	"FiducialDescription":                                            DCMFiducialDescription,
+	"FiducialIdentifier":                                             DCMFiducialIdentifier,
+	"FiducialIdentifierCodeSequence":                                 DCMFiducialIdentifierCodeSequence,
+	"ContourUncertaintyRadius":                                       DCMContourUncertaintyRadius,
+	"UsedFiducialsSequence":                                          DCMUsedFiducialsSequence,
+	"GraphicCoordinatesDataSequence":                                 DCMGraphicCoordinatesDataSequence,
+	"FiducialUID":                                                    DCMFiducialUID,
+	"FiducialSetSequence":                                            DCMFiducialSetSequence,
+	"FiducialSequence":                                               DCMFiducialSequence,
+	"GraphicLayerRecommendedDisplayCIELabValue":                      DCMGraphicLayerRecommendedDisplayCIELabValue,
+	"BlendingSequence":                                               DCMBlendingSequence,
+	"RelativeOpacity":                                                DCMRelativeOpacity,
+	"ReferencedSpatialRegistrationSequence":                          DCMReferencedSpatialRegistrationSequence,
+	"BlendingPosition":                                               DCMBlendingPosition,
+	"HangingProtocolName":                                            DCMHangingProtocolName,
+	"HangingProtocolDescription":                                     DCMHangingProtocolDescription,
+	"HangingProtocolLevel":                                           DCMHangingProtocolLevel,
+	"HangingProtocolCreator":                                         DCMHangingProtocolCreator,
+	"HangingProtocolCreationDateTime":                                DCMHangingProtocolCreationDateTime,
+	"HangingProtocolDefinitionSequence":                              DCMHangingProtocolDefinitionSequence,
+	"HangingProtocolUserIdentificationCodeSequence":                  DCMHangingProtocolUserIdentificationCodeSequence,
+	"HangingProtocolUserGroupName":                                   DCMHangingProtocolUserGroupName,
+	"SourceHangingProtocolSequence":                                  DCMSourceHangingProtocolSequence,
+	"NumberOfPriorsReferenced":                                       DCMNumberOfPriorsReferenced,
+	"ImageSetsSequence":                                              DCMImageSetsSequence,
+	"ImageSetSelectorSequence":                                       DCMImageSetSelectorSequence,
+	"ImageSetSelectorUsageFlag":                                      DCMImageSetSelectorUsageFlag,
+	"SelectorAttribute":                                              DCMSelectorAttribute,
+	"SelectorValueNumber":                                            DCMSelectorValueNumber,
+	"TimeBasedImageSetsSequence":                                     DCMTimeBasedImageSetsSequence,
+	"ImageSetNumber":                                                 DCMImageSetNumber,
+	"ImageSetSelectorCategory":                                       DCMImageSetSelectorCategory,
+	"RelativeTime":                                                   DCMRelativeTime,
+	"RelativeTimeUnits":                                              DCMRelativeTimeUnits,
+	"AbstractPriorValue":                                             DCMAbstractPriorValue,
+	"AbstractPriorCodeSequence":                                      DCMAbstractPriorCodeSequence,
+	"ImageSetLabel":                                                  DCMImageSetLabel,
+	"SelectorAttributeVR":                                            DCMSelectorAttributeVR,
+	"SelectorSequencePointer":                                        DCMSelectorSequencePointer,
+	"SelectorSequencePointerPrivateCreator":                          DCMSelectorSequencePointerPrivateCreator,
+	"SelectorAttributePrivateCreator":                                DCMSelectorAttributePrivateCreator,
+	"SelectorATValue":                                                DCMSelectorATValue,
+	"SelectorCSValue":                                                DCMSelectorCSValue,
+	"SelectorISValue":                                                DCMSelectorISValue,
+	"SelectorLOValue":                                                DCMSelectorLOValue,
+	"SelectorLTValue":                                                DCMSelectorLTValue,
+	"SelectorPNValue":                                                DCMSelectorPNValue,
+	"SelectorSHValue":                                                DCMSelectorSHValue,
+	"SelectorSTValue":                                                DCMSelectorSTValue,
+	"SelectorUTValue":                                                DCMSelectorUTValue,
+	"SelectorDSValue":                                                DCMSelectorDSValue,
+	"SelectorFDValue":                                                DCMSelectorFDValue,
+	"SelectorFLValue":                                                DCMSelectorFLValue,
+	"SelectorULValue":                                                DCMSelectorULValue,
+	"SelectorUSValue":                                                DCMSelectorUSValue,
+	"SelectorSLValue":                                                DCMSelectorSLValue,
+	"SelectorSSValue":                                                DCMSelectorSSValue,
+	"SelectorCodeSequenceValue":                                      DCMSelectorCodeSequenceValue,
+	"NumberOfScreens":                                                DCMNumberOfScreens,
+	"NominalScreenDefinitionSequence":                                DCMNominalScreenDefinitionSequence,
+	"NumberOfVerticalPixels":                                         DCMNumberOfVerticalPixels,
+	"NumberOfHorizontalPixels":                                       DCMNumberOfHorizontalPixels,
+	"DisplayEnvironmentSpatialPosition":                              DCMDisplayEnvironmentSpatialPosition,
+	"ScreenMinimumGrayscaleBitDepth":                                 DCMScreenMinimumGrayscaleBitDepth,
+	"ScreenMinimumColorBitDepth":                                     DCMScreenMinimumColorBitDepth,
+	"ApplicationMaximumRepaintTime":                                  DCMApplicationMaximumRepaintTime,
+	"DisplaySetsSequence":                                            DCMDisplaySetsSequence,
+	"DisplaySetNumber":                                               DCMDisplaySetNumber,
+	"DisplaySetLabel":                                                DCMDisplaySetLabel,
+	"DisplaySetPresentationGroup":                                    DCMDisplaySetPresentationGroup,
+	"DisplaySetPresentationGroupDescription":                         DCMDisplaySetPresentationGroupDescription,
+	"PartialDataDisplayHandling":                                     DCMPartialDataDisplayHandling,
+	"SynchronizedScrollingSequence":                                  DCMSynchronizedScrollingSequence,
+	"DisplaySetScrollingGroup":                                       DCMDisplaySetScrollingGroup,
+	"NavigationIndicatorSequence":                                    DCMNavigationIndicatorSequence,
+	"NavigationDisplaySet":                                           DCMNavigationDisplaySet,
+	"ReferenceDisplaySets":                                           DCMReferenceDisplaySets,
+	"ImageBoxesSequence":                                             DCMImageBoxesSequence,
+	"ImageBoxNumber":                                                 DCMImageBoxNumber,
+	"ImageBoxLayoutType":                                             DCMImageBoxLayoutType,
+	"ImageBoxTileHorizontalDimension":                                DCMImageBoxTileHorizontalDimension,
+	"ImageBoxTileVerticalDimension":                                  DCMImageBoxTileVerticalDimension,
+	"ImageBoxScrollDirection":                                        DCMImageBoxScrollDirection,
+	"ImageBoxSmallScrollType":                                        DCMImageBoxSmallScrollType,
+	"ImageBoxSmallScrollAmount":                                      DCMImageBoxSmallScrollAmount,
+	"ImageBoxLargeScrollType":                                        DCMImageBoxLargeScrollType,
+	"ImageBoxLargeScrollAmount":                                      DCMImageBoxLargeScrollAmount,
+	"ImageBoxOverlapPriority":                                        DCMImageBoxOverlapPriority,
+	"CineRelativeToRealTime":                                         DCMCineRelativeToRealTime,
+	"FilterOperationsSequence":                                       DCMFilterOperationsSequence,
+	"FilterByCategory":                                               DCMFilterByCategory,
+	"FilterByAttributePresence":                                      DCMFilterByAttributePresence,
+	"FilterByOperator":                                               DCMFilterByOperator,
+	"StructuredDisplayBackgroundCIELabValue":                         DCMStructuredDisplayBackgroundCIELabValue,
+	"EmptyImageBoxCIELabValue":                                       DCMEmptyImageBoxCIELabValue,
+	"StructuredDisplayImageBoxSequence":                              DCMStructuredDisplayImageBoxSequence,
+	"StructuredDisplayTextBoxSequence":                               DCMStructuredDisplayTextBoxSequence,
+	"ReferencedFirstFrameSequence":                                   DCMReferencedFirstFrameSequence,
+	"ImageBoxSynchronizationSequence":                                DCMImageBoxSynchronizationSequence,
+	"SynchronizedImageBoxList":                                       DCMSynchronizedImageBoxList,
+	"TypeOfSynchronization":                                          DCMTypeOfSynchronization,
+	"BlendingOperationType":                                          DCMBlendingOperationType,
+	"ReformattingOperationType":                                      DCMReformattingOperationType,
+	"ReformattingThickness":                                          DCMReformattingThickness,
+	"ReformattingInterval":                                           DCMReformattingInterval,
+	"ReformattingOperationInitialViewDirection":                      DCMReformattingOperationInitialViewDirection,
+	"ThreeDRenderingType":                                            DCMThreeDRenderingType,
+	"SortingOperationsSequence":                                      DCMSortingOperationsSequence,
+	"SortByCategory":                                                 DCMSortByCategory,
+	"SortingDirection":                                               DCMSortingDirection,
+	"DisplaySetPatientOrientation":                                   DCMDisplaySetPatientOrientation,
+	"VOIType":                                                        DCMVOIType,
+	"PseudoColorType":                                                DCMPseudoColorType,
+	"ShowGrayscaleInverted":                                          DCMShowGrayscaleInverted,
+	"ShowImageTrueSizeFlag":                                          DCMShowImageTrueSizeFlag,
+	"ShowGraphicAnnotationFlag":                                      DCMShowGraphicAnnotationFlag,
+	"ShowPatientDemographicsFlag":                                    DCMShowPatientDemographicsFlag,
+	"ShowAcquisitionTechniquesFlag":                                  DCMShowAcquisitionTechniquesFlag,
+	"DisplaySetHorizontalJustification":                              DCMDisplaySetHorizontalJustification,
+	"DisplaySetVerticalJustification":                                DCMDisplaySetVerticalJustification,
+	"UnifiedProcedureStepState":                                      DCMUnifiedProcedureStepState,
+	"UnifiedProcedureStepProgressInformationSequence":                DCMUnifiedProcedureStepProgressInformationSequence,
+	"UnifiedProcedureStepProgress":                                   DCMUnifiedProcedureStepProgress,
+	"UnifiedProcedureStepProgressDescription":                        DCMUnifiedProcedureStepProgressDescription,
+	"UnifiedProcedureStepCommunicationsURISequence":                  DCMUnifiedProcedureStepCommunicationsURISequence,
+	"ContactURI":                                                     DCMContactURI,
+	"ContactDisplayName":                                             DCMContactDisplayName,
+	"UnifiedProcedureStepDiscontinuationReasonCodeSequence":          DCMUnifiedProcedureStepDiscontinuationReasonCodeSequence,
+	"BeamTaskSequence":                                               DCMBeamTaskSequence,
+	"BeamTaskType":                                                   DCMBeamTaskType,
+	"BeamOrderIndex":                                                 DCMBeamOrderIndex,
+	"DeliveryVerificationImageSequence":                              DCMDeliveryVerificationImageSequence,
+	"VerificationImageTiming":                                        DCMVerificationImageTiming,
+	"DoubleExposureFlag":                                             DCMDoubleExposureFlag,
+	"DoubleExposureOrdering":                                         DCMDoubleExposureOrdering,
+	"DoubleExposureMeterset":                                         DCMDoubleExposureMeterset,
+	"DoubleExposureFieldDelta":                                       DCMDoubleExposureFieldDelta,
+	"RelatedReferenceRTImageSequence":                                DCMRelatedReferenceRTImageSequence,
+	"GeneralMachineVerificationSequence":                             DCMGeneralMachineVerificationSequence,
+	"ConventionalMachineVerificationSequence":                        DCMConventionalMachineVerificationSequence,
+	"IonMachineVerificationSequence":                                 DCMIonMachineVerificationSequence,
+	"FailedAttributesSequence":                                       DCMFailedAttributesSequence,
+	"OverriddenAttributesSequence":                                   DCMOverriddenAttributesSequence,
+	"ConventionalControlPointVerificationSequence":                   DCMConventionalControlPointVerificationSequence,
+	"IonControlPointVerificationSequence":                            DCMIonControlPointVerificationSequence,
+	"AttributeOccurrenceSequence":                                    DCMAttributeOccurrenceSequence,
+	"AttributeOccurrencePointer":                                     DCMAttributeOccurrencePointer,
+	"AttributeItemSelector":                                          DCMAttributeItemSelector,
+	"AttributeOccurrencePrivateCreator":                              DCMAttributeOccurrencePrivateCreator,
+	"ScheduledProcedureStepPriority":                                 DCMScheduledProcedureStepPriority,
+	"WorklistLabel":                                                  DCMWorklistLabel,
+	"ProcedureStepLabel":                                             DCMProcedureStepLabel,
+	"ScheduledProcessingParametersSequence":                          DCMScheduledProcessingParametersSequence,
+	"PerformedProcessingParametersSequence":                          DCMPerformedProcessingParametersSequence,
+	"UnifiedProcedureStepPerformedProcedureSequence":                 DCMUnifiedProcedureStepPerformedProcedureSequence,
+	"RelatedProcedureStepSequence":                                   DCMRelatedProcedureStepSequence,
+	"ProcedureStepRelationshipType":                                  DCMProcedureStepRelationshipType,
+	"DeletionLock":                                                   DCMDeletionLock,
+	"ReceivingAE":                                                    DCMReceivingAE,
+	"RequestingAE":                                                   DCMRequestingAE,
+	"ReasonForCancellation":                                          DCMReasonForCancellation,
+	"SCPStatus":                                                      DCMSCPStatus,
+	"SubscriptionListStatus":                                         DCMSubscriptionListStatus,
+	"UnifiedProcedureStepListStatus":                                 DCMUnifiedProcedureStepListStatus,
+	"ImplantAssemblyTemplateName":                                    DCMImplantAssemblyTemplateName,
+	"ImplantAssemblyTemplateIssuer":                                  DCMImplantAssemblyTemplateIssuer,
+	"ImplantAssemblyTemplateVersion":                                 DCMImplantAssemblyTemplateVersion,
+	"ReplacedImplantAssemblyTemplateSequence":                        DCMReplacedImplantAssemblyTemplateSequence,
+	"ImplantAssemblyTemplateType":                                    DCMImplantAssemblyTemplateType,
+	"OriginalImplantAssemblyTemplateSequence":                        DCMOriginalImplantAssemblyTemplateSequence,
+	"DerivationImplantAssemblyTemplateSequence":                      DCMDerivationImplantAssemblyTemplateSequence,
+	"ImplantAssemblyTemplateTargetAnatomySequence":                   DCMImplantAssemblyTemplateTargetAnatomySequence,
+	"ProcedureTypeCodeSequence":                                      DCMProcedureTypeCodeSequence,
+	"SurgicalTechnique":                                              DCMSurgicalTechnique,
+	"ComponentTypesSequence":                                         DCMComponentTypesSequence,
+	"ComponentTypeCodeSequence":                                      DCMComponentTypeCodeSequence,
+	"ExclusiveComponentType":                                         DCMExclusiveComponentType,
+	"MandatoryComponentType":                                         DCMMandatoryComponentType,
+	"ComponentSequence":                                              DCMComponentSequence,
+	"ComponentID":                                                    DCMComponentID,
+	"ComponentAssemblySequence":                                      DCMComponentAssemblySequence,
+	"Component1ReferencedID":                                         DCMComponent1ReferencedID,
+	"Component1ReferencedMatingFeatureSetID":                         DCMComponent1ReferencedMatingFeatureSetID,
+	"Component1ReferencedMatingFeatureID":                            DCMComponent1ReferencedMatingFeatureID,
+	"Component2ReferencedID":                                         DCMComponent2ReferencedID,
+	"Component2ReferencedMatingFeatureSetID":                         DCMComponent2ReferencedMatingFeatureSetID,
+	"Component2ReferencedMatingFeatureID":                            DCMComponent2ReferencedMatingFeatureID,
+	"ImplantTemplateGroupName":                                       DCMImplantTemplateGroupName,
+	"ImplantTemplateGroupDescription":                                DCMImplantTemplateGroupDescription,
+	"ImplantTemplateGroupIssuer":                                     DCMImplantTemplateGroupIssuer,
+	"ImplantTemplateGroupVersion":                                    DCMImplantTemplateGroupVersion,
+	"ReplacedImplantTemplateGroupSequence":                           DCMReplacedImplantTemplateGroupSequence,
+	"ImplantTemplateGroupTargetAnatomySequence":                      DCMImplantTemplateGroupTargetAnatomySequence,
+	"ImplantTemplateGroupMembersSequence":                            DCMImplantTemplateGroupMembersSequence,
+	"ImplantTemplateGroupMemberID":                                   DCMImplantTemplateGroupMemberID,
+	"ThreeDImplantTemplateGroupMemberMatchingPoint":                  DCMThreeDImplantTemplateGroupMemberMatchingPoint,
+	"ThreeDImplantTemplateGroupMemberMatchingAxes":                   DCMThreeDImplantTemplateGroupMemberMatchingAxes,
+	"ImplantTemplateGroupMemberMatching2DCoordinatesSequence":        DCMImplantTemplateGroupMemberMatching2DCoordinatesSequence,
+	"TwoDImplantTemplateGroupMemberMatchingPoint":                    DCMTwoDImplantTemplateGroupMemberMatchingPoint,
+	"TwoDImplantTemplateGroupMemberMatchingAxes":                     DCMTwoDImplantTemplateGroupMemberMatchingAxes,
+	"ImplantTemplateGroupVariationDimensionSequence":                 DCMImplantTemplateGroupVariationDimensionSequence,
+	"ImplantTemplateGroupVariationDimensionName":                     DCMImplantTemplateGroupVariationDimensionName,
+	"ImplantTemplateGroupVariationDimensionRankSequence":             DCMImplantTemplateGroupVariationDimensionRankSequence,
+	"ReferencedImplantTemplateGroupMemberID":                         DCMReferencedImplantTemplateGroupMemberID,
+	"ImplantTemplateGroupVariationDimensionRank":                     DCMImplantTemplateGroupVariationDimensionRank,
+	"StorageMediaFileSetID":                                          DCMStorageMediaFileSetID,
+	"StorageMediaFileSetUID":                                         DCMStorageMediaFileSetUID,
+	"IconImageSequence":                                              DCMIconImageSequence,
+	"RETIREDTopicTitle":                                              DCMRETIREDTopicTitle,
+	"RETIREDTopicSubject":                                            DCMRETIREDTopicSubject,
+	"RETIREDTopicAuthor":                                             DCMRETIREDTopicAuthor,
+	"RETIREDTopicKeywords":                                           DCMRETIREDTopicKeywords,
+	"SOPInstanceStatus":                                              DCMSOPInstanceStatus,
+	"SOPAuthorizationDateTime":                                       DCMSOPAuthorizationDateTime,
+	"SOPAuthorizationComment":                                        DCMSOPAuthorizationComment,
+	"AuthorizationEquipmentCertificationNumber":                      DCMAuthorizationEquipmentCertificationNumber,
+	"MACIDNumber":                                                    DCMMACIDNumber,
+	"MACCalculationTransferSyntaxUID":                                DCMMACCalculationTransferSyntaxUID,
+	"MACAlgorithm":                                                   DCMMACAlgorithm,
+	"DataElementsSigned":                                             DCMDataElementsSigned,
+	"DigitalSignatureUID":                                            DCMDigitalSignatureUID,
+	"DigitalSignatureDateTime":                                       DCMDigitalSignatureDateTime,
+	"CertificateType":                                                DCMCertificateType,
+	"CertificateOfSigner":                                            DCMCertificateOfSigner,
+	"Signature":                                                      DCMSignature,
+	"CertifiedTimestampType":                                         DCMCertifiedTimestampType,
+	"CertifiedTimestamp":                                             DCMCertifiedTimestamp,
+	"DigitalSignaturePurposeCodeSequence":                            DCMDigitalSignaturePurposeCodeSequence,
+	"ReferencedDigitalSignatureSequence":                             DCMReferencedDigitalSignatureSequence,
+	"ReferencedSOPInstanceMACSequence":                               DCMReferencedSOPInstanceMACSequence,
+	"MAC":                                                            DCMMAC,
+	"EncryptedAttributesSequence":                                    DCMEncryptedAttributesSequence,
+	"EncryptedContentTransferSyntaxUID":                              DCMEncryptedContentTransferSyntaxUID,
+	"EncryptedContent":                                               DCMEncryptedContent,
+	"ModifiedAttributesSequence":                                     DCMModifiedAttributesSequence,
+	"OriginalAttributesSequence":                                     DCMOriginalAttributesSequence,
+	"AttributeModificationDateTime":                                  DCMAttributeModificationDateTime,
+	"ModifyingSystem":                                                DCMModifyingSystem,
+	"SourceOfPreviousValues":                                         DCMSourceOfPreviousValues,
+	"ReasonForTheAttributeModification":                              DCMReasonForTheAttributeModification,
+	"ACRNEMA2CCodeTableGroupLength":                                  DCMACRNEMA2CCodeTableGroupLength,
+	"ACRNEMA2CEscapeTriplet":                                         DCMACRNEMA2CEscapeTriplet,
+	"ACRNEMA2CRunLengthTriplet":                                      DCMACRNEMA2CRunLengthTriplet,
+	"ACRNEMA2CHuffmanTableSize":                                      DCMACRNEMA2CHuffmanTableSize,
+	"ACRNEMA2CHuffmanTableTriplet":                                   DCMACRNEMA2CHuffmanTableTriplet,
+	"ACRNEMA2CShiftTableSize":                                        DCMACRNEMA2CShiftTableSize,
+	"ACRNEMA2CShiftTableTriplet":                                     DCMACRNEMA2CShiftTableTriplet,
+	"ACRNEMA2CZonalMapGroupLength":                                   DCMACRNEMA2CZonalMapGroupLength,
+	"ACRNEMA2CZonalMap":                                              DCMACRNEMA2CZonalMap,
+	"NumberOfCopies":                                                 DCMNumberOfCopies,
+	"PrinterConfigurationSequence":                                   DCMPrinterConfigurationSequence,
+	"PrintPriority":                                                  DCMPrintPriority,
+	"MediumType":                                                     DCMMediumType,
+	"FilmDestination":                                                DCMFilmDestination,
+	"FilmSessionLabel":                                               DCMFilmSessionLabel,
+	"MemoryAllocation":                                               DCMMemoryAllocation,
+	"MaximumMemoryAllocation":                                        DCMMaximumMemoryAllocation,
+	"RETIREDColorImagePrintingFlag":                                  DCMRETIREDColorImagePrintingFlag,
+	"RETIREDCollationFlag":                                           DCMRETIREDCollationFlag,
+	"RETIREDAnnotationFlag":                                          DCMRETIREDAnnotationFlag,
+	"RETIREDImageOverlayFlag":                                        DCMRETIREDImageOverlayFlag,
+	"RETIREDPresentationLUTFlag":                                     DCMRETIREDPresentationLUTFlag,
+	"RETIREDImageBoxPresentationLUTFlag":                             DCMRETIREDImageBoxPresentationLUTFlag,
+	"MemoryBitDepth":                                                 DCMMemoryBitDepth,
+	"PrintingBitDepth":                                               DCMPrintingBitDepth,
+	"MediaInstalledSequence":                                         DCMMediaInstalledSequence,
+	"OtherMediaAvailableSequence":                                    DCMOtherMediaAvailableSequence,
+	"SupportedImageDisplayFormatsSequence":                           DCMSupportedImageDisplayFormatsSequence,
+	"ReferencedFilmBoxSequence":                                      DCMReferencedFilmBoxSequence,
+	"RETIREDReferencedStoredPrintSequence":                           DCMRETIREDReferencedStoredPrintSequence,
+	"ImageDisplayFormat":                                             DCMImageDisplayFormat,
+	"AnnotationDisplayFormatID":                                      DCMAnnotationDisplayFormatID,
+	"FilmOrientation":                                                DCMFilmOrientation,
+	"FilmSizeID":                                                     DCMFilmSizeID,
+	"PrinterResolutionID":                                            DCMPrinterResolutionID,
+	"DefaultPrinterResolutionID":                                     DCMDefaultPrinterResolutionID,
+	"MagnificationType":                                              DCMMagnificationType,
+	"SmoothingType":                                                  DCMSmoothingType,
+	"DefaultMagnificationType":                                       DCMDefaultMagnificationType,
+	"OtherMagnificationTypesAvailable":                               DCMOtherMagnificationTypesAvailable,
+	"DefaultSmoothingType":                                           DCMDefaultSmoothingType,
+	"OtherSmoothingTypesAvailable":                                   DCMOtherSmoothingTypesAvailable,
+	"BorderDensity":                                                  DCMBorderDensity,
+	"EmptyImageDensity":                                              DCMEmptyImageDensity,
+	"MinDensity":                                                     DCMMinDensity,
+	"MaxDensity":                                                     DCMMaxDensity,
+	"Trim":                                                           DCMTrim,
+	"ConfigurationInformation":                                       DCMConfigurationInformation,
+	"ConfigurationInformationDescription":                            DCMConfigurationInformationDescription,
+	"MaximumCollatedFilms":                                           DCMMaximumCollatedFilms,
+	"Illumination":                                                   DCMIllumination,
+	"ReflectedAmbientLight":                                          DCMReflectedAmbientLight,
+	"PrinterPixelSpacing":                                            DCMPrinterPixelSpacing,
+	"ReferencedFilmSessionSequence":                                  DCMReferencedFilmSessionSequence,
+	"ReferencedImageBoxSequence":                                     DCMReferencedImageBoxSequence,
+	"ReferencedBasicAnnotationBoxSequence":                           DCMReferencedBasicAnnotationBoxSequence,
+	"ImageBoxPosition":                                               DCMImageBoxPosition,
+	"Polarity":                                                       DCMPolarity,
+	"RequestedImageSize":                                             DCMRequestedImageSize,
+	"RequestedDecimateCropBehavior":                                  DCMRequestedDecimateCropBehavior,
+	"RequestedResolutionID":                                          DCMRequestedResolutionID,
+	"RequestedImageSizeFlag":                                         DCMRequestedImageSizeFlag,
+	"DecimateCropResult":                                             DCMDecimateCropResult,
+	"BasicGrayscaleImageSequence":                                    DCMBasicGrayscaleImageSequence,
+	"BasicColorImageSequence":                                        DCMBasicColorImageSequence,
+	"RETIREDReferencedImageOverlayBoxSequence":                       DCMRETIREDReferencedImageOverlayBoxSequence,
+	"RETIREDReferencedVOILUTBoxSequence":                             DCMRETIREDReferencedVOILUTBoxSequence,
+	"AnnotationPosition":                                             DCMAnnotationPosition,
+	"TextString":                                                     DCMTextString,
+	"RETIREDReferencedOverlayPlaneSequence":                          DCMRETIREDReferencedOverlayPlaneSequence,
+	"RETIREDReferencedOverlayPlaneGroups":                            DCMRETIREDReferencedOverlayPlaneGroups,
+	"RETIREDOverlayPixelDataSequence":                                DCMRETIREDOverlayPixelDataSequence,
+	"RETIREDOverlayMagnificationType":                                DCMRETIREDOverlayMagnificationType,
+	"RETIREDOverlaySmoothingType":                                    DCMRETIREDOverlaySmoothingType,
+	"RETIREDOverlayOrImageMagnification":                             DCMRETIREDOverlayOrImageMagnification,
+	"RETIREDMagnifyToNumberOfColumns":                                DCMRETIREDMagnifyToNumberOfColumns,
+	"RETIREDOverlayForegroundDensity":                                DCMRETIREDOverlayForegroundDensity,
+	"RETIREDOverlayBackgroundDensity":                                DCMRETIREDOverlayBackgroundDensity,
+	"RETIREDOverlayMode":                                             DCMRETIREDOverlayMode,
+	"RETIREDThresholdDensity":                                        DCMRETIREDThresholdDensity,
+	"RETIREDReferencedImageBoxSequenceRetired":                       DCMRETIREDReferencedImageBoxSequenceRetired,
+	"PresentationLUTSequence":                                        DCMPresentationLUTSequence,
+	"PresentationLUTShape":                                           DCMPresentationLUTShape,
+	"ReferencedPresentationLUTSequence":                              DCMReferencedPresentationLUTSequence,
+	"RETIREDPrintJobID":                                              DCMRETIREDPrintJobID,
+	"ExecutionStatus":                                                DCMExecutionStatus,
+	"ExecutionStatusInfo":                                            DCMExecutionStatusInfo,
+	"CreationDate":                                                   DCMCreationDate,
+	"CreationTime":                                                   DCMCreationTime,
+	"Originator":                                                     DCMOriginator,
+	"RETIREDDestinationAE":                                           DCMRETIREDDestinationAE,
+	"OwnerID":                                                        DCMOwnerID,
+	"NumberOfFilms":                                                  DCMNumberOfFilms,
+	"RETIREDReferencedPrintJobSequencePullStoredPrint":               DCMRETIREDReferencedPrintJobSequencePullStoredPrint,
+	"PrinterStatus":                                                  DCMPrinterStatus,
+	"PrinterStatusInfo":                                              DCMPrinterStatusInfo,
+	"PrinterName":                                                    DCMPrinterName,
+	"RETIREDPrintQueueID":                                            DCMRETIREDPrintQueueID,
+	"RETIREDQueueStatus":                                             DCMRETIREDQueueStatus,
+	"RETIREDPrintJobDescriptionSequence":                             DCMRETIREDPrintJobDescriptionSequence,
+	"RETIREDReferencedPrintJobSequence":                              DCMRETIREDReferencedPrintJobSequence,
+	"RETIREDPrintManagementCapabilitiesSequence":                     DCMRETIREDPrintManagementCapabilitiesSequence,
+	"RETIREDPrinterCharacteristicsSequence":                          DCMRETIREDPrinterCharacteristicsSequence,
+	"RETIREDFilmBoxContentSequence":                                  DCMRETIREDFilmBoxContentSequence,
+	"RETIREDImageBoxContentSequence":                                 DCMRETIREDImageBoxContentSequence,
+	"RETIREDAnnotationContentSequence":                               DCMRETIREDAnnotationContentSequence,
+	"RETIREDImageOverlayBoxContentSequence":                          DCMRETIREDImageOverlayBoxContentSequence,
+	"RETIREDPresentationLUTContentSequence":                          DCMRETIREDPresentationLUTContentSequence,
+	"RETIREDProposedStudySequence":                                   DCMRETIREDProposedStudySequence,
+	"RETIREDOriginalImageSequence":                                   DCMRETIREDOriginalImageSequence,
+	"LabelUsingInformationExtractedFromInstances":                    DCMLabelUsingInformationExtractedFromInstances,
+	"LabelText":                                                      DCMLabelText,
+	"LabelStyleSelection":                                            DCMLabelStyleSelection,
+	"MediaDisposition":                                               DCMMediaDisposition,
+	"BarcodeValue":                                                   DCMBarcodeValue,
+	"BarcodeSymbology":                                               DCMBarcodeSymbology,
+	"AllowMediaSplitting":                                            DCMAllowMediaSplitting,
+	"IncludeNonDICOMObjects":                                         DCMIncludeNonDICOMObjects,
+	"IncludeDisplayApplication":                                      DCMIncludeDisplayApplication,
+	"PreserveCompositeInstancesAfterMediaCreation":                   DCMPreserveCompositeInstancesAfterMediaCreation,
+	"TotalNumberOfPiecesOfMediaCreated":                              DCMTotalNumberOfPiecesOfMediaCreated,
+	"RequestedMediaApplicationProfile":                               DCMRequestedMediaApplicationProfile,
+	"ReferencedStorageMediaSequence":                                 DCMReferencedStorageMediaSequence,
+	"FailureAttributes":                                              DCMFailureAttributes,
+	"AllowLossyCompression":                                          DCMAllowLossyCompression,
+	"RequestPriority":                                                DCMRequestPriority,
+	"RTImageLabel":                                                   DCMRTImageLabel,
+	"RTImageName":                                                    DCMRTImageName,
+	"RTImageDescription":                                             DCMRTImageDescription,
+	"ReportedValuesOrigin":                                           DCMReportedValuesOrigin,
+	"RTImagePlane":                                                   DCMRTImagePlane,
+	"XRayImageReceptorTranslation":                                   DCMXRayImageReceptorTranslation,
+	"XRayImageReceptorAngle":                                         DCMXRayImageReceptorAngle,
+	"RTImageOrientation":                                             DCMRTImageOrientation,
+	"ImagePlanePixelSpacing":                                         DCMImagePlanePixelSpacing,
+	"RTImagePosition":                                                DCMRTImagePosition,
+	"RadiationMachineName":                                           DCMRadiationMachineName,
+	"RadiationMachineSAD":                                            DCMRadiationMachineSAD,
+	"RadiationMachineSSD":                                            DCMRadiationMachineSSD,
+	"RTImageSID":                                                     DCMRTImageSID,
+	"SourceToReferenceObjectDistance":                                DCMSourceToReferenceObjectDistance,
+	"FractionNumber":                                                 DCMFractionNumber,
+	"ExposureSequence":                                               DCMExposureSequence,
+	"MetersetExposure":                                               DCMMetersetExposure,
+	"DiaphragmPosition":                                              DCMDiaphragmPosition,
+	"FluenceMapSequence":                                             DCMFluenceMapSequence,
+	"FluenceDataSource":                                              DCMFluenceDataSource,
+	"FluenceDataScale":                                               DCMFluenceDataScale,
+	"PrimaryFluenceModeSequence":                                     DCMPrimaryFluenceModeSequence,
+	"FluenceMode":                                                    DCMFluenceMode,
+	"FluenceModeID":                                                  DCMFluenceModeID,
+	"DVHType":                                                        DCMDVHType,
+	"DoseUnits":                                                      DCMDoseUnits,
+	"DoseType":                                                       DCMDoseType,
+	"DoseComment":                                                    DCMDoseComment,
+	"NormalizationPoint":                                             DCMNormalizationPoint,
+	"DoseSummationType":                                              DCMDoseSummationType,
+	"GridFrameOffsetVector":                                          DCMGridFrameOffsetVector,
+	"DoseGridScaling":                                                DCMDoseGridScaling,
+	"RTDoseROISequence":                                              DCMRTDoseROISequence,
+	"DoseValue":                                                      DCMDoseValue,
+	"TissueHeterogeneityCorrection":                                  DCMTissueHeterogeneityCorrection,
+	"DVHNormalizationPoint":                                          DCMDVHNormalizationPoint,
+	"DVHNormalizationDoseValue":                                      DCMDVHNormalizationDoseValue,
+	"DVHSequence":                                                    DCMDVHSequence,
+	"DVHDoseScaling":                                                 DCMDVHDoseScaling,
+	"DVHVolumeUnits":                                                 DCMDVHVolumeUnits,
+	"DVHNumberOfBins":                                                DCMDVHNumberOfBins,
+	"DVHData":                                                        DCMDVHData,
+	"DVHReferencedROISequence":                                       DCMDVHReferencedROISequence,
+	"DVHROIContributionType":                                         DCMDVHROIContributionType,
+	"DVHMinimumDose":                                                 DCMDVHMinimumDose,
+	"DVHMaximumDose":                                                 DCMDVHMaximumDose,
+	"DVHMeanDose":                                                    DCMDVHMeanDose,
+	"StructureSetLabel":                                              DCMStructureSetLabel,
+	"StructureSetName":                                               DCMStructureSetName,
+	"StructureSetDescription":                                        DCMStructureSetDescription,
+	"StructureSetDate":                                               DCMStructureSetDate,
+	"StructureSetTime":                                               DCMStructureSetTime,
+	"ReferencedFrameOfReferenceSequence":                             DCMReferencedFrameOfReferenceSequence,
+	"RTReferencedStudySequence":                                      DCMRTReferencedStudySequence,
+	"RTReferencedSeriesSequence":                                     DCMRTReferencedSeriesSequence,
+	"ContourImageSequence":                                           DCMContourImageSequence,
+	"StructureSetROISequence":                                        DCMStructureSetROISequence,
+	"ROINumber":                                                      DCMROINumber,
+	"ReferencedFrameOfReferenceUID":                                  DCMReferencedFrameOfReferenceUID,
+	"ROIName":                                                        DCMROIName,
+	"ROIDescription":                                                 DCMROIDescription,
+	"ROIDisplayColor":                                                DCMROIDisplayColor,
+	"ROIVolume":                                                      DCMROIVolume,
+	"RTRelatedROISequence":                                           DCMRTRelatedROISequence,
+	"RTROIRelationship":                                              DCMRTROIRelationship,
+	"ROIGenerationAlgorithm":                                         DCMROIGenerationAlgorithm,
+	"ROIGenerationDescription":                                       DCMROIGenerationDescription,
+	"ROIContourSequence":                                             DCMROIContourSequence,
+	"ContourSequence":                                                DCMContourSequence,
+	"ContourGeometricType":                                           DCMContourGeometricType,
+	"ContourSlabThickness":                                           DCMContourSlabThickness,
+	"ContourOffsetVector":                                            DCMContourOffsetVector,
+	"NumberOfContourPoints":                                          DCMNumberOfContourPoints,
+	"ContourNumber":                                                  DCMContourNumber,
+	"AttachedContours":                                               DCMAttachedContours,
+	"ContourData":                                                    DCMContourData,
+	"RTROIObservationsSequence":                                      DCMRTROIObservationsSequence,
+	"ObservationNumber":                                              DCMObservationNumber,
+	"ReferencedROINumber":                                            DCMReferencedROINumber,
+	"ROIObservationLabel":                                            DCMROIObservationLabel,
+	"RTROIIdentificationCodeSequence":                                DCMRTROIIdentificationCodeSequence,
+	"ROIObservationDescription":                                      DCMROIObservationDescription,
+	"RelatedRTROIObservationsSequence":                               DCMRelatedRTROIObservationsSequence,
+	"RTROIInterpretedType":                                           DCMRTROIInterpretedType,
+	"ROIInterpreter":                                                 DCMROIInterpreter,
+	"ROIPhysicalPropertiesSequence":                                  DCMROIPhysicalPropertiesSequence,
+	"ROIPhysicalProperty":                                            DCMROIPhysicalProperty,
+	"ROIPhysicalPropertyValue":                                       DCMROIPhysicalPropertyValue,
+	"ROIElementalCompositionSequence":                                DCMROIElementalCompositionSequence,
+	"ROIElementalCompositionAtomicNumber":                            DCMROIElementalCompositionAtomicNumber,
+	"ROIElementalCompositionAtomicMassFraction":                      DCMROIElementalCompositionAtomicMassFraction,
+	"FrameOfReferenceRelationshipSequence":                           DCMFrameOfReferenceRelationshipSequence,
+	"RelatedFrameOfReferenceUID":                                     DCMRelatedFrameOfReferenceUID,
+	"FrameOfReferenceTransformationType":                             DCMFrameOfReferenceTransformationType,
+	"FrameOfReferenceTransformationMatrix":                           DCMFrameOfReferenceTransformationMatrix,
+	"FrameOfReferenceTransformationComment":                          DCMFrameOfReferenceTransformationComment,
+	"MeasuredDoseReferenceSequence":                                  DCMMeasuredDoseReferenceSequence,
+	"MeasuredDoseDescription":                                        DCMMeasuredDoseDescription,
+	"MeasuredDoseType":                                               DCMMeasuredDoseType,
+	"MeasuredDoseValue":                                              DCMMeasuredDoseValue,
+	"TreatmentSessionBeamSequence":                                   DCMTreatmentSessionBeamSequence,
+	"TreatmentSessionIonBeamSequence":                                DCMTreatmentSessionIonBeamSequence,
+	"CurrentFractionNumber":                                          DCMCurrentFractionNumber,
+	"TreatmentControlPointDate":                                      DCMTreatmentControlPointDate,
+	"TreatmentControlPointTime":                                      DCMTreatmentControlPointTime,
+	"TreatmentTerminationStatus":                                     DCMTreatmentTerminationStatus,
+	"TreatmentTerminationCode":                                       DCMTreatmentTerminationCode,
+	"TreatmentVerificationStatus":                                    DCMTreatmentVerificationStatus,
+	"ReferencedTreatmentRecordSequence":                              DCMReferencedTreatmentRecordSequence,
+	"SpecifiedPrimaryMeterset":                                       DCMSpecifiedPrimaryMeterset,
+	"SpecifiedSecondaryMeterset":                                     DCMSpecifiedSecondaryMeterset,
+	"DeliveredPrimaryMeterset":                                       DCMDeliveredPrimaryMeterset,
+	"DeliveredSecondaryMeterset":                                     DCMDeliveredSecondaryMeterset,
+	"SpecifiedTreatmentTime":                                         DCMSpecifiedTreatmentTime,
+	"DeliveredTreatmentTime":                                         DCMDeliveredTreatmentTime,
+	"ControlPointDeliverySequence":                                   DCMControlPointDeliverySequence,
+	"IonControlPointDeliverySequence":                                DCMIonControlPointDeliverySequence,
+	"SpecifiedMeterset":                                              DCMSpecifiedMeterset,
+	"DeliveredMeterset":                                              DCMDeliveredMeterset,
+	"MetersetRateSet":                                                DCMMetersetRateSet,
+	"MetersetRateDelivered":                                          DCMMetersetRateDelivered,
+	"ScanSpotMetersetsDelivered":                                     DCMScanSpotMetersetsDelivered,
+	"DoseRateDelivered":                                              DCMDoseRateDelivered,
+	"TreatmentSummaryCalculatedDoseReferenceSequence":                DCMTreatmentSummaryCalculatedDoseReferenceSequence,
+	"CumulativeDoseToDoseReference":                                  DCMCumulativeDoseToDoseReference,
+	"FirstTreatmentDate":                                             DCMFirstTreatmentDate,
+	"MostRecentTreatmentDate":                                        DCMMostRecentTreatmentDate,
+	"NumberOfFractionsDelivered":                                     DCMNumberOfFractionsDelivered,
+	"OverrideSequence":                                               DCMOverrideSequence,
+	"ParameterSequencePointer":                                       DCMParameterSequencePointer,
+	"OverrideParameterPointer":                                       DCMOverrideParameterPointer,
+	"ParameterItemIndex":                                             DCMParameterItemIndex,
+	"MeasuredDoseReferenceNumber":                                    DCMMeasuredDoseReferenceNumber,
+	"ParameterPointer":                                               DCMParameterPointer,
+	"OverrideReason":                                                 DCMOverrideReason,
+	"CorrectedParameterSequence":                                     DCMCorrectedParameterSequence,
+	"CorrectionValue":                                                DCMCorrectionValue,
+	"CalculatedDoseReferenceSequence":                                DCMCalculatedDoseReferenceSequence,
+	"CalculatedDoseReferenceNumber":                                  DCMCalculatedDoseReferenceNumber,
+	"CalculatedDoseReferenceDescription":                             DCMCalculatedDoseReferenceDescription,
+	"CalculatedDoseReferenceDoseValue":                               DCMCalculatedDoseReferenceDoseValue,
+	"StartMeterset":                                                  DCMStartMeterset,
+	"EndMeterset":                                                    DCMEndMeterset,
+	"ReferencedMeasuredDoseReferenceSequence":                        DCMReferencedMeasuredDoseReferenceSequence,
+	"ReferencedMeasuredDoseReferenceNumber":                          DCMReferencedMeasuredDoseReferenceNumber,
+	"ReferencedCalculatedDoseReferenceSequence":                      DCMReferencedCalculatedDoseReferenceSequence,
+	"ReferencedCalculatedDoseReferenceNumber":                        DCMReferencedCalculatedDoseReferenceNumber,
+	"BeamLimitingDeviceLeafPairsSequence":                            DCMBeamLimitingDeviceLeafPairsSequence,
+	"RecordedWedgeSequence":                                          DCMRecordedWedgeSequence,
+	"RecordedCompensatorSequence":                                    DCMRecordedCompensatorSequence,
+	"RecordedBlockSequence":                                          DCMRecordedBlockSequence,
+	"TreatmentSummaryMeasuredDoseReferenceSequence":                  DCMTreatmentSummaryMeasuredDoseReferenceSequence,
+	"RecordedSnoutSequence":                                          DCMRecordedSnoutSequence,
+	"RecordedRangeShifterSequence":                                   DCMRecordedRangeShifterSequence,
+	"RecordedLateralSpreadingDeviceSequence":                         DCMRecordedLateralSpreadingDeviceSequence,
+	"RecordedRangeModulatorSequence":                                 DCMRecordedRangeModulatorSequence,
+	"RecordedSourceSequence":                                         DCMRecordedSourceSequence,
+	"SourceSerialNumber":                                             DCMSourceSerialNumber,
+	"TreatmentSessionApplicationSetupSequence":                       DCMTreatmentSessionApplicationSetupSequence,
+	"ApplicationSetupCheck":                                          DCMApplicationSetupCheck,
+	"RecordedBrachyAccessoryDeviceSequence":                          DCMRecordedBrachyAccessoryDeviceSequence,
+	"ReferencedBrachyAccessoryDeviceNumber":                          DCMReferencedBrachyAccessoryDeviceNumber,
+	"RecordedChannelSequence":                                        DCMRecordedChannelSequence,
+	"SpecifiedChannelTotalTime":                                      DCMSpecifiedChannelTotalTime,
+	"DeliveredChannelTotalTime":                                      DCMDeliveredChannelTotalTime,
+	"SpecifiedNumberOfPulses":                                        DCMSpecifiedNumberOfPulses,
+	"DeliveredNumberOfPulses":                                        DCMDeliveredNumberOfPulses,
+	"SpecifiedPulseRepetitionInterval":                               DCMSpecifiedPulseRepetitionInterval,
+	"DeliveredPulseRepetitionInterval":                               DCMDeliveredPulseRepetitionInterval,
+	"RecordedSourceApplicatorSequence":                               DCMRecordedSourceApplicatorSequence,
+	"ReferencedSourceApplicatorNumber":                               DCMReferencedSourceApplicatorNumber,
+	"RecordedChannelShieldSequence":                                  DCMRecordedChannelShieldSequence,
+	"ReferencedChannelShieldNumber":                                  DCMReferencedChannelShieldNumber,
+	"BrachyControlPointDeliveredSequence":                            DCMBrachyControlPointDeliveredSequence,
+	"SafePositionExitDate":                                           DCMSafePositionExitDate,
+	"SafePositionExitTime":                                           DCMSafePositionExitTime,
+	"SafePositionReturnDate":                                         DCMSafePositionReturnDate,
+	"SafePositionReturnTime":                                         DCMSafePositionReturnTime,
+	"CurrentTreatmentStatus":                                         DCMCurrentTreatmentStatus,
+	"TreatmentStatusComment":                                         DCMTreatmentStatusComment,
+	"FractionGroupSummarySequence":                                   DCMFractionGroupSummarySequence,
+	"ReferencedFractionNumber":                                       DCMReferencedFractionNumber,
+	"FractionGroupType":                                              DCMFractionGroupType,
+	"BeamStopperPosition":                                            DCMBeamStopperPosition,
+	"FractionStatusSummarySequence":                                  DCMFractionStatusSummarySequence,
+	"TreatmentDate":                                                  DCMTreatmentDate,
+	"TreatmentTime":                                                  DCMTreatmentTime,
+	"RTPlanLabel":                                                    DCMRTPlanLabel,
+	"RTPlanName":                                                     DCMRTPlanName,
+	"RTPlanDescription":                                              DCMRTPlanDescription,
+	"RTPlanDate":                                                     DCMRTPlanDate,
+	"RTPlanTime":                                                     DCMRTPlanTime,
+	"TreatmentProtocols":                                             DCMTreatmentProtocols,
+	"PlanIntent":                                                     DCMPlanIntent,
+	"TreatmentSites":                                                 DCMTreatmentSites,
+	"RTPlanGeometry":                                                 DCMRTPlanGeometry,
+	"PrescriptionDescription":                                        DCMPrescriptionDescription,
+	"DoseReferenceSequence":                                          DCMDoseReferenceSequence,
+	"DoseReferenceNumber":                                            DCMDoseReferenceNumber,
+	"DoseReferenceUID":                                               DCMDoseReferenceUID,
+	"DoseReferenceStructureType":                                     DCMDoseReferenceStructureType,
+	"NominalBeamEnergyUnit":                                          DCMNominalBeamEnergyUnit,
+	"DoseReferenceDescription":                                       DCMDoseReferenceDescription,
+	"DoseReferencePointCoordinates":                                  DCMDoseReferencePointCoordinates,
+	"NominalPriorDose":                                               DCMNominalPriorDose,
+	"DoseReferenceType":                                              DCMDoseReferenceType,
+	"ConstraintWeight":                                               DCMConstraintWeight,
+	"DeliveryWarningDose":                                            DCMDeliveryWarningDose,
+	"DeliveryMaximumDose":                                            DCMDeliveryMaximumDose,
+	"TargetMinimumDose":                                              DCMTargetMinimumDose,
+	"TargetPrescriptionDose":                                         DCMTargetPrescriptionDose,
+	"TargetMaximumDose":                                              DCMTargetMaximumDose,
+	"TargetUnderdoseVolumeFraction":                                  DCMTargetUnderdoseVolumeFraction,
+	"OrganAtRiskFullVolumeDose":                                      DCMOrganAtRiskFullVolumeDose,
+	"OrganAtRiskLimitDose":                                           DCMOrganAtRiskLimitDose,
+	"OrganAtRiskMaximumDose":                                         DCMOrganAtRiskMaximumDose,
+	"OrganAtRiskOverdoseVolumeFraction":                              DCMOrganAtRiskOverdoseVolumeFraction,
+	"ToleranceTableSequence":                                         DCMToleranceTableSequence,
+	"ToleranceTableNumber":                                           DCMToleranceTableNumber,
+	"ToleranceTableLabel":                                            DCMToleranceTableLabel,
+	"GantryAngleTolerance":                                           DCMGantryAngleTolerance,
+	"BeamLimitingDeviceAngleTolerance":                               DCMBeamLimitingDeviceAngleTolerance,
+	"BeamLimitingDeviceToleranceSequence":                            DCMBeamLimitingDeviceToleranceSequence,
+	"BeamLimitingDevicePositionTolerance":                            DCMBeamLimitingDevicePositionTolerance,
+	"SnoutPositionTolerance":                                         DCMSnoutPositionTolerance,
+	"PatientSupportAngleTolerance":                                   DCMPatientSupportAngleTolerance,
+	"TableTopEccentricAngleTolerance":                                DCMTableTopEccentricAngleTolerance,
+	"TableTopPitchAngleTolerance":                                    DCMTableTopPitchAngleTolerance,
+	"TableTopRollAngleTolerance":                                     DCMTableTopRollAngleTolerance,
+	"TableTopVerticalPositionTolerance":                              DCMTableTopVerticalPositionTolerance,
+	"TableTopLongitudinalPositionTolerance":                          DCMTableTopLongitudinalPositionTolerance,
+	"TableTopLateralPositionTolerance":                               DCMTableTopLateralPositionTolerance,
+	"RTPlanRelationship":                                             DCMRTPlanRelationship,
+	"FractionGroupSequence":                                          DCMFractionGroupSequence,
+	"FractionGroupNumber":                                            DCMFractionGroupNumber,
+	"FractionGroupDescription":                                       DCMFractionGroupDescription,
+	"NumberOfFractionsPlanned":                                       DCMNumberOfFractionsPlanned,
+	"NumberOfFractionPatternDigitsPerDay":                            DCMNumberOfFractionPatternDigitsPerDay,
+	"RepeatFractionCycleLength":                                      DCMRepeatFractionCycleLength,
+	"FractionPattern":                                                DCMFractionPattern,
+	"NumberOfBeams":                                                  DCMNumberOfBeams,
+	"BeamDoseSpecificationPoint":                                     DCMBeamDoseSpecificationPoint,
+	"BeamDose":                                                       DCMBeamDose,
+	"BeamMeterset":                                                   DCMBeamMeterset,
+	"BeamDosePointDepth":                                             DCMBeamDosePointDepth,
+	"BeamDosePointEquivalentDepth":                                   DCMBeamDosePointEquivalentDepth,
+	"BeamDosePointSSD":                                               DCMBeamDosePointSSD,
+	"NumberOfBrachyApplicationSetups":                                DCMNumberOfBrachyApplicationSetups,
+	"BrachyApplicationSetupDoseSpecificationPoint":                   DCMBrachyApplicationSetupDoseSpecificationPoint,
+	"BrachyApplicationSetupDose":                                     DCMBrachyApplicationSetupDose,
+	"BeamSequence":                                                   DCMBeamSequence,
+	"TreatmentMachineName":                                           DCMTreatmentMachineName,
+	"PrimaryDosimeterUnit":                                           DCMPrimaryDosimeterUnit,
+	"SourceAxisDistance":                                             DCMSourceAxisDistance,
+	"BeamLimitingDeviceSequence":                                     DCMBeamLimitingDeviceSequence,
+	"RTBeamLimitingDeviceType":                                       DCMRTBeamLimitingDeviceType,
+	"SourceToBeamLimitingDeviceDistance":                             DCMSourceToBeamLimitingDeviceDistance,
+	"IsocenterToBeamLimitingDeviceDistance":                          DCMIsocenterToBeamLimitingDeviceDistance,
+	"NumberOfLeafJawPairs":                                           DCMNumberOfLeafJawPairs,
+	"LeafPositionBoundaries":                                         DCMLeafPositionBoundaries,
+	"BeamNumber":                                                     DCMBeamNumber,
+	"BeamName":                                                       DCMBeamName,
+	"BeamDescription":                                                DCMBeamDescription,
+	"BeamType":                                                       DCMBeamType,
+	"RadiationType":                                                  DCMRadiationType,
+	"HighDoseTechniqueType":                                          DCMHighDoseTechniqueType,
+	"ReferenceImageNumber":                                           DCMReferenceImageNumber,
+	"PlannedVerificationImageSequence":                               DCMPlannedVerificationImageSequence,
+	"ImagingDeviceSpecificAcquisitionParameters":                     DCMImagingDeviceSpecificAcquisitionParameters,
+	"TreatmentDeliveryType":                                          DCMTreatmentDeliveryType,
+	"NumberOfWedges":                                                 DCMNumberOfWedges,
+	"WedgeSequence":                                                  DCMWedgeSequence,
+	"WedgeNumber":                                                    DCMWedgeNumber,
+	"WedgeType":                                                      DCMWedgeType,
+	"WedgeID":                                                        DCMWedgeID,
+	"WedgeAngle":                                                     DCMWedgeAngle,
+	"WedgeFactor":                                                    DCMWedgeFactor,
+	"TotalWedgeTrayWaterEquivalentThickness":                         DCMTotalWedgeTrayWaterEquivalentThickness,
+	"WedgeOrientation":                                               DCMWedgeOrientation,
+	"IsocenterToWedgeTrayDistance":                                   DCMIsocenterToWedgeTrayDistance,
+	"SourceToWedgeTrayDistance":                                      DCMSourceToWedgeTrayDistance,
+	"WedgeThinEdgePosition":                                          DCMWedgeThinEdgePosition,
+	"BolusID":                                                        DCMBolusID,
+	"BolusDescription":                                               DCMBolusDescription,
+	"NumberOfCompensators":                                           DCMNumberOfCompensators,
+	"MaterialID":                                                     DCMMaterialID,
+	"TotalCompensatorTrayFactor":                                     DCMTotalCompensatorTrayFactor,
+	"CompensatorSequence":                                            DCMCompensatorSequence,
+	"CompensatorNumber":                                              DCMCompensatorNumber,
+	"CompensatorID":                                                  DCMCompensatorID,
+	"SourceToCompensatorTrayDistance":                                DCMSourceToCompensatorTrayDistance,
+	"CompensatorRows":                                                DCMCompensatorRows,
+	"CompensatorColumns":                                             DCMCompensatorColumns,
+	"CompensatorPixelSpacing":                                        DCMCompensatorPixelSpacing,
+	"CompensatorPosition":                                            DCMCompensatorPosition,
+	"CompensatorTransmissionData":                                    DCMCompensatorTransmissionData,
+	"CompensatorThicknessData":                                       DCMCompensatorThicknessData,
+	"NumberOfBoli":                                                   DCMNumberOfBoli,
+	"CompensatorType":                                                DCMCompensatorType,
+	"NumberOfBlocks":                                                 DCMNumberOfBlocks,
+	"TotalBlockTrayFactor":                                           DCMTotalBlockTrayFactor,
+	"TotalBlockTrayWaterEquivalentThickness":                         DCMTotalBlockTrayWaterEquivalentThickness,
+	"BlockSequence":                                                  DCMBlockSequence,
+	"BlockTrayID":                                                    DCMBlockTrayID,
+	"SourceToBlockTrayDistance":                                      DCMSourceToBlockTrayDistance,
+	"IsocenterToBlockTrayDistance":                                   DCMIsocenterToBlockTrayDistance,
+	"BlockType":                                                      DCMBlockType,
+	"AccessoryCode":                                                  DCMAccessoryCode,
+	"BlockDivergence":                                                DCMBlockDivergence,
+	"BlockMountingPosition":                                          DCMBlockMountingPosition,
+	"BlockNumber":                                                    DCMBlockNumber,
+	"BlockName":                                                      DCMBlockName,
+	"BlockThickness":                                                 DCMBlockThickness,
+	"BlockTransmission":                                              DCMBlockTransmission,
+	"BlockNumberOfPoints":                                            DCMBlockNumberOfPoints,
+	"BlockData":                                                      DCMBlockData,
+	"ApplicatorSequence":                                             DCMApplicatorSequence,
+	"ApplicatorID":                                                   DCMApplicatorID,
+	"ApplicatorType":                                                 DCMApplicatorType,
+	"ApplicatorDescription":                                          DCMApplicatorDescription,
+	"CumulativeDoseReferenceCoefficient":                             DCMCumulativeDoseReferenceCoefficient,
+	"FinalCumulativeMetersetWeight":                                  DCMFinalCumulativeMetersetWeight,
+	"NumberOfControlPoints":                                          DCMNumberOfControlPoints,
+	"ControlPointSequence":                                           DCMControlPointSequence,
+	"ControlPointIndex":                                              DCMControlPointIndex,
+	"NominalBeamEnergy":                                              DCMNominalBeamEnergy,
+	"DoseRateSet":                                                    DCMDoseRateSet,
+	"WedgePositionSequence":                                          DCMWedgePositionSequence,
+	"WedgePosition":                                                  DCMWedgePosition,
+	"BeamLimitingDevicePositionSequence":                             DCMBeamLimitingDevicePositionSequence,
+	"LeafJawPositions":                                               DCMLeafJawPositions,
+	"GantryAngle":                                                    DCMGantryAngle,
+	"GantryRotationDirection":                                        DCMGantryRotationDirection,
+	"BeamLimitingDeviceAngle":                                        DCMBeamLimitingDeviceAngle,
+	"BeamLimitingDeviceRotationDirection":                            DCMBeamLimitingDeviceRotationDirection,
+	"PatientSupportAngle":                                            DCMPatientSupportAngle,
+	"PatientSupportRotationDirection":                                DCMPatientSupportRotationDirection,
+	"TableTopEccentricAxisDistance":                                  DCMTableTopEccentricAxisDistance,
+	"TableTopEccentricAngle":                                         DCMTableTopEccentricAngle,
+	"TableTopEccentricRotationDirection":                             DCMTableTopEccentricRotationDirection,
+	"TableTopVerticalPosition":                                       DCMTableTopVerticalPosition,
+	"TableTopLongitudinalPosition":                                   DCMTableTopLongitudinalPosition,
+	"TableTopLateralPosition":                                        DCMTableTopLateralPosition,
+	"IsocenterPosition":                                              DCMIsocenterPosition,
+	"SurfaceEntryPoint":                                              DCMSurfaceEntryPoint,
+	"SourceToSurfaceDistance":                                        DCMSourceToSurfaceDistance,
+	"CumulativeMetersetWeight":                                       DCMCumulativeMetersetWeight,
+	"TableTopPitchAngle":                                             DCMTableTopPitchAngle,
+	"TableTopPitchRotationDirection":                                 DCMTableTopPitchRotationDirection,
+	"TableTopRollAngle":                                              DCMTableTopRollAngle,
+	"TableTopRollRotationDirection":                                  DCMTableTopRollRotationDirection,
+	"HeadFixationAngle":                                              DCMHeadFixationAngle,
+	"GantryPitchAngle":                                               DCMGantryPitchAngle,
+	"GantryPitchRotationDirection":                                   DCMGantryPitchRotationDirection,
+	"GantryPitchAngleTolerance":                                      DCMGantryPitchAngleTolerance,
+	"PatientSetupSequence":                                           DCMPatientSetupSequence,
+	"PatientSetupNumber":                                             DCMPatientSetupNumber,
+	"PatientSetupLabel":                                              DCMPatientSetupLabel,
+	"PatientAdditionalPosition":                                      DCMPatientAdditionalPosition,
+	"FixationDeviceSequence":                                         DCMFixationDeviceSequence,
+	"FixationDeviceType":                                             DCMFixationDeviceType,
+	"FixationDeviceLabel":                                            DCMFixationDeviceLabel,
+	"FixationDeviceDescription":                                      DCMFixationDeviceDescription,
+	"FixationDevicePosition":                                         DCMFixationDevicePosition,
+	"FixationDevicePitchAngle":                                       DCMFixationDevicePitchAngle,
+	"FixationDeviceRollAngle":                                        DCMFixationDeviceRollAngle,
+	"ShieldingDeviceSequence":                                        DCMShieldingDeviceSequence,
+	"ShieldingDeviceType":                                            DCMShieldingDeviceType,
+	"ShieldingDeviceLabel":                                           DCMShieldingDeviceLabel,
+	"ShieldingDeviceDescription":                                     DCMShieldingDeviceDescription,
+	"ShieldingDevicePosition":                                        DCMShieldingDevicePosition,
+	"SetupTechnique":                                                 DCMSetupTechnique,
+	"SetupTechniqueDescription":                                      DCMSetupTechniqueDescription,
+	"SetupDeviceSequence":                                            DCMSetupDeviceSequence,
+	"SetupDeviceType":                                                DCMSetupDeviceType,
+	"SetupDeviceLabel":                                               DCMSetupDeviceLabel,
+	"SetupDeviceDescription":                                         DCMSetupDeviceDescription,
+	"SetupDeviceParameter":                                           DCMSetupDeviceParameter,
+	"SetupReferenceDescription":                                      DCMSetupReferenceDescription,
+	"TableTopVerticalSetupDisplacement":                              DCMTableTopVerticalSetupDisplacement,
+	"TableTopLongitudinalSetupDisplacement":                          DCMTableTopLongitudinalSetupDisplacement,
+	"TableTopLateralSetupDisplacement":                               DCMTableTopLateralSetupDisplacement,
+	"BrachyTreatmentTechnique":                                       DCMBrachyTreatmentTechnique,
+	"BrachyTreatmentType":                                            DCMBrachyTreatmentType,
+	"TreatmentMachineSequence":                                       DCMTreatmentMachineSequence,
+	"SourceSequence":                                                 DCMSourceSequence,
+	"SourceNumber":                                                   DCMSourceNumber,
+	"SourceType":                                                     DCMSourceType,
+	"SourceManufacturer":                                             DCMSourceManufacturer,
+	"ActiveSourceDiameter":                                           DCMActiveSourceDiameter,
+	"ActiveSourceLength":                                             DCMActiveSourceLength,
+	"SourceEncapsulationNominalThickness":                            DCMSourceEncapsulationNominalThickness,
+	"SourceEncapsulationNominalTransmission":                         DCMSourceEncapsulationNominalTransmission,
+	"SourceIsotopeName":                                              DCMSourceIsotopeName,
+	"SourceIsotopeHalfLife":                                          DCMSourceIsotopeHalfLife,
+	"SourceStrengthUnits":                                            DCMSourceStrengthUnits,
+	"ReferenceAirKermaRate":                                          DCMReferenceAirKermaRate,
+	"SourceStrength":                                                 DCMSourceStrength,
+	"SourceStrengthReferenceDate":                                    DCMSourceStrengthReferenceDate,
+	"SourceStrengthReferenceTime":                                    DCMSourceStrengthReferenceTime,
+	"ApplicationSetupSequence":                                       DCMApplicationSetupSequence,
+	"ApplicationSetupType":                                           DCMApplicationSetupType,
+	"ApplicationSetupNumber":                                         DCMApplicationSetupNumber,
+	"ApplicationSetupName":                                           DCMApplicationSetupName,
+	"ApplicationSetupManufacturer":                                   DCMApplicationSetupManufacturer,
+	"TemplateNumber":                                                 DCMTemplateNumber,
+	"TemplateType":                                                   DCMTemplateType,
+	"TemplateName":                                                   DCMTemplateName,
+	"TotalReferenceAirKerma":                                         DCMTotalReferenceAirKerma,
+	"BrachyAccessoryDeviceSequence":                                  DCMBrachyAccessoryDeviceSequence,
+	"BrachyAccessoryDeviceNumber":                                    DCMBrachyAccessoryDeviceNumber,
+	"BrachyAccessoryDeviceID":                                        DCMBrachyAccessoryDeviceID,
+	"BrachyAccessoryDeviceType":                                      DCMBrachyAccessoryDeviceType,
+	"BrachyAccessoryDeviceName":                                      DCMBrachyAccessoryDeviceName,
+	"BrachyAccessoryDeviceNominalThickness":                          DCMBrachyAccessoryDeviceNominalThickness,
+	"BrachyAccessoryDeviceNominalTransmission":                       DCMBrachyAccessoryDeviceNominalTransmission,
+	"ChannelSequence":                                                DCMChannelSequence,
+	"ChannelNumber":                                                  DCMChannelNumber,
+	"ChannelLength":                                                  DCMChannelLength,
+	"ChannelTotalTime":                                               DCMChannelTotalTime,
+	"SourceMovementType":                                             DCMSourceMovementType,
+	"NumberOfPulses":                                                 DCMNumberOfPulses,
+	"PulseRepetitionInterval":                                        DCMPulseRepetitionInterval,
+	"SourceApplicatorNumber":                                         DCMSourceApplicatorNumber,
+	"SourceApplicatorID":                                             DCMSourceApplicatorID,
+	"SourceApplicatorType":                                           DCMSourceApplicatorType,
+	"SourceApplicatorName":                                           DCMSourceApplicatorName,
+	"SourceApplicatorLength":                                         DCMSourceApplicatorLength,
+	"SourceApplicatorManufacturer":                                   DCMSourceApplicatorManufacturer,
+	"SourceApplicatorWallNominalThickness":                           DCMSourceApplicatorWallNominalThickness,
+	"SourceApplicatorWallNominalTransmission":                        DCMSourceApplicatorWallNominalTransmission,
+	"SourceApplicatorStepSize":                                       DCMSourceApplicatorStepSize,
+	"TransferTubeNumber":                                             DCMTransferTubeNumber,
+	"TransferTubeLength":                                             DCMTransferTubeLength,
+	"ChannelShieldSequence":                                          DCMChannelShieldSequence,
+	"ChannelShieldNumber":                                            DCMChannelShieldNumber,
+	"ChannelShieldID":                                                DCMChannelShieldID,
+	"ChannelShieldName":                                              DCMChannelShieldName,
+	"ChannelShieldNominalThickness":                                  DCMChannelShieldNominalThickness,
+	"ChannelShieldNominalTransmission":                               DCMChannelShieldNominalTransmission,
+	"FinalCumulativeTimeWeight":                                      DCMFinalCumulativeTimeWeight,
+	"BrachyControlPointSequence":                                     DCMBrachyControlPointSequence,
+	"ControlPointRelativePosition":                                   DCMControlPointRelativePosition,
+	"ControlPoint3DPosition":                                         DCMControlPoint3DPosition,
+	"CumulativeTimeWeight":                                           DCMCumulativeTimeWeight,
+	"CompensatorDivergence":                                          DCMCompensatorDivergence,
+	"CompensatorMountingPosition":                                    DCMCompensatorMountingPosition,
+	"SourceToCompensatorDistance":                                    DCMSourceToCompensatorDistance,
+	"TotalCompensatorTrayWaterEquivalentThickness":                   DCMTotalCompensatorTrayWaterEquivalentThickness,
+	"IsocenterToCompensatorTrayDistance":                             DCMIsocenterToCompensatorTrayDistance,
+	"CompensatorColumnOffset":                                        DCMCompensatorColumnOffset,
+	"IsocenterToCompensatorDistances":                                DCMIsocenterToCompensatorDistances,
+	"CompensatorRelativeStoppingPowerRatio":                          DCMCompensatorRelativeStoppingPowerRatio,
+	"CompensatorMillingToolDiameter":                                 DCMCompensatorMillingToolDiameter,
+	"IonRangeCompensatorSequence":                                    DCMIonRangeCompensatorSequence,
+	"CompensatorDescription":                                         DCMCompensatorDescription,
+	"RadiationMassNumber":                                            DCMRadiationMassNumber,
+	"RadiationAtomicNumber":                                          DCMRadiationAtomicNumber,
+	"RadiationChargeState":                                           DCMRadiationChargeState,
+	"ScanMode":                                                       DCMScanMode,
+	"VirtualSourceAxisDistances":                                     DCMVirtualSourceAxisDistances,
+	"SnoutSequence":                                                  DCMSnoutSequence,
+	"SnoutPosition":                                                  DCMSnoutPosition,
+	"SnoutID":                                                        DCMSnoutID,
+	"NumberOfRangeShifters":                                          DCMNumberOfRangeShifters,
+	"RangeShifterSequence":                                           DCMRangeShifterSequence,
+	"RangeShifterNumber":                                             DCMRangeShifterNumber,
+	"RangeShifterID":                                                 DCMRangeShifterID,
+	"RangeShifterType":                                               DCMRangeShifterType,
+	"RangeShifterDescription":                                        DCMRangeShifterDescription,
+	"NumberOfLateralSpreadingDevices":                                DCMNumberOfLateralSpreadingDevices,
+	"LateralSpreadingDeviceSequence":                                 DCMLateralSpreadingDeviceSequence,
+	"LateralSpreadingDeviceNumber":                                   DCMLateralSpreadingDeviceNumber,
+	"LateralSpreadingDeviceID":                                       DCMLateralSpreadingDeviceID,
+	"LateralSpreadingDeviceType":                                     DCMLateralSpreadingDeviceType,
+	"LateralSpreadingDeviceDescription":                              DCMLateralSpreadingDeviceDescription,
+	"LateralSpreadingDeviceWaterEquivalentThickness":                 DCMLateralSpreadingDeviceWaterEquivalentThickness,
+	"NumberOfRangeModulators":                                        DCMNumberOfRangeModulators,
+	"RangeModulatorSequence":                                         DCMRangeModulatorSequence,
+	"RangeModulatorNumber":                                           DCMRangeModulatorNumber,
+	"RangeModulatorID":                                               DCMRangeModulatorID,
+	"RangeModulatorType":                                             DCMRangeModulatorType,
+	"RangeModulatorDescription":                                      DCMRangeModulatorDescription,
+	"BeamCurrentModulationID":                                        DCMBeamCurrentModulationID,
+	"PatientSupportType":                                             DCMPatientSupportType,
+	"PatientSupportID":                                               DCMPatientSupportID,
+	"PatientSupportAccessoryCode":                                    DCMPatientSupportAccessoryCode,
+	"FixationLightAzimuthalAngle":                                    DCMFixationLightAzimuthalAngle,
+	"FixationLightPolarAngle":                                        DCMFixationLightPolarAngle,
+	"MetersetRate":                                                   DCMMetersetRate,
+	"RangeShifterSettingsSequence":                                   DCMRangeShifterSettingsSequence,
+	"RangeShifterSetting":                                            DCMRangeShifterSetting,
+	"IsocenterToRangeShifterDistance":                                DCMIsocenterToRangeShifterDistance,
+	"RangeShifterWaterEquivalentThickness":                           DCMRangeShifterWaterEquivalentThickness,
+	"LateralSpreadingDeviceSettingsSequence":                         DCMLateralSpreadingDeviceSettingsSequence,
+	"LateralSpreadingDeviceSetting":                                  DCMLateralSpreadingDeviceSetting,
+	"IsocenterToLateralSpreadingDeviceDistance":                      DCMIsocenterToLateralSpreadingDeviceDistance,
+	"RangeModulatorSettingsSequence":                                 DCMRangeModulatorSettingsSequence,
+	"RangeModulatorGatingStartValue":                                 DCMRangeModulatorGatingStartValue,
+	"RangeModulatorGatingStopValue":                                  DCMRangeModulatorGatingStopValue,
+	"RangeModulatorGatingStartWaterEquivalentThickness":              DCMRangeModulatorGatingStartWaterEquivalentThickness,
+	"RangeModulatorGatingStopWaterEquivalentThickness":               DCMRangeModulatorGatingStopWaterEquivalentThickness,
+	"IsocenterToRangeModulatorDistance":                              DCMIsocenterToRangeModulatorDistance,
+	"ScanSpotTuneID":                                                 DCMScanSpotTuneID,
+	"NumberOfScanSpotPositions":                                      DCMNumberOfScanSpotPositions,
+	"ScanSpotPositionMap":                                            DCMScanSpotPositionMap,
+	"ScanSpotMetersetWeights":                                        DCMScanSpotMetersetWeights,
+	"ScanningSpotSize":                                               DCMScanningSpotSize,
+	"NumberOfPaintings":                                              DCMNumberOfPaintings,
+	"IonToleranceTableSequence":                                      DCMIonToleranceTableSequence,
+	"IonBeamSequence":                                                DCMIonBeamSequence,
+	"IonBeamLimitingDeviceSequence":                                  DCMIonBeamLimitingDeviceSequence,
+	"IonBlockSequence":                                               DCMIonBlockSequence,
+	"IonControlPointSequence":                                        DCMIonControlPointSequence,
+	"IonWedgeSequence":                                               DCMIonWedgeSequence,
+	"IonWedgePositionSequence":                                       DCMIonWedgePositionSequence,
+	"ReferencedSetupImageSequence":                                   DCMReferencedSetupImageSequence,
+	"SetupImageComment":                                              DCMSetupImageComment,
+	"MotionSynchronizationSequence":                                  DCMMotionSynchronizationSequence,
+	"ControlPointOrientation":                                        DCMControlPointOrientation,
+	"GeneralAccessorySequence":                                       DCMGeneralAccessorySequence,
+	"GeneralAccessoryID":                                             DCMGeneralAccessoryID,
+	"GeneralAccessoryDescription":                                    DCMGeneralAccessoryDescription,
+	"GeneralAccessoryType":                                           DCMGeneralAccessoryType,
+	"GeneralAccessoryNumber":                                         DCMGeneralAccessoryNumber,
+	"ApplicatorGeometrySequence":                                     DCMApplicatorGeometrySequence,
+	"ApplicatorApertureShape":                                        DCMApplicatorApertureShape,
+	"ApplicatorOpening":                                              DCMApplicatorOpening,
+	"ApplicatorOpeningX":                                             DCMApplicatorOpeningX,
+	"ApplicatorOpeningY":                                             DCMApplicatorOpeningY,
+	"SourceToApplicatorMountingPositionDistance":                     DCMSourceToApplicatorMountingPositionDistance,
+	"ReferencedRTPlanSequence":                                       DCMReferencedRTPlanSequence,
+	"ReferencedBeamSequence":                                         DCMReferencedBeamSequence,
+	"ReferencedBeamNumber":                                           DCMReferencedBeamNumber,
+	"ReferencedReferenceImageNumber":                                 DCMReferencedReferenceImageNumber,
+	"StartCumulativeMetersetWeight":                                  DCMStartCumulativeMetersetWeight,
+	"EndCumulativeMetersetWeight":                                    DCMEndCumulativeMetersetWeight,
+	"ReferencedBrachyApplicationSetupSequence":                       DCMReferencedBrachyApplicationSetupSequence,
+	"ReferencedBrachyApplicationSetupNumber":                         DCMReferencedBrachyApplicationSetupNumber,
+	"ReferencedSourceNumber":                                         DCMReferencedSourceNumber,
+	"ReferencedFractionGroupSequence":                                DCMReferencedFractionGroupSequence,
+	"ReferencedFractionGroupNumber":                                  DCMReferencedFractionGroupNumber,
+	"ReferencedVerificationImageSequence":                            DCMReferencedVerificationImageSequence,
+	"ReferencedReferenceImageSequence":                               DCMReferencedReferenceImageSequence,
+	"ReferencedDoseReferenceSequence":                                DCMReferencedDoseReferenceSequence,
+	"ReferencedDoseReferenceNumber":                                  DCMReferencedDoseReferenceNumber,
+	"BrachyReferencedDoseReferenceSequence":                          DCMBrachyReferencedDoseReferenceSequence,
+	"ReferencedStructureSetSequence":                                 DCMReferencedStructureSetSequence,
+	"ReferencedPatientSetupNumber":                                   DCMReferencedPatientSetupNumber,
+	"ReferencedDoseSequence":                                         DCMReferencedDoseSequence,
+	"ReferencedToleranceTableNumber":                                 DCMReferencedToleranceTableNumber,
+	"ReferencedBolusSequence":                                        DCMReferencedBolusSequence,
+	"ReferencedWedgeNumber":                                          DCMReferencedWedgeNumber,
+	"ReferencedCompensatorNumber":                                    DCMReferencedCompensatorNumber,
+	"ReferencedBlockNumber":                                          DCMReferencedBlockNumber,
+	"ReferencedControlPointIndex":                                    DCMReferencedControlPointIndex,
+	"ReferencedControlPointSequence":                                 DCMReferencedControlPointSequence,
+	"ReferencedStartControlPointIndex":                               DCMReferencedStartControlPointIndex,
+	"ReferencedStopControlPointIndex":                                DCMReferencedStopControlPointIndex,
+	"ReferencedRangeShifterNumber":                                   DCMReferencedRangeShifterNumber,
+	"ReferencedLateralSpreadingDeviceNumber":                         DCMReferencedLateralSpreadingDeviceNumber,
+	"ReferencedRangeModulatorNumber":                                 DCMReferencedRangeModulatorNumber,
+	"ApprovalStatus":                                                 DCMApprovalStatus,
+	"ReviewDate":                                                     DCMReviewDate,
+	"ReviewTime":                                                     DCMReviewTime,
+	"ReviewerName":                                                   DCMReviewerName,
+	"ACRNEMATextGroupLength":                                         DCMACRNEMATextGroupLength,
+	"ACRNEMATextArbitrary":                                           DCMACRNEMATextArbitrary,
+	"ACRNEMATextComments":                                            DCMACRNEMATextComments,
+	"RETIREDResultsID":                                               DCMRETIREDResultsID,
+	"RETIREDResultsIDIssuer":                                         DCMRETIREDResultsIDIssuer,
+	"RETIREDReferencedInterpretationSequence":                        DCMRETIREDReferencedInterpretationSequence,
+	"RETIREDInterpretationRecordedDate":                              DCMRETIREDInterpretationRecordedDate,
+	"RETIREDInterpretationRecordedTime":                              DCMRETIREDInterpretationRecordedTime,
+	"RETIREDInterpretationRecorder":                                  DCMRETIREDInterpretationRecorder,
+	"RETIREDReferenceToRecordedSound":                                DCMRETIREDReferenceToRecordedSound,
+	"RETIREDInterpretationTranscriptionDate":                         DCMRETIREDInterpretationTranscriptionDate,
+	"RETIREDInterpretationTranscriptionTime":                         DCMRETIREDInterpretationTranscriptionTime,
+	"RETIREDInterpretationTranscriber":                               DCMRETIREDInterpretationTranscriber,
+	"RETIREDInterpretationText":                                      DCMRETIREDInterpretationText,
+	"RETIREDInterpretationAuthor":                                    DCMRETIREDInterpretationAuthor,
+	"RETIREDInterpretationApproverSequence":                          DCMRETIREDInterpretationApproverSequence,
+	"RETIREDInterpretationApprovalDate":                              DCMRETIREDInterpretationApprovalDate,
+	"RETIREDInterpretationApprovalTime":                              DCMRETIREDInterpretationApprovalTime,
+	"RETIREDPhysicianApprovingInterpretation":                        DCMRETIREDPhysicianApprovingInterpretation,
+	"RETIREDInterpretationDiagnosisDescription":                      DCMRETIREDInterpretationDiagnosisDescription,
+	"RETIREDInterpretationDiagnosisCodeSequence":                     DCMRETIREDInterpretationDiagnosisCodeSequence,
+	"RETIREDResultsDistributionListSequence":                         DCMRETIREDResultsDistributionListSequence,
+	"RETIREDDistributionName":                                        DCMRETIREDDistributionName,
+	"RETIREDDistributionAddress":                                     DCMRETIREDDistributionAddress,
+	"RETIREDInterpretationID":                                        DCMRETIREDInterpretationID,
+	"RETIREDInterpretationIDIssuer":                                  DCMRETIREDInterpretationIDIssuer,
+	"RETIREDInterpretationTypeID":                                    DCMRETIREDInterpretationTypeID,
+	"RETIREDInterpretationStatusID":                                  DCMRETIREDInterpretationStatusID,
+	"RETIREDImpressions":                                             DCMRETIREDImpressions,
+	"RETIREDResultsComments":                                         DCMRETIREDResultsComments,
+	"MACParametersSequence":                                          DCMMACParametersSequence,
+	"SharedFunctionalGroupsSequence":                                 DCMSharedFunctionalGroupsSequence,
+	"PerFrameFunctionalGroupsSequence":                               DCMPerFrameFunctionalGroupsSequence,
+	"WaveformSequence":                                               DCMWaveformSequence,
+	"ChannelMinimumValue":                                            DCMChannelMinimumValue,
+	"ChannelMaximumValue":                                            DCMChannelMaximumValue,
+	"WaveformBitsAllocated":                                          DCMWaveformBitsAllocated,
+	"WaveformSampleInterpretation":                                   DCMWaveformSampleInterpretation,
+	"WaveformPaddingValue":                                           DCMWaveformPaddingValue,
+	"WaveformData":                                                   DCMWaveformData,
+	"FirstOrderPhaseCorrectionAngle":                                 DCMFirstOrderPhaseCorrectionAngle,
+	"SpectroscopyData":                                               DCMSpectroscopyData,
+	"ExtendedOffsetTable":                                            DCMExtendedOffsetTable,
+	"ExtendedOffsetTableLengths":                                     DCMExtendedOffsetTableLengths,
+	"PixelData":                                                      DCMPixelData,
+	"ACRNEMA2CCoefficientsSDVN":                                      DCMACRNEMA2CCoefficientsSDVN,
+	"ACRNEMA2CCoefficientsSDHN":                                      DCMACRNEMA2CCoefficientsSDHN,
+	"ACRNEMA2CCoefficientsSDDN":                                      DCMACRNEMA2CCoefficientsSDDN,
+	"DigitalSignaturesSequence":                                      DCMDigitalSignaturesSequence,
+	"DataSetTrailingPadding":                                         DCMDataSetTrailingPadding,
+	"Item":                                                           DCMItem,
+	"ItemDelimitationItem":                                           DCMItemDelimitationItem,
+	"SequenceDelimitationItem":                                       DCMSequenceDelimitationItem,
+	"OverlayRows":                                                    DCMOverlayRows,
+	"OverlayColumns":                                                 DCMOverlayColumns,
+	"NumberOfFramesInOverlay":                                        DCMNumberOfFramesInOverlay,
+	"OverlayDescription":                                             DCMOverlayDescription,
+	"OverlayType":                                                    DCMOverlayType,
+	"OverlaySubtype":                                                 DCMOverlaySubtype,
+	"OverlayOrigin":                                                  DCMOverlayOrigin,
+	"ImageFrameOrigin":                                               DCMImageFrameOrigin,
+	"OverlayBitsAllocated":                                           DCMOverlayBitsAllocated,
+	"OverlayBitPosition":                                             DCMOverlayBitPosition,
+	"OverlayActivationLayer":                                         DCMOverlayActivationLayer,
+	"ROIArea":                                                        DCMROIArea,
+	"ROIMean":                                                        DCMROIMean,
+	"ROIStandardDeviation":                                           DCMROIStandardDeviation,
+	"OverlayLabel":                                                   DCMOverlayLabel,
+	"OverlayData":                                                    DCMOverlayData,
+	"ACRNEMA2CVariablePixelDataGroupLength":                          DCMACRNEMA2CVariablePixelDataGroupLength,
+	"PrivateGroupLength":                                             DCMPrivateGroupLength,
+	"RETIREDNumberOfPoints":                                          DCMRETIREDNumberOfPoints,
+	"RETIREDTypeOfData":                                              DCMRETIREDTypeOfData,
+	"RETIREDCurveDescription":                                        DCMRETIREDCurveDescription,
+	"RETIREDAxisUnits":                                               DCMRETIREDAxisUnits,
+	"RETIREDAxisLabels":                                              DCMRETIREDAxisLabels,
+	"RETIREDOverlayPlanes":                                           DCMRETIREDOverlayPlanes,
+	"RETIREDOverlayPlaneOrigin":                                      DCMRETIREDOverlayPlaneOrigin,
+	"ACRNEMA2COverlayCompressionCode":                                DCMACRNEMA2COverlayCompressionCode,
+	"ACRNEMA2COverlayCompressionOriginator":                          DCMACRNEMA2COverlayCompressionOriginator,
+	"ACRNEMA2COverlayCompressionLabel":                               DCMACRNEMA2COverlayCompressionLabel,
+	"ACRNEMA2COverlayCompressionDescription":                         DCMACRNEMA2COverlayCompressionDescription,
+	"ACRNEMA2COverlayCompressionStepPointers":                        DCMACRNEMA2COverlayCompressionStepPointers,
+	"ACRNEMA2COverlayRepeatInterval":                                 DCMACRNEMA2COverlayRepeatInterval,
+	"ACRNEMA2COverlayBitsGrouped":                                    DCMACRNEMA2COverlayBitsGrouped,
+	"ACRNEMA2CVariablePixelData":                                     DCMACRNEMA2CVariablePixelData,
+	"ACRNEMA2CVariableNextDataGroup":                                 DCMACRNEMA2CVariableNextDataGroup,
+	"ACRNEMA2CVariableCoefficientsSDVN":                              DCMACRNEMA2CVariableCoefficientsSDVN,
+	"ACRNEMA2CVariableCoefficientsSDHN":                              DCMACRNEMA2CVariableCoefficientsSDHN,
+	"ACRNEMA2CVariableCoefficientsSDDN":                              DCMACRNEMA2CVariableCoefficientsSDDN,
+	"PrivateCreator":                                                 DCMPrivateCreator,
+	"IllegalGroupLength":                                             DCMIllegalGroupLength,
+	"IllegalPrivateCreator":                                          DCMIllegalPrivateCreator,
+	"GenericGroupLength":                                             DCMGenericGroupLength,
+	"ACRNEMASourceImageID":                                           DCMACRNEMASourceImageID,
+	"RETIREDCurveDimensions":                                         DCMRETIREDCurveDimensions,
+	"RETIREDDataValueRepresentation":                                 DCMRETIREDDataValueRepresentation,
+	"RETIREDMinimumCoordinateValue":                                  DCMRETIREDMinimumCoordinateValue,
+	"RETIREDMaximumCoordinateValue":                                  DCMRETIREDMaximumCoordinateValue,
+	"RETIREDCurveRange":                                              DCMRETIREDCurveRange,
+	"RETIREDCurveDataDescriptor":                                     DCMRETIREDCurveDataDescriptor,
+	"RETIREDCoordinateStartValue":                                    DCMRETIREDCoordinateStartValue,
+	"RETIREDCoordinateStepValue":                                     DCMRETIREDCoordinateStepValue,
+	"RETIREDCurveActivationLayer":                                    DCMRETIREDCurveActivationLayer,
+	"RETIREDAudioType":                                               DCMRETIREDAudioType,
+	"RETIREDAudioSampleFormat":                                       DCMRETIREDAudioSampleFormat,
+	"RETIREDNumberOfChannels":                                        DCMRETIREDNumberOfChannels,
+	"RETIREDNumberOfSamples":                                         DCMRETIREDNumberOfSamples,
+	"RETIREDSampleRate":                                              DCMRETIREDSampleRate,
+	"RETIREDTotalTime":                                               DCMRETIREDTotalTime,
+	"RETIREDAudioSampleData":                                         DCMRETIREDAudioSampleData,
+	"RETIREDAudioComments":                                           DCMRETIREDAudioComments,
+	"RETIREDCurveLabel":                                              DCMRETIREDCurveLabel,
+	"RETIREDCurveReferencedOverlaySequence":                          DCMRETIREDCurveReferencedOverlaySequence,
+	"RETIREDCurveReferencedOverlayGroup":                             DCMRETIREDCurveReferencedOverlayGroup,
+	"RETIREDCurveData":                                               DCMRETIREDCurveData,
+	"ACRNEMAOverlayFormat":                                           DCMACRNEMAOverlayFormat,
+	"ACRNEMAOverlayLocation":                                         DCMACRNEMAOverlayLocation,
+	"ACRNEMA2COverlayCodeLabel":                                      DCMACRNEMA2COverlayCodeLabel,
+	"ACRNEMA2COverlayNumberOfTables":                                 DCMACRNEMA2COverlayNumberOfTables,
+	"ACRNEMA2COverlayCodeTableLocation":                              DCMACRNEMA2COverlayCodeTableLocation,
+	"ACRNEMA2COverlayBitsForCodeWord":                                DCMACRNEMA2COverlayBitsForCodeWord,
+	"RETIREDOverlayDescriptorGray":                                   DCMRETIREDOverlayDescriptorGray,
+	"RETIREDOverlayDescriptorRed":                                    DCMRETIREDOverlayDescriptorRed,
+	"RETIREDOverlayDescriptorGreen":                                  DCMRETIREDOverlayDescriptorGreen,
+	"RETIREDOverlayDescriptorBlue":                                   DCMRETIREDOverlayDescriptorBlue,
+	"RETIREDOverlaysGray":                                            DCMRETIREDOverlaysGray,
+	"RETIREDOverlaysRed":                                             DCMRETIREDOverlaysRed,
+	"RETIREDOverlaysGreen":                                           DCMRETIREDOverlaysGreen,
+	"RETIREDOverlaysBlue":                                            DCMRETIREDOverlaysBlue,
+	"ACRNEMAOverlayComments":                                         DCMACRNEMAOverlayComments,
+}
@@ -0,0 +1,60 @@
+//go:build ignore
+
+// This program generates dcmkeyword.go from the tags declared in dcdeftag.go. It is
+// run by go generate in the core folder.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "dcdeftag.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// the keywords are kept in the order of dcdeftag.go
+	var names []string
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				if strings.HasPrefix(name.Name, "DCM") {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by dcmkeyword_gen.go from dcdeftag.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package core\n\n")
+	buf.WriteString("// dcmKeywords maps the keywords of the data elements to the tags. The keywords are the\n")
+	buf.WriteString("// names of the tags in dcdeftag.go without the prefix DCM.\n")
+	buf.WriteString("var dcmKeywords = map[string]DcmTag{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %s,\n", strings.TrimPrefix(name, "DCM"), name)
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("dcmkeyword.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
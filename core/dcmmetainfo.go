@@ -108,6 +108,21 @@ func (meta DcmMetaInfo) GetByteOrder() (EByteOrder, error) {
 	return xfer.ByteOrder, nil
 }
 
+// IsDeflated is to check if the data set is compressed with deflate
+func (meta DcmMetaInfo) IsDeflated() (bool, error) {
+	uid, err := meta.getTransferSyntaxUID()
+	if err != nil {
+		return false, err
+	}
+	var xfer DcmXfer
+	xfer.XferID = uid
+	err = xfer.GetDcmXferByID()
+	if err != nil {
+		return false, err
+	}
+	return xfer.IsDeflated(), nil
+}
+
 // FindElement get the element info by given tag
 func (meta DcmMetaInfo) FindElement(e *DcmElement) error {
 	for _, v := range meta.Elements {
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+)
+
+// DcmMappedValue is the value of an element mapped into memory from the file, see
+// DcmElement.MapValue.
+type DcmMappedValue struct {
+	// Bytes is the mapped value, or nil if the value can not be mapped.
+	Bytes []byte
+
+	// Reader reads the value. If Bytes is nil, it reads from the file or the reader
+	// the element is read from.
+	Reader io.ReaderAt
+	Size   int64
+
+	closer func() error
+}
+
+// Close unmaps the value, or closes the file it is read from.
+func (v *DcmMappedValue) Close() error {
+	if v.closer == nil {
+		return nil
+	}
+	err := v.closer()
+	v.closer = nil
+	v.Bytes = nil
+	return err
+}
+
+// MapValue maps the value of the element read from a file into memory without
+// copying it. On the platforms without mmap, or if the element is not read from a
+// file, the value is read with io.ReaderAt instead. The value which is already read
+// is used as it is.
+func (e DcmElement) MapValue() (*DcmMappedValue, error) {
+	if e.IsValueLoaded() {
+		return &DcmMappedValue{Bytes: e.Value, Reader: bytes.NewReader(e.Value), Size: int64(len(e.Value))}, nil
+	}
+	if e.Squence != nil {
+		return nil, errorSequenceNotLoaded(e.Tag)
+	}
+	if e.source == nil {
+		str := "DcmElement: the value of the tag '" + e.Tag.String() + "' can not be loaded"
+		return nil, errors.New(str)
+	}
+	return e.source.mapValue(e.Offset, e.Length)
+}
+
+func (src dcmFileSource) mapValue(offset int64, length int64) (*DcmMappedValue, error) {
+	f, err := os.Open(src.filename)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() < offset+length {
+		f.Close()
+		return nil, errors.New("DcmElement: the value is beyond the end of the file")
+	}
+	b, unmap, err := mmapFile(f, offset, length)
+	if err == nil {
+		f.Close()
+		return &DcmMappedValue{Bytes: b, Reader: bytes.NewReader(b), Size: length, closer: unmap}, nil
+	}
+	// read the value from the file if it can not be mapped
+	return &DcmMappedValue{Reader: io.NewSectionReader(f, offset, length), Size: length, closer: f.Close}, nil
+}
+
+func (src dcmReaderAtSource) mapValue(offset int64, length int64) (*DcmMappedValue, error) {
+	return &DcmMappedValue{Reader: io.NewSectionReader(src.reader, offset, length), Size: length}, nil
+}
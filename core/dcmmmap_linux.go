@@ -0,0 +1,26 @@
+//go:build linux
+
+package core
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// mmapFile maps length bytes of the file from offset into memory, read only.
+func mmapFile(f *os.File, offset int64, length int64) ([]byte, func() error, error) {
+	if length <= 0 || int64(int(length)) != length {
+		return nil, nil, errors.New("mmapFile: invalid length")
+	}
+	// the offset of mmap must be a multiple of the page size
+	delta := offset % int64(os.Getpagesize())
+	b, err := syscall.Mmap(int(f.Fd()), offset-delta, int(length+delta), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	unmap := func() error {
+		return syscall.Munmap(b)
+	}
+	return b[delta : delta+length], unmap, nil
+}
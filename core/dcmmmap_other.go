@@ -0,0 +1,13 @@
+//go:build !linux
+
+package core
+
+import (
+	"errors"
+	"os"
+)
+
+// mmapFile is only supported on Linux, the value is read from the file instead.
+func mmapFile(f *os.File, offset int64, length int64) ([]byte, func() error, error) {
+	return nil, nil, errors.New("mmapFile: not supported")
+}
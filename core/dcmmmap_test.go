@@ -0,0 +1,80 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func TestDcmReaderGetMappedImageInfo(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "US-MONO2-8-8x-execho.dcm",
+		util.GetTestDataFolder() + "MR-MONO2-8-16x-heart.dcm",
+		util.GetTestDataFolder() + "CT-MONO2-16-ankle",
+	}
+	dir, err := ioutil.TempDir("", "godcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, c := range cases {
+		all := readTestFile(t, c)
+		want := all.GetImageInfo()
+		data, _ := ioutil.ReadFile(c)
+
+		var reader DcmReader
+		reader.IsReadValue = true
+		err := reader.ReadFile(c)
+		if err != nil {
+			t.Fatalf("DcmReader.ReadFile(%s): %s", c, err.Error())
+		}
+		img, mapped, err := reader.GetMappedImageInfo()
+		if err != nil {
+			t.Errorf("GetMappedImageInfo(%s): %s", c, err.Error())
+			continue
+		}
+		if runtime.GOOS == "linux" && !bytes.Equal(img.PixelData, want.PixelData) {
+			t.Errorf("GetMappedImageInfo(%s), the mapped pixel data is not the same as the one read", c)
+		}
+
+		var section DcmReader
+		section.IsReadValue = true
+		err = section.ReadSection(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("DcmReader.ReadSection(%s): %s", c, err.Error())
+		}
+		fromReader, _, err := section.GetMappedImageInfo()
+		if err != nil {
+			t.Errorf("GetMappedImageInfo(%s) from io.ReaderAt: %s", c, err.Error())
+			continue
+		}
+		if fromReader.PixelData != nil || fromReader.PixelDataSize != int64(len(want.PixelData)) {
+			t.Errorf("GetMappedImageInfo(%s) from io.ReaderAt, want %d bytes to read got %d", c, len(want.PixelData), fromReader.PixelDataSize)
+		}
+
+		for frame := 0; frame < want.NumberOfFrames; frame++ {
+			name := filepath.Join(dir, filepath.Base(c))
+			want.ConvertToPNG(name+"_want.png", frame)
+			img.ConvertToPNG(name+"_mapped.png", frame)
+			fromReader.ConvertToPNG(name+"_reader.png", frame)
+			w, _ := ioutil.ReadFile(name + "_want.png")
+			m, _ := ioutil.ReadFile(name + "_mapped.png")
+			r, _ := ioutil.ReadFile(name + "_reader.png")
+			if len(w) == 0 || !bytes.Equal(w, m) || !bytes.Equal(w, r) {
+				t.Errorf("GetMappedImageInfo(%s), frame %d is not the same as the one read", c, frame)
+				break
+			}
+		}
+
+		err = mapped.Close()
+		if err != nil {
+			t.Errorf("DcmMappedValue.Close(%s): %s", c, err.Error())
+		}
+	}
+}
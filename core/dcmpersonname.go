@@ -0,0 +1,121 @@
+package core
+
+import (
+	"strings"
+)
+
+// PersonNameGroup is a component group of a PN value, see PS3.5 6.2.1.
+type PersonNameGroup struct {
+	Family string
+	Given  string
+	Middle string
+	Prefix string
+	Suffix string
+}
+
+// PersonName is a PN value with the alphabetic, ideographic and phonetic component
+// groups, e.g. "Yamada^Tarou=山田^太郎=やまだ^たろう".
+type PersonName struct {
+	Alphabetic  PersonNameGroup
+	Ideographic PersonNameGroup
+	Phonetic    PersonNameGroup
+}
+
+// ParsePersonNameGroup parses the components of a group separated by '^'.
+func ParsePersonNameGroup(value string) PersonNameGroup {
+	var components [5]string
+	for i, v := range strings.SplitN(value, "^", 5) {
+		components[i] = strings.TrimSpace(v)
+	}
+	return PersonNameGroup{
+		Family: components[0],
+		Given:  components[1],
+		Middle: components[2],
+		Prefix: components[3],
+		Suffix: components[4],
+	}
+}
+
+// ParsePersonName parses the component groups of a PN value separated by '='.
+func ParsePersonName(value string) PersonName {
+	var groups [3]PersonNameGroup
+	for i, v := range strings.SplitN(strings.TrimRight(value, " \x00"), "=", 3) {
+		groups[i] = ParsePersonNameGroup(v)
+	}
+	return PersonName{Alphabetic: groups[0], Ideographic: groups[1], Phonetic: groups[2]}
+}
+
+// String encodes the group as in a PN value, without the trailing empty components.
+func (g PersonNameGroup) String() string {
+	s := strings.Join([]string{g.Family, g.Given, g.Middle, g.Prefix, g.Suffix}, "^")
+	return strings.TrimRight(s, "^")
+}
+
+// IsEmpty is to check whether all the components are empty.
+func (g PersonNameGroup) IsEmpty() bool {
+	return g == PersonNameGroup{}
+}
+
+// Formatted gets the name to display, e.g. "Dr John Doe Jr" for "Doe^John^^Dr^Jr".
+func (g PersonNameGroup) Formatted() string {
+	var result []string
+	for _, v := range []string{g.Prefix, g.Given, g.Middle, g.Family, g.Suffix} {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+// String encodes the name as a PN value, without the trailing empty groups.
+func (pn PersonName) String() string {
+	s := strings.Join([]string{pn.Alphabetic.String(), pn.Ideographic.String(), pn.Phonetic.String()}, "=")
+	return strings.TrimRight(s, "=")
+}
+
+// IsEmpty is to check whether all the groups are empty.
+func (pn PersonName) IsEmpty() bool {
+	return pn == PersonName{}
+}
+
+// Formatted gets the name to display from the alphabetic group, or from the
+// ideographic or the phonetic group if the alphabetic one is empty.
+func (pn PersonName) Formatted() string {
+	for _, g := range []PersonNameGroup{pn.Alphabetic, pn.Ideographic, pn.Phonetic} {
+		if !g.IsEmpty() {
+			return g.Formatted()
+		}
+	}
+	return ""
+}
+
+// GetPersonNames gets the values of a PN element.
+func (e DcmElement) GetPersonNames() ([]PersonName, error) {
+	if e.VR != "PN" {
+		return nil, e.errorVR("PersonName")
+	}
+	values, err := e.GetStrings()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]PersonName, len(values))
+	for i, v := range values {
+		result[i] = ParsePersonName(v)
+	}
+	return result, nil
+}
+
+// GetPersonName gets the first value of the PN element of the tag.
+func (dataset DcmDataset) GetPersonName(tag DcmTag) (PersonName, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return PersonName{}, err
+	}
+	names, err := elem.GetPersonNames()
+	if err != nil || len(names) == 0 {
+		return PersonName{}, err
+	}
+	return names[0], nil
+}
@@ -0,0 +1,69 @@
+package core
+
+import "testing"
+
+func TestParsePersonName(t *testing.T) {
+	cases := []struct {
+		in        string
+		want      PersonName
+		formatted string
+	}{
+		{"", PersonName{}, ""},
+		{"Doe^John", PersonName{Alphabetic: PersonNameGroup{Family: "Doe", Given: "John"}}, "John Doe"},
+		{"Doe^John^Q^Dr^Jr ", PersonName{Alphabetic: PersonNameGroup{"Doe", "John", "Q", "Dr", "Jr"}}, "Dr John Q Doe Jr"},
+		{"Yamada^Tarou=山田^太郎=やまだ^たろう", PersonName{
+			Alphabetic:  PersonNameGroup{Family: "Yamada", Given: "Tarou"},
+			Ideographic: PersonNameGroup{Family: "山田", Given: "太郎"},
+			Phonetic:    PersonNameGroup{Family: "やまだ", Given: "たろう"},
+		}, "Tarou Yamada"},
+		{"=王^小東", PersonName{Ideographic: PersonNameGroup{Family: "王", Given: "小東"}}, "小東 王"},
+	}
+	for _, c := range cases {
+		got := ParsePersonName(c.in)
+		if got != c.want {
+			t.Errorf("ParsePersonName(%s), want %+v got %+v", c.in, c.want, got)
+		}
+		if got.Formatted() != c.formatted {
+			t.Errorf("Formatted(%s), want '%s' got '%s'", c.in, c.formatted, got.Formatted())
+		}
+		if got.String() != c.in && got.String()+" " != c.in {
+			t.Errorf("String(%s), got '%s'", c.in, got.String())
+		}
+	}
+}
+
+func TestDcmDatasetPersonName(t *testing.T) {
+	want := PersonName{
+		Alphabetic:  PersonNameGroup{Family: "Hong", Given: "Gildong"},
+		Ideographic: PersonNameGroup{Family: "洪", Given: "吉洞"},
+		Phonetic:    PersonNameGroup{Family: "홍", Given: "길동"},
+	}
+	var dataset DcmDataset
+	err := dataset.Set(DCMSpecificCharacterSet, "", "\\ISO 2022 IR 149")
+	if err != nil {
+		t.Fatalf("Set(SpecificCharacterSet): %s", err.Error())
+	}
+	err = dataset.Set(DCMPatientName, "", want)
+	if err != nil {
+		t.Fatalf("Set(PatientName): %s", err.Error())
+	}
+	got, err := dataset.GetPersonName(DCMPatientName)
+	if err != nil {
+		t.Fatalf("GetPersonName(): %s", err.Error())
+	}
+	if got != want {
+		t.Errorf("GetPersonName(), want %+v got %+v", want, got)
+	}
+
+	err = dataset.Set(DCMOtherPatientNames, "", []PersonName{want, ParsePersonName("Doe^John")})
+	if err != nil {
+		t.Fatalf("Set(OtherPatientNames): %s", err.Error())
+	}
+	var elem DcmElement
+	elem.Tag = DCMOtherPatientNames
+	dataset.FindElement(&elem)
+	names, err := elem.GetPersonNames()
+	if err != nil || len(names) != 2 || names[1].Alphabetic.Given != "John" {
+		t.Errorf("GetPersonNames(), got %+v (%v)", names, err)
+	}
+}
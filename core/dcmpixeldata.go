@@ -0,0 +1,239 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// EncapsulatedPixelData contains the items of encapsulated (compressed) pixel data.
+// The transfer syntaxes with encapsulated pixel data are always little endian.
+type EncapsulatedPixelData struct {
+	// BasicOffsetTable contains the offset of the first fragment of each frame, measured
+	// from the first byte of the item tag of the first fragment. It may be empty.
+	BasicOffsetTable []uint32
+
+	// ExtendedOffsetTable (7FE0,0001) and ExtendedOffsetTableLengths (7FE0,0002) are
+	// used instead of the Basic Offset Table if present.
+	ExtendedOffsetTable        []uint64
+	ExtendedOffsetTableLengths []uint64
+
+	// Fragments contains the values of the items following the Basic Offset Table.
+	Fragments [][]byte
+
+	// NumberOfFrames is read from (0028,0008), the default is 1.
+	NumberOfFrames int
+
+	// the indexes of the fragments of each frame
+	frames [][]int
+}
+
+// EncapsulatedPixelData gets the Basic Offset Table and the fragments of the pixel data.
+func (dataset DcmDataset) EncapsulatedPixelData() (*EncapsulatedPixelData, error) {
+	var elem DcmElement
+	elem.Tag = DCMPixelData
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil, err
+	}
+	if elem.Squence == nil {
+		return nil, errors.New("EncapsulatedPixelData: the pixel data is not encapsulated")
+	}
+
+	var pd EncapsulatedPixelData
+	isFoundBOT := false
+	for _, item := range elem.Squence.Item {
+		if item.Tag != DCMItem {
+			continue
+		}
+		if !isFoundBOT {
+			// the first item is always the Basic Offset Table
+			pd.BasicOffsetTable = make([]uint32, len(item.Value)/4)
+			for i := range pd.BasicOffsetTable {
+				pd.BasicOffsetTable[i] = binary.LittleEndian.Uint32(item.Value[4*i:])
+			}
+			isFoundBOT = true
+			continue
+		}
+		pd.Fragments = append(pd.Fragments, item.Value)
+	}
+
+	pd.ExtendedOffsetTable = dataset.getUint64s(DCMExtendedOffsetTable)
+	pd.ExtendedOffsetTableLengths = dataset.getUint64s(DCMExtendedOffsetTableLengths)
+
+	num, err := strconv.Atoi(dataset.NumberOfFrames())
+	if err != nil || num < 1 {
+		num = 1
+	}
+	pd.NumberOfFrames = num
+	return &pd, nil
+}
+
+// getUint64s gets the values of an OV element.
+func (dataset DcmDataset) getUint64s(tag DcmTag) []uint64 {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil
+	}
+	result := make([]uint64, len(elem.Value)/8)
+	for i := range result {
+		result[i] = binary.LittleEndian.Uint64(elem.Value[8*i:])
+	}
+	return result
+}
+
+// FrameFragments gets the fragments of the given frame, starting from 0.
+// The fragments are grouped into frames by the Extended Offset Table, the Basic
+// Offset Table, or by scanning the JPEG markers if both of the tables are empty.
+func (pd *EncapsulatedPixelData) FrameFragments(frame int) ([][]byte, error) {
+	if pd.frames == nil {
+		err := pd.groupFragments()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if frame < 0 || frame >= len(pd.frames) {
+		str := fmt.Sprintf("FrameFragments: frame %d out of range, the number of frames is %d", frame, len(pd.frames))
+		return nil, errors.New(str)
+	}
+	var result [][]byte
+	for _, i := range pd.frames[frame] {
+		result = append(result, pd.Fragments[i])
+	}
+	return result, nil
+}
+
+// Frame gets the compressed bit stream of the given frame, starting from 0.
+func (pd *EncapsulatedPixelData) Frame(frame int) ([]byte, error) {
+	fragments, err := pd.FrameFragments(frame)
+	if err != nil {
+		return nil, err
+	}
+	if len(fragments) == 1 {
+		return fragments[0], nil
+	}
+	return bytes.Join(fragments, nil), nil
+}
+
+// NumberOfFramesFound gets the number of frames the fragments are grouped into.
+func (pd *EncapsulatedPixelData) NumberOfFramesFound() (int, error) {
+	if pd.frames == nil {
+		err := pd.groupFragments()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(pd.frames), nil
+}
+
+func (pd *EncapsulatedPixelData) groupFragments() error {
+	if len(pd.Fragments) == 0 {
+		return errors.New("EncapsulatedPixelData: no fragment is found")
+	}
+	var err error
+	switch {
+	case len(pd.ExtendedOffsetTable) > 0:
+		pd.frames, err = pd.groupByOffsets(pd.ExtendedOffsetTable)
+	case len(pd.BasicOffsetTable) > 0:
+		offsets := make([]uint64, len(pd.BasicOffsetTable))
+		for i, v := range pd.BasicOffsetTable {
+			offsets[i] = uint64(v)
+		}
+		pd.frames, err = pd.groupByOffsets(offsets)
+	case pd.NumberOfFrames == 1:
+		pd.frames = [][]int{pd.fragmentRange(0, len(pd.Fragments))}
+	case pd.NumberOfFrames == len(pd.Fragments):
+		for i := range pd.Fragments {
+			pd.frames = append(pd.frames, []int{i})
+		}
+	default:
+		pd.frames, err = pd.groupByMarkers()
+	}
+	return err
+}
+
+func (pd EncapsulatedPixelData) fragmentRange(start int, end int) []int {
+	var result []int
+	for i := start; i < end; i++ {
+		result = append(result, i)
+	}
+	return result
+}
+
+// groupByOffsets groups the fragments by the offsets of the first fragment of each frame.
+func (pd EncapsulatedPixelData) groupByOffsets(offsets []uint64) ([][]int, error) {
+	// the offset of each fragment item, including the 8 bytes item tag and length
+	index := make(map[uint64]int)
+	var pos uint64
+	for i, v := range pd.Fragments {
+		index[pos] = i
+		pos += 8 + uint64(len(v))
+	}
+
+	var starts []int
+	for _, offset := range offsets {
+		i, ok := index[offset]
+		if !ok {
+			str := fmt.Sprintf("EncapsulatedPixelData: offset %d does not point to a fragment", offset)
+			return nil, errors.New(str)
+		}
+		starts = append(starts, i)
+	}
+
+	var result [][]int
+	for n, start := range starts {
+		end := len(pd.Fragments)
+		if n+1 < len(starts) {
+			end = starts[n+1]
+		}
+		if end <= start {
+			return nil, errors.New("EncapsulatedPixelData: the offsets are not in ascending order")
+		}
+		result = append(result, pd.fragmentRange(start, end))
+	}
+	return result, nil
+}
+
+// groupByMarkers starts a new frame at each fragment which begins with the JPEG SOI
+// marker or the JPEG 2000 SOC marker.
+func (pd EncapsulatedPixelData) groupByMarkers() ([][]int, error) {
+	var result [][]int
+	for i, v := range pd.Fragments {
+		isStart := len(v) >= 2 && v[0] == 0xFF && (v[1] == 0xD8 || v[1] == 0x4F)
+		if isStart || len(result) == 0 {
+			result = append(result, []int{i})
+			continue
+		}
+		result[len(result)-1] = append(result[len(result)-1], i)
+	}
+	if len(result) != pd.NumberOfFrames {
+		str := fmt.Sprintf("EncapsulatedPixelData: found %d frames in the fragments, want %d", len(result), pd.NumberOfFrames)
+		return nil, errors.New(str)
+	}
+	return result, nil
+}
+
+// EncapsulatedFrames gets the compressed bit stream of each frame.
+func (dataset DcmDataset) EncapsulatedFrames() ([][]byte, error) {
+	pd, err := dataset.EncapsulatedPixelData()
+	if err != nil {
+		return nil, err
+	}
+	num, err := pd.NumberOfFramesFound()
+	if err != nil {
+		return nil, err
+	}
+	var result [][]byte
+	for i := 0; i < num; i++ {
+		frame, err := pd.Frame(i)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, frame)
+	}
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func newTestEncapsulatedDataset(frames string, bot []uint32, eot []uint64, fragments ...[]byte) DcmDataset {
+	var dataset DcmDataset
+	if frames != "" {
+		dataset.Elements = append(dataset.Elements, DcmElement{Tag: DCMNumberOfFrames, VR: "IS", Value: []byte(frames)})
+	}
+	if eot != nil {
+		value := make([]byte, 8*len(eot))
+		for i, v := range eot {
+			binary.LittleEndian.PutUint64(value[8*i:], v)
+		}
+		dataset.Elements = append(dataset.Elements, DcmElement{Tag: DCMExtendedOffsetTable, VR: "OV", Value: value})
+	}
+
+	sq := new(DcmSQElement)
+	table := make([]byte, 4*len(bot))
+	for i, v := range bot {
+		binary.LittleEndian.PutUint32(table[4*i:], v)
+	}
+	sq.Item = append(sq.Item, DcmElement{Tag: DCMItem, Length: int64(len(table)), Value: table})
+	for _, v := range fragments {
+		sq.Item = append(sq.Item, DcmElement{Tag: DCMItem, Length: int64(len(v)), Value: v})
+	}
+	sq.Item = append(sq.Item, DcmElement{Tag: DCMSequenceDelimitationItem})
+	dataset.Elements = append(dataset.Elements, DcmElement{Tag: DCMPixelData, VR: "OB", Length: 0xFFFFFFFF, Squence: sq})
+	return dataset
+}
+
+func TestEncapsulatedPixelDataFrameFragments(t *testing.T) {
+	a1 := []byte{0xFF, 0xD8, 0x01, 0x02}
+	a2 := []byte{0x03, 0x04}
+	b := []byte{0xFF, 0xD8, 0x05, 0x06, 0x07, 0x08}
+	c1 := []byte{0xFF, 0x4F, 0x09, 0x0A}
+	c2 := []byte{0x0B, 0x0C}
+
+	cases := []struct {
+		name    string
+		dataset DcmDataset
+		want    [][]byte
+	}{
+		{"basic offset table",
+			newTestEncapsulatedDataset("3", []uint32{0, 22, 36}, nil, a1, a2, b, c1, c2),
+			[][]byte{{0xFF, 0xD8, 0x01, 0x02, 0x03, 0x04}, b, {0xFF, 0x4F, 0x09, 0x0A, 0x0B, 0x0C}}},
+		{"extended offset table",
+			newTestEncapsulatedDataset("3", nil, []uint64{0, 22, 36}, a1, a2, b, c1, c2),
+			[][]byte{{0xFF, 0xD8, 0x01, 0x02, 0x03, 0x04}, b, {0xFF, 0x4F, 0x09, 0x0A, 0x0B, 0x0C}}},
+		{"JPEG markers",
+			newTestEncapsulatedDataset("3", nil, nil, a1, a2, b, c1, c2),
+			[][]byte{{0xFF, 0xD8, 0x01, 0x02, 0x03, 0x04}, b, {0xFF, 0x4F, 0x09, 0x0A, 0x0B, 0x0C}}},
+		{"one fragment per frame",
+			newTestEncapsulatedDataset("2", nil, nil, a2, c2),
+			[][]byte{a2, c2}},
+		{"single frame",
+			newTestEncapsulatedDataset("", nil, nil, a1, a2),
+			[][]byte{{0xFF, 0xD8, 0x01, 0x02, 0x03, 0x04}}},
+	}
+	for _, c := range cases {
+		pd, err := c.dataset.EncapsulatedPixelData()
+		if err != nil {
+			t.Errorf("%s: EncapsulatedPixelData(): %s", c.name, err.Error())
+			continue
+		}
+		num, err := pd.NumberOfFramesFound()
+		if err != nil || num != len(c.want) {
+			t.Errorf("%s: NumberOfFramesFound(), want %d got %d (%v)", c.name, len(c.want), num, err)
+			continue
+		}
+		for i, want := range c.want {
+			got, err := pd.Frame(i)
+			if err != nil {
+				t.Errorf("%s: Frame(%d): %s", c.name, i, err.Error())
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: Frame(%d), want %x got %x", c.name, i, want, got)
+			}
+		}
+		_, err = pd.FrameFragments(len(c.want))
+		if err == nil {
+			t.Errorf("%s: FrameFragments(%d) should be out of range", c.name, len(c.want))
+		}
+	}
+}
+
+func TestEncapsulatedPixelDataErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		dataset DcmDataset
+	}{
+		{"offset not pointing to a fragment", newTestEncapsulatedDataset("2", []uint32{0, 5}, nil, []byte{0x01, 0x02}, []byte{0x03, 0x04})},
+		{"missing JPEG markers", newTestEncapsulatedDataset("2", nil, nil, []byte{0xFF, 0xD8}, []byte{0x01, 0x02}, []byte{0x03, 0x04})},
+		{"no fragment", newTestEncapsulatedDataset("1", nil, nil)},
+	}
+	for _, c := range cases {
+		pd, err := c.dataset.EncapsulatedPixelData()
+		if err != nil {
+			t.Errorf("%s: EncapsulatedPixelData(): %s", c.name, err.Error())
+			continue
+		}
+		_, err = pd.FrameFragments(0)
+		if err == nil {
+			t.Errorf("%s: FrameFragments(0) should fail", c.name)
+		}
+	}
+
+	var native DcmDataset
+	native.Elements = append(native.Elements, DcmElement{Tag: DCMPixelData, VR: "OW", Value: []byte{0x00, 0x01}})
+	_, err := native.EncapsulatedPixelData()
+	if err == nil {
+		t.Errorf("EncapsulatedPixelData() should fail for native pixel data")
+	}
+}
+
+func TestEncapsulatedPixelDataFile(t *testing.T) {
+	cases := []struct {
+		in      string
+		botSize int
+	}{
+		{util.GetTestDataFolder() + "xr_chicken2.dcm", 0},
+		{util.GetTestDataFolder() + "IM-0001-0010.dcm", 1},
+		{util.GetTestDataFolder() + "GH133.dcm", 1},
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, c.in)
+		pd, err := reader.Dataset.EncapsulatedPixelData()
+		if err != nil {
+			t.Errorf("EncapsulatedPixelData() %s: %s", c.in, err.Error())
+			continue
+		}
+		if len(pd.BasicOffsetTable) != c.botSize {
+			t.Errorf("BasicOffsetTable %s, want %d entries got %d", c.in, c.botSize, len(pd.BasicOffsetTable))
+		}
+		frame, err := pd.Frame(0)
+		if err != nil {
+			t.Errorf("Frame(0) %s: %s", c.in, err.Error())
+			continue
+		}
+		if !bytes.Equal(frame, reader.Dataset.PixelData()) {
+			t.Errorf("Frame(0) %s, the frame is not the same as PixelData()", c.in)
+		}
+	}
+}
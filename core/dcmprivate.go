@@ -0,0 +1,194 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// IsPrivate is to check whether the tag is in a private group. The odd groups 0x0001-0x0007
+// are not allowed to be private.
+func (t DcmTag) IsPrivate() bool {
+	return t.Group%2 == 1 && t.Group > 0x0008
+}
+
+// IsPrivateCreator is to check whether the tag is a Private Creator (gggg,0010-00FF),
+// which reserves the private elements (gggg,xx00-xxFF).
+func (t DcmTag) IsPrivateCreator() bool {
+	return t.IsPrivate() && t.Element >= 0x0010 && t.Element <= 0x00ff
+}
+
+// privateCreatorTag gets the tag of the Private Creator reserving the private element.
+func (t DcmTag) privateCreatorTag() DcmTag {
+	return DcmTag{t.Group, t.Element >> 8}
+}
+
+// dcmPrivateCreators contains the Private Creators read from a data set by tag. The
+// reservations are valid in the data set only, so each item has its own.
+type dcmPrivateCreators map[DcmTag]string
+
+// update records the Private Creator of the element.
+func (creators dcmPrivateCreators) update(e DcmElement) {
+	if creators == nil || !e.Tag.IsPrivateCreator() {
+		return
+	}
+	creators[e.Tag] = strings.TrimRight(string(e.Value), " \x00")
+}
+
+// find gets the Private Creator of the private element, or an empty string if the
+// block of the element is not reserved.
+func (creators dcmPrivateCreators) find(tag DcmTag) string {
+	if !tag.IsPrivate() || tag.Element < 0x1000 {
+		return ""
+	}
+	return creators[tag.privateCreatorTag()]
+}
+
+// DcmPrivateElement is the registry information of a private data element. The element
+// of Tag is the offset in the reserved block, 0x0000-0x00FF, e.g. (0029,0010) for the
+// element (0029,xx10) reserved by the PrivateCreator.
+type DcmPrivateElement struct {
+	PrivateCreator string
+	Tag            DcmTag
+	Name           string
+	VR             string
+}
+
+type dcmPrivateKey struct {
+	creator string
+	tag     DcmTag
+}
+
+var (
+	privateRegistryByKey map[dcmPrivateKey]DcmPrivateElement
+	privateRegistryOnce  sync.Once
+	privateRegistryMutex sync.RWMutex
+)
+
+// privateRegistryIndex gets the entries of DcmPrivateElementRegistry and the registered
+// private elements by creator and tag. The index is guarded by privateRegistryMutex.
+func privateRegistryIndex() map[dcmPrivateKey]DcmPrivateElement {
+	privateRegistryOnce.Do(func() {
+		privateRegistryByKey = make(map[dcmPrivateKey]DcmPrivateElement, len(DcmPrivateElementRegistry))
+		for _, v := range DcmPrivateElementRegistry {
+			privateRegistryByKey[v.key()] = v
+		}
+	})
+	return privateRegistryByKey
+}
+
+func (p DcmPrivateElement) key() dcmPrivateKey {
+	return dcmPrivateKey{p.PrivateCreator, DcmTag{p.Tag.Group, p.Tag.Element & 0x00ff}}
+}
+
+// RegisterPrivateElement adds the private element to the private dictionary, or replaces
+// the entry of the same creator and tag.
+func RegisterPrivateElement(p DcmPrivateElement) {
+	index := privateRegistryIndex()
+	privateRegistryMutex.Lock()
+	defer privateRegistryMutex.Unlock()
+	index[p.key()] = p
+}
+
+// FindDcmPrivateElement gets the registry information of the private element reserved by
+// the creator. The tag may be given either as the element in the file, e.g. (0029,1010),
+// or as the offset in the block, e.g. (0029,0010).
+func FindDcmPrivateElement(creator string, tag DcmTag) (DcmPrivateElement, error) {
+	p := DcmPrivateElement{PrivateCreator: creator, Tag: tag}
+	index := privateRegistryIndex()
+	privateRegistryMutex.RLock()
+	v, ok := index[p.key()]
+	privateRegistryMutex.RUnlock()
+	if !ok {
+		str := "not find the private tag '" + tag.String() + "' of '" + creator + "' in the private dictionary"
+		return p, errors.New(str)
+	}
+	return v, nil
+}
+
+// LoadPrivateDictionary is to load the private elements of a DCMTK data dictionary, e.g.
+// private.dic, into the private dictionary. The entries look like
+//
+//	(0029,"SIEMENS CSA HEADER",08)	CS	CSAImageHeaderType	1	PrivateTag
+//
+// The lines without a private creator are ignored.
+func LoadPrivateDictionary(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || !strings.Contains(text, "\"") {
+			continue
+		}
+		entry, err := parseDcmtkDictionaryEntry(text)
+		if err == nil && !entry.Tag.IsPrivate() {
+			err = errors.New("the group of '" + entry.Tag.String() + "' is not private")
+		}
+		if err != nil {
+			str := fmt.Sprintf("line %d: %s", line, err.Error())
+			return errors.New(str)
+		}
+		RegisterDictionaryEntry(entry)
+	}
+	return scanner.Err()
+}
+
+// LoadPrivateDictionaryFile is to load the private elements of a DCMTK data dictionary file.
+func LoadPrivateDictionaryFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadPrivateDictionary(f)
+}
+
+// DcmPrivateElementRegistry contains the built-in private dictionary of the common vendors.
+// It is indexed on the first lookup; use RegisterPrivateElement or LoadPrivateDictionary to
+// extend it afterwards.
+var DcmPrivateElementRegistry = []DcmPrivateElement{
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0008}, Name: "CSA Image Header Type", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0009}, Name: "CSA Image Header Version", VR: "LO"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0010}, Name: "CSA Image Header Info", VR: "OB"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0018}, Name: "CSA Series Header Type", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0019}, Name: "CSA Series Header Version", VR: "LO"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS CSA HEADER", Tag: DcmTag{0x0029, 0x0020}, Name: "CSA Series Header Info", VR: "OB"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x0008}, Name: "CSA Image Header Type", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x0009}, Name: "CSA Image Header Version", VR: "LO"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x000a}, Name: "Number of Images in Mosaic", VR: "US"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x000b}, Name: "Slice Measurement Duration", VR: "DS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x000c}, Name: "B Value", VR: "IS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x000d}, Name: "Diffusion Directionality", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x000e}, Name: "Diffusion Gradient Direction", VR: "FD"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x0027}, Name: "B Matrix", VR: "FD"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x0028}, Name: "Bandwidth per Pixel Phase Encode", VR: "FD"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0019, 0x0029}, Name: "Mosaic Refers Acquisition Times", VR: "FD"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0051, 0x0008}, Name: "CSA Image Header Type", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "SIEMENS MR HEADER", Tag: DcmTag{0x0051, 0x0009}, Name: "CSA Image Header Version", VR: "LO"},
+
+	DcmPrivateElement{PrivateCreator: "GEMS_IDEN_01", Tag: DcmTag{0x0009, 0x0001}, Name: "Full Fidelity", VR: "LO"},
+	DcmPrivateElement{PrivateCreator: "GEMS_IDEN_01", Tag: DcmTag{0x0009, 0x0002}, Name: "Suite Id", VR: "SH"},
+	DcmPrivateElement{PrivateCreator: "GEMS_IDEN_01", Tag: DcmTag{0x0009, 0x0004}, Name: "Product Id", VR: "SH"},
+	DcmPrivateElement{PrivateCreator: "GEMS_ACQU_01", Tag: DcmTag{0x0019, 0x00bb}, Name: "User Data 20", VR: "DS"},
+	DcmPrivateElement{PrivateCreator: "GEMS_ACQU_01", Tag: DcmTag{0x0019, 0x00bc}, Name: "User Data 21", VR: "DS"},
+	DcmPrivateElement{PrivateCreator: "GEMS_ACQU_01", Tag: DcmTag{0x0019, 0x00bd}, Name: "User Data 22", VR: "DS"},
+	DcmPrivateElement{PrivateCreator: "GEMS_RELA_01", Tag: DcmTag{0x0021, 0x0003}, Name: "Series from which Prescribed", VR: "SS"},
+	DcmPrivateElement{PrivateCreator: "GEMS_IMAG_01", Tag: DcmTag{0x0027, 0x0035}, Name: "Plane Type", VR: "SS"},
+	DcmPrivateElement{PrivateCreator: "GEMS_PARM_01", Tag: DcmTag{0x0043, 0x0039}, Name: "Slop Int 6-9", VR: "IS"},
+
+	DcmPrivateElement{PrivateCreator: "Philips Imaging DD 001", Tag: DcmTag{0x2001, 0x0003}, Name: "Diffusion B-Factor", VR: "FL"},
+	DcmPrivateElement{PrivateCreator: "Philips Imaging DD 001", Tag: DcmTag{0x2001, 0x0004}, Name: "Diffusion Direction", VR: "CS"},
+	DcmPrivateElement{PrivateCreator: "Philips Imaging DD 001", Tag: DcmTag{0x2001, 0x0008}, Name: "Phase Number", VR: "IS"},
+	DcmPrivateElement{PrivateCreator: "Philips Imaging DD 001", Tag: DcmTag{0x2001, 0x000a}, Name: "Slice Number MR", VR: "IS"},
+	DcmPrivateElement{PrivateCreator: "Philips Imaging DD 001", Tag: DcmTag{0x2001, 0x0018}, Name: "Number of Slices MR", VR: "SL"},
+	DcmPrivateElement{PrivateCreator: "Philips MR Imaging DD 001", Tag: DcmTag{0x2005, 0x000d}, Name: "Scale Intercept", VR: "FL"},
+	DcmPrivateElement{PrivateCreator: "Philips MR Imaging DD 001", Tag: DcmTag{0x2005, 0x000e}, Name: "Scale Slope", VR: "FL"},
+	DcmPrivateElement{PrivateCreator: "Philips MR Imaging DD 001", Tag: DcmTag{0x2005, 0x00b0}, Name: "Diffusion Direction RL", VR: "FL"},
+	DcmPrivateElement{PrivateCreator: "Philips MR Imaging DD 001", Tag: DcmTag{0x2005, 0x00b1}, Name: "Diffusion Direction AP", VR: "FL"},
+	DcmPrivateElement{PrivateCreator: "Philips MR Imaging DD 001", Tag: DcmTag{0x2005, 0x00b2}, Name: "Diffusion Direction FH", VR: "FL"},
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDcmDatasetReadPrivateCreator(t *testing.T) {
+	var dataset DcmDataset
+	dataset.Set(DcmTag{0x0029, 0x0010}, "LO", "SIEMENS CSA HEADER")
+	dataset.Set(DcmTag{0x0029, 0x0011}, "LO", "UNKNOWN CREATOR")
+	dataset.Set(DcmTag{0x0029, 0x1008}, "CS", "IMAGE NUM 4")
+	dataset.Set(DcmTag{0x0029, 0x1010}, "OB", []byte{1, 2, 3, 4})
+	dataset.Set(DcmTag{0x0029, 0x1108}, "CS", "OTHER")
+	dataset.Set(DcmTag{0x0029, 0x1208}, "CS", "FREE")
+
+	for _, isExplicitVR := range []bool{true, false} {
+		enc := dcmEncoder{isExplicitVR: isExplicitVR, byteOrder: EBOLittleEndian}
+		b, err := enc.encodeDataset(dataset.Elements)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got DcmDataset
+		err = got.Read(newDcmBufferStream(b), isExplicitVR, EBOLittleEndian, false, false)
+		if err != nil {
+			t.Fatalf("Read() explicit VR %v: %s", isExplicitVR, err.Error())
+		}
+
+		cases := []struct {
+			in      DcmTag
+			creator string
+			vr      string
+		}{
+			{DcmTag{0x0029, 0x0010}, "", "LO"},
+			{DcmTag{0x0029, 0x1008}, "SIEMENS CSA HEADER", "CS"},
+			{DcmTag{0x0029, 0x1010}, "SIEMENS CSA HEADER", "OB"},
+			{DcmTag{0x0029, 0x1108}, "UNKNOWN CREATOR", "CS"},
+			{DcmTag{0x0029, 0x1208}, "", "CS"},
+		}
+		for _, c := range cases {
+			elem := DcmElement{Tag: c.in}
+			err = got.FindElement(&elem)
+			if err != nil {
+				t.Errorf("FindElement(%s) explicit VR %v: %s", c.in, isExplicitVR, err.Error())
+				continue
+			}
+			// the VR of the private elements is unknown in implicit VR without a dictionary entry
+			vr := c.vr
+			if !isExplicitVR && c.creator != "SIEMENS CSA HEADER" && c.in.Element >= 0x1000 {
+				vr = ""
+			}
+			if elem.PrivateCreator != c.creator || elem.VR != vr {
+				t.Errorf("FindElement(%s) explicit VR %v, want '%s' %s got '%s' %s", c.in, isExplicitVR, c.creator, vr, elem.PrivateCreator, elem.VR)
+			}
+		}
+	}
+}
+
+func TestLoadPrivateDictionary(t *testing.T) {
+	restoreDictionary(t)
+	dict := `# test dictionary
+(0009,"ACME 1.1",10)	LO	AcmeScannerName	1	PrivateTag
+(0009,"ACME, INC",20)	US	AcmeSliceCount	1	PrivateTag
+(0010,0010)	PN	PatientName	1	dicom`
+	err := LoadPrivateDictionary(strings.NewReader(dict))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		creator string
+		in      DcmTag
+		name    string
+		vr      string
+		isError bool
+	}{
+		{"ACME 1.1", DcmTag{0x0009, 0x1010}, "AcmeScannerName", "LO", false},
+		{"ACME 1.1", DcmTag{0x0009, 0x0010}, "AcmeScannerName", "LO", false},
+		{"ACME, INC", DcmTag{0x0009, 0x1120}, "AcmeSliceCount", "US", false},
+		{"ACME 1.1", DcmTag{0x0009, 0x1020}, "", "", true},
+		{"GEMS_IDEN_01", DcmTag{0x0009, 0x1001}, "Full Fidelity", "LO", false},
+		{"Philips MR Imaging DD 001", DcmTag{0x2005, 0x100e}, "Scale Slope", "FL", false},
+	}
+	for _, c := range cases {
+		got, err := FindDcmPrivateElement(c.creator, c.in)
+		if (err != nil) != c.isError || (!c.isError && (got.Name != c.name || got.VR != c.vr)) {
+			t.Errorf("FindDcmPrivateElement(%s, %s), want %s %s got %s %s (%v)", c.creator, c.in, c.name, c.vr, got.Name, got.VR, err)
+		}
+	}
+
+	for _, in := range []string{
+		`(0009,"ACME,10)	LO	AcmeScannerName	1	PrivateTag`,
+		`(0008,"ACME",10)	LO	AcmeScannerName	1	PrivateTag`,
+		`(0009,"ACME",1x)	LO	AcmeScannerName	1	PrivateTag`,
+		`(0009,"ACME",10)`,
+	} {
+		if LoadPrivateDictionary(strings.NewReader(in)) == nil {
+			t.Errorf("LoadPrivateDictionary(%s) should fail", in)
+		}
+	}
+}
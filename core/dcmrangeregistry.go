@@ -0,0 +1,135 @@
+package core
+
+import "github.com/grayzone/godcm/dcmdata"
+
+// DcmRangeElement is the registry information of the data elements with repeating
+// groups or elements, e.g. the overlays (60xx,eeee). It follows DcmDictEntry of the
+// dcmdata package: Tag is the lower limit of the range, and UpperTag the upper limit.
+type DcmRangeElement struct {
+	Tag                DcmTag
+	UpperTag           DcmTag
+	GroupRestriction   dcmdata.DcmDictRangeRestriction
+	ElementRestriction dcmdata.DcmDictRangeRestriction
+	Name               string
+	VR                 string
+}
+
+// Contains is to check whether the tag is in the range.
+func (r DcmRangeElement) Contains(tag DcmTag) bool {
+	if !rangeAllows(r.GroupRestriction, tag.Group) || !rangeAllows(r.ElementRestriction, tag.Element) {
+		return false
+	}
+	return tag.Group >= r.Tag.Group && tag.Group <= r.UpperTag.Group &&
+		tag.Element >= r.Tag.Element && tag.Element <= r.UpperTag.Element
+}
+
+// rangeAllows is to check whether the group or element v is allowed by the restriction.
+func rangeAllows(restriction dcmdata.DcmDictRangeRestriction, v uint16) bool {
+	switch restriction {
+	case dcmdata.DcmDictRange_Odd:
+		return v%2 == 1
+	case dcmdata.DcmDictRange_Even:
+		return v%2 == 0
+	}
+	return true
+}
+
+// findDcmRangeElement gets the first entry of DcmRangeElementRegistry containing the tag.
+// The caller must hold dictionaryMutex.
+func findDcmRangeElement(tag DcmTag) (DcmRangeElement, bool) {
+	for _, v := range DcmRangeElementRegistry {
+		if v.Contains(tag) {
+			return v, true
+		}
+	}
+	return DcmRangeElement{}, false
+}
+
+// DcmRangeElementRegistry contains the Registry of DICOM Data Elements with repeating
+// groups or elements. The ranges follow the DCMTK data dictionary: a group range without
+// restriction means the even groups. The first entry containing a tag wins, so the
+// narrower ranges are listed first.
+var DcmRangeElementRegistry = []DcmRangeElement{
+	DcmRangeElement{Tag: DcmTag{0x0020, 0x3100}, UpperTag: DcmTag{0x0020, 0x31ff}, ElementRestriction: dcmdata.DcmDictRange_Even, Name: "Source Image IDs", VR: "CS"},
+
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0005}, UpperTag: DcmTag{0x50ff, 0x0005}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Dimensions", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0010}, UpperTag: DcmTag{0x50ff, 0x0010}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Number of Points", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0020}, UpperTag: DcmTag{0x50ff, 0x0020}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Type of Data", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0022}, UpperTag: DcmTag{0x50ff, 0x0022}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Description", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0030}, UpperTag: DcmTag{0x50ff, 0x0030}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Axis Units", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0040}, UpperTag: DcmTag{0x50ff, 0x0040}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Axis Labels", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0103}, UpperTag: DcmTag{0x50ff, 0x0103}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Data Value Representation", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0104}, UpperTag: DcmTag{0x50ff, 0x0104}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Minimum Coordinate Value", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0105}, UpperTag: DcmTag{0x50ff, 0x0105}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Maximum Coordinate Value", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0106}, UpperTag: DcmTag{0x50ff, 0x0106}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Range", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0110}, UpperTag: DcmTag{0x50ff, 0x0110}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Data Descriptor", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0112}, UpperTag: DcmTag{0x50ff, 0x0112}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Coordinate Start Value", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x0114}, UpperTag: DcmTag{0x50ff, 0x0114}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Coordinate Step Value", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x1001}, UpperTag: DcmTag{0x50ff, 0x1001}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Activation Layer", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2000}, UpperTag: DcmTag{0x50ff, 0x2000}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Audio Type", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2002}, UpperTag: DcmTag{0x50ff, 0x2002}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Audio Sample Format", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2004}, UpperTag: DcmTag{0x50ff, 0x2004}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Number of Channels", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2006}, UpperTag: DcmTag{0x50ff, 0x2006}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Number of Samples", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2008}, UpperTag: DcmTag{0x50ff, 0x2008}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Sample Rate", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x200a}, UpperTag: DcmTag{0x50ff, 0x200a}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Total Time", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x200c}, UpperTag: DcmTag{0x50ff, 0x200c}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Audio Sample Data", VR: "OB or OW"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x200e}, UpperTag: DcmTag{0x50ff, 0x200e}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Audio Comments", VR: "LT"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2500}, UpperTag: DcmTag{0x50ff, 0x2500}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Label", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2600}, UpperTag: DcmTag{0x50ff, 0x2600}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Referenced Overlay Sequence", VR: "SQ"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x2610}, UpperTag: DcmTag{0x50ff, 0x2610}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Referenced Overlay Group", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x5000, 0x3000}, UpperTag: DcmTag{0x50ff, 0x3000}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Curve Data", VR: "OB or OW"},
+
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0010}, UpperTag: DcmTag{0x60ff, 0x0010}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Rows", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0011}, UpperTag: DcmTag{0x60ff, 0x0011}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Columns", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0012}, UpperTag: DcmTag{0x60ff, 0x0012}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Planes", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0015}, UpperTag: DcmTag{0x60ff, 0x0015}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Number of Frames in Overlay", VR: "IS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0022}, UpperTag: DcmTag{0x60ff, 0x0022}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Description", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0040}, UpperTag: DcmTag{0x60ff, 0x0040}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Type", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0045}, UpperTag: DcmTag{0x60ff, 0x0045}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Subtype", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0050}, UpperTag: DcmTag{0x60ff, 0x0050}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Origin", VR: "SS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0051}, UpperTag: DcmTag{0x60ff, 0x0051}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Image Frame Origin", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0052}, UpperTag: DcmTag{0x60ff, 0x0052}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Plane Origin", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0060}, UpperTag: DcmTag{0x60ff, 0x0060}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Compression Code", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0061}, UpperTag: DcmTag{0x60ff, 0x0061}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Compression Originator", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0062}, UpperTag: DcmTag{0x60ff, 0x0062}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Compression Label", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0063}, UpperTag: DcmTag{0x60ff, 0x0063}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Compression Description", VR: "SH"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0066}, UpperTag: DcmTag{0x60ff, 0x0066}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Compression Step Pointers", VR: "AT"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0068}, UpperTag: DcmTag{0x60ff, 0x0068}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Repeat Interval", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0069}, UpperTag: DcmTag{0x60ff, 0x0069}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Bits Grouped", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0100}, UpperTag: DcmTag{0x60ff, 0x0100}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Bits Allocated", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0102}, UpperTag: DcmTag{0x60ff, 0x0102}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Bit Position", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0110}, UpperTag: DcmTag{0x60ff, 0x0110}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Format", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0200}, UpperTag: DcmTag{0x60ff, 0x0200}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Location", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0800}, UpperTag: DcmTag{0x60ff, 0x0800}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Code Label", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0802}, UpperTag: DcmTag{0x60ff, 0x0802}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Number of Tables", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0803}, UpperTag: DcmTag{0x60ff, 0x0803}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Code Table Location", VR: "AT"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x0804}, UpperTag: DcmTag{0x60ff, 0x0804}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Bits For Code Word", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1001}, UpperTag: DcmTag{0x60ff, 0x1001}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Activation Layer", VR: "CS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1100}, UpperTag: DcmTag{0x60ff, 0x1100}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Descriptor - Gray", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1101}, UpperTag: DcmTag{0x60ff, 0x1101}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Descriptor - Red", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1102}, UpperTag: DcmTag{0x60ff, 0x1102}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Descriptor - Green", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1103}, UpperTag: DcmTag{0x60ff, 0x1103}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Descriptor - Blue", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1200}, UpperTag: DcmTag{0x60ff, 0x1200}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlays - Gray", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1201}, UpperTag: DcmTag{0x60ff, 0x1201}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlays - Red", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1202}, UpperTag: DcmTag{0x60ff, 0x1202}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlays - Green", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1203}, UpperTag: DcmTag{0x60ff, 0x1203}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlays - Blue", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1301}, UpperTag: DcmTag{0x60ff, 0x1301}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "ROI Area", VR: "IS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1302}, UpperTag: DcmTag{0x60ff, 0x1302}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "ROI Mean", VR: "DS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1303}, UpperTag: DcmTag{0x60ff, 0x1303}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "ROI Standard Deviation", VR: "DS"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x1500}, UpperTag: DcmTag{0x60ff, 0x1500}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Label", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x3000}, UpperTag: DcmTag{0x60ff, 0x3000}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Data", VR: "OB or OW"},
+	DcmRangeElement{Tag: DcmTag{0x6000, 0x4000}, UpperTag: DcmTag{0x60ff, 0x4000}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Overlay Comments", VR: "LT"},
+
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0000}, UpperTag: DcmTag{0x7fff, 0x0000}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Pixel Data Group Length", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0010}, UpperTag: DcmTag{0x7fff, 0x0010}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Pixel Data", VR: "OB or OW"},
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0011}, UpperTag: DcmTag{0x7fff, 0x0011}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Next Data Group", VR: "US"},
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0020}, UpperTag: DcmTag{0x7fff, 0x0020}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Coefficients SDVN", VR: "OW"},
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0030}, UpperTag: DcmTag{0x7fff, 0x0030}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Coefficients SDHN", VR: "OW"},
+	DcmRangeElement{Tag: DcmTag{0x7f00, 0x0040}, UpperTag: DcmTag{0x7fff, 0x0040}, GroupRestriction: dcmdata.DcmDictRange_Even, Name: "Variable Coefficients SDDN", VR: "OW"},
+
+	DcmRangeElement{Tag: DcmTag{0x0001, 0x0000}, UpperTag: DcmTag{0x0007, 0x0000}, GroupRestriction: dcmdata.DcmDictRange_Odd, Name: "Illegal Group Length", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x0001, 0x0010}, UpperTag: DcmTag{0x0007, 0x00ff}, GroupRestriction: dcmdata.DcmDictRange_Odd, Name: "Illegal Private Creator", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x0009, 0x0000}, UpperTag: DcmTag{0xffff, 0x0000}, GroupRestriction: dcmdata.DcmDictRange_Odd, Name: "Private Group Length", VR: "UL"},
+	DcmRangeElement{Tag: DcmTag{0x0009, 0x0010}, UpperTag: DcmTag{0xffff, 0x00ff}, GroupRestriction: dcmdata.DcmDictRange_Odd, Name: "Private Creator", VR: "LO"},
+	DcmRangeElement{Tag: DcmTag{0x0000, 0x0000}, UpperTag: DcmTag{0xffff, 0x0000}, Name: "Generic Group Length", VR: "UL"},
+}
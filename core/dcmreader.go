@@ -1,7 +1,9 @@
 package core
 
 import (
+	"compress/flate"
 	"errors"
+	"io"
 	"log"
 	"strconv"
 
@@ -18,6 +20,21 @@ type DcmReader struct {
 	Dataset     DcmDataset
 	IsReadValue bool
 	IsReadPixel bool
+
+	// StopReading is called with the tag of each element of the data set. If it
+	// returns true, reading stops before the element without error, and the elements
+	// read so far are kept in Dataset. See StopAfterTag.
+	StopReading func(tag DcmTag) bool
+}
+
+// StopAfterTag returns a DcmReader.StopReading function, which stops reading after
+// the element of the tag, e.g. StopAfterTag(DcmTag{0x0020, 0xFFFF}) reads the groups
+// up to (0020,xxxx).
+func StopAfterTag(tag DcmTag) func(tag DcmTag) bool {
+	last := tag
+	return func(tag DcmTag) bool {
+		return tag.IsAfter(last)
+	}
 }
 
 // ReadFile is to read dicom file.
@@ -28,33 +45,84 @@ func (reader *DcmReader) ReadFile(filename string) error {
 		return err
 	}
 	defer reader.fs.Close()
+	return reader.read(&reader.fs)
+}
+
+// Read is to read dicom data from r, e.g. the body of a HTTP request.
+// If r is not an io.ReadSeeker, the data is buffered in memory while reading.
+func (reader *DcmReader) Read(r io.Reader) error {
+	err := reader.fs.OpenReader(r)
+	if err != nil {
+		return err
+	}
+	return reader.read(&reader.fs)
+}
+
+// ReadSection is to read dicom data from the first size bytes of r.
+func (reader *DcmReader) ReadSection(r io.ReaderAt, size int64) error {
+	err := reader.fs.OpenReaderAt(r, size)
+	if err != nil {
+		return err
+	}
+	return reader.read(&reader.fs)
+}
+
+// read is to read the meta information and the data set from the stream.
+func (reader *DcmReader) read(stream *DcmFileStream) error {
+	stream, isExplicitVR, byteOrder, err := reader.openDataset(stream)
+	if err != nil {
+		return err
+	}
+
+	// read dicom dataset
+	err = reader.Dataset.readUntil(stream, isExplicitVR, byteOrder, reader.IsReadValue, reader.IsReadPixel, reader.StopReading)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// openDataset is to read the meta information from the stream, and returns the
+// stream of the data set following it with the encoding of the data set.
+func (reader *DcmReader) openDataset(stream *DcmFileStream) (*DcmFileStream, bool, EByteOrder, error) {
+	reader.fs = *stream
 	isDCM3, err := reader.IsDicom3()
 	if !isDCM3 {
-		return err
+		return nil, false, EBOunknown, err
 	}
 
 	//read dicom file meta information
 	err = reader.Meta.Read(&reader.fs)
 	if err != nil {
-		return err
+		return nil, false, EBOunknown, err
 	}
 
 	isExplicitVR, err := reader.Meta.IsExplicitVR()
 	if err != nil {
-		return err
+		return nil, false, EBOunknown, err
 	}
 
 	byteOrder, err := reader.Meta.GetByteOrder()
 	if err != nil {
-		return err
+		return nil, false, EBOunknown, err
 	}
-	// read dicom dataset
-	err = reader.Dataset.Read(&reader.fs, isExplicitVR, byteOrder, reader.IsReadValue, reader.IsReadPixel)
+
+	isDeflated, err := reader.Meta.IsDeflated()
 	if err != nil {
-		return err
+		return nil, false, EBOunknown, err
 	}
 
-	return nil
+	stream = &reader.fs
+	if isDeflated {
+		// the data set following the meta information is compressed with deflate
+		stream = new(DcmFileStream)
+		err = stream.OpenReader(flate.NewReader(reader.fs.handler))
+		if err != nil {
+			return nil, false, EBOunknown, err
+		}
+	}
+	return stream, isExplicitVR, byteOrder, nil
 }
 
 func (reader *DcmReader) GetImageInfo() dcmimage.DcmImage {
@@ -115,11 +183,48 @@ func (reader *DcmReader) GetImageInfo() dcmimage.DcmImage {
 	num, _ = strconv.ParseUint(reader.Dataset.SamplesPerPixel(), 10, 16)
 	img.SamplesPerPixel = uint16(num.(uint64))
 
+	num, _ = strconv.ParseUint(reader.Dataset.PlanarConfiguration(), 10, 16)
+	img.PlanarConfiguration = uint16(num.(uint64))
+
 	img.PixelData = pixeldata
 
+	img.TransferSyntaxUID = reader.Meta.TransferSyntaxUID()
+	if isCompressed {
+		img.Frames, err = reader.Dataset.EncapsulatedFrames()
+		if err != nil {
+			log.Println(err.Error())
+		}
+	}
+
 	return img
 }
 
+// GetMappedImageInfo is the same as GetImageInfo, except the native pixel data is
+// mapped from the file instead of being copied, so that it does not need to be read
+// with IsReadPixel. Close the returned DcmMappedValue after using the image. PixelData
+// and PixelDataReader of the image refer to the mapped memory, so the image must not be
+// used after Close; copy PixelData to keep it.
+func (reader *DcmReader) GetMappedImageInfo() (dcmimage.DcmImage, *DcmMappedValue, error) {
+	img := reader.GetImageInfo()
+	if img.IsCompressed {
+		return img, nil, errors.New("DcmReader: the compressed pixel data can not be mapped")
+	}
+	var elem DcmElement
+	elem.Tag = DCMPixelData
+	err := reader.Dataset.FindElement(&elem)
+	if err != nil {
+		return img, nil, err
+	}
+	v, err := elem.MapValue()
+	if err != nil {
+		return img, nil, err
+	}
+	img.PixelData = v.Bytes
+	img.PixelDataReader = v.Reader
+	img.PixelDataSize = v.Size
+	return img, v, nil
+}
+
 func (reader DcmReader) Convert2PNG(filepath string) error {
 	img := reader.GetImageInfo()
 	frame := img.NumberOfFrames
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/grayzone/godcm/util"
@@ -98,6 +101,77 @@ func TestDcmReaderReadFileDICOM(t *testing.T) {
 	}
 }
 
+func TestDcmReaderRead(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "CT-MONO2-16-ankle",
+		util.GetTestDataFolder() + "GH179A.dcm",
+		util.GetTestDataFolder() + "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+		util.GetTestDataFolder() + "CT1_J2KI",
+	}
+	for _, c := range cases {
+		want := readTestFile(t, c)
+		data, err := ioutil.ReadFile(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var reader DcmReader
+		reader.IsReadValue = true
+		reader.IsReadPixel = true
+		err = reader.Read(onlyReader{bytes.NewReader(data)})
+		if err != nil {
+			t.Errorf("DcmReader.Read(%s): %s", c, err.Error())
+			continue
+		}
+		if len(reader.Dataset.Elements) != len(want.Dataset.Elements) {
+			t.Errorf("DcmReader.Read(%s), want %d elements got %d", c, len(want.Dataset.Elements), len(reader.Dataset.Elements))
+		}
+		if !bytes.Equal(reader.Dataset.PixelData(), want.Dataset.PixelData()) {
+			t.Errorf("DcmReader.Read(%s), the pixel data is not the same as ReadFile()", c)
+		}
+	}
+}
+
+func TestDcmReaderReadSection(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "CT-MONO2-16-ankle",
+		util.GetTestDataFolder() + "GH179A.dcm",
+		util.GetTestDataFolder() + "CT1_J2KI",
+	}
+	for _, c := range cases {
+		want := readTestFile(t, c)
+		f, err := os.Open(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, _ := f.Stat()
+
+		var reader DcmReader
+		reader.IsReadValue = true
+		reader.IsReadPixel = true
+		err = reader.ReadSection(f, info.Size())
+		f.Close()
+		if err != nil {
+			t.Errorf("DcmReader.ReadSection(%s): %s", c, err.Error())
+			continue
+		}
+		if reader.Dataset.SOPInstanceUID() != want.Dataset.SOPInstanceUID() {
+			t.Errorf("DcmReader.ReadSection(%s), want SOP Instance UID '%s' got '%s'", c, want.Dataset.SOPInstanceUID(), reader.Dataset.SOPInstanceUID())
+		}
+		if !bytes.Equal(reader.Dataset.PixelData(), want.Dataset.PixelData()) {
+			t.Errorf("DcmReader.ReadSection(%s), the pixel data is not the same as ReadFile()", c)
+		}
+	}
+}
+
+func TestDcmReaderReadNONDICOM(t *testing.T) {
+	var reader DcmReader
+	err := reader.Read(onlyReader{bytes.NewReader([]byte("not a dicom file"))})
+	if err == nil {
+		t.Errorf("DcmReader.Read() should fail to read non-DICOM data")
+	}
+}
+
 func TestFileMetaInformationGroupLength(t *testing.T) {
 	cases := []struct {
 		in   string
@@ -901,3 +975,164 @@ func TestIsBigEndian(t *testing.T) {
 		}
 	}
 }
+
+func TestDcmReaderStopReading(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "CT-MONO2-16-ankle",
+		util.GetTestDataFolder() + "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+		util.GetTestDataFolder() + "US-RGB-8-esopecho.dcm",
+	}
+	last := DcmTag{0x0020, 0xFFFF}
+	for _, c := range cases {
+		all := readTestFile(t, c)
+
+		var reader DcmReader
+		reader.IsReadValue = true
+		reader.StopReading = StopAfterTag(last)
+		err := reader.ReadFile(c)
+		if err != nil {
+			t.Errorf("DcmReader.ReadFile(%s): %s", c, err.Error())
+			continue
+		}
+		var want []DcmElement
+		for _, e := range all.Dataset.Elements {
+			if !e.Tag.IsAfter(last) {
+				want = append(want, e)
+			}
+		}
+		if len(reader.Dataset.Elements) != len(want) {
+			t.Errorf("StopAfterTag() %s, want %d elements got %d", c, len(want), len(reader.Dataset.Elements))
+			continue
+		}
+		for i, e := range want {
+			got := reader.Dataset.Elements[i]
+			if got.Tag != e.Tag || !bytes.Equal(got.Value, e.Value) {
+				t.Errorf("StopAfterTag() %s, want element '%s' got '%s'", c, e.Tag, got.Tag)
+			}
+		}
+		if reader.Dataset.StudyInstanceUID() != all.Dataset.StudyInstanceUID() {
+			t.Errorf("StopAfterTag() %s, want StudyInstanceUID '%s' got '%s'", c, all.Dataset.StudyInstanceUID(), reader.Dataset.StudyInstanceUID())
+		}
+	}
+}
+
+func TestDcmReaderStopBeforePixelData(t *testing.T) {
+	filename := util.GetTestDataFolder() + "MR-MONO2-8-16x-heart.dcm"
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reader DcmReader
+	reader.IsReadValue = true
+	reader.IsReadPixel = true
+	reader.StopReading = func(tag DcmTag) bool {
+		return tag == DCMPixelData
+	}
+	err = reader.Read(onlyReader{bytes.NewReader(data)})
+	if err != nil {
+		t.Fatalf("DcmReader.Read(): %s", err.Error())
+	}
+	if reader.Dataset.PixelData() != nil {
+		t.Errorf("DcmReader.Read(), the pixel data should not be read")
+	}
+	if reader.Dataset.Rows() != "256" {
+		t.Errorf("DcmReader.Read(), want Rows '256' got '%s'", reader.Dataset.Rows())
+	}
+}
+
+func TestDcmElementLoad(t *testing.T) {
+	for _, file := range []string{"MR-MONO2-8-16x-heart.dcm", "CT1_J2KI"} {
+		testDcmElementLoad(t, util.GetTestDataFolder()+file)
+	}
+}
+
+func testDcmElementLoad(t *testing.T, filename string) {
+	all := readTestFile(t, filename)
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readers := map[string]func(reader *DcmReader) error{
+		"ReadFile": func(reader *DcmReader) error {
+			return reader.ReadFile(filename)
+		},
+		"ReadSection": func(reader *DcmReader) error {
+			return reader.ReadSection(bytes.NewReader(data), int64(len(data)))
+		},
+		"Read": func(reader *DcmReader) error {
+			return reader.Read(onlyReader{bytes.NewReader(data)})
+		},
+	}
+	for name, read := range readers {
+		var reader DcmReader
+		err := read(&reader)
+		if err != nil {
+			t.Errorf("%s(%s): %s", name, filename, err.Error())
+			continue
+		}
+		for i, e := range reader.Dataset.Elements {
+			want := all.Dataset.Elements[i]
+			if e.Tag != want.Tag || e.Length != want.Length {
+				t.Errorf("%s(%s), want element '%s' got '%s'", name, filename, want.Tag, e.Tag)
+				break
+			}
+			if e.Squence != nil || e.Length == 0 {
+				continue
+			}
+			if e.IsValueLoaded() && !e.Tag.IsPrivateCreator() && e.Tag != DCMSpecificCharacterSet {
+				t.Errorf("%s(%s), the value of '%s' should not be read", name, filename, e.Tag)
+			}
+			err = e.Load()
+			if err != nil {
+				t.Errorf("%s(%s) Load(%s): %s", name, filename, e.Tag, err.Error())
+				continue
+			}
+			if !bytes.Equal(e.Value, want.Value) {
+				t.Errorf("%s(%s) Load(%s), the value is not the same as the one read", name, filename, e.Tag)
+			}
+		}
+		if len(reader.Dataset.PixelData()) != 0 {
+			t.Errorf("%s(%s), the pixel data should not be read", name, filename)
+		}
+		err = reader.Dataset.LoadElement(DCMPixelData)
+		if err != nil {
+			t.Errorf("%s(%s) LoadElement(): %s", name, filename, err.Error())
+			continue
+		}
+		pixelData := reader.Dataset.PixelData()
+		if len(pixelData) == 0 || !bytes.Equal(pixelData, all.Dataset.PixelData()) {
+			t.Errorf("%s(%s) LoadElement(), the pixel data is not the same as the one read", name, filename)
+		}
+	}
+}
+
+func TestDcmReaderReadDeflated(t *testing.T) {
+	// the data set is deflated by zlib, see test/deflate_testdata.py
+	filename := util.GetTestDataFolder() + "US-RGB-8-esopecho_deflated.dcm"
+	want := readTestFile(t, util.GetTestDataFolder()+"US-RGB-8-esopecho.dcm")
+	enc := newDcmEncoder(*NewDcmXfer(EXSLittleEndianExplicit), want.Dataset)
+	wantData, _ := enc.encodeDataset(want.Dataset.Elements)
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stream DcmReader
+	stream.IsReadValue = true
+	stream.IsReadPixel = true
+	err = stream.Read(onlyReader{bytes.NewReader(data)})
+	if err != nil {
+		t.Fatalf("DcmReader.Read(%s): %s", filename, err.Error())
+	}
+
+	for name, reader := range map[string]DcmReader{"ReadFile": readTestFile(t, filename), "Read": stream} {
+		if reader.Meta.TransferSyntaxUID() != UIDDeflatedExplicitVRLittleEndianTransferSyntax {
+			t.Errorf("%s(%s), want transfer syntax '%s' got '%s'", name, filename, UIDDeflatedExplicitVRLittleEndianTransferSyntax, reader.Meta.TransferSyntaxUID())
+		}
+		got, _ := enc.encodeDataset(reader.Dataset.Elements)
+		if len(got) == 0 || !bytes.Equal(got, wantData) {
+			t.Errorf("%s(%s), the data set is not the same as the one before deflated", name, filename)
+		}
+	}
+}
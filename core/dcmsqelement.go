@@ -1,12 +1,21 @@
 package core
 
 import (
+	"errors"
+	"fmt"
 	_ "log"
 )
 
 // DcmSQElement contain a SQ Data Element
 type DcmSQElement struct {
 	Item []DcmElement
+
+	// the encoding of the data sets in the items
+	isExplicitVR bool
+	byteOrder    EByteOrder
+
+	// the specific character set of the data set containing the sequence
+	charset *DcmCharset
 }
 
 func (sq DcmSQElement) String() string {
@@ -19,55 +28,68 @@ func (sq DcmSQElement) String() string {
 }
 
 // Read the items in an SQ data element
-func (sq *DcmSQElement) Read(stream *DcmFileStream, length int64, isExplicitVR bool, isReadValue bool) error {
+func (sq *DcmSQElement) Read(stream *DcmFileStream, length int64, isExplicitVR bool, byteOrder EByteOrder, isReadValue bool) error {
+	sq.isExplicitVR, sq.byteOrder = isExplicitVR, byteOrder
 	if isExplicitVR {
-		return sq.ReadItemsWithExplicitVR(stream, length, isReadValue)
+		return sq.ReadItemsWithExplicitVR(stream, length, byteOrder, isReadValue)
 	}
-	return sq.ReadItemsWithImplicitVR(stream)
+	return sq.ReadItemsWithImplicitVR(stream, length, byteOrder, isReadValue)
 }
 
-func readItemWithUndefinedLength(e *DcmElement, s *DcmFileStream, isReadValue bool) error {
-	isFoundDelimTag := false
-	for !isFoundDelimTag {
-		//read 2 bytes to check the group of the delim tag.
-		bg, err := s.Read(2)
+// readItemWithUndefinedLength walks the nested elements of the item until the
+// item delimitation tag, so that delimiters of nested items are not mistaken
+// for the end of this one. The value excludes the delimitation item.
+func readItemWithUndefinedLength(e *DcmElement, s *DcmFileStream, isExplicitVR bool, byteOrder EByteOrder, isReadValue bool) error {
+	startPos := s.Position
+	creators := make(dcmPrivateCreators)
+	for !s.Eos() {
+		var elem DcmElement
+		elem.isExplicitVR = isExplicitVR
+		elem.byteOrder = byteOrder
+		elem.privateCreators = creators
+		err := elem.ReadDcmTag(s)
 		if err != nil {
 			return err
 		}
-		if bg[0] == 0xFE && bg[1] == 0xFF {
-			// continue to check the element of the delim tag.
-			be, err := s.Read(2)
-			if err != nil {
-				return err
-			}
-			if be[0] == 0x0D && be[1] == 0xE0 {
-				// find the delim tag
+		if elem.Tag == DCMItemDelimitationItem {
+			endPos := s.Position - 4
+			if isReadValue {
+				err = s.Putback(s.Position - startPos)
+				if err != nil {
+					return err
+				}
+				e.Value, err = s.Read(endPos - startPos)
+				if err != nil {
+					return err
+				}
 				_, err = s.Skip(4)
 				if err != nil {
 					return err
 				}
-				isFoundDelimTag = true
-			}
-			if isReadValue {
-				e.Value = append(e.Value, bg...)
-				e.Value = append(e.Value, be...)
-			}
-		} else {
-			if isReadValue {
-				e.Value = append(e.Value, bg...)
 			}
+			// skip the item delimitation length
+			_, err = s.Skip(4)
+			return err
+		}
+		err = s.Putback(4)
+		if err != nil {
+			return err
+		}
+		err = elem.ReadDcmElement(s)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// ReadItemsWithExplicitVR the items in an SQ data element with explicit VR
-func (sq *DcmSQElement) ReadItemsWithExplicitVR(stream *DcmFileStream, length int64, isReadValue bool) error {
+func (sq *DcmSQElement) readItems(stream *DcmFileStream, length int64, isExplicitVR bool, byteOrder EByteOrder, isReadValue bool) error {
 	startPos := stream.Position
 	var delta int64
 	for !stream.Eos() && delta < length {
 		var elem DcmElement
 		elem.isReadValue = isReadValue
+		elem.byteOrder = byteOrder
 		err := elem.ReadDcmTag(stream)
 		//		log.Println("SQ :", elem, delta)
 
@@ -82,7 +104,7 @@ func (sq *DcmSQElement) ReadItemsWithExplicitVR(stream *DcmFileStream, length in
 			}
 
 			if elem.Length == 0xFFFFFFFF {
-				err = readItemWithUndefinedLength(&elem, stream, isReadValue)
+				err = readItemWithUndefinedLength(&elem, stream, isExplicitVR, byteOrder, isReadValue)
 				if err != nil {
 					return err
 				}
@@ -111,45 +133,106 @@ func (sq *DcmSQElement) ReadItemsWithExplicitVR(stream *DcmFileStream, length in
 	return nil
 }
 
-/*
-
-var (
-	DCMItem                     = DcmTagKey{0xfffe, 0xe000}
-	DCMItemDelimitationItem     = DcmTagKey{0xfffe, 0xe00d}
-	DCMSequenceDelimitationItem = DcmTagKey{0xfffe, 0xe0dd}
-)
-
-
-*/
+// ReadItemsWithExplicitVR the items in an SQ data element with explicit VR
+func (sq *DcmSQElement) ReadItemsWithExplicitVR(stream *DcmFileStream, length int64, byteOrder EByteOrder, isReadValue bool) error {
+	return sq.readItems(stream, length, true, byteOrder, isReadValue)
+}
 
 // ReadItemsWithImplicitVR the items in an SQ data element with implicit VR
-func (sq *DcmSQElement) ReadItemsWithImplicitVR(stream *DcmFileStream) error {
+func (sq *DcmSQElement) ReadItemsWithImplicitVR(stream *DcmFileStream, length int64, byteOrder EByteOrder, isReadValue bool) error {
+	return sq.readItems(stream, length, false, byteOrder, isReadValue)
+}
 
-	/*
-		for !stream.Eos() {
-			var elem DcmElement
-			err := elem.ReadDcmTag(stream)
-			if err != nil {
-				return err
-			}
-			if elem.Tag == DCMItem {
-				// read item length
-			}
+// NumberOfItems gets the number of the items in the sequence.
+func (sq DcmSQElement) NumberOfItems() int {
+	var num int
+	for _, v := range sq.Item {
+		if v.Tag == DCMItem {
+			num++
+		}
+	}
+	return num
+}
 
-			// Sequence Delimitation Item
-			if elem.Tag.Group == 0xFFFE && elem.Tag.Element == 0xE0DD {
-				// read item length
-				err = elem.ReadValueLengthWithImplicitVR(stream)
-				if err != nil {
-					return err
-				}
-				break
+// itemIndex gets the position of the index-th item in Item, or the position following
+// the last item if index equals the number of the items.
+func (sq DcmSQElement) itemIndex(index int) (int, error) {
+	num := 0
+	for i, v := range sq.Item {
+		if v.Tag != DCMItem {
+			continue
+		}
+		if num == index {
+			return i, nil
+		}
+		num++
+	}
+	if index == num {
+		for i, v := range sq.Item {
+			if v.Tag == DCMSequenceDelimitationItem {
+				return i, nil
 			}
-
-			sq.Item = appemd(sq.Item, elem)
 		}
+		return len(sq.Item), nil
+	}
+	str := fmt.Sprintf("DcmSQElement: item %d out of range", index)
+	return 0, errors.New(str)
+}
 
+// GetItem gets the data set of the index-th item.
+func (sq DcmSQElement) GetItem(index int) (DcmDataset, error) {
+	var dataset DcmDataset
+	i, err := sq.itemIndex(index)
+	if err != nil {
+		return dataset, err
+	}
+	if i == len(sq.Item) || sq.Item[i].Tag != DCMItem {
+		str := fmt.Sprintf("DcmSQElement: item %d out of range", index)
+		return dataset, errors.New(str)
+	}
+	item := sq.Item[i]
+	if item.Value == nil && item.Length > 0 {
+		return dataset, errors.New("DcmSQElement: the value of the item is not read")
+	}
+	dataset.charset = sq.charset
+	err = dataset.Read(newDcmBufferStream(item.Value), sq.isExplicitVR, byteOrderOf(sq.byteOrder), true, true)
+	return dataset, err
+}
 
-	*/
+// InsertItem inserts the data set as the index-th item. The item is appended if index
+// equals the number of the items.
+func (sq *DcmSQElement) InsertItem(index int, item DcmDataset) error {
+	i, err := sq.itemIndex(index)
+	if err != nil {
+		return err
+	}
+	enc := dcmEncoder{isExplicitVR: sq.isExplicitVR, byteOrder: byteOrderOf(sq.byteOrder)}
+	value, err := enc.encodeDataset(item.Elements)
+	if err != nil {
+		return err
+	}
+	var elem DcmElement
+	elem.Tag = DCMItem
+	elem.Value = value
+	elem.Length = int64(len(value))
+	elem.byteOrder = sq.byteOrder
+
+	sq.Item = append(sq.Item, DcmElement{})
+	copy(sq.Item[i+1:], sq.Item[i:])
+	sq.Item[i] = elem
+	return nil
+}
+
+// RemoveItem removes the index-th item.
+func (sq *DcmSQElement) RemoveItem(index int) error {
+	i, err := sq.itemIndex(index)
+	if err != nil {
+		return err
+	}
+	if i == len(sq.Item) || sq.Item[i].Tag != DCMItem {
+		str := fmt.Sprintf("DcmSQElement: item %d out of range", index)
+		return errors.New(str)
+	}
+	sq.Item = append(sq.Item[:i], sq.Item[i+1:]...)
 	return nil
 }
@@ -12,3 +12,9 @@ type DcmTag struct {
 func (t DcmTag) String() string {
 	return fmt.Sprintf("0x%04x%04x", t.Group, t.Element)
 }
+
+// IsAfter is to check whether the tag follows the other one in the ascending order
+// of the tags.
+func (t DcmTag) IsAfter(other DcmTag) bool {
+	return t.Group > other.Group || (t.Group == other.Group && t.Element > other.Element)
+}
@@ -0,0 +1,238 @@
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/grayzone/godcm/dcmimage"
+)
+
+// Transcode is to convert the data set into the transfer syntax xferID. The pixel data
+// is decompressed or compressed with the codecs of the dcmimage package, and the
+// TransferSyntaxUID of the meta information, the encapsulation of the pixel data and
+// the Lossy Image Compression attributes are updated. Use WriteFile or Write with an
+// empty transfer syntax to write the result.
+func (writer *DcmWriter) Transcode(xferID string) error {
+	return writer.TranscodeWithParams(xferID, dcmimage.EncodeParams{})
+}
+
+// TranscodeWithParams is the same as Transcode, except the pixel data is compressed
+// with the parameters, e.g. the NEAR parameter of JPEG-LS Lossy.
+func (writer *DcmWriter) TranscodeWithParams(xferID string, params dcmimage.EncodeParams) error {
+	var src DcmXfer
+	src.XferID = writer.Meta.TransferSyntaxUID()
+	err := src.GetDcmXferByID()
+	if err != nil {
+		return err
+	}
+	var dst DcmXfer
+	dst.XferID = xferID
+	err = dst.GetDcmXferByID()
+	if err != nil {
+		return err
+	}
+
+	// the elements may be shared with the reader
+	writer.Meta.Elements = append([]DcmElement(nil), writer.Meta.Elements...)
+	writer.Dataset.Elements = append([]DcmElement(nil), writer.Dataset.Elements...)
+
+	var elem DcmElement
+	elem.Tag = DCMPixelData
+	isFoundPixel := writer.Dataset.FindElement(&elem) == nil
+	if isFoundPixel && src.XferID != dst.XferID && (src.IsCompressed() || dst.IsCompressed()) {
+		err = writer.transcodePixelData(src, dst, params)
+		if err != nil {
+			return err
+		}
+	}
+	writer.Meta.Elements = setElement(writer.Meta.Elements, newUIElement(DCMTransferSyntaxUID, dst.XferID))
+	return nil
+}
+
+// transcodePixelData decompresses the pixel data of the source transfer syntax, and
+// compresses it with the encoder of the destination transfer syntax if required.
+func (writer *DcmWriter) transcodePixelData(src DcmXfer, dst DcmXfer, params dcmimage.EncodeParams) error {
+	reader := DcmReader{Meta: writer.Meta, Dataset: writer.Dataset}
+	img := reader.GetImageInfo()
+	if img.NumberOfFrames < 1 {
+		img.NumberOfFrames = 1
+	}
+	if len(img.PixelData) == 0 && len(img.Frames) == 0 {
+		return errors.New("Transcode: the pixel data is not read")
+	}
+
+	if src.IsCompressed() {
+		size := len(img.PixelData)
+		if len(img.Frames) > 0 {
+			size = 0
+			for _, v := range img.Frames {
+				size += len(v)
+			}
+		}
+		var err error
+		img, err = decompressFrames(img)
+		if err != nil {
+			return err
+		}
+		// the pixel data decompressed from a lossy transfer syntax is lossy as well
+		method := lossyMethod(src)
+		if method != "" && writer.Dataset.GetElementValue(DCMLossyImageCompression) != "01" {
+			writer.setLossyCompression(float64(len(img.PixelData))/float64(size), method)
+		}
+	}
+
+	var pixel DcmElement
+	pixel.Tag = DCMPixelData
+	pixel.byteOrder = EBOLittleEndian
+	if dst.IsCompressed() {
+		frames, err := img.CompressWithParams(dst.XferID, params)
+		if err != nil {
+			return err
+		}
+		pixel = newEncapsulatedPixelData(frames)
+
+		encoder, _ := dcmimage.NewEncoder(dst.XferID, params)
+		lossy, ok := encoder.(dcmimage.LossyEncoder)
+		if ok && lossy.LossyMethod() != "" {
+			var size int
+			for _, v := range frames {
+				size += len(v)
+			}
+			writer.setLossyCompression(float64(len(img.PixelData))/float64(size), lossy.LossyMethod())
+		}
+	} else {
+		pixel.VR = "OW"
+		if img.BitsAllocated <= 8 {
+			pixel.VR = "OB"
+		}
+		pixel.Value = img.PixelData
+		if len(pixel.Value)%2 != 0 {
+			pixel.Value = append(pixel.Value, 0x00)
+		}
+		pixel.Length = int64(len(pixel.Value))
+	}
+
+	elements := writer.Dataset.Elements
+	elements = removeElement(elements, DCMExtendedOffsetTable)
+	elements = removeElement(elements, DCMExtendedOffsetTableLengths)
+	elements = setElement(elements, pixel)
+	if img.PhotometricInterpretation != "" && img.PhotometricInterpretation != writer.Dataset.PhotometricInterpretation() {
+		elements = setElement(elements, newStringElement(DCMPhotometricInterpretation, "CS", img.PhotometricInterpretation))
+	}
+	if img.SamplesPerPixel > 1 {
+		// the decoded samples are interleaved, and the compressed pixel data is color-by-pixel
+		var planar DcmElement
+		planar.Tag = DCMPlanarConfiguration
+		planar.VR = "US"
+		planar.Value = make([]byte, 2)
+		planar.Length = 2
+		elements = setElement(elements, planar)
+	}
+	writer.Dataset.Elements = elements
+	writer.Dataset.reindex()
+	return nil
+}
+
+// decompressFrames decodes all frames of the compressed image into native pixel data.
+func decompressFrames(img dcmimage.DcmImage) (dcmimage.DcmImage, error) {
+	var result dcmimage.DcmImage
+	var pixelData []byte
+	for i := 0; i < img.NumberOfFrames; i++ {
+		frame, err := img.Decompress(i)
+		if err != nil {
+			str := fmt.Sprintf("Transcode: frame %d: %s", i, err.Error())
+			return img, errors.New(str)
+		}
+		pixelData = append(pixelData, frame.PixelData...)
+		result = frame
+	}
+	result.PixelData = pixelData
+	result.NumberOfFrames = img.NumberOfFrames
+	result.TransferSyntaxUID = UIDLittleEndianExplicitTransferSyntax
+	return result, nil
+}
+
+// lossyMethod gets the Lossy Image Compression Method (0028,2114) of a lossy transfer
+// syntax, or an empty string if the transfer syntax is lossless.
+func lossyMethod(xfer DcmXfer) string {
+	switch xfer.XferSyn {
+	case EXSJPEGProcess1TransferSyntax, EXSJPEGProcess24TransferSyntax, EXSJPEGProcess35TransferSyntax,
+		EXSJPEGProcess68TransferSyntax, EXSJPEGProcess79TransferSyntax, EXSJPEGProcess1012TransferSyntax,
+		EXSJPEGProcess1113TransferSyntax, EXSJPEGProcess1618TransferSyntax, EXSJPEGProcess1719TransferSyntax,
+		EXSJPEGProcess2022TransferSyntax, EXSJPEGProcess2123TransferSyntax, EXSJPEGProcess2426TransferSyntax,
+		EXSJPEGProcess2527TransferSyntax:
+		return "ISO_10918_1"
+	case EXSJPEGLSLossy:
+		return "ISO_14495_1"
+	case EXSJPEG2000:
+		return "ISO_15444_1"
+	}
+	return ""
+}
+
+// newEncapsulatedPixelData creates the pixel data element containing the Basic Offset
+// Table and one fragment for each frame.
+func newEncapsulatedPixelData(frames [][]byte) DcmElement {
+	var bot DcmElement
+	bot.Tag = DCMItem
+	bot.Value = make([]byte, 4*len(frames))
+
+	var sq DcmSQElement
+	sq.Item = append(sq.Item, bot)
+	var offset uint32
+	for i, v := range frames {
+		binary.LittleEndian.PutUint32(bot.Value[4*i:], offset)
+		var item DcmElement
+		item.Tag = DCMItem
+		item.Value = v
+		if len(item.Value)%2 != 0 {
+			item.Value = append(item.Value, 0x00)
+		}
+		item.Length = int64(len(item.Value))
+		offset += 8 + uint32(item.Length)
+		sq.Item = append(sq.Item, item)
+	}
+	sq.Item[0].Length = int64(len(bot.Value))
+
+	var elem DcmElement
+	elem.Tag = DCMPixelData
+	elem.VR = "OB"
+	elem.Length = 0xFFFFFFFF
+	elem.Squence = &sq
+	return elem
+}
+
+// setLossyCompression sets Lossy Image Compression (0028,2110) to "01", and appends the
+// ratio and the method to the values of the previous lossy compressions.
+func (writer *DcmWriter) setLossyCompression(ratio float64, method string) {
+	ratios := strconv.FormatFloat(ratio, 'f', 2, 64)
+	methods := method
+	if writer.Dataset.GetElementValue(DCMLossyImageCompression) == "01" {
+		if v := writer.Dataset.GetElementValue(DCMLossyImageCompressionRatio); v != "" {
+			ratios = v + "\\" + ratios
+		}
+		if v := writer.Dataset.GetElementValue(DCMLossyImageCompressionMethod); v != "" {
+			methods = v + "\\" + methods
+		}
+	}
+	elements := writer.Dataset.Elements
+	elements = setElement(elements, newStringElement(DCMLossyImageCompression, "CS", "01"))
+	elements = setElement(elements, newStringElement(DCMLossyImageCompressionRatio, "DS", ratios))
+	elements = setElement(elements, newStringElement(DCMLossyImageCompressionMethod, "CS", methods))
+	writer.Dataset.Elements = elements
+}
+
+// newStringElement creates an element of a string VR, padded to even length with a space.
+func newStringElement(tag DcmTag, vr string, value string) DcmElement {
+	var elem DcmElement
+	elem.Tag = tag
+	elem.VR = vr
+	elem.Value = []byte(value)
+	if len(elem.Value)%2 != 0 {
+		elem.Value = append(elem.Value, ' ')
+	}
+	elem.Length = int64(len(elem.Value))
+	return elem
+}
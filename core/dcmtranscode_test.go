@@ -0,0 +1,198 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/grayzone/godcm/dcmimage"
+	"github.com/grayzone/godcm/util"
+)
+
+// transcode transcodes the file, and reads the data set written with the new transfer syntax.
+func transcode(t *testing.T, reader DcmReader, xferID string) (DcmReader, error) {
+	writer := NewDcmWriter(reader)
+	err := writer.Transcode(xferID)
+	if err != nil {
+		return reader, err
+	}
+	var buf bytes.Buffer
+	err = writer.Write(&buf, "")
+	if err != nil {
+		t.Fatalf("DcmWriter.Write(%s): %s", xferID, err.Error())
+	}
+	var result DcmReader
+	result.IsReadValue = true
+	result.IsReadPixel = true
+	err = result.Read(&buf)
+	if err != nil {
+		t.Fatalf("read the data set transcoded to %s: %s", xferID, err.Error())
+	}
+	if result.Meta.TransferSyntaxUID() != xferID {
+		t.Errorf("TransferSyntaxUID(), want '%s' got '%s'", xferID, result.Meta.TransferSyntaxUID())
+	}
+	return result, nil
+}
+
+func TestDcmWriterTranscodeLossless(t *testing.T) {
+	cases := []struct {
+		in    string
+		xfers []string
+	}{
+		{"MR-MONO2-8-16x-heart.dcm", []string{UIDRLELosslessTransferSyntax, UIDJPEGLSLosslessTransferSyntax, UIDJPEG2000LosslessOnlyTransferSyntax}},
+		{"US-RGB-8-esopecho.dcm", []string{UIDRLELosslessTransferSyntax, UIDJPEGLSLosslessTransferSyntax, UIDJPEG2000LosslessOnlyTransferSyntax}},
+		{"CT-MONO2-16-ankle", []string{UIDJPEGLSLosslessTransferSyntax, UIDJPEG2000LosslessOnlyTransferSyntax, UIDJPEG2000TransferSyntax}},
+		{"GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm", []string{UIDRLELosslessTransferSyntax}},
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, util.GetTestDataFolder()+c.in)
+		native, err := transcode(t, reader, UIDLittleEndianExplicitTransferSyntax)
+		if err != nil {
+			t.Fatalf("Transcode(%s): %s", c.in, err.Error())
+		}
+		want := native.Dataset.PixelData()
+		for _, xfer := range c.xfers {
+			compressed, err := transcode(t, reader, xfer)
+			if err != nil {
+				t.Errorf("Transcode(%s, %s): %s", c.in, xfer, err.Error())
+				continue
+			}
+			if compressed.Dataset.GetElementValue(DCMLossyImageCompression) == "01" {
+				t.Errorf("Transcode(%s, %s), the lossless compression is marked as lossy", c.in, xfer)
+			}
+			result, err := transcode(t, compressed, UIDLittleEndianExplicitTransferSyntax)
+			if err != nil {
+				t.Errorf("Transcode(%s, %s, decompress): %s", c.in, xfer, err.Error())
+				continue
+			}
+			if !bytes.Equal(result.Dataset.PixelData(), want) {
+				t.Errorf("Transcode(%s, %s), the pixel data is changed", c.in, xfer)
+			}
+		}
+	}
+}
+
+func TestDcmWriterTranscodeLossy(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	result, err := transcode(t, reader, UIDJPEGLSLossyTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(): %s", err.Error())
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompression); got != "01" {
+		t.Errorf("LossyImageCompression, want '01' got '%s'", got)
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompressionMethod); got != "ISO_14495_1" {
+		t.Errorf("LossyImageCompressionMethod, want 'ISO_14495_1' got '%s'", got)
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompressionRatio); got == "" {
+		t.Errorf("LossyImageCompressionRatio should be set")
+	}
+	frames, err := result.Dataset.EncapsulatedFrames()
+	if err != nil || len(frames) != 1 {
+		t.Errorf("EncapsulatedFrames(), want 1 frame got %d (%v)", len(frames), err)
+	}
+
+	// the ratio and the method of the second lossy compression are appended
+	result, err = transcode(t, result, UIDLittleEndianExplicitTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(): %s", err.Error())
+	}
+	result, err = transcode(t, result, UIDJPEGLSLossyTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(): %s", err.Error())
+	}
+	want := "ISO_14495_1\\ISO_14495_1"
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompressionMethod); got != want {
+		t.Errorf("LossyImageCompressionMethod, want '%s' got '%s'", want, got)
+	}
+}
+
+func TestDcmWriterTranscodeWithParams(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	native, err := transcode(t, reader, UIDLittleEndianExplicitTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(): %s", err.Error())
+	}
+	near := 6
+	writer := NewDcmWriter(native)
+	err = writer.TranscodeWithParams(UIDJPEGLSLossyTransferSyntax, dcmimage.EncodeParams{JPEGLSNear: near})
+	if err != nil {
+		t.Fatalf("TranscodeWithParams(): %s", err.Error())
+	}
+	result, err := transcode(t, DcmReader{Meta: writer.Meta, Dataset: writer.Dataset}, UIDLittleEndianExplicitTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(): %s", err.Error())
+	}
+	want := native.Dataset.PixelData()
+	got := result.Dataset.PixelData()
+	if len(got) != len(want) {
+		t.Fatalf("TranscodeWithParams(), want %d bytes of pixel data got %d", len(want), len(got))
+	}
+	maxDiff := 0
+	for i := 0; i+1 < len(want); i += 2 {
+		d := int(int16(binary.LittleEndian.Uint16(got[i:]))) - int(int16(binary.LittleEndian.Uint16(want[i:])))
+		if d < 0 {
+			d = -d
+		}
+		if d > maxDiff {
+			maxDiff = d
+		}
+	}
+	if maxDiff > near || maxDiff <= 2 {
+		t.Errorf("TranscodeWithParams(), want the samples to differ by at most %d got %d", near, maxDiff)
+	}
+}
+
+func TestDcmWriterTranscodeDecompress(t *testing.T) {
+	cases := []struct {
+		in                        string
+		photometricInterpretation string
+	}{
+		{"CT1_J2KI", "MONOCHROME2"},
+		{"GH064.dcm", ""},
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, util.GetTestDataFolder()+c.in)
+		result, err := transcode(t, reader, UIDLittleEndianExplicitTransferSyntax)
+		if err != nil {
+			t.Errorf("Transcode(%s): %s", c.in, err.Error())
+			continue
+		}
+		img := result.GetImageInfo()
+		if img.IsCompressed {
+			t.Errorf("Transcode(%s), the pixel data is compressed", c.in)
+		}
+		size := int(img.Rows) * int(img.Columns) * int(img.SamplesPerPixel) * int(img.BitsAllocated) / 8
+		if len(img.PixelData) < size*img.NumberOfFrames {
+			t.Errorf("Transcode(%s), want %d bytes of pixel data got %d", c.in, size*img.NumberOfFrames, len(img.PixelData))
+		}
+		if c.photometricInterpretation != "" && img.PhotometricInterpretation != c.photometricInterpretation {
+			t.Errorf("Transcode(%s), want '%s' got '%s'", c.in, c.photometricInterpretation, img.PhotometricInterpretation)
+		}
+	}
+
+	// the pixel data decompressed from JPEG 2000 (Lossless or Lossy) is marked as lossy
+	reader := readTestFile(t, util.GetTestDataFolder()+"IM-0001-0010.dcm")
+	if got := reader.Dataset.GetElementValue(DCMLossyImageCompression); got != "00" {
+		t.Fatalf("LossyImageCompression of IM-0001-0010.dcm, want '00' got '%s'", got)
+	}
+	result, err := transcode(t, reader, UIDLittleEndianExplicitTransferSyntax)
+	if err != nil {
+		t.Fatalf("Transcode(IM-0001-0010.dcm): %s", err.Error())
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompression); got != "01" {
+		t.Errorf("LossyImageCompression, want '01' got '%s'", got)
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompressionMethod); got != "ISO_15444_1" {
+		t.Errorf("LossyImageCompressionMethod, want 'ISO_15444_1' got '%s'", got)
+	}
+	if got := result.Dataset.GetElementValue(DCMLossyImageCompressionRatio); got == "" {
+		t.Errorf("LossyImageCompressionRatio should be set")
+	}
+
+	reader = readTestFile(t, util.GetTestDataFolder()+"CT1_J2KI")
+	err = NewDcmWriter(reader).Transcode("1.2.3")
+	if err == nil {
+		t.Errorf("Transcode() should fail with an unknown transfer syntax")
+	}
+}
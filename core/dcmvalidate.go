@@ -0,0 +1,350 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grayzone/godcm/dcmdata"
+)
+
+// EValidationCode is the kind of an issue found by Validate.
+type EValidationCode int
+
+const (
+	// EVCInvalidLength is a length which is not a multiple of the size of a binary
+	// VR, or a value of AS or DA which is not of the fixed length.
+	EVCInvalidLength EValidationCode = iota
+	// EVCValueTooLong is a value longer than the maximum length of the VR.
+	EVCValueTooLong
+	// EVCInvalidCharacter is a character which is not allowed by the VR, e.g. a
+	// lowercase letter of CS.
+	EVCInvalidCharacter
+	// EVCLeadingZero is a component of a UI value with a leading zero.
+	EVCLeadingZero
+	// EVCInvalidValue is a value which cannot be parsed, e.g. the date of DA.
+	EVCInvalidValue
+	// EVCInvalidVM is a number of values out of the VM of the data dictionary.
+	EVCInvalidVM
+	// EVCOddLength is an element with an odd length.
+	EVCOddLength
+	// EVCInvalidPadding is a value padded with a wrong character, e.g. a UI value
+	// padded with a space.
+	EVCInvalidPadding
+	// EVCMissingAttribute is a Type 1 or Type 2 attribute of a module which is not in
+	// the data set, see ValidateIOD.
+	EVCMissingAttribute
+	// EVCEmptyAttribute is a Type 1 attribute of a module without value.
+	EVCEmptyAttribute
+)
+
+var validationCodeNames = []string{
+	"InvalidLength",
+	"ValueTooLong",
+	"InvalidCharacter",
+	"LeadingZero",
+	"InvalidValue",
+	"InvalidVM",
+	"OddLength",
+	"InvalidPadding",
+	"MissingAttribute",
+	"EmptyAttribute",
+}
+
+func (code EValidationCode) String() string {
+	if code < 0 || int(code) >= len(validationCodeNames) {
+		return "EValidationCode(" + strconv.Itoa(int(code)) + ")"
+	}
+	return validationCodeNames[code]
+}
+
+// MarshalText encodes the code by name, e.g. in the JSON of a report.
+func (code EValidationCode) MarshalText() ([]byte, error) {
+	return []byte(code.String()), nil
+}
+
+// DcmValidationIssue is an issue of an element found by Validate.
+type DcmValidationIssue struct {
+	Tag DcmTag
+	VR  string
+
+	// Path is the position of the element, with the sequences and the item indexes
+	// containing it, e.g. "0x00081115[0].0x00081150".
+	Path string
+
+	// Module is the module of the IOD requiring the element, see ValidateIOD.
+	Module string
+
+	Code    EValidationCode
+	Message string
+}
+
+func (issue DcmValidationIssue) String() string {
+	return issue.Path + " " + issue.VR + " " + issue.Code.String() + ": " + issue.Message
+}
+
+// DcmValidationReport is the result of Validate.
+type DcmValidationReport struct {
+	Issues []DcmValidationIssue
+}
+
+// IsValid is to check whether no issue is found.
+func (report DcmValidationReport) IsValid() bool {
+	return len(report.Issues) == 0
+}
+
+// IssuesOf gets the issues of the tag, in any sequence.
+func (report DcmValidationReport) IssuesOf(tag DcmTag) []DcmValidationIssue {
+	var result []DcmValidationIssue
+	for _, v := range report.Issues {
+		if v.Tag == tag {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func (report DcmValidationReport) String() string {
+	var buf bytes.Buffer
+	for _, v := range report.Issues {
+		buf.WriteString(v.String())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func (report *DcmValidationReport) add(e DcmElement, path string, code EValidationCode, format string, a ...interface{}) {
+	issue := DcmValidationIssue{Tag: e.Tag, VR: e.VR, Path: path, Code: code, Message: fmt.Sprintf(format, a...)}
+	report.Issues = append(report.Issues, issue)
+}
+
+// vrMaxLength gets the maximum length of a value of the string VR in characters from the
+// VR dictionary of dcmdata, or 0 if the length is not limited. The VRs with a minimum
+// length, AS and DA, are checked by their formats instead.
+func vrMaxLength(name string) int {
+	var vr dcmdata.DcmVR
+	vr.SetByName(name)
+	if !vr.IsaString() || vr.GetMinValueLength() != 0 || vr.GetMaxValueLength() == dcmdata.DCM_UndefinedLength {
+		return 0
+	}
+	return int(vr.GetMaxValueLength())
+}
+
+// Validate checks the values of the elements, and the elements in the items of the
+// sequences, against the rules of their VR and the VM of the data dictionary. Only the
+// lengths are checked if the values are not read.
+func Validate(dataset DcmDataset) DcmValidationReport {
+	var report DcmValidationReport
+	validateDataset(dataset, "", &report)
+	return report
+}
+
+func validateDataset(dataset DcmDataset, prefix string, report *DcmValidationReport) {
+	for _, e := range dataset.Elements {
+		validateElement(e, prefix+e.Tag.String(), report)
+	}
+}
+
+func validateElement(e DcmElement, path string, report *DcmValidationReport) {
+	if e.VR == "" || strings.Contains(e.VR, " or ") {
+		// the VR of the element is unknown
+		return
+	}
+	if e.Squence != nil || e.VR == "SQ" {
+		if e.Squence == nil || e.Tag == DCMPixelData {
+			return
+		}
+		for i := 0; i < e.Squence.NumberOfItems(); i++ {
+			item, err := e.Squence.GetItem(i)
+			if err != nil {
+				report.add(e, path, EVCInvalidValue, "item %d: %s", i, err.Error())
+				continue
+			}
+			validateDataset(item, fmt.Sprintf("%s[%d].", path, i), report)
+		}
+		return
+	}
+	if e.Length == 0xFFFFFFFF {
+		return
+	}
+
+	unit, ok := vrUnitSizes[e.VR]
+	if !ok {
+		return
+	}
+	isString := unit == 1 && e.VR != "OB" && e.VR != "UN"
+	if e.Length%2 != 0 {
+		if isString {
+			report.add(e, path, EVCOddLength, "the length %d is not padded to even", e.Length)
+		} else {
+			report.add(e, path, EVCOddLength, "the length %d is odd", e.Length)
+		}
+	}
+	if !isString {
+		if e.Length%int64(unit) != 0 {
+			report.add(e, path, EVCInvalidLength, "the length %d is not a multiple of %d", e.Length, unit)
+		}
+		if isBinaryNumberVR(e.VR) && e.Length > 0 {
+			validateVM(e, path, int(e.Length)/unit, report)
+		}
+		return
+	}
+	if e.Value == nil {
+		// the value is not read
+		return
+	}
+
+	value := e.Value
+	if len(value)%2 == 0 && len(value) > 0 {
+		switch last := value[len(value)-1]; {
+		case e.VR == "UI" && last == ' ':
+			report.add(e, path, EVCInvalidPadding, "the value is padded with a space instead of NUL")
+		case e.VR != "UI" && last == 0:
+			report.add(e, path, EVCInvalidPadding, "the value is padded with NUL instead of a space")
+		}
+	}
+	value = bytes.TrimRight(value, "\x00 ")
+	if len(value) == 0 {
+		return
+	}
+
+	text := string(value)
+	if e.charset != nil && isCharsetVR(e.VR) {
+		text, _ = e.charset.Decode(value, e.VR)
+	}
+	values := []string{text}
+	switch e.VR {
+	case "LT", "ST", "UT", "UR":
+	default:
+		values = strings.Split(text, "\\")
+	}
+	for i, v := range values {
+		validateValue(e, path, i, v, report)
+	}
+	validateVM(e, path, len(values), report)
+}
+
+// validateValue checks the index-th value of a string VR.
+func validateValue(e DcmElement, path string, index int, v string, report *DcmValidationReport) {
+	if max := vrMaxLength(e.VR); max != 0 {
+		// the length of PN is of each component group
+		groups := []string{v}
+		if e.VR == "PN" {
+			groups = strings.Split(v, "=")
+		}
+		for _, g := range groups {
+			if n := utf8.RuneCountInString(g); n > max {
+				report.add(e, path, EVCValueTooLong, "value %d has %d characters, the maximum is %d", index, n, max)
+			}
+		}
+	}
+
+	switch e.VR {
+	case "AS":
+		if len(v) != 4 {
+			report.add(e, path, EVCInvalidLength, "value %d '%s' is not of 4 characters", index, v)
+		} else if _, err := ParseAS(v); err != nil {
+			report.add(e, path, EVCInvalidValue, "value %d '%s' is not an age", index, v)
+		}
+	case "CS":
+		if c, ok := findInvalidCharacter(v, isCSCharacter); ok {
+			report.add(e, path, EVCInvalidCharacter, "value %d '%s' has the character %q", index, v, c)
+		}
+	case "DA":
+		if c, ok := findInvalidCharacter(v, isDigit); ok {
+			report.add(e, path, EVCInvalidCharacter, "value %d '%s' has the character %q", index, v, c)
+		} else if len(v) != 8 {
+			report.add(e, path, EVCInvalidLength, "value %d '%s' is not of 8 characters", index, v)
+		} else if _, err := ParseDA(v, nil); err != nil {
+			report.add(e, path, EVCInvalidValue, "value %d '%s' is not a date", index, v)
+		}
+	case "UI":
+		if c, ok := findInvalidCharacter(v, isUICharacter); ok {
+			report.add(e, path, EVCInvalidCharacter, "value %d '%s' has the character %q", index, v, c)
+			return
+		}
+		for _, component := range strings.Split(v, ".") {
+			if component == "" {
+				report.add(e, path, EVCInvalidValue, "value %d '%s' has an empty component", index, v)
+				return
+			}
+			if len(component) > 1 && component[0] == '0' {
+				report.add(e, path, EVCLeadingZero, "value %d '%s' has the component '%s' with a leading zero", index, v, component)
+				return
+			}
+		}
+	}
+}
+
+// validateVM checks the number of the values against the VM of the data dictionary.
+func validateVM(e DcmElement, path string, n int, report *DcmValidationReport) {
+	vm := e.Tag.VM()
+	min, max, step, ok := parseVM(vm)
+	if !ok {
+		return
+	}
+	if n < min || (max >= 0 && n > max) || n%step != 0 {
+		report.add(e, path, EVCInvalidVM, "%d values, the VM is %s", n, vm)
+	}
+}
+
+// parseVM parses a VM, e.g. "1", "1-3", "1-n" or "2-2n". max is -1 if it is unlimited, and
+// the number of the values must be a multiple of step.
+func parseVM(vm string) (min int, max int, step int, ok bool) {
+	parts := strings.SplitN(vm, "-", 2)
+	min, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	if len(parts) == 1 {
+		return min, min, 1, true
+	}
+	if strings.HasSuffix(parts[1], "n") {
+		step = 1
+		if s := strings.TrimSuffix(parts[1], "n"); s != "" {
+			step, err = strconv.Atoi(s)
+			if err != nil || step <= 0 {
+				return 0, 0, 0, false
+			}
+		}
+		return min, -1, step, true
+	}
+	max, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return min, max, 1, true
+}
+
+// isBinaryNumberVR is to check whether the VR has one number per value, unlike the VRs of
+// the other binary data, e.g. OB and OW, whose VM is 1.
+func isBinaryNumberVR(vr string) bool {
+	switch vr {
+	case "AT", "FL", "FD", "SL", "SS", "SV", "UL", "US", "UV":
+		return true
+	}
+	return false
+}
+
+func findInvalidCharacter(v string, isValid func(rune) bool) (rune, bool) {
+	for _, c := range v {
+		if !isValid(c) {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isCSCharacter(c rune) bool {
+	return (c >= 'A' && c <= 'Z') || isDigit(c) || c == ' ' || c == '_'
+}
+
+func isUICharacter(c rune) bool {
+	return isDigit(c) || c == '.'
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func TestValidate(t *testing.T) {
+	var dataset DcmDataset
+	dataset.Set(DCMSOPInstanceUID, "", "1.2.840.10008.5.1.4.1.1.2")
+	dataset.Set(DCMStudyDate, "", "20200101")
+	dataset.Set(DCMModality, "", "CT")
+	dataset.Set(DCMPatientAge, "", "045Y")
+	dataset.Set(DCMRows, "", 512)
+	dataset.Set(DCMPixelSpacing, "", []float64{0.5, 0.5})
+	report := Validate(dataset)
+	if !report.IsValid() {
+		t.Errorf("Validate(), want no issue got:\n%s", report)
+	}
+
+	cases := []struct {
+		elem DcmElement
+		want EValidationCode
+	}{
+		{DcmElement{Tag: DCMRecommendedDisplayFrameRateInFloat, VR: "FL", Length: 6, Value: make([]byte, 6)}, EVCInvalidLength},
+		{DcmElement{Tag: DCMRows, VR: "US", Length: 4, Value: []byte{0, 2, 0, 2}}, EVCInvalidVM},
+		{DcmElement{Tag: DCMPatientAge, VR: "AS", Length: 4, Value: []byte("045X")}, EVCInvalidValue},
+		{DcmElement{Tag: DCMPatientAge, VR: "AS", Length: 4, Value: []byte("45Y ")}, EVCInvalidLength},
+		{DcmElement{Tag: DCMModality, VR: "CS", Length: 2, Value: []byte("ct")}, EVCInvalidCharacter},
+		{DcmElement{Tag: DCMModality, VR: "CS", Length: 18, Value: []byte("CT_AND_MORE_THAN16")}, EVCValueTooLong},
+		{DcmElement{Tag: DCMModality, VR: "CS", Length: 6, Value: []byte("CT\\MR ")}, EVCInvalidVM},
+		{DcmElement{Tag: DCMModality, VR: "CS", Length: 3, Value: []byte("CT ")}, EVCOddLength},
+		{DcmElement{Tag: DCMModality, VR: "CS", Length: 2, Value: []byte("C\x00")}, EVCInvalidPadding},
+		{DcmElement{Tag: DCMStudyDate, VR: "DA", Length: 10, Value: []byte("2020.01.01")}, EVCInvalidCharacter},
+		{DcmElement{Tag: DCMStudyDate, VR: "DA", Length: 6, Value: []byte("202001")}, EVCInvalidLength},
+		{DcmElement{Tag: DCMStudyDate, VR: "DA", Length: 8, Value: []byte("20200230")}, EVCInvalidValue},
+		{DcmElement{Tag: DCMSOPInstanceUID, VR: "UI", Length: 6, Value: []byte("1.02.3")}, EVCLeadingZero},
+		{DcmElement{Tag: DCMSOPInstanceUID, VR: "UI", Length: 6, Value: []byte("1..2.3")}, EVCInvalidValue},
+		{DcmElement{Tag: DCMSOPInstanceUID, VR: "UI", Length: 6, Value: []byte("1.2.a\x00")}, EVCInvalidCharacter},
+		{DcmElement{Tag: DCMSOPInstanceUID, VR: "UI", Length: 6, Value: []byte("1.2.3 ")}, EVCInvalidPadding},
+	}
+	for _, c := range cases {
+		var dataset DcmDataset
+		dataset.Elements = append(dataset.Elements, c.elem)
+		report := Validate(dataset)
+		issues := report.IssuesOf(c.elem.Tag)
+		if len(issues) != 1 || issues[0].Code != c.want {
+			t.Errorf("Validate(%s '%q'), want %s got:\n%s", c.elem.Tag, c.elem.Value, c.want, report)
+		}
+	}
+}
+
+func TestValidateSequence(t *testing.T) {
+	var item DcmDataset
+	item.Set(DCMReferencedSOPInstanceUID, "", "1.02")
+	var dataset DcmDataset
+	err := dataset.Set(DCMReferencedImageSequence, "", []DcmDataset{item})
+	if err != nil {
+		t.Fatalf("Set(ReferencedImageSequence): %s", err.Error())
+	}
+	report := Validate(dataset)
+	if len(report.Issues) != 1 || report.Issues[0].Code != EVCLeadingZero {
+		t.Fatalf("Validate(), want %s got:\n%s", EVCLeadingZero, report)
+	}
+	want := DCMReferencedImageSequence.String() + "[0]." + DCMReferencedSOPInstanceUID.String()
+	if report.Issues[0].Path != want {
+		t.Errorf("Validate(), want path '%s' got '%s'", want, report.Issues[0].Path)
+	}
+}
+
+func TestValidateFile(t *testing.T) {
+	dataset := readTestFile(t, util.GetTestDataFolder()+"CT1_J2KI").Dataset
+	report := Validate(dataset)
+	if !report.IsValid() {
+		t.Errorf("Validate(CT1_J2KI), want no issue got:\n%s", report)
+	}
+
+	// the dates of ACR-NEMA, e.g. "1993.04.30"
+	dataset = readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle").Dataset
+	report = Validate(dataset)
+	issues := report.IssuesOf(DCMStudyDate)
+	if len(issues) != 1 || issues[0].Code != EVCInvalidCharacter {
+		t.Errorf("Validate(CT-MONO2-16-ankle), want %s of StudyDate got:\n%s", EVCInvalidCharacter, report)
+	}
+}
+
+func TestDcmTagVM(t *testing.T) {
+	cases := []struct {
+		in   DcmTag
+		want string
+	}{
+		{DCMPatientName, "1"},
+		{DCMImageType, "2-n"},
+		{DCMPixelSpacing, "2"},
+		{DcmTag{0x6002, 0x0050}, "2"},
+		{DcmTag{0x0009, 0x1010}, ""},
+	}
+	for _, c := range cases {
+		if got := c.in.VM(); got != c.want {
+			t.Errorf("%s.VM(), want '%s' got '%s'", c.in, c.want, got)
+		}
+	}
+}
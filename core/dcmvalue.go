@@ -0,0 +1,496 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// order gets the byte order of the binary values of the element.
+func (e DcmElement) order() binary.ByteOrder {
+	if e.byteOrder == EBOBigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+// binaryValue checks that the value is read and contains complete values of the size.
+func (e DcmElement) binaryValue(size int) ([]byte, error) {
+	if e.Value == nil && e.Length > 0 {
+		str := "DcmElement: the value of the tag '" + e.Tag.String() + "' is not read"
+		return nil, errors.New(str)
+	}
+	if len(e.Value)%size != 0 {
+		str := fmt.Sprintf("DcmElement: the value length %d of the tag '%s' is not a multiple of %d", len(e.Value), e.Tag.String(), size)
+		return nil, errors.New(str)
+	}
+	return e.Value, nil
+}
+
+func (e DcmElement) errorVR(to string) error {
+	str := fmt.Sprintf("DcmElement: cannot get the VR '%s' of the tag '%s' as %s", e.VR, e.Tag.String(), to)
+	return errors.New(str)
+}
+
+// GetUint16s gets the values of an US, OW or AT element.
+func (e DcmElement) GetUint16s() ([]uint16, error) {
+	switch e.VR {
+	case "US", "OW", "AT", "US or SS", "OB or OW", "US or OW", "US or SS or OW":
+	default:
+		return nil, e.errorVR("uint16")
+	}
+	value, err := e.binaryValue(2)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]uint16, len(value)/2)
+	for i := range result {
+		result[i] = e.order().Uint16(value[2*i:])
+	}
+	return result, nil
+}
+
+// GetInt32s gets the values of a SL, SS or US element, the values of SS are sign-extended.
+// The values of "US or SS" are read as SS.
+func (e DcmElement) GetInt32s() ([]int32, error) {
+	var size int
+	switch e.VR {
+	case "SL":
+		size = 4
+	case "SS", "US or SS", "US":
+		size = 2
+	default:
+		return nil, e.errorVR("int32")
+	}
+	value, err := e.binaryValue(size)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int32, len(value)/size)
+	for i := range result {
+		if e.VR == "US" {
+			result[i] = int32(e.order().Uint16(value[2*i:]))
+		} else if size == 2 {
+			result[i] = int32(int16(e.order().Uint16(value[2*i:])))
+		} else {
+			result[i] = int32(e.order().Uint32(value[4*i:]))
+		}
+	}
+	return result, nil
+}
+
+// GetFloat32s gets the values of a FL or OF element.
+func (e DcmElement) GetFloat32s() ([]float32, error) {
+	switch e.VR {
+	case "FL", "OF":
+	default:
+		return nil, e.errorVR("float32")
+	}
+	value, err := e.binaryValue(4)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]float32, len(value)/4)
+	for i := range result {
+		result[i] = math.Float32frombits(e.order().Uint32(value[4*i:]))
+	}
+	return result, nil
+}
+
+// GetFloat64s gets the values of a FD or OD element, or the values of a FL or OF element
+// converted to float64.
+func (e DcmElement) GetFloat64s() ([]float64, error) {
+	switch e.VR {
+	case "FL", "OF":
+		values, err := e.GetFloat32s()
+		if err != nil {
+			return nil, err
+		}
+		result := make([]float64, len(values))
+		for i, v := range values {
+			result[i] = float64(v)
+		}
+		return result, nil
+	case "FD", "OD":
+	default:
+		return nil, e.errorVR("float64")
+	}
+	value, err := e.binaryValue(8)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]float64, len(value)/8)
+	for i := range result {
+		result[i] = math.Float64frombits(e.order().Uint64(value[8*i:]))
+	}
+	return result, nil
+}
+
+// GetStrings gets the values of an element with a string VR, split on backslash.
+// The leading and trailing spaces are removed, except the leading spaces of the text
+// VRs LT, ST and UT, which have only one value. The bytes which can not be decoded by
+// the character set are replaced by U+FFFD, and the values are returned with the error.
+func (e DcmElement) GetStrings() ([]string, error) {
+	isText := false
+	switch e.VR {
+	case "AE", "AS", "CS", "DA", "DS", "DT", "IS", "LO", "PN", "SH", "TM", "UC", "UI":
+	case "LT", "ST", "UT", "UR":
+		isText = true
+	default:
+		return nil, e.errorVR("string")
+	}
+	if e.Value == nil && e.Length > 0 {
+		str := "DcmElement: the value of the tag '" + e.Tag.String() + "' is not read"
+		return nil, errors.New(str)
+	}
+	var err error
+	value := string(bytes.TrimRight(e.Value, "\x00 "))
+	if e.charset != nil && isCharsetVR(e.VR) {
+		value, err = e.charset.Decode(bytes.TrimRight(e.Value, "\x00 "), e.VR)
+	}
+	if len(value) == 0 {
+		return nil, err
+	}
+	if isText {
+		if e.VR == "UR" {
+			value = strings.TrimLeft(value, " ")
+		}
+		return []string{value}, err
+	}
+	result := strings.Split(value, "\\")
+	for i, v := range result {
+		result[i] = strings.Trim(v, "\x00 ")
+	}
+	return result, err
+}
+
+// GetInt gets the first value of an IS element, or of an element with a binary integer VR.
+// The value of "US or SS" is read as US, DcmDataset.GetInt reads it with the
+// PixelRepresentation of the data set.
+func (e DcmElement) GetInt() (int, error) {
+	switch e.VR {
+	case "IS":
+		values, err := e.GetStrings()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) == 0 {
+			break
+		}
+		return strconv.Atoi(values[0])
+	case "US", "US or SS", "OW":
+		values, err := e.GetUint16s()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) == 0 {
+			break
+		}
+		return int(values[0]), nil
+	case "SS", "SL":
+		values, err := e.GetInt32s()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) == 0 {
+			break
+		}
+		return int(values[0]), nil
+	case "UL":
+		value, err := e.binaryValue(4)
+		if err != nil {
+			return 0, err
+		}
+		if len(value) == 0 {
+			break
+		}
+		return int(e.order().Uint32(value)), nil
+	default:
+		return 0, e.errorVR("int")
+	}
+	str := "DcmElement: the tag '" + e.Tag.String() + "' has no value"
+	return 0, errors.New(str)
+}
+
+// GetFloat gets the first value of a DS element, or of an element with a numeric VR.
+func (e DcmElement) GetFloat() (float64, error) {
+	switch e.VR {
+	case "DS":
+		values, err := e.GetStrings()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) == 0 {
+			str := "DcmElement: the tag '" + e.Tag.String() + "' has no value"
+			return 0, errors.New(str)
+		}
+		return strconv.ParseFloat(values[0], 64)
+	case "FL", "OF", "FD", "OD":
+		values, err := e.GetFloat64s()
+		if err != nil {
+			return 0, err
+		}
+		if len(values) == 0 {
+			str := "DcmElement: the tag '" + e.Tag.String() + "' has no value"
+			return 0, errors.New(str)
+		}
+		return values[0], nil
+	}
+	v, err := e.GetInt()
+	if err != nil {
+		return 0, err
+	}
+	return float64(v), nil
+}
+
+// findSignedElement finds the element of the tag, and resolves the VR "US or SS" of an
+// implicit VR data set with PixelRepresentation, as DCMTK does.
+func (dataset DcmDataset) findSignedElement(tag DcmTag) (DcmElement, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return elem, err
+	}
+	if elem.VR == "US or SS" {
+		elem.VR = "US"
+		if dataset.PixelRepresentation() == "1" {
+			elem.VR = "SS"
+		}
+	}
+	return elem, nil
+}
+
+// GetUint16s gets the values of the element of the tag as uint16.
+func (dataset DcmDataset) GetUint16s(tag DcmTag) ([]uint16, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil, err
+	}
+	return elem.GetUint16s()
+}
+
+// GetInt32s gets the values of the element of the tag as int32. The values of
+// "US or SS" are read as US or SS by the PixelRepresentation of the data set.
+func (dataset DcmDataset) GetInt32s(tag DcmTag) ([]int32, error) {
+	elem, err := dataset.findSignedElement(tag)
+	if err != nil {
+		return nil, err
+	}
+	return elem.GetInt32s()
+}
+
+// GetFloat32s gets the values of the element of the tag as float32.
+func (dataset DcmDataset) GetFloat32s(tag DcmTag) ([]float32, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil, err
+	}
+	return elem.GetFloat32s()
+}
+
+// GetFloat64s gets the values of the element of the tag as float64.
+func (dataset DcmDataset) GetFloat64s(tag DcmTag) ([]float64, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil, err
+	}
+	return elem.GetFloat64s()
+}
+
+// GetStrings gets the values of the element of the tag as strings.
+func (dataset DcmDataset) GetStrings(tag DcmTag) ([]string, error) {
+	var elem DcmElement
+	elem.Tag = tag
+	err := dataset.FindElement(&elem)
+	if err != nil {
+		return nil, err
+	}
+	return elem.GetStrings()
+}
+
+// GetInt gets the first value of the element of the tag as int. The value of
+// "US or SS" is read as US or SS by the PixelRepresentation of the data set.
+func (dataset DcmDataset) GetInt(tag DcmTag) (int, error) {
+	elem, err := dataset.findSignedElement(tag)
+	if err != nil {
+		return 0, err
+	}
+	return elem.GetInt()
+}
+
+// GetFloat gets the first value of the element of the tag as float64.
+func (dataset DcmDataset) GetFloat(tag DcmTag) (float64, error) {
+	elem, err := dataset.findSignedElement(tag)
+	if err != nil {
+		return 0, err
+	}
+	return elem.GetFloat()
+}
+
+// the layouts of DA, TM and DT values
+const (
+	dateLayout     = "20060102"
+	timeLayout     = "150405.999999"
+	dateTimeLayout = "20060102150405.999999-0700"
+)
+
+// encodeValue encodes a Go value with the VR in little endian. The supported values are
+// string and []string for the string VRs, PersonName and []PersonName for PN, int,
+// float64 and their slices for IS and DS, time.Time for DA, TM and DT, the integer and
+// float types and their slices for the binary VRs, and []byte for any VR.
+func encodeValue(vr string, value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	isString := false
+	switch vr {
+	case "AE", "AS", "CS", "DA", "DS", "DT", "IS", "LO", "LT", "PN", "SH", "ST", "TM", "UC", "UI", "UR", "UT":
+		isString = true
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case []byte:
+		buf.Write(v)
+	case string:
+		if !isString {
+			return nil, errorEncodeValue(vr, value)
+		}
+		buf.WriteString(v)
+	case []string:
+		if !isString {
+			return nil, errorEncodeValue(vr, value)
+		}
+		buf.WriteString(strings.Join(v, "\\"))
+	case PersonName:
+		return encodeValue(vr, []PersonName{v})
+	case []PersonName:
+		if vr != "PN" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		var strs []string
+		for _, pn := range v {
+			strs = append(strs, pn.String())
+		}
+		buf.WriteString(strings.Join(strs, "\\"))
+	case time.Time:
+		switch vr {
+		case "DA":
+			buf.WriteString(v.Format(dateLayout))
+		case "TM":
+			buf.WriteString(v.Format(timeLayout))
+		case "DT":
+			buf.WriteString(v.Format(dateTimeLayout))
+		default:
+			return nil, errorEncodeValue(vr, value)
+		}
+	case int:
+		return encodeValue(vr, []int{v})
+	case []int:
+		var strs []string
+		for _, i := range v {
+			switch vr {
+			case "IS", "DS":
+				strs = append(strs, strconv.Itoa(i))
+			case "US", "OW", "AT":
+				binary.Write(&buf, binary.LittleEndian, uint16(i))
+			case "SS":
+				binary.Write(&buf, binary.LittleEndian, int16(i))
+			case "UL", "OL":
+				binary.Write(&buf, binary.LittleEndian, uint32(i))
+			case "SL":
+				binary.Write(&buf, binary.LittleEndian, int32(i))
+			case "UV", "OV":
+				binary.Write(&buf, binary.LittleEndian, uint64(i))
+			case "SV":
+				binary.Write(&buf, binary.LittleEndian, int64(i))
+			default:
+				return nil, errorEncodeValue(vr, value)
+			}
+		}
+		buf.WriteString(strings.Join(strs, "\\"))
+	case float64:
+		return encodeValue(vr, []float64{v})
+	case []float64:
+		var strs []string
+		for _, f := range v {
+			switch vr {
+			case "DS":
+				strs = append(strs, formatDS(f))
+			case "FL", "OF":
+				binary.Write(&buf, binary.LittleEndian, float32(f))
+			case "FD", "OD":
+				binary.Write(&buf, binary.LittleEndian, f)
+			default:
+				return nil, errorEncodeValue(vr, value)
+			}
+		}
+		buf.WriteString(strings.Join(strs, "\\"))
+	case uint16, []uint16:
+		if vr != "US" && vr != "OW" && vr != "AT" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	case int16, []int16:
+		if vr != "SS" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	case uint32, []uint32:
+		if vr != "UL" && vr != "OL" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	case int32, []int32:
+		if vr != "SL" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	case float32, []float32:
+		if vr != "FL" && vr != "OF" {
+			return nil, errorEncodeValue(vr, value)
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	default:
+		return nil, errorEncodeValue(vr, value)
+	}
+
+	// pad to even length
+	if buf.Len()%2 != 0 {
+		switch {
+		case vr == "UI":
+			buf.WriteByte(0x00)
+		case isString:
+			buf.WriteByte(' ')
+		default:
+			buf.WriteByte(0x00)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func errorEncodeValue(vr string, value interface{}) error {
+	str := fmt.Sprintf("DcmElement: cannot encode the value of %T as the VR '%s'", value, vr)
+	return errors.New(str)
+}
+
+// formatDS formats the value of a DS in at most 16 characters.
+func formatDS(f float64) string {
+	for prec := 16; prec > 0; prec-- {
+		str := strconv.FormatFloat(f, 'g', prec, 64)
+		if len(str) <= 16 {
+			return str
+		}
+	}
+	return strconv.FormatFloat(f, 'g', 1, 64)
+}
@@ -0,0 +1,208 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func newTestElement(vr string, value []byte, byteOrder EByteOrder) DcmElement {
+	var e DcmElement
+	e.Tag = DcmTag{0x0009, 0x0010}
+	e.VR = vr
+	e.Value = value
+	e.Length = int64(len(value))
+	e.byteOrder = byteOrder
+	return e
+}
+
+func TestDcmElementGetUint16s(t *testing.T) {
+	cases := []struct {
+		in      DcmElement
+		want    []uint16
+		isError bool
+	}{
+		{newTestElement("US", []byte{0x01, 0x02, 0x03, 0x04}, EBOLittleEndian), []uint16{0x0201, 0x0403}, false},
+		{newTestElement("US", []byte{0x01, 0x02, 0x03, 0x04}, EBOBigEndian), []uint16{0x0102, 0x0304}, false},
+		{newTestElement("US or SS", []byte{0xFF, 0xFF}, EBOLittleEndian), []uint16{0xFFFF}, false},
+		{newTestElement("US", []byte{0x01, 0x02, 0x03}, EBOLittleEndian), nil, true},
+		{newTestElement("SS", []byte{0x01, 0x02}, EBOLittleEndian), nil, true},
+	}
+	for _, c := range cases {
+		got, err := c.in.GetUint16s()
+		if (err != nil) != c.isError || (!c.isError && !reflect.DeepEqual(got, c.want)) {
+			t.Errorf("GetUint16s(%s % X), want %v (error %v) got %v (%v)", c.in.VR, c.in.Value, c.want, c.isError, got, err)
+		}
+	}
+}
+
+func TestDcmElementGetInt32s(t *testing.T) {
+	cases := []struct {
+		in      DcmElement
+		want    []int32
+		isError bool
+	}{
+		{newTestElement("SS", []byte{0xFE, 0xFF, 0x02, 0x00}, EBOLittleEndian), []int32{-2, 2}, false},
+		{newTestElement("SL", []byte{0xFF, 0xFF, 0xFF, 0xFD}, EBOBigEndian), []int32{-3}, false},
+		{newTestElement("US or SS", []byte{0xFF, 0xFF}, EBOLittleEndian), []int32{-1}, false},
+		{newTestElement("US", []byte{0xFF, 0xFF}, EBOLittleEndian), []int32{65535}, false},
+		{newTestElement("SL", []byte{0x01, 0x02}, EBOLittleEndian), nil, true},
+		{newTestElement("FL", []byte{0x00, 0x00, 0x80, 0x3F}, EBOLittleEndian), nil, true},
+	}
+	for _, c := range cases {
+		got, err := c.in.GetInt32s()
+		if (err != nil) != c.isError || (!c.isError && !reflect.DeepEqual(got, c.want)) {
+			t.Errorf("GetInt32s(%s % X), want %v (error %v) got %v (%v)", c.in.VR, c.in.Value, c.want, c.isError, got, err)
+		}
+	}
+}
+
+func TestDcmElementGetFloats(t *testing.T) {
+	fl := newTestElement("FL", []byte{0x00, 0x00, 0x80, 0x3F, 0x00, 0x00, 0x20, 0xC0}, EBOLittleEndian)
+	got32, err := fl.GetFloat32s()
+	if err != nil || !reflect.DeepEqual(got32, []float32{1, -2.5}) {
+		t.Errorf("GetFloat32s(), want [1 -2.5] got %v (%v)", got32, err)
+	}
+	got64, err := fl.GetFloat64s()
+	if err != nil || !reflect.DeepEqual(got64, []float64{1, -2.5}) {
+		t.Errorf("GetFloat64s(FL), want [1 -2.5] got %v (%v)", got64, err)
+	}
+	if fl.GetValueString() != "1.000000" {
+		t.Errorf("GetValueString(FL), want '1.000000' got '%s'", fl.GetValueString())
+	}
+
+	fd := newTestElement("FD", []byte{0x3F, 0xF8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, EBOBigEndian)
+	got64, err = fd.GetFloat64s()
+	if err != nil || !reflect.DeepEqual(got64, []float64{1.5}) {
+		t.Errorf("GetFloat64s(FD), want [1.5] got %v (%v)", got64, err)
+	}
+	_, err = fd.GetFloat32s()
+	if err == nil {
+		t.Errorf("GetFloat32s(FD) should fail")
+	}
+}
+
+func TestDcmElementGetStrings(t *testing.T) {
+	cases := []struct {
+		in   DcmElement
+		want []string
+	}{
+		{newTestElement("CS", []byte("ORIGINAL\\PRIMARY\\AXIAL "), EBOLittleEndian), []string{"ORIGINAL", "PRIMARY", "AXIAL"}},
+		{newTestElement("DS", []byte(" 40\\400 "), EBOLittleEndian), []string{"40", "400"}},
+		{newTestElement("UI", []byte("1.2.840.10008.1.2\x00"), EBOLittleEndian), []string{"1.2.840.10008.1.2"}},
+		{newTestElement("LT", []byte("  a\\b "), EBOLittleEndian), []string{"  a\\b"}},
+		{newTestElement("LO", []byte("a\\\\b"), EBOLittleEndian), []string{"a", "", "b"}},
+		{newTestElement("SH", nil, EBOLittleEndian), nil},
+	}
+	for _, c := range cases {
+		got, err := c.in.GetStrings()
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("GetStrings(%s '%s'), want %q got %q (%v)", c.in.VR, c.in.Value, c.want, got, err)
+		}
+	}
+	_, err := newTestElement("US", []byte{0x01, 0x00}, EBOLittleEndian).GetStrings()
+	if err == nil {
+		t.Errorf("GetStrings(US) should fail")
+	}
+
+	// the invalid bytes are replaced, and reported by the error
+	cs, err := NewDcmCharset("ISO_IR 192")
+	if err != nil {
+		t.Fatalf("NewDcmCharset(ISO_IR 192): %s", err.Error())
+	}
+	elem := newTestElement("LO", []byte("a\\b\xFF"), EBOLittleEndian)
+	elem.charset = cs
+	got, err := elem.GetStrings()
+	if err == nil || !reflect.DeepEqual(got, []string{"a", "b\uFFFD"}) {
+		t.Errorf("GetStrings(LO 'a\\b\\xFF') in UTF-8, want %q with an error got %q (%v)", []string{"a", "b\uFFFD"}, got, err)
+	}
+}
+
+func TestDcmElementGetIntFloat(t *testing.T) {
+	cases := []struct {
+		in      DcmElement
+		want    float64
+		isInt   bool
+		isError bool
+	}{
+		{newTestElement("IS", []byte("12\\34 "), EBOLittleEndian), 12, true, false},
+		{newTestElement("US", []byte{0x00, 0x02}, EBOLittleEndian), 512, true, false},
+		{newTestElement("SS", []byte{0xFF, 0xFF}, EBOLittleEndian), -1, true, false},
+		{newTestElement("UL", []byte{0x00, 0x00, 0x01, 0x00}, EBOBigEndian), 256, true, false},
+		{newTestElement("DS", []byte("-1024.5 "), EBOLittleEndian), -1024.5, false, false},
+		{newTestElement("IS", []byte("abc "), EBOLittleEndian), 0, true, true},
+		{newTestElement("IS", nil, EBOLittleEndian), 0, true, true},
+		{newTestElement("DS", []byte("1e "), EBOLittleEndian), 0, false, true},
+		{newTestElement("PN", []byte("A^B "), EBOLittleEndian), 0, true, true},
+	}
+	for _, c := range cases {
+		if c.isInt {
+			got, err := c.in.GetInt()
+			if (err != nil) != c.isError || (!c.isError && float64(got) != c.want) {
+				t.Errorf("GetInt(%s % X), want %v (error %v) got %v (%v)", c.in.VR, c.in.Value, c.want, c.isError, got, err)
+			}
+		}
+		got, err := c.in.GetFloat()
+		if (err != nil) != c.isError || (!c.isError && got != c.want) {
+			t.Errorf("GetFloat(%s % X), want %v (error %v) got %v (%v)", c.in.VR, c.in.Value, c.want, c.isError, got, err)
+		}
+	}
+}
+
+func TestDcmDatasetGetInt(t *testing.T) {
+	cases := []struct {
+		in   string
+		tag  DcmTag
+		want int
+	}{
+		{"CT-MONO2-16-ankle", DCMRows, 512},
+		{"MR-MONO2-8-16x-heart.dcm", DCMNumberOfFrames, 16},
+		{"GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm", DCMBitsAllocated, 16},
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, util.GetTestDataFolder()+c.in)
+		got, err := reader.Dataset.GetInt(c.tag)
+		if err != nil || got != c.want {
+			t.Errorf("GetInt(%s, %s), want %d got %d (%v)", c.in, c.tag, c.want, got, err)
+		}
+	}
+
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	_, err := reader.Dataset.GetInt(DcmTag{0x0009, 0x9999})
+	if err == nil {
+		t.Errorf("GetInt() should fail if the element does not exist")
+	}
+}
+
+func TestDcmDatasetGetIntUSorSS(t *testing.T) {
+	// "US or SS" of an implicit VR data set is read by the Pixel Representation
+	for _, c := range []struct {
+		pixelRepresentation uint16
+		want                int
+	}{
+		{0, 65535},
+		{1, -1},
+	} {
+		pixel := newTestElement("US", []byte{byte(c.pixelRepresentation), 0x00}, EBOLittleEndian)
+		pixel.Tag = DCMPixelRepresentation
+		smallest := newTestElement("US or SS", []byte{0xFF, 0xFF}, EBOLittleEndian)
+		smallest.Tag = DCMSmallestImagePixelValue
+		var dataset DcmDataset
+		dataset.Elements = []DcmElement{pixel, smallest}
+		dataset.reindex()
+
+		got, err := dataset.GetInt(DCMSmallestImagePixelValue)
+		if err != nil || got != c.want {
+			t.Errorf("GetInt() with PixelRepresentation %d, want %d got %d (%v)", c.pixelRepresentation, c.want, got, err)
+		}
+		f, err := dataset.GetFloat(DCMSmallestImagePixelValue)
+		if err != nil || f != float64(c.want) {
+			t.Errorf("GetFloat() with PixelRepresentation %d, want %d got %v (%v)", c.pixelRepresentation, c.want, f, err)
+		}
+		values, err := dataset.GetInt32s(DCMSmallestImagePixelValue)
+		if err != nil || !reflect.DeepEqual(values, []int32{int32(c.want)}) {
+			t.Errorf("GetInt32s() with PixelRepresentation %d, want [%d] got %v (%v)", c.pixelRepresentation, c.want, values, err)
+		}
+	}
+}
@@ -0,0 +1,3335 @@
+// Code generated by dcmvmregistry_gen.go from dcmdata/dicom.dic; DO NOT EDIT.
+
+package core
+
+// dcmVMRegistry contains the value multiplicity of the DICOM Data Elements. The VM of
+// the repeating groups or elements is stored by the lower limit of the range.
+var dcmVMRegistry = map[DcmTag]string{
+	DcmTag{0x0000, 0x0000}: "1",
+	DcmTag{0x0000, 0x0001}: "1",
+	DcmTag{0x0000, 0x0002}: "1",
+	DcmTag{0x0000, 0x0003}: "1",
+	DcmTag{0x0000, 0x0010}: "1",
+	DcmTag{0x0000, 0x0100}: "1",
+	DcmTag{0x0000, 0x0110}: "1",
+	DcmTag{0x0000, 0x0120}: "1",
+	DcmTag{0x0000, 0x0200}: "1",
+	DcmTag{0x0000, 0x0300}: "1",
+	DcmTag{0x0000, 0x0400}: "1",
+	DcmTag{0x0000, 0x0600}: "1",
+	DcmTag{0x0000, 0x0700}: "1",
+	DcmTag{0x0000, 0x0800}: "1",
+	DcmTag{0x0000, 0x0850}: "1",
+	DcmTag{0x0000, 0x0860}: "1",
+	DcmTag{0x0000, 0x0900}: "1",
+	DcmTag{0x0000, 0x0901}: "1-n",
+	DcmTag{0x0000, 0x0902}: "1",
+	DcmTag{0x0000, 0x0903}: "1",
+	DcmTag{0x0000, 0x1000}: "1",
+	DcmTag{0x0000, 0x1001}: "1",
+	DcmTag{0x0000, 0x1002}: "1",
+	DcmTag{0x0000, 0x1005}: "1-n",
+	DcmTag{0x0000, 0x1008}: "1",
+	DcmTag{0x0000, 0x1020}: "1",
+	DcmTag{0x0000, 0x1021}: "1",
+	DcmTag{0x0000, 0x1022}: "1",
+	DcmTag{0x0000, 0x1023}: "1",
+	DcmTag{0x0000, 0x1030}: "1",
+	DcmTag{0x0000, 0x1031}: "1",
+	DcmTag{0x0000, 0x4000}: "1",
+	DcmTag{0x0000, 0x4010}: "1",
+	DcmTag{0x0000, 0x5010}: "1",
+	DcmTag{0x0000, 0x5020}: "1",
+	DcmTag{0x0000, 0x5110}: "1",
+	DcmTag{0x0000, 0x5120}: "1",
+	DcmTag{0x0000, 0x5130}: "1",
+	DcmTag{0x0000, 0x5140}: "1",
+	DcmTag{0x0000, 0x5150}: "1",
+	DcmTag{0x0000, 0x5160}: "1",
+	DcmTag{0x0000, 0x5170}: "1",
+	DcmTag{0x0000, 0x5180}: "1",
+	DcmTag{0x0000, 0x5190}: "1-n",
+	DcmTag{0x0000, 0x51a0}: "1",
+	DcmTag{0x0000, 0x51b0}: "1-n",
+	DcmTag{0x0001, 0x0000}: "1",
+	DcmTag{0x0001, 0x0010}: "1",
+	DcmTag{0x0002, 0x0000}: "1",
+	DcmTag{0x0002, 0x0001}: "1",
+	DcmTag{0x0002, 0x0002}: "1",
+	DcmTag{0x0002, 0x0003}: "1",
+	DcmTag{0x0002, 0x0010}: "1",
+	DcmTag{0x0002, 0x0012}: "1",
+	DcmTag{0x0002, 0x0013}: "1",
+	DcmTag{0x0002, 0x0016}: "1",
+	DcmTag{0x0002, 0x0100}: "1",
+	DcmTag{0x0002, 0x0102}: "1",
+	DcmTag{0x0004, 0x1130}: "1",
+	DcmTag{0x0004, 0x1141}: "1-8",
+	DcmTag{0x0004, 0x1142}: "1",
+	DcmTag{0x0004, 0x1200}: "1",
+	DcmTag{0x0004, 0x1202}: "1",
+	DcmTag{0x0004, 0x1212}: "1",
+	DcmTag{0x0004, 0x1220}: "1",
+	DcmTag{0x0004, 0x1400}: "1",
+	DcmTag{0x0004, 0x1410}: "1",
+	DcmTag{0x0004, 0x1420}: "1",
+	DcmTag{0x0004, 0x1430}: "1",
+	DcmTag{0x0004, 0x1432}: "1",
+	DcmTag{0x0004, 0x1500}: "1-8",
+	DcmTag{0x0004, 0x1504}: "1",
+	DcmTag{0x0004, 0x1510}: "1",
+	DcmTag{0x0004, 0x1511}: "1",
+	DcmTag{0x0004, 0x1512}: "1",
+	DcmTag{0x0004, 0x151a}: "1-n",
+	DcmTag{0x0004, 0x1600}: "1",
+	DcmTag{0x0008, 0x0001}: "1",
+	DcmTag{0x0008, 0x0005}: "1-n",
+	DcmTag{0x0008, 0x0006}: "1",
+	DcmTag{0x0008, 0x0008}: "2-n",
+	DcmTag{0x0008, 0x0010}: "1",
+	DcmTag{0x0008, 0x0012}: "1",
+	DcmTag{0x0008, 0x0013}: "1",
+	DcmTag{0x0008, 0x0014}: "1",
+	DcmTag{0x0008, 0x0016}: "1",
+	DcmTag{0x0008, 0x0018}: "1",
+	DcmTag{0x0008, 0x001a}: "1-n",
+	DcmTag{0x0008, 0x001b}: "1",
+	DcmTag{0x0008, 0x0020}: "1",
+	DcmTag{0x0008, 0x0021}: "1",
+	DcmTag{0x0008, 0x0022}: "1",
+	DcmTag{0x0008, 0x0023}: "1",
+	DcmTag{0x0008, 0x0024}: "1",
+	DcmTag{0x0008, 0x0025}: "1",
+	DcmTag{0x0008, 0x002a}: "1",
+	DcmTag{0x0008, 0x0030}: "1",
+	DcmTag{0x0008, 0x0031}: "1",
+	DcmTag{0x0008, 0x0032}: "1",
+	DcmTag{0x0008, 0x0033}: "1",
+	DcmTag{0x0008, 0x0034}: "1",
+	DcmTag{0x0008, 0x0035}: "1",
+	DcmTag{0x0008, 0x0040}: "1",
+	DcmTag{0x0008, 0x0041}: "1",
+	DcmTag{0x0008, 0x0042}: "1",
+	DcmTag{0x0008, 0x0050}: "1",
+	DcmTag{0x0008, 0x0051}: "1",
+	DcmTag{0x0008, 0x0052}: "1",
+	DcmTag{0x0008, 0x0054}: "1-n",
+	DcmTag{0x0008, 0x0056}: "1",
+	DcmTag{0x0008, 0x0058}: "1-n",
+	DcmTag{0x0008, 0x0060}: "1",
+	DcmTag{0x0008, 0x0061}: "1-n",
+	DcmTag{0x0008, 0x0062}: "1-n",
+	DcmTag{0x0008, 0x0064}: "1",
+	DcmTag{0x0008, 0x0068}: "1",
+	DcmTag{0x0008, 0x0070}: "1",
+	DcmTag{0x0008, 0x0080}: "1",
+	DcmTag{0x0008, 0x0081}: "1",
+	DcmTag{0x0008, 0x0082}: "1",
+	DcmTag{0x0008, 0x0090}: "1",
+	DcmTag{0x0008, 0x0092}: "1",
+	DcmTag{0x0008, 0x0094}: "1-n",
+	DcmTag{0x0008, 0x0096}: "1",
+	DcmTag{0x0008, 0x0100}: "1",
+	DcmTag{0x0008, 0x0102}: "1",
+	DcmTag{0x0008, 0x0103}: "1",
+	DcmTag{0x0008, 0x0104}: "1",
+	DcmTag{0x0008, 0x0105}: "1",
+	DcmTag{0x0008, 0x0106}: "1",
+	DcmTag{0x0008, 0x0107}: "1",
+	DcmTag{0x0008, 0x010b}: "1",
+	DcmTag{0x0008, 0x010c}: "1",
+	DcmTag{0x0008, 0x010d}: "1",
+	DcmTag{0x0008, 0x010f}: "1",
+	DcmTag{0x0008, 0x0110}: "1",
+	DcmTag{0x0008, 0x0112}: "1",
+	DcmTag{0x0008, 0x0114}: "1",
+	DcmTag{0x0008, 0x0115}: "1",
+	DcmTag{0x0008, 0x0116}: "1",
+	DcmTag{0x0008, 0x0117}: "1",
+	DcmTag{0x0008, 0x0201}: "1",
+	DcmTag{0x0008, 0x1000}: "1",
+	DcmTag{0x0008, 0x1010}: "1",
+	DcmTag{0x0008, 0x1030}: "1",
+	DcmTag{0x0008, 0x1032}: "1",
+	DcmTag{0x0008, 0x103e}: "1",
+	DcmTag{0x0008, 0x103f}: "1",
+	DcmTag{0x0008, 0x1040}: "1",
+	DcmTag{0x0008, 0x1048}: "1-n",
+	DcmTag{0x0008, 0x1049}: "1",
+	DcmTag{0x0008, 0x1050}: "1-n",
+	DcmTag{0x0008, 0x1052}: "1",
+	DcmTag{0x0008, 0x1060}: "1-n",
+	DcmTag{0x0008, 0x1062}: "1",
+	DcmTag{0x0008, 0x1070}: "1-n",
+	DcmTag{0x0008, 0x1072}: "1",
+	DcmTag{0x0008, 0x1080}: "1-n",
+	DcmTag{0x0008, 0x1084}: "1",
+	DcmTag{0x0008, 0x1090}: "1",
+	DcmTag{0x0008, 0x1100}: "1",
+	DcmTag{0x0008, 0x1110}: "1",
+	DcmTag{0x0008, 0x1111}: "1",
+	DcmTag{0x0008, 0x1115}: "1",
+	DcmTag{0x0008, 0x1120}: "1",
+	DcmTag{0x0008, 0x1125}: "1",
+	DcmTag{0x0008, 0x1130}: "1",
+	DcmTag{0x0008, 0x1134}: "1",
+	DcmTag{0x0008, 0x113a}: "1",
+	DcmTag{0x0008, 0x1140}: "1",
+	DcmTag{0x0008, 0x1145}: "1",
+	DcmTag{0x0008, 0x114a}: "1",
+	DcmTag{0x0008, 0x114b}: "1",
+	DcmTag{0x0008, 0x1150}: "1",
+	DcmTag{0x0008, 0x1155}: "1",
+	DcmTag{0x0008, 0x115a}: "1-n",
+	DcmTag{0x0008, 0x1160}: "1-n",
+	DcmTag{0x0008, 0x1161}: "1-n",
+	DcmTag{0x0008, 0x1162}: "3-3n",
+	DcmTag{0x0008, 0x1163}: "2",
+	DcmTag{0x0008, 0x1164}: "1",
+	DcmTag{0x0008, 0x1167}: "1",
+	DcmTag{0x0008, 0x1195}: "1",
+	DcmTag{0x0008, 0x1197}: "1",
+	DcmTag{0x0008, 0x1198}: "1",
+	DcmTag{0x0008, 0x1199}: "1",
+	DcmTag{0x0008, 0x1200}: "1",
+	DcmTag{0x0008, 0x1250}: "1",
+	DcmTag{0x0008, 0x2110}: "1",
+	DcmTag{0x0008, 0x2111}: "1",
+	DcmTag{0x0008, 0x2112}: "1",
+	DcmTag{0x0008, 0x2120}: "1",
+	DcmTag{0x0008, 0x2122}: "1",
+	DcmTag{0x0008, 0x2124}: "1",
+	DcmTag{0x0008, 0x2127}: "1",
+	DcmTag{0x0008, 0x2128}: "1",
+	DcmTag{0x0008, 0x2129}: "1",
+	DcmTag{0x0008, 0x212a}: "1",
+	DcmTag{0x0008, 0x2130}: "1-n",
+	DcmTag{0x0008, 0x2132}: "1-n",
+	DcmTag{0x0008, 0x2133}: "1",
+	DcmTag{0x0008, 0x2134}: "1",
+	DcmTag{0x0008, 0x2135}: "1",
+	DcmTag{0x0008, 0x2142}: "1",
+	DcmTag{0x0008, 0x2143}: "1",
+	DcmTag{0x0008, 0x2144}: "1",
+	DcmTag{0x0008, 0x2200}: "1",
+	DcmTag{0x0008, 0x2204}: "1",
+	DcmTag{0x0008, 0x2208}: "1",
+	DcmTag{0x0008, 0x2218}: "1",
+	DcmTag{0x0008, 0x2220}: "1",
+	DcmTag{0x0008, 0x2228}: "1",
+	DcmTag{0x0008, 0x2229}: "1",
+	DcmTag{0x0008, 0x2230}: "1",
+	DcmTag{0x0008, 0x2240}: "1",
+	DcmTag{0x0008, 0x2242}: "1",
+	DcmTag{0x0008, 0x2244}: "1",
+	DcmTag{0x0008, 0x2246}: "1",
+	DcmTag{0x0008, 0x2251}: "1",
+	DcmTag{0x0008, 0x2253}: "1",
+	DcmTag{0x0008, 0x2255}: "1",
+	DcmTag{0x0008, 0x2256}: "1",
+	DcmTag{0x0008, 0x2257}: "1",
+	DcmTag{0x0008, 0x2258}: "1",
+	DcmTag{0x0008, 0x2259}: "1",
+	DcmTag{0x0008, 0x225a}: "1",
+	DcmTag{0x0008, 0x225c}: "1",
+	DcmTag{0x0008, 0x3001}: "1",
+	DcmTag{0x0008, 0x3010}: "1",
+	DcmTag{0x0008, 0x4000}: "1-n",
+	DcmTag{0x0008, 0x9007}: "4",
+	DcmTag{0x0008, 0x9092}: "1",
+	DcmTag{0x0008, 0x9121}: "1",
+	DcmTag{0x0008, 0x9123}: "1",
+	DcmTag{0x0008, 0x9124}: "1",
+	DcmTag{0x0008, 0x9154}: "1",
+	DcmTag{0x0008, 0x9205}: "1",
+	DcmTag{0x0008, 0x9206}: "1",
+	DcmTag{0x0008, 0x9207}: "1",
+	DcmTag{0x0008, 0x9208}: "1",
+	DcmTag{0x0008, 0x9209}: "1",
+	DcmTag{0x0008, 0x9215}: "1",
+	DcmTag{0x0008, 0x9237}: "1",
+	DcmTag{0x0008, 0x9410}: "1",
+	DcmTag{0x0008, 0x9458}: "1",
+	DcmTag{0x0008, 0x9459}: "1",
+	DcmTag{0x0008, 0x9460}: "1",
+	DcmTag{0x0009, 0x0000}: "1",
+	DcmTag{0x0009, 0x0010}: "1",
+	DcmTag{0x0010, 0x0010}: "1",
+	DcmTag{0x0010, 0x0020}: "1",
+	DcmTag{0x0010, 0x0021}: "1",
+	DcmTag{0x0010, 0x0022}: "1",
+	DcmTag{0x0010, 0x0024}: "1",
+	DcmTag{0x0010, 0x0030}: "1",
+	DcmTag{0x0010, 0x0032}: "1",
+	DcmTag{0x0010, 0x0040}: "1",
+	DcmTag{0x0010, 0x0050}: "1",
+	DcmTag{0x0010, 0x0101}: "1",
+	DcmTag{0x0010, 0x0102}: "1",
+	DcmTag{0x0010, 0x1000}: "1-n",
+	DcmTag{0x0010, 0x1001}: "1-n",
+	DcmTag{0x0010, 0x1002}: "1",
+	DcmTag{0x0010, 0x1005}: "1",
+	DcmTag{0x0010, 0x1010}: "1",
+	DcmTag{0x0010, 0x1020}: "1",
+	DcmTag{0x0010, 0x1021}: "1",
+	DcmTag{0x0010, 0x1030}: "1",
+	DcmTag{0x0010, 0x1040}: "1",
+	DcmTag{0x0010, 0x1050}: "1-n",
+	DcmTag{0x0010, 0x1060}: "1",
+	DcmTag{0x0010, 0x1080}: "1",
+	DcmTag{0x0010, 0x1081}: "1",
+	DcmTag{0x0010, 0x1090}: "1",
+	DcmTag{0x0010, 0x2000}: "1-n",
+	DcmTag{0x0010, 0x2110}: "1-n",
+	DcmTag{0x0010, 0x2150}: "1",
+	DcmTag{0x0010, 0x2152}: "1",
+	DcmTag{0x0010, 0x2154}: "1-n",
+	DcmTag{0x0010, 0x2160}: "1",
+	DcmTag{0x0010, 0x2180}: "1",
+	DcmTag{0x0010, 0x21a0}: "1",
+	DcmTag{0x0010, 0x21b0}: "1",
+	DcmTag{0x0010, 0x21c0}: "1",
+	DcmTag{0x0010, 0x21d0}: "1",
+	DcmTag{0x0010, 0x21f0}: "1",
+	DcmTag{0x0010, 0x2201}: "1",
+	DcmTag{0x0010, 0x2202}: "1",
+	DcmTag{0x0010, 0x2203}: "1",
+	DcmTag{0x0010, 0x2210}: "1",
+	DcmTag{0x0010, 0x2292}: "1",
+	DcmTag{0x0010, 0x2293}: "1",
+	DcmTag{0x0010, 0x2294}: "1",
+	DcmTag{0x0010, 0x2295}: "1",
+	DcmTag{0x0010, 0x2296}: "1",
+	DcmTag{0x0010, 0x2297}: "1",
+	DcmTag{0x0010, 0x2298}: "1",
+	DcmTag{0x0010, 0x2299}: "1",
+	DcmTag{0x0010, 0x4000}: "1",
+	DcmTag{0x0010, 0x9431}: "1",
+	DcmTag{0x0012, 0x0010}: "1",
+	DcmTag{0x0012, 0x0020}: "1",
+	DcmTag{0x0012, 0x0021}: "1",
+	DcmTag{0x0012, 0x0030}: "1",
+	DcmTag{0x0012, 0x0031}: "1",
+	DcmTag{0x0012, 0x0040}: "1",
+	DcmTag{0x0012, 0x0042}: "1",
+	DcmTag{0x0012, 0x0050}: "1",
+	DcmTag{0x0012, 0x0051}: "1",
+	DcmTag{0x0012, 0x0060}: "1",
+	DcmTag{0x0012, 0x0062}: "1",
+	DcmTag{0x0012, 0x0063}: "1-n",
+	DcmTag{0x0012, 0x0064}: "1",
+	DcmTag{0x0012, 0x0071}: "1",
+	DcmTag{0x0012, 0x0072}: "1",
+	DcmTag{0x0012, 0x0081}: "1",
+	DcmTag{0x0012, 0x0082}: "1",
+	DcmTag{0x0012, 0x0083}: "1",
+	DcmTag{0x0012, 0x0084}: "1",
+	DcmTag{0x0012, 0x0085}: "1",
+	DcmTag{0x0018, 0x0010}: "1",
+	DcmTag{0x0018, 0x0012}: "1",
+	DcmTag{0x0018, 0x0014}: "1",
+	DcmTag{0x0018, 0x0015}: "1",
+	DcmTag{0x0018, 0x0020}: "1-n",
+	DcmTag{0x0018, 0x0021}: "1-n",
+	DcmTag{0x0018, 0x0022}: "1-n",
+	DcmTag{0x0018, 0x0023}: "1",
+	DcmTag{0x0018, 0x0024}: "1",
+	DcmTag{0x0018, 0x0025}: "1",
+	DcmTag{0x0018, 0x0026}: "1",
+	DcmTag{0x0018, 0x0027}: "1",
+	DcmTag{0x0018, 0x0028}: "1",
+	DcmTag{0x0018, 0x0029}: "1",
+	DcmTag{0x0018, 0x002a}: "1",
+	DcmTag{0x0018, 0x0030}: "1-n",
+	DcmTag{0x0018, 0x0031}: "1",
+	DcmTag{0x0018, 0x0032}: "1",
+	DcmTag{0x0018, 0x0033}: "1-n",
+	DcmTag{0x0018, 0x0034}: "1",
+	DcmTag{0x0018, 0x0035}: "1",
+	DcmTag{0x0018, 0x0036}: "1",
+	DcmTag{0x0018, 0x0037}: "1",
+	DcmTag{0x0018, 0x0038}: "1",
+	DcmTag{0x0018, 0x0039}: "1",
+	DcmTag{0x0018, 0x003a}: "1",
+	DcmTag{0x0018, 0x0040}: "1",
+	DcmTag{0x0018, 0x0042}: "1",
+	DcmTag{0x0018, 0x0050}: "1",
+	DcmTag{0x0018, 0x0060}: "1",
+	DcmTag{0x0018, 0x0070}: "1",
+	DcmTag{0x0018, 0x0071}: "1",
+	DcmTag{0x0018, 0x0072}: "1",
+	DcmTag{0x0018, 0x0073}: "1",
+	DcmTag{0x0018, 0x0074}: "1",
+	DcmTag{0x0018, 0x0075}: "1",
+	DcmTag{0x0018, 0x0080}: "1",
+	DcmTag{0x0018, 0x0081}: "1",
+	DcmTag{0x0018, 0x0082}: "1",
+	DcmTag{0x0018, 0x0083}: "1",
+	DcmTag{0x0018, 0x0084}: "1",
+	DcmTag{0x0018, 0x0085}: "1",
+	DcmTag{0x0018, 0x0086}: "1-n",
+	DcmTag{0x0018, 0x0087}: "1",
+	DcmTag{0x0018, 0x0088}: "1",
+	DcmTag{0x0018, 0x0089}: "1",
+	DcmTag{0x0018, 0x0090}: "1",
+	DcmTag{0x0018, 0x0091}: "1",
+	DcmTag{0x0018, 0x0093}: "1",
+	DcmTag{0x0018, 0x0094}: "1",
+	DcmTag{0x0018, 0x0095}: "1",
+	DcmTag{0x0018, 0x1000}: "1",
+	DcmTag{0x0018, 0x1002}: "1",
+	DcmTag{0x0018, 0x1003}: "1",
+	DcmTag{0x0018, 0x1004}: "1",
+	DcmTag{0x0018, 0x1005}: "1",
+	DcmTag{0x0018, 0x1006}: "1",
+	DcmTag{0x0018, 0x1007}: "1",
+	DcmTag{0x0018, 0x1008}: "1",
+	DcmTag{0x0018, 0x1010}: "1",
+	DcmTag{0x0018, 0x1011}: "1",
+	DcmTag{0x0018, 0x1012}: "1",
+	DcmTag{0x0018, 0x1014}: "1",
+	DcmTag{0x0018, 0x1016}: "1",
+	DcmTag{0x0018, 0x1017}: "1",
+	DcmTag{0x0018, 0x1018}: "1",
+	DcmTag{0x0018, 0x1019}: "1-n",
+	DcmTag{0x0018, 0x101a}: "1-n",
+	DcmTag{0x0018, 0x101b}: "1",
+	DcmTag{0x0018, 0x1020}: "1-n",
+	DcmTag{0x0018, 0x1022}: "1",
+	DcmTag{0x0018, 0x1023}: "1",
+	DcmTag{0x0018, 0x1030}: "1",
+	DcmTag{0x0018, 0x1040}: "1",
+	DcmTag{0x0018, 0x1041}: "1",
+	DcmTag{0x0018, 0x1042}: "1",
+	DcmTag{0x0018, 0x1043}: "1",
+	DcmTag{0x0018, 0x1044}: "1",
+	DcmTag{0x0018, 0x1045}: "1",
+	DcmTag{0x0018, 0x1046}: "1-n",
+	DcmTag{0x0018, 0x1047}: "1-n",
+	DcmTag{0x0018, 0x1048}: "1",
+	DcmTag{0x0018, 0x1049}: "1",
+	DcmTag{0x0018, 0x1050}: "1",
+	DcmTag{0x0018, 0x1060}: "1",
+	DcmTag{0x0018, 0x1061}: "1",
+	DcmTag{0x0018, 0x1062}: "1",
+	DcmTag{0x0018, 0x1063}: "1",
+	DcmTag{0x0018, 0x1064}: "1",
+	DcmTag{0x0018, 0x1065}: "1-n",
+	DcmTag{0x0018, 0x1066}: "1",
+	DcmTag{0x0018, 0x1067}: "1",
+	DcmTag{0x0018, 0x1068}: "1",
+	DcmTag{0x0018, 0x1069}: "1",
+	DcmTag{0x0018, 0x106a}: "1",
+	DcmTag{0x0018, 0x106c}: "2",
+	DcmTag{0x0018, 0x106e}: "1",
+	DcmTag{0x0018, 0x1070}: "1",
+	DcmTag{0x0018, 0x1071}: "1",
+	DcmTag{0x0018, 0x1072}: "1",
+	DcmTag{0x0018, 0x1073}: "1",
+	DcmTag{0x0018, 0x1074}: "1",
+	DcmTag{0x0018, 0x1075}: "1",
+	DcmTag{0x0018, 0x1076}: "1",
+	DcmTag{0x0018, 0x1077}: "1",
+	DcmTag{0x0018, 0x1078}: "1",
+	DcmTag{0x0018, 0x1079}: "1",
+	DcmTag{0x0018, 0x1080}: "1",
+	DcmTag{0x0018, 0x1081}: "1",
+	DcmTag{0x0018, 0x1082}: "1",
+	DcmTag{0x0018, 0x1083}: "1",
+	DcmTag{0x0018, 0x1084}: "1",
+	DcmTag{0x0018, 0x1085}: "1",
+	DcmTag{0x0018, 0x1086}: "1",
+	DcmTag{0x0018, 0x1088}: "1",
+	DcmTag{0x0018, 0x1090}: "1",
+	DcmTag{0x0018, 0x1094}: "1",
+	DcmTag{0x0018, 0x1100}: "1",
+	DcmTag{0x0018, 0x1110}: "1",
+	DcmTag{0x0018, 0x1111}: "1",
+	DcmTag{0x0018, 0x1114}: "1",
+	DcmTag{0x0018, 0x1120}: "1",
+	DcmTag{0x0018, 0x1121}: "1",
+	DcmTag{0x0018, 0x1130}: "1",
+	DcmTag{0x0018, 0x1131}: "1",
+	DcmTag{0x0018, 0x1134}: "1",
+	DcmTag{0x0018, 0x1135}: "1-n",
+	DcmTag{0x0018, 0x1136}: "1-n",
+	DcmTag{0x0018, 0x1137}: "1-n",
+	DcmTag{0x0018, 0x1138}: "1",
+	DcmTag{0x0018, 0x113a}: "1",
+	DcmTag{0x0018, 0x1140}: "1",
+	DcmTag{0x0018, 0x1141}: "1",
+	DcmTag{0x0018, 0x1142}: "1-n",
+	DcmTag{0x0018, 0x1143}: "1",
+	DcmTag{0x0018, 0x1144}: "1",
+	DcmTag{0x0018, 0x1145}: "1",
+	DcmTag{0x0018, 0x1146}: "1-n",
+	DcmTag{0x0018, 0x1147}: "1",
+	DcmTag{0x0018, 0x1149}: "1-2",
+	DcmTag{0x0018, 0x1150}: "1",
+	DcmTag{0x0018, 0x1151}: "1",
+	DcmTag{0x0018, 0x1152}: "1",
+	DcmTag{0x0018, 0x1153}: "1",
+	DcmTag{0x0018, 0x1154}: "1",
+	DcmTag{0x0018, 0x1155}: "1",
+	DcmTag{0x0018, 0x1156}: "1",
+	DcmTag{0x0018, 0x115a}: "1",
+	DcmTag{0x0018, 0x115e}: "1",
+	DcmTag{0x0018, 0x1160}: "1",
+	DcmTag{0x0018, 0x1161}: "1-n",
+	DcmTag{0x0018, 0x1162}: "1",
+	DcmTag{0x0018, 0x1164}: "2",
+	DcmTag{0x0018, 0x1166}: "1-n",
+	DcmTag{0x0018, 0x1170}: "1",
+	DcmTag{0x0018, 0x1180}: "1",
+	DcmTag{0x0018, 0x1181}: "1",
+	DcmTag{0x0018, 0x1182}: "1-2",
+	DcmTag{0x0018, 0x1183}: "1-2",
+	DcmTag{0x0018, 0x1184}: "1-2",
+	DcmTag{0x0018, 0x1190}: "1-n",
+	DcmTag{0x0018, 0x1191}: "1",
+	DcmTag{0x0018, 0x11a0}: "1",
+	DcmTag{0x0018, 0x11a2}: "1",
+	DcmTag{0x0018, 0x1200}: "1-n",
+	DcmTag{0x0018, 0x1201}: "1-n",
+	DcmTag{0x0018, 0x1210}: "1-n",
+	DcmTag{0x0018, 0x1240}: "1-n",
+	DcmTag{0x0018, 0x1242}: "1",
+	DcmTag{0x0018, 0x1243}: "1",
+	DcmTag{0x0018, 0x1244}: "1",
+	DcmTag{0x0018, 0x1250}: "1",
+	DcmTag{0x0018, 0x1251}: "1",
+	DcmTag{0x0018, 0x1260}: "1",
+	DcmTag{0x0018, 0x1261}: "1",
+	DcmTag{0x0018, 0x1300}: "1",
+	DcmTag{0x0018, 0x1301}: "1-n",
+	DcmTag{0x0018, 0x1302}: "1",
+	DcmTag{0x0018, 0x1310}: "4",
+	DcmTag{0x0018, 0x1312}: "1",
+	DcmTag{0x0018, 0x1314}: "1",
+	DcmTag{0x0018, 0x1315}: "1",
+	DcmTag{0x0018, 0x1316}: "1",
+	DcmTag{0x0018, 0x1318}: "1",
+	DcmTag{0x0018, 0x1400}: "1",
+	DcmTag{0x0018, 0x1401}: "1",
+	DcmTag{0x0018, 0x1402}: "1",
+	DcmTag{0x0018, 0x1403}: "1",
+	DcmTag{0x0018, 0x1404}: "1",
+	DcmTag{0x0018, 0x1405}: "1",
+	DcmTag{0x0018, 0x1411}: "1",
+	DcmTag{0x0018, 0x1412}: "1",
+	DcmTag{0x0018, 0x1413}: "1",
+	DcmTag{0x0018, 0x1450}: "1",
+	DcmTag{0x0018, 0x1460}: "1",
+	DcmTag{0x0018, 0x1470}: "1",
+	DcmTag{0x0018, 0x1480}: "1",
+	DcmTag{0x0018, 0x1490}: "1",
+	DcmTag{0x0018, 0x1491}: "1",
+	DcmTag{0x0018, 0x1495}: "1",
+	DcmTag{0x0018, 0x1500}: "1",
+	DcmTag{0x0018, 0x1508}: "1",
+	DcmTag{0x0018, 0x1510}: "1",
+	DcmTag{0x0018, 0x1511}: "1",
+	DcmTag{0x0018, 0x1520}: "1-n",
+	DcmTag{0x0018, 0x1521}: "1-n",
+	DcmTag{0x0018, 0x1530}: "1",
+	DcmTag{0x0018, 0x1531}: "1",
+	DcmTag{0x0018, 0x1600}: "1-3",
+	DcmTag{0x0018, 0x1602}: "1",
+	DcmTag{0x0018, 0x1604}: "1",
+	DcmTag{0x0018, 0x1606}: "1",
+	DcmTag{0x0018, 0x1608}: "1",
+	DcmTag{0x0018, 0x1610}: "2",
+	DcmTag{0x0018, 0x1612}: "1",
+	DcmTag{0x0018, 0x1620}: "2-2n",
+	DcmTag{0x0018, 0x1622}: "1",
+	DcmTag{0x0018, 0x1623}: "1",
+	DcmTag{0x0018, 0x1624}: "3",
+	DcmTag{0x0018, 0x1700}: "1-3",
+	DcmTag{0x0018, 0x1702}: "1",
+	DcmTag{0x0018, 0x1704}: "1",
+	DcmTag{0x0018, 0x1706}: "1",
+	DcmTag{0x0018, 0x1708}: "1",
+	DcmTag{0x0018, 0x1710}: "2",
+	DcmTag{0x0018, 0x1712}: "1",
+	DcmTag{0x0018, 0x1720}: "2-2n",
+	DcmTag{0x0018, 0x1800}: "1",
+	DcmTag{0x0018, 0x1801}: "1",
+	DcmTag{0x0018, 0x1802}: "1",
+	DcmTag{0x0018, 0x1803}: "1",
+	DcmTag{0x0018, 0x2001}: "1-n",
+	DcmTag{0x0018, 0x2002}: "1-n",
+	DcmTag{0x0018, 0x2003}: "1-n",
+	DcmTag{0x0018, 0x2004}: "1-n",
+	DcmTag{0x0018, 0x2005}: "1-n",
+	DcmTag{0x0018, 0x2006}: "1-n",
+	DcmTag{0x0018, 0x2010}: "2",
+	DcmTag{0x0018, 0x2020}: "1",
+	DcmTag{0x0018, 0x2030}: "1",
+	DcmTag{0x0018, 0x3100}: "1",
+	DcmTag{0x0018, 0x3101}: "1",
+	DcmTag{0x0018, 0x3102}: "1",
+	DcmTag{0x0018, 0x3103}: "1",
+	DcmTag{0x0018, 0x3104}: "1",
+	DcmTag{0x0018, 0x3105}: "1-n",
+	DcmTag{0x0018, 0x4000}: "1-n",
+	DcmTag{0x0018, 0x5000}: "1-n",
+	DcmTag{0x0018, 0x5010}: "1-n",
+	DcmTag{0x0018, 0x5012}: "1",
+	DcmTag{0x0018, 0x5020}: "1",
+	DcmTag{0x0018, 0x5021}: "1",
+	DcmTag{0x0018, 0x5022}: "1",
+	DcmTag{0x0018, 0x5024}: "1",
+	DcmTag{0x0018, 0x5026}: "1",
+	DcmTag{0x0018, 0x5027}: "1",
+	DcmTag{0x0018, 0x5028}: "1",
+	DcmTag{0x0018, 0x5029}: "1",
+	DcmTag{0x0018, 0x5030}: "1",
+	DcmTag{0x0018, 0x5040}: "1",
+	DcmTag{0x0018, 0x5050}: "1",
+	DcmTag{0x0018, 0x5100}: "1",
+	DcmTag{0x0018, 0x5101}: "1",
+	DcmTag{0x0018, 0x5104}: "1",
+	DcmTag{0x0018, 0x5210}: "6",
+	DcmTag{0x0018, 0x5212}: "3",
+	DcmTag{0x0018, 0x6000}: "1",
+	DcmTag{0x0018, 0x6011}: "1",
+	DcmTag{0x0018, 0x6012}: "1",
+	DcmTag{0x0018, 0x6014}: "1",
+	DcmTag{0x0018, 0x6016}: "1",
+	DcmTag{0x0018, 0x6018}: "1",
+	DcmTag{0x0018, 0x601a}: "1",
+	DcmTag{0x0018, 0x601c}: "1",
+	DcmTag{0x0018, 0x601e}: "1",
+	DcmTag{0x0018, 0x6020}: "1",
+	DcmTag{0x0018, 0x6022}: "1",
+	DcmTag{0x0018, 0x6024}: "1",
+	DcmTag{0x0018, 0x6026}: "1",
+	DcmTag{0x0018, 0x6028}: "1",
+	DcmTag{0x0018, 0x602a}: "1",
+	DcmTag{0x0018, 0x602c}: "1",
+	DcmTag{0x0018, 0x602e}: "1",
+	DcmTag{0x0018, 0x6030}: "1",
+	DcmTag{0x0018, 0x6031}: "1",
+	DcmTag{0x0018, 0x6032}: "1",
+	DcmTag{0x0018, 0x6034}: "1",
+	DcmTag{0x0018, 0x6036}: "1",
+	DcmTag{0x0018, 0x6038}: "1",
+	DcmTag{0x0018, 0x6039}: "1",
+	DcmTag{0x0018, 0x603a}: "1",
+	DcmTag{0x0018, 0x603b}: "1",
+	DcmTag{0x0018, 0x603c}: "1",
+	DcmTag{0x0018, 0x603d}: "1",
+	DcmTag{0x0018, 0x603e}: "1",
+	DcmTag{0x0018, 0x603f}: "1",
+	DcmTag{0x0018, 0x6040}: "1",
+	DcmTag{0x0018, 0x6041}: "1",
+	DcmTag{0x0018, 0x6042}: "1",
+	DcmTag{0x0018, 0x6043}: "1",
+	DcmTag{0x0018, 0x6044}: "1",
+	DcmTag{0x0018, 0x6046}: "1",
+	DcmTag{0x0018, 0x6048}: "1",
+	DcmTag{0x0018, 0x604a}: "1",
+	DcmTag{0x0018, 0x604c}: "1",
+	DcmTag{0x0018, 0x604e}: "1",
+	DcmTag{0x0018, 0x6050}: "1",
+	DcmTag{0x0018, 0x6052}: "1-n",
+	DcmTag{0x0018, 0x6054}: "1-n",
+	DcmTag{0x0018, 0x6056}: "1",
+	DcmTag{0x0018, 0x6058}: "1-n",
+	DcmTag{0x0018, 0x605a}: "1-n",
+	DcmTag{0x0018, 0x6060}: "1-n",
+	DcmTag{0x0018, 0x7000}: "1",
+	DcmTag{0x0018, 0x7001}: "1",
+	DcmTag{0x0018, 0x7004}: "1",
+	DcmTag{0x0018, 0x7005}: "1",
+	DcmTag{0x0018, 0x7006}: "1",
+	DcmTag{0x0018, 0x7008}: "1",
+	DcmTag{0x0018, 0x700a}: "1",
+	DcmTag{0x0018, 0x700c}: "1",
+	DcmTag{0x0018, 0x700e}: "1",
+	DcmTag{0x0018, 0x7010}: "1",
+	DcmTag{0x0018, 0x7011}: "1",
+	DcmTag{0x0018, 0x7012}: "1",
+	DcmTag{0x0018, 0x7014}: "1",
+	DcmTag{0x0018, 0x7016}: "1",
+	DcmTag{0x0018, 0x701a}: "2",
+	DcmTag{0x0018, 0x7020}: "2",
+	DcmTag{0x0018, 0x7022}: "2",
+	DcmTag{0x0018, 0x7024}: "1",
+	DcmTag{0x0018, 0x7026}: "1-2",
+	DcmTag{0x0018, 0x7028}: "2",
+	DcmTag{0x0018, 0x702a}: "1",
+	DcmTag{0x0018, 0x702b}: "1",
+	DcmTag{0x0018, 0x7030}: "2",
+	DcmTag{0x0018, 0x7032}: "1",
+	DcmTag{0x0018, 0x7034}: "1",
+	DcmTag{0x0018, 0x7040}: "1",
+	DcmTag{0x0018, 0x7041}: "1",
+	DcmTag{0x0018, 0x7042}: "1",
+	DcmTag{0x0018, 0x7044}: "1",
+	DcmTag{0x0018, 0x7046}: "2",
+	DcmTag{0x0018, 0x7048}: "1",
+	DcmTag{0x0018, 0x704c}: "1",
+	DcmTag{0x0018, 0x7050}: "1-n",
+	DcmTag{0x0018, 0x7052}: "1-n",
+	DcmTag{0x0018, 0x7054}: "1-n",
+	DcmTag{0x0018, 0x7056}: "1-n",
+	DcmTag{0x0018, 0x7058}: "1-n",
+	DcmTag{0x0018, 0x7060}: "1",
+	DcmTag{0x0018, 0x7062}: "1",
+	DcmTag{0x0018, 0x7064}: "1",
+	DcmTag{0x0018, 0x7065}: "1",
+	DcmTag{0x0018, 0x8150}: "1",
+	DcmTag{0x0018, 0x8151}: "1",
+	DcmTag{0x0018, 0x9004}: "1",
+	DcmTag{0x0018, 0x9005}: "1",
+	DcmTag{0x0018, 0x9006}: "1",
+	DcmTag{0x0018, 0x9008}: "1",
+	DcmTag{0x0018, 0x9009}: "1",
+	DcmTag{0x0018, 0x9010}: "1",
+	DcmTag{0x0018, 0x9011}: "1",
+	DcmTag{0x0018, 0x9012}: "1",
+	DcmTag{0x0018, 0x9014}: "1",
+	DcmTag{0x0018, 0x9015}: "1",
+	DcmTag{0x0018, 0x9016}: "1",
+	DcmTag{0x0018, 0x9017}: "1",
+	DcmTag{0x0018, 0x9018}: "1",
+	DcmTag{0x0018, 0x9019}: "1",
+	DcmTag{0x0018, 0x9020}: "1",
+	DcmTag{0x0018, 0x9021}: "1",
+	DcmTag{0x0018, 0x9022}: "1",
+	DcmTag{0x0018, 0x9024}: "1",
+	DcmTag{0x0018, 0x9025}: "1",
+	DcmTag{0x0018, 0x9026}: "1",
+	DcmTag{0x0018, 0x9027}: "1",
+	DcmTag{0x0018, 0x9028}: "1",
+	DcmTag{0x0018, 0x9029}: "1",
+	DcmTag{0x0018, 0x9030}: "1",
+	DcmTag{0x0018, 0x9032}: "1",
+	DcmTag{0x0018, 0x9033}: "1",
+	DcmTag{0x0018, 0x9034}: "1",
+	DcmTag{0x0018, 0x9035}: "1",
+	DcmTag{0x0018, 0x9036}: "1",
+	DcmTag{0x0018, 0x9037}: "1",
+	DcmTag{0x0018, 0x9041}: "1",
+	DcmTag{0x0018, 0x9042}: "1",
+	DcmTag{0x0018, 0x9043}: "1",
+	DcmTag{0x0018, 0x9044}: "1",
+	DcmTag{0x0018, 0x9045}: "1",
+	DcmTag{0x0018, 0x9046}: "1",
+	DcmTag{0x0018, 0x9047}: "1",
+	DcmTag{0x0018, 0x9048}: "1",
+	DcmTag{0x0018, 0x9049}: "1",
+	DcmTag{0x0018, 0x9050}: "1",
+	DcmTag{0x0018, 0x9051}: "1",
+	DcmTag{0x0018, 0x9052}: "1-2",
+	DcmTag{0x0018, 0x9053}: "1-2",
+	DcmTag{0x0018, 0x9054}: "1",
+	DcmTag{0x0018, 0x9058}: "1",
+	DcmTag{0x0018, 0x9059}: "1",
+	DcmTag{0x0018, 0x9060}: "1-2",
+	DcmTag{0x0018, 0x9061}: "1-2",
+	DcmTag{0x0018, 0x9062}: "1",
+	DcmTag{0x0018, 0x9063}: "1-2",
+	DcmTag{0x0018, 0x9064}: "1",
+	DcmTag{0x0018, 0x9065}: "1-2",
+	DcmTag{0x0018, 0x9066}: "1-2",
+	DcmTag{0x0018, 0x9067}: "1",
+	DcmTag{0x0018, 0x9069}: "1",
+	DcmTag{0x0018, 0x9070}: "1",
+	DcmTag{0x0018, 0x9073}: "1",
+	DcmTag{0x0018, 0x9074}: "1",
+	DcmTag{0x0018, 0x9075}: "1",
+	DcmTag{0x0018, 0x9076}: "1",
+	DcmTag{0x0018, 0x9077}: "1",
+	DcmTag{0x0018, 0x9078}: "1",
+	DcmTag{0x0018, 0x9079}: "1-n",
+	DcmTag{0x0018, 0x9080}: "1",
+	DcmTag{0x0018, 0x9081}: "1",
+	DcmTag{0x0018, 0x9082}: "1",
+	DcmTag{0x0018, 0x9083}: "1",
+	DcmTag{0x0018, 0x9084}: "1",
+	DcmTag{0x0018, 0x9085}: "1",
+	DcmTag{0x0018, 0x9087}: "1",
+	DcmTag{0x0018, 0x9089}: "3",
+	DcmTag{0x0018, 0x9090}: "3",
+	DcmTag{0x0018, 0x9091}: "1",
+	DcmTag{0x0018, 0x9092}: "1",
+	DcmTag{0x0018, 0x9093}: "1",
+	DcmTag{0x0018, 0x9094}: "1",
+	DcmTag{0x0018, 0x9095}: "1",
+	DcmTag{0x0018, 0x9096}: "1",
+	DcmTag{0x0018, 0x9098}: "1-2",
+	DcmTag{0x0018, 0x9100}: "1-2",
+	DcmTag{0x0018, 0x9101}: "1",
+	DcmTag{0x0018, 0x9103}: "1",
+	DcmTag{0x0018, 0x9104}: "1",
+	DcmTag{0x0018, 0x9105}: "3",
+	DcmTag{0x0018, 0x9106}: "3",
+	DcmTag{0x0018, 0x9107}: "1",
+	DcmTag{0x0018, 0x9112}: "1",
+	DcmTag{0x0018, 0x9114}: "1",
+	DcmTag{0x0018, 0x9115}: "1",
+	DcmTag{0x0018, 0x9117}: "1",
+	DcmTag{0x0018, 0x9118}: "1",
+	DcmTag{0x0018, 0x9119}: "1",
+	DcmTag{0x0018, 0x9125}: "1",
+	DcmTag{0x0018, 0x9126}: "1",
+	DcmTag{0x0018, 0x9127}: "1",
+	DcmTag{0x0018, 0x9147}: "1",
+	DcmTag{0x0018, 0x9151}: "1",
+	DcmTag{0x0018, 0x9152}: "1",
+	DcmTag{0x0018, 0x9155}: "1",
+	DcmTag{0x0018, 0x9159}: "1",
+	DcmTag{0x0018, 0x9166}: "1",
+	DcmTag{0x0018, 0x9168}: "1",
+	DcmTag{0x0018, 0x9169}: "1",
+	DcmTag{0x0018, 0x9170}: "1",
+	DcmTag{0x0018, 0x9171}: "1",
+	DcmTag{0x0018, 0x9172}: "1",
+	DcmTag{0x0018, 0x9173}: "1",
+	DcmTag{0x0018, 0x9174}: "1",
+	DcmTag{0x0018, 0x9175}: "1",
+	DcmTag{0x0018, 0x9176}: "1",
+	DcmTag{0x0018, 0x9177}: "1",
+	DcmTag{0x0018, 0x9178}: "1",
+	DcmTag{0x0018, 0x9179}: "1",
+	DcmTag{0x0018, 0x9180}: "1",
+	DcmTag{0x0018, 0x9181}: "1",
+	DcmTag{0x0018, 0x9182}: "1",
+	DcmTag{0x0018, 0x9183}: "1",
+	DcmTag{0x0018, 0x9184}: "1",
+	DcmTag{0x0018, 0x9185}: "1",
+	DcmTag{0x0018, 0x9186}: "1",
+	DcmTag{0x0018, 0x9195}: "1",
+	DcmTag{0x0018, 0x9196}: "1",
+	DcmTag{0x0018, 0x9197}: "1",
+	DcmTag{0x0018, 0x9198}: "1",
+	DcmTag{0x0018, 0x9199}: "1",
+	DcmTag{0x0018, 0x9200}: "1",
+	DcmTag{0x0018, 0x9214}: "1",
+	DcmTag{0x0018, 0x9217}: "1",
+	DcmTag{0x0018, 0x9218}: "1",
+	DcmTag{0x0018, 0x9219}: "1",
+	DcmTag{0x0018, 0x9220}: "1",
+	DcmTag{0x0018, 0x9226}: "1",
+	DcmTag{0x0018, 0x9227}: "1",
+	DcmTag{0x0018, 0x9231}: "1",
+	DcmTag{0x0018, 0x9232}: "1",
+	DcmTag{0x0018, 0x9234}: "1",
+	DcmTag{0x0018, 0x9236}: "1",
+	DcmTag{0x0018, 0x9239}: "1",
+	DcmTag{0x0018, 0x9240}: "1",
+	DcmTag{0x0018, 0x9241}: "1",
+	DcmTag{0x0018, 0x9295}: "1",
+	DcmTag{0x0018, 0x9296}: "1",
+	DcmTag{0x0018, 0x9301}: "1",
+	DcmTag{0x0018, 0x9302}: "1",
+	DcmTag{0x0018, 0x9303}: "1",
+	DcmTag{0x0018, 0x9304}: "1",
+	DcmTag{0x0018, 0x9305}: "1",
+	DcmTag{0x0018, 0x9306}: "1",
+	DcmTag{0x0018, 0x9307}: "1",
+	DcmTag{0x0018, 0x9308}: "1",
+	DcmTag{0x0018, 0x9309}: "1",
+	DcmTag{0x0018, 0x9310}: "1",
+	DcmTag{0x0018, 0x9311}: "1",
+	DcmTag{0x0018, 0x9312}: "1",
+	DcmTag{0x0018, 0x9313}: "3",
+	DcmTag{0x0018, 0x9314}: "1",
+	DcmTag{0x0018, 0x9315}: "1",
+	DcmTag{0x0018, 0x9316}: "1",
+	DcmTag{0x0018, 0x9317}: "2",
+	DcmTag{0x0018, 0x9318}: "3",
+	DcmTag{0x0018, 0x9319}: "1",
+	DcmTag{0x0018, 0x9320}: "1",
+	DcmTag{0x0018, 0x9321}: "1",
+	DcmTag{0x0018, 0x9322}: "2",
+	DcmTag{0x0018, 0x9323}: "1",
+	DcmTag{0x0018, 0x9324}: "1",
+	DcmTag{0x0018, 0x9325}: "1",
+	DcmTag{0x0018, 0x9326}: "1",
+	DcmTag{0x0018, 0x9327}: "1",
+	DcmTag{0x0018, 0x9328}: "1",
+	DcmTag{0x0018, 0x9329}: "1",
+	DcmTag{0x0018, 0x9330}: "1",
+	DcmTag{0x0018, 0x9332}: "1",
+	DcmTag{0x0018, 0x9333}: "1",
+	DcmTag{0x0018, 0x9334}: "1",
+	DcmTag{0x0018, 0x9335}: "1",
+	DcmTag{0x0018, 0x9337}: "1",
+	DcmTag{0x0018, 0x9338}: "1",
+	DcmTag{0x0018, 0x9340}: "1",
+	DcmTag{0x0018, 0x9341}: "1",
+	DcmTag{0x0018, 0x9342}: "1",
+	DcmTag{0x0018, 0x9343}: "1",
+	DcmTag{0x0018, 0x9344}: "1",
+	DcmTag{0x0018, 0x9345}: "1",
+	DcmTag{0x0018, 0x9346}: "1",
+	DcmTag{0x0018, 0x9351}: "1",
+	DcmTag{0x0018, 0x9352}: "3",
+	DcmTag{0x0018, 0x9353}: "1",
+	DcmTag{0x0018, 0x9360}: "1",
+	DcmTag{0x0018, 0x9401}: "1",
+	DcmTag{0x0018, 0x9402}: "1",
+	DcmTag{0x0018, 0x9403}: "1",
+	DcmTag{0x0018, 0x9404}: "2",
+	DcmTag{0x0018, 0x9405}: "1",
+	DcmTag{0x0018, 0x9406}: "1",
+	DcmTag{0x0018, 0x9407}: "1",
+	DcmTag{0x0018, 0x9412}: "1",
+	DcmTag{0x0018, 0x9417}: "1",
+	DcmTag{0x0018, 0x9420}: "1",
+	DcmTag{0x0018, 0x9423}: "1",
+	DcmTag{0x0018, 0x9424}: "1",
+	DcmTag{0x0018, 0x9425}: "1",
+	DcmTag{0x0018, 0x9426}: "1",
+	DcmTag{0x0018, 0x9427}: "1",
+	DcmTag{0x0018, 0x9428}: "1-2",
+	DcmTag{0x0018, 0x9429}: "2",
+	DcmTag{0x0018, 0x9430}: "2",
+	DcmTag{0x0018, 0x9432}: "1",
+	DcmTag{0x0018, 0x9433}: "1",
+	DcmTag{0x0018, 0x9434}: "1",
+	DcmTag{0x0018, 0x9435}: "1",
+	DcmTag{0x0018, 0x9436}: "1",
+	DcmTag{0x0018, 0x9437}: "1",
+	DcmTag{0x0018, 0x9438}: "1",
+	DcmTag{0x0018, 0x9439}: "1",
+	DcmTag{0x0018, 0x9440}: "2",
+	DcmTag{0x0018, 0x9441}: "1",
+	DcmTag{0x0018, 0x9442}: "2-n",
+	DcmTag{0x0018, 0x9447}: "1",
+	DcmTag{0x0018, 0x9449}: "1",
+	DcmTag{0x0018, 0x9451}: "1",
+	DcmTag{0x0018, 0x9452}: "1",
+	DcmTag{0x0018, 0x9455}: "1",
+	DcmTag{0x0018, 0x9456}: "1",
+	DcmTag{0x0018, 0x9457}: "1",
+	DcmTag{0x0018, 0x9461}: "1-2",
+	DcmTag{0x0018, 0x9462}: "1",
+	DcmTag{0x0018, 0x9463}: "1",
+	DcmTag{0x0018, 0x9464}: "1",
+	DcmTag{0x0018, 0x9465}: "1",
+	DcmTag{0x0018, 0x9466}: "1",
+	DcmTag{0x0018, 0x9467}: "1",
+	DcmTag{0x0018, 0x9468}: "1",
+	DcmTag{0x0018, 0x9469}: "1",
+	DcmTag{0x0018, 0x9470}: "1",
+	DcmTag{0x0018, 0x9471}: "1",
+	DcmTag{0x0018, 0x9472}: "1",
+	DcmTag{0x0018, 0x9473}: "1",
+	DcmTag{0x0018, 0x9474}: "1",
+	DcmTag{0x0018, 0x9476}: "1",
+	DcmTag{0x0018, 0x9477}: "1",
+	DcmTag{0x0018, 0x9504}: "1",
+	DcmTag{0x0018, 0x9506}: "1",
+	DcmTag{0x0018, 0x9507}: "1",
+	DcmTag{0x0018, 0x9508}: "1",
+	DcmTag{0x0018, 0x9509}: "1",
+	DcmTag{0x0018, 0x9510}: "1",
+	DcmTag{0x0018, 0x9511}: "1",
+	DcmTag{0x0018, 0x9514}: "1",
+	DcmTag{0x0018, 0x9515}: "1",
+	DcmTag{0x0018, 0x9516}: "1",
+	DcmTag{0x0018, 0x9517}: "1",
+	DcmTag{0x0018, 0x9524}: "1",
+	DcmTag{0x0018, 0x9525}: "1",
+	DcmTag{0x0018, 0x9526}: "1",
+	DcmTag{0x0018, 0x9527}: "1",
+	DcmTag{0x0018, 0x9528}: "1",
+	DcmTag{0x0018, 0x9530}: "1",
+	DcmTag{0x0018, 0x9531}: "1",
+	DcmTag{0x0018, 0x9538}: "1",
+	DcmTag{0x0018, 0x9601}: "1",
+	DcmTag{0x0018, 0x9602}: "1",
+	DcmTag{0x0018, 0x9603}: "1",
+	DcmTag{0x0018, 0x9604}: "1",
+	DcmTag{0x0018, 0x9605}: "1",
+	DcmTag{0x0018, 0x9606}: "1",
+	DcmTag{0x0018, 0x9607}: "1",
+	DcmTag{0x0018, 0x9701}: "1",
+	DcmTag{0x0018, 0x9715}: "1",
+	DcmTag{0x0018, 0x9716}: "1",
+	DcmTag{0x0018, 0x9717}: "1",
+	DcmTag{0x0018, 0x9718}: "1",
+	DcmTag{0x0018, 0x9719}: "1",
+	DcmTag{0x0018, 0x9720}: "1",
+	DcmTag{0x0018, 0x9721}: "1",
+	DcmTag{0x0018, 0x9722}: "1",
+	DcmTag{0x0018, 0x9723}: "1",
+	DcmTag{0x0018, 0x9724}: "1",
+	DcmTag{0x0018, 0x9725}: "1",
+	DcmTag{0x0018, 0x9726}: "1",
+	DcmTag{0x0018, 0x9727}: "1",
+	DcmTag{0x0018, 0x9729}: "1",
+	DcmTag{0x0018, 0x9732}: "1",
+	DcmTag{0x0018, 0x9733}: "1",
+	DcmTag{0x0018, 0x9734}: "1",
+	DcmTag{0x0018, 0x9735}: "1",
+	DcmTag{0x0018, 0x9736}: "1",
+	DcmTag{0x0018, 0x9737}: "1",
+	DcmTag{0x0018, 0x9738}: "1",
+	DcmTag{0x0018, 0x9739}: "1",
+	DcmTag{0x0018, 0x9740}: "1",
+	DcmTag{0x0018, 0x9749}: "1",
+	DcmTag{0x0018, 0x9751}: "1",
+	DcmTag{0x0018, 0x9755}: "1",
+	DcmTag{0x0018, 0x9756}: "1",
+	DcmTag{0x0018, 0x9758}: "1",
+	DcmTag{0x0018, 0x9759}: "1",
+	DcmTag{0x0018, 0x9760}: "1",
+	DcmTag{0x0018, 0x9761}: "1",
+	DcmTag{0x0018, 0x9762}: "1",
+	DcmTag{0x0018, 0x9763}: "1",
+	DcmTag{0x0018, 0x9764}: "1",
+	DcmTag{0x0018, 0x9765}: "1",
+	DcmTag{0x0018, 0x9766}: "1",
+	DcmTag{0x0018, 0x9767}: "1",
+	DcmTag{0x0018, 0x9768}: "1",
+	DcmTag{0x0018, 0x9769}: "1",
+	DcmTag{0x0018, 0x9770}: "1",
+	DcmTag{0x0018, 0x9771}: "1",
+	DcmTag{0x0018, 0x9772}: "1",
+	DcmTag{0x0018, 0x9801}: "1-n",
+	DcmTag{0x0018, 0x9803}: "1",
+	DcmTag{0x0018, 0x9804}: "1",
+	DcmTag{0x0018, 0x9805}: "1",
+	DcmTag{0x0018, 0x9806}: "1",
+	DcmTag{0x0018, 0x9807}: "1",
+	DcmTag{0x0018, 0x9808}: "1",
+	DcmTag{0x0018, 0x9809}: "1",
+	DcmTag{0x0018, 0x980b}: "1",
+	DcmTag{0x0018, 0x980c}: "1",
+	DcmTag{0x0018, 0x980d}: "1",
+	DcmTag{0x0018, 0x980e}: "1",
+	DcmTag{0x0018, 0x980f}: "1",
+	DcmTag{0x0018, 0xa001}: "1",
+	DcmTag{0x0018, 0xa002}: "1",
+	DcmTag{0x0018, 0xa003}: "1",
+	DcmTag{0x0020, 0x000d}: "1",
+	DcmTag{0x0020, 0x000e}: "1",
+	DcmTag{0x0020, 0x0010}: "1",
+	DcmTag{0x0020, 0x0011}: "1",
+	DcmTag{0x0020, 0x0012}: "1",
+	DcmTag{0x0020, 0x0013}: "1",
+	DcmTag{0x0020, 0x0014}: "1",
+	DcmTag{0x0020, 0x0015}: "1",
+	DcmTag{0x0020, 0x0016}: "1",
+	DcmTag{0x0020, 0x0017}: "1",
+	DcmTag{0x0020, 0x0018}: "1",
+	DcmTag{0x0020, 0x0019}: "1",
+	DcmTag{0x0020, 0x0020}: "2",
+	DcmTag{0x0020, 0x0022}: "1",
+	DcmTag{0x0020, 0x0024}: "1",
+	DcmTag{0x0020, 0x0026}: "1",
+	DcmTag{0x0020, 0x0030}: "3",
+	DcmTag{0x0020, 0x0032}: "3",
+	DcmTag{0x0020, 0x0035}: "6",
+	DcmTag{0x0020, 0x0037}: "6",
+	DcmTag{0x0020, 0x0050}: "1",
+	DcmTag{0x0020, 0x0052}: "1",
+	DcmTag{0x0020, 0x0060}: "1",
+	DcmTag{0x0020, 0x0062}: "1",
+	DcmTag{0x0020, 0x0070}: "1",
+	DcmTag{0x0020, 0x0080}: "1-n",
+	DcmTag{0x0020, 0x0100}: "1",
+	DcmTag{0x0020, 0x0105}: "1",
+	DcmTag{0x0020, 0x0110}: "1",
+	DcmTag{0x0020, 0x0200}: "1",
+	DcmTag{0x0020, 0x0242}: "1",
+	DcmTag{0x0020, 0x1000}: "1",
+	DcmTag{0x0020, 0x1001}: "1",
+	DcmTag{0x0020, 0x1002}: "1",
+	DcmTag{0x0020, 0x1003}: "1",
+	DcmTag{0x0020, 0x1004}: "1",
+	DcmTag{0x0020, 0x1005}: "1",
+	DcmTag{0x0020, 0x1020}: "1-n",
+	DcmTag{0x0020, 0x1040}: "1",
+	DcmTag{0x0020, 0x1041}: "1",
+	DcmTag{0x0020, 0x1070}: "1-n",
+	DcmTag{0x0020, 0x1200}: "1",
+	DcmTag{0x0020, 0x1202}: "1",
+	DcmTag{0x0020, 0x1204}: "1",
+	DcmTag{0x0020, 0x1206}: "1",
+	DcmTag{0x0020, 0x1208}: "1",
+	DcmTag{0x0020, 0x1209}: "1",
+	DcmTag{0x0020, 0x3100}: "1-n",
+	DcmTag{0x0020, 0x3401}: "1",
+	DcmTag{0x0020, 0x3402}: "1",
+	DcmTag{0x0020, 0x3403}: "1",
+	DcmTag{0x0020, 0x3404}: "1",
+	DcmTag{0x0020, 0x3405}: "1",
+	DcmTag{0x0020, 0x3406}: "1",
+	DcmTag{0x0020, 0x4000}: "1",
+	DcmTag{0x0020, 0x5000}: "1-n",
+	DcmTag{0x0020, 0x5002}: "1-n",
+	DcmTag{0x0020, 0x9056}: "1",
+	DcmTag{0x0020, 0x9057}: "1",
+	DcmTag{0x0020, 0x9071}: "1",
+	DcmTag{0x0020, 0x9072}: "1",
+	DcmTag{0x0020, 0x9111}: "1",
+	DcmTag{0x0020, 0x9113}: "1",
+	DcmTag{0x0020, 0x9116}: "1",
+	DcmTag{0x0020, 0x9128}: "1",
+	DcmTag{0x0020, 0x9153}: "1",
+	DcmTag{0x0020, 0x9156}: "1",
+	DcmTag{0x0020, 0x9157}: "1-n",
+	DcmTag{0x0020, 0x9158}: "1",
+	DcmTag{0x0020, 0x9161}: "1",
+	DcmTag{0x0020, 0x9162}: "1",
+	DcmTag{0x0020, 0x9163}: "1",
+	DcmTag{0x0020, 0x9164}: "1",
+	DcmTag{0x0020, 0x9165}: "1",
+	DcmTag{0x0020, 0x9167}: "1",
+	DcmTag{0x0020, 0x9213}: "1",
+	DcmTag{0x0020, 0x9221}: "1",
+	DcmTag{0x0020, 0x9222}: "1",
+	DcmTag{0x0020, 0x9228}: "1",
+	DcmTag{0x0020, 0x9238}: "1",
+	DcmTag{0x0020, 0x9241}: "1",
+	DcmTag{0x0020, 0x9245}: "1",
+	DcmTag{0x0020, 0x9246}: "1",
+	DcmTag{0x0020, 0x9247}: "1",
+	DcmTag{0x0020, 0x9248}: "1",
+	DcmTag{0x0020, 0x9249}: "1",
+	DcmTag{0x0020, 0x9250}: "1",
+	DcmTag{0x0020, 0x9251}: "1",
+	DcmTag{0x0020, 0x9252}: "1",
+	DcmTag{0x0020, 0x9253}: "1",
+	DcmTag{0x0020, 0x9254}: "1",
+	DcmTag{0x0020, 0x9255}: "1",
+	DcmTag{0x0020, 0x9256}: "1",
+	DcmTag{0x0020, 0x9257}: "1",
+	DcmTag{0x0020, 0x9301}: "3",
+	DcmTag{0x0020, 0x9302}: "6",
+	DcmTag{0x0020, 0x9307}: "1",
+	DcmTag{0x0020, 0x9308}: "3",
+	DcmTag{0x0020, 0x9309}: "16",
+	DcmTag{0x0020, 0x930a}: "16",
+	DcmTag{0x0020, 0x930c}: "1",
+	DcmTag{0x0020, 0x930d}: "1",
+	DcmTag{0x0020, 0x930e}: "1",
+	DcmTag{0x0020, 0x930f}: "1",
+	DcmTag{0x0020, 0x9310}: "1",
+	DcmTag{0x0020, 0x9311}: "1",
+	DcmTag{0x0020, 0x9312}: "1",
+	DcmTag{0x0020, 0x9313}: "1",
+	DcmTag{0x0020, 0x9421}: "1",
+	DcmTag{0x0020, 0x9450}: "1",
+	DcmTag{0x0020, 0x9453}: "1",
+	DcmTag{0x0020, 0x9518}: "1-n",
+	DcmTag{0x0020, 0x9529}: "1",
+	DcmTag{0x0020, 0x9536}: "1",
+	DcmTag{0x0022, 0x0001}: "1",
+	DcmTag{0x0022, 0x0002}: "2",
+	DcmTag{0x0022, 0x0003}: "1",
+	DcmTag{0x0022, 0x0004}: "2",
+	DcmTag{0x0022, 0x0005}: "1",
+	DcmTag{0x0022, 0x0006}: "1",
+	DcmTag{0x0022, 0x0007}: "1",
+	DcmTag{0x0022, 0x0008}: "1",
+	DcmTag{0x0022, 0x0009}: "1",
+	DcmTag{0x0022, 0x000a}: "1",
+	DcmTag{0x0022, 0x000b}: "1",
+	DcmTag{0x0022, 0x000c}: "1",
+	DcmTag{0x0022, 0x000d}: "1",
+	DcmTag{0x0022, 0x000e}: "1",
+	DcmTag{0x0022, 0x0010}: "1",
+	DcmTag{0x0022, 0x0011}: "1",
+	DcmTag{0x0022, 0x0012}: "1",
+	DcmTag{0x0022, 0x0013}: "1",
+	DcmTag{0x0022, 0x0014}: "1",
+	DcmTag{0x0022, 0x0015}: "1",
+	DcmTag{0x0022, 0x0016}: "1",
+	DcmTag{0x0022, 0x0017}: "1",
+	DcmTag{0x0022, 0x0018}: "1",
+	DcmTag{0x0022, 0x0019}: "1",
+	DcmTag{0x0022, 0x001a}: "1",
+	DcmTag{0x0022, 0x001b}: "1",
+	DcmTag{0x0022, 0x001c}: "1",
+	DcmTag{0x0022, 0x001d}: "1",
+	DcmTag{0x0022, 0x0020}: "1",
+	DcmTag{0x0022, 0x0021}: "1",
+	DcmTag{0x0022, 0x0022}: "1",
+	DcmTag{0x0022, 0x0030}: "1",
+	DcmTag{0x0022, 0x0031}: "1",
+	DcmTag{0x0022, 0x0032}: "2-2n",
+	DcmTag{0x0022, 0x0035}: "1",
+	DcmTag{0x0022, 0x0036}: "1",
+	DcmTag{0x0022, 0x0037}: "1",
+	DcmTag{0x0022, 0x0038}: "1",
+	DcmTag{0x0022, 0x0039}: "1",
+	DcmTag{0x0022, 0x0041}: "1",
+	DcmTag{0x0022, 0x0042}: "1",
+	DcmTag{0x0022, 0x0048}: "1",
+	DcmTag{0x0022, 0x0049}: "1",
+	DcmTag{0x0022, 0x004e}: "1",
+	DcmTag{0x0022, 0x0055}: "1",
+	DcmTag{0x0022, 0x0056}: "1",
+	DcmTag{0x0022, 0x0057}: "1",
+	DcmTag{0x0022, 0x0058}: "1",
+	DcmTag{0x0022, 0x1007}: "1",
+	DcmTag{0x0022, 0x1008}: "1",
+	DcmTag{0x0022, 0x1009}: "1",
+	DcmTag{0x0022, 0x1010}: "1",
+	DcmTag{0x0022, 0x1012}: "1",
+	DcmTag{0x0022, 0x1019}: "1",
+	DcmTag{0x0022, 0x1024}: "1",
+	DcmTag{0x0022, 0x1025}: "1",
+	DcmTag{0x0022, 0x1028}: "1",
+	DcmTag{0x0022, 0x1029}: "1",
+	DcmTag{0x0022, 0x1033}: "1",
+	DcmTag{0x0022, 0x1035}: "1",
+	DcmTag{0x0022, 0x1037}: "1",
+	DcmTag{0x0022, 0x1039}: "1",
+	DcmTag{0x0022, 0x1040}: "1",
+	DcmTag{0x0022, 0x1044}: "1",
+	DcmTag{0x0022, 0x1050}: "1",
+	DcmTag{0x0022, 0x1053}: "1",
+	DcmTag{0x0022, 0x1054}: "1",
+	DcmTag{0x0022, 0x1059}: "1",
+	DcmTag{0x0022, 0x1065}: "1",
+	DcmTag{0x0022, 0x1066}: "1",
+	DcmTag{0x0022, 0x1090}: "1",
+	DcmTag{0x0022, 0x1092}: "1",
+	DcmTag{0x0022, 0x1093}: "1",
+	DcmTag{0x0022, 0x1095}: "1",
+	DcmTag{0x0022, 0x1096}: "1",
+	DcmTag{0x0022, 0x1097}: "1",
+	DcmTag{0x0022, 0x1100}: "1",
+	DcmTag{0x0022, 0x1101}: "1",
+	DcmTag{0x0022, 0x1103}: "1",
+	DcmTag{0x0022, 0x1121}: "1",
+	DcmTag{0x0022, 0x1122}: "1",
+	DcmTag{0x0022, 0x1125}: "1",
+	DcmTag{0x0022, 0x1127}: "1",
+	DcmTag{0x0022, 0x1128}: "1",
+	DcmTag{0x0022, 0x1130}: "1",
+	DcmTag{0x0022, 0x1131}: "1",
+	DcmTag{0x0022, 0x1132}: "1",
+	DcmTag{0x0022, 0x1133}: "1",
+	DcmTag{0x0022, 0x1134}: "1",
+	DcmTag{0x0022, 0x1135}: "1",
+	DcmTag{0x0022, 0x1140}: "1",
+	DcmTag{0x0022, 0x1150}: "1",
+	DcmTag{0x0022, 0x1155}: "1",
+	DcmTag{0x0022, 0x1159}: "1",
+	DcmTag{0x0022, 0x1210}: "1",
+	DcmTag{0x0022, 0x1211}: "1",
+	DcmTag{0x0022, 0x1212}: "1",
+	DcmTag{0x0022, 0x1220}: "1",
+	DcmTag{0x0022, 0x1225}: "1",
+	DcmTag{0x0022, 0x1230}: "1",
+	DcmTag{0x0022, 0x1250}: "1",
+	DcmTag{0x0022, 0x1255}: "1",
+	DcmTag{0x0022, 0x1257}: "1",
+	DcmTag{0x0022, 0x1260}: "1",
+	DcmTag{0x0022, 0x1262}: "1",
+	DcmTag{0x0022, 0x1265}: "1",
+	DcmTag{0x0022, 0x1300}: "1",
+	DcmTag{0x0022, 0x1310}: "1",
+	DcmTag{0x0022, 0x1330}: "1",
+	DcmTag{0x0024, 0x0010}: "1",
+	DcmTag{0x0024, 0x0011}: "1",
+	DcmTag{0x0024, 0x0012}: "1",
+	DcmTag{0x0024, 0x0016}: "1",
+	DcmTag{0x0024, 0x0018}: "1",
+	DcmTag{0x0024, 0x0020}: "1",
+	DcmTag{0x0024, 0x0021}: "1",
+	DcmTag{0x0024, 0x0024}: "1",
+	DcmTag{0x0024, 0x0025}: "1",
+	DcmTag{0x0024, 0x0028}: "1",
+	DcmTag{0x0024, 0x0032}: "1",
+	DcmTag{0x0024, 0x0033}: "1",
+	DcmTag{0x0024, 0x0034}: "1",
+	DcmTag{0x0024, 0x0035}: "1",
+	DcmTag{0x0024, 0x0036}: "1",
+	DcmTag{0x0024, 0x0037}: "1",
+	DcmTag{0x0024, 0x0038}: "1",
+	DcmTag{0x0024, 0x0039}: "1",
+	DcmTag{0x0024, 0x0040}: "1",
+	DcmTag{0x0024, 0x0042}: "1",
+	DcmTag{0x0024, 0x0044}: "1",
+	DcmTag{0x0024, 0x0045}: "1",
+	DcmTag{0x0024, 0x0046}: "1",
+	DcmTag{0x0024, 0x0048}: "1",
+	DcmTag{0x0024, 0x0050}: "1",
+	DcmTag{0x0024, 0x0051}: "1",
+	DcmTag{0x0024, 0x0052}: "1",
+	DcmTag{0x0024, 0x0053}: "1",
+	DcmTag{0x0024, 0x0054}: "1",
+	DcmTag{0x0024, 0x0055}: "1",
+	DcmTag{0x0024, 0x0056}: "1",
+	DcmTag{0x0024, 0x0057}: "1",
+	DcmTag{0x0024, 0x0058}: "1",
+	DcmTag{0x0024, 0x0059}: "1",
+	DcmTag{0x0024, 0x0060}: "1",
+	DcmTag{0x0024, 0x0061}: "1",
+	DcmTag{0x0024, 0x0062}: "1",
+	DcmTag{0x0024, 0x0063}: "1",
+	DcmTag{0x0024, 0x0064}: "1",
+	DcmTag{0x0024, 0x0065}: "1",
+	DcmTag{0x0024, 0x0066}: "1",
+	DcmTag{0x0024, 0x0067}: "1",
+	DcmTag{0x0024, 0x0068}: "1",
+	DcmTag{0x0024, 0x0069}: "1",
+	DcmTag{0x0024, 0x0070}: "1",
+	DcmTag{0x0024, 0x0071}: "1",
+	DcmTag{0x0024, 0x0072}: "1",
+	DcmTag{0x0024, 0x0073}: "1",
+	DcmTag{0x0024, 0x0074}: "1",
+	DcmTag{0x0024, 0x0075}: "1",
+	DcmTag{0x0024, 0x0076}: "1",
+	DcmTag{0x0024, 0x0077}: "1",
+	DcmTag{0x0024, 0x0078}: "1",
+	DcmTag{0x0024, 0x0079}: "1",
+	DcmTag{0x0024, 0x0080}: "1",
+	DcmTag{0x0024, 0x0081}: "1",
+	DcmTag{0x0024, 0x0083}: "1",
+	DcmTag{0x0024, 0x0085}: "1",
+	DcmTag{0x0024, 0x0086}: "1",
+	DcmTag{0x0024, 0x0087}: "1",
+	DcmTag{0x0024, 0x0088}: "1",
+	DcmTag{0x0024, 0x0089}: "1",
+	DcmTag{0x0024, 0x0090}: "1",
+	DcmTag{0x0024, 0x0091}: "1",
+	DcmTag{0x0024, 0x0092}: "1",
+	DcmTag{0x0024, 0x0093}: "1",
+	DcmTag{0x0024, 0x0094}: "1",
+	DcmTag{0x0024, 0x0095}: "1",
+	DcmTag{0x0024, 0x0096}: "1",
+	DcmTag{0x0024, 0x0097}: "1",
+	DcmTag{0x0024, 0x0098}: "1",
+	DcmTag{0x0024, 0x0102}: "1",
+	DcmTag{0x0024, 0x0103}: "1",
+	DcmTag{0x0024, 0x0104}: "1",
+	DcmTag{0x0024, 0x0105}: "1",
+	DcmTag{0x0024, 0x0106}: "1",
+	DcmTag{0x0024, 0x0107}: "1",
+	DcmTag{0x0024, 0x0108}: "1",
+	DcmTag{0x0024, 0x0110}: "1",
+	DcmTag{0x0024, 0x0112}: "1",
+	DcmTag{0x0024, 0x0113}: "1",
+	DcmTag{0x0024, 0x0114}: "1",
+	DcmTag{0x0024, 0x0115}: "1",
+	DcmTag{0x0024, 0x0117}: "1",
+	DcmTag{0x0024, 0x0118}: "1",
+	DcmTag{0x0024, 0x0120}: "1",
+	DcmTag{0x0024, 0x0122}: "1",
+	DcmTag{0x0024, 0x0124}: "1",
+	DcmTag{0x0024, 0x0126}: "1",
+	DcmTag{0x0024, 0x0202}: "1",
+	DcmTag{0x0024, 0x0306}: "1",
+	DcmTag{0x0024, 0x0307}: "1",
+	DcmTag{0x0024, 0x0308}: "1",
+	DcmTag{0x0024, 0x0309}: "1",
+	DcmTag{0x0024, 0x0317}: "1",
+	DcmTag{0x0024, 0x0320}: "1",
+	DcmTag{0x0024, 0x0325}: "1",
+	DcmTag{0x0024, 0x0338}: "1",
+	DcmTag{0x0024, 0x0341}: "1",
+	DcmTag{0x0024, 0x0344}: "1",
+	DcmTag{0x0028, 0x0002}: "1",
+	DcmTag{0x0028, 0x0003}: "1",
+	DcmTag{0x0028, 0x0004}: "1",
+	DcmTag{0x0028, 0x0005}: "1",
+	DcmTag{0x0028, 0x0006}: "1",
+	DcmTag{0x0028, 0x0008}: "1",
+	DcmTag{0x0028, 0x0009}: "1-n",
+	DcmTag{0x0028, 0x000a}: "1-n",
+	DcmTag{0x0028, 0x0010}: "1",
+	DcmTag{0x0028, 0x0011}: "1",
+	DcmTag{0x0028, 0x0012}: "1",
+	DcmTag{0x0028, 0x0014}: "1",
+	DcmTag{0x0028, 0x0030}: "2",
+	DcmTag{0x0028, 0x0031}: "2",
+	DcmTag{0x0028, 0x0032}: "2",
+	DcmTag{0x0028, 0x0034}: "2",
+	DcmTag{0x0028, 0x0040}: "1",
+	DcmTag{0x0028, 0x0050}: "1-n",
+	DcmTag{0x0028, 0x0051}: "1-n",
+	DcmTag{0x0028, 0x005f}: "1",
+	DcmTag{0x0028, 0x0060}: "1",
+	DcmTag{0x0028, 0x0061}: "1",
+	DcmTag{0x0028, 0x0062}: "1",
+	DcmTag{0x0028, 0x0063}: "1",
+	DcmTag{0x0028, 0x0065}: "1-n",
+	DcmTag{0x0028, 0x0066}: "1-n",
+	DcmTag{0x0028, 0x0068}: "1",
+	DcmTag{0x0028, 0x0069}: "1",
+	DcmTag{0x0028, 0x0070}: "1-n",
+	DcmTag{0x0028, 0x0071}: "1",
+	DcmTag{0x0028, 0x0080}: "1",
+	DcmTag{0x0028, 0x0081}: "1",
+	DcmTag{0x0028, 0x0082}: "1-n",
+	DcmTag{0x0028, 0x0090}: "1",
+	DcmTag{0x0028, 0x0091}: "1",
+	DcmTag{0x0028, 0x0092}: "1",
+	DcmTag{0x0028, 0x0093}: "1",
+	DcmTag{0x0028, 0x0094}: "1",
+	DcmTag{0x0028, 0x0100}: "1",
+	DcmTag{0x0028, 0x0101}: "1",
+	DcmTag{0x0028, 0x0102}: "1",
+	DcmTag{0x0028, 0x0103}: "1",
+	DcmTag{0x0028, 0x0104}: "1",
+	DcmTag{0x0028, 0x0105}: "1",
+	DcmTag{0x0028, 0x0106}: "1",
+	DcmTag{0x0028, 0x0107}: "1",
+	DcmTag{0x0028, 0x0108}: "1",
+	DcmTag{0x0028, 0x0109}: "1",
+	DcmTag{0x0028, 0x0110}: "1",
+	DcmTag{0x0028, 0x0111}: "1",
+	DcmTag{0x0028, 0x0120}: "1",
+	DcmTag{0x0028, 0x0121}: "1",
+	DcmTag{0x0028, 0x0200}: "1",
+	DcmTag{0x0028, 0x0300}: "1",
+	DcmTag{0x0028, 0x0301}: "1",
+	DcmTag{0x0028, 0x0400}: "1",
+	DcmTag{0x0028, 0x0401}: "1",
+	DcmTag{0x0028, 0x0402}: "1",
+	DcmTag{0x0028, 0x0403}: "1-n",
+	DcmTag{0x0028, 0x0404}: "1-n",
+	DcmTag{0x0028, 0x0410}: "1",
+	DcmTag{0x0028, 0x0411}: "1",
+	DcmTag{0x0028, 0x0412}: "1-n",
+	DcmTag{0x0028, 0x0413}: "1-n",
+	DcmTag{0x0028, 0x0700}: "1",
+	DcmTag{0x0028, 0x0701}: "1-n",
+	DcmTag{0x0028, 0x0702}: "1-n",
+	DcmTag{0x0028, 0x0710}: "1",
+	DcmTag{0x0028, 0x0720}: "1",
+	DcmTag{0x0028, 0x0721}: "1-n",
+	DcmTag{0x0028, 0x0722}: "1",
+	DcmTag{0x0028, 0x0730}: "1",
+	DcmTag{0x0028, 0x0740}: "1",
+	DcmTag{0x0028, 0x0800}: "1-n",
+	DcmTag{0x0028, 0x0802}: "1",
+	DcmTag{0x0028, 0x0803}: "1-n",
+	DcmTag{0x0028, 0x0804}: "1",
+	DcmTag{0x0028, 0x0808}: "1-n",
+	DcmTag{0x0028, 0x0a02}: "1",
+	DcmTag{0x0028, 0x0a04}: "1",
+	DcmTag{0x0028, 0x1040}: "1",
+	DcmTag{0x0028, 0x1041}: "1",
+	DcmTag{0x0028, 0x1050}: "1-n",
+	DcmTag{0x0028, 0x1051}: "1-n",
+	DcmTag{0x0028, 0x1052}: "1",
+	DcmTag{0x0028, 0x1053}: "1",
+	DcmTag{0x0028, 0x1054}: "1",
+	DcmTag{0x0028, 0x1055}: "1-n",
+	DcmTag{0x0028, 0x1056}: "1",
+	DcmTag{0x0028, 0x1080}: "1",
+	DcmTag{0x0028, 0x1090}: "1",
+	DcmTag{0x0028, 0x1100}: "3",
+	DcmTag{0x0028, 0x1101}: "3",
+	DcmTag{0x0028, 0x1102}: "3",
+	DcmTag{0x0028, 0x1103}: "3",
+	DcmTag{0x0028, 0x1104}: "3",
+	DcmTag{0x0028, 0x1111}: "4",
+	DcmTag{0x0028, 0x1112}: "4",
+	DcmTag{0x0028, 0x1113}: "4",
+	DcmTag{0x0028, 0x1199}: "1",
+	DcmTag{0x0028, 0x1200}: "1-n",
+	DcmTag{0x0028, 0x1201}: "1",
+	DcmTag{0x0028, 0x1202}: "1",
+	DcmTag{0x0028, 0x1203}: "1",
+	DcmTag{0x0028, 0x1204}: "1",
+	DcmTag{0x0028, 0x1211}: "1",
+	DcmTag{0x0028, 0x1212}: "1",
+	DcmTag{0x0028, 0x1213}: "1",
+	DcmTag{0x0028, 0x1214}: "1",
+	DcmTag{0x0028, 0x1221}: "1",
+	DcmTag{0x0028, 0x1222}: "1",
+	DcmTag{0x0028, 0x1223}: "1",
+	DcmTag{0x0028, 0x1300}: "1",
+	DcmTag{0x0028, 0x1350}: "1",
+	DcmTag{0x0028, 0x1351}: "1",
+	DcmTag{0x0028, 0x1352}: "1",
+	DcmTag{0x0028, 0x135a}: "1",
+	DcmTag{0x0028, 0x1401}: "1",
+	DcmTag{0x0028, 0x1402}: "1",
+	DcmTag{0x0028, 0x1403}: "1",
+	DcmTag{0x0028, 0x1404}: "1",
+	DcmTag{0x0028, 0x1405}: "1",
+	DcmTag{0x0028, 0x1406}: "1",
+	DcmTag{0x0028, 0x1407}: "3",
+	DcmTag{0x0028, 0x1408}: "1",
+	DcmTag{0x0028, 0x140b}: "1",
+	DcmTag{0x0028, 0x140c}: "1",
+	DcmTag{0x0028, 0x140d}: "1",
+	DcmTag{0x0028, 0x140e}: "1",
+	DcmTag{0x0028, 0x140f}: "1",
+	DcmTag{0x0028, 0x1410}: "1",
+	DcmTag{0x0028, 0x2000}: "1",
+	DcmTag{0x0028, 0x2110}: "1",
+	DcmTag{0x0028, 0x2112}: "1-n",
+	DcmTag{0x0028, 0x2114}: "1-n",
+	DcmTag{0x0028, 0x3000}: "1",
+	DcmTag{0x0028, 0x3002}: "3",
+	DcmTag{0x0028, 0x3003}: "1",
+	DcmTag{0x0028, 0x3004}: "1",
+	DcmTag{0x0028, 0x3006}: "1-n",
+	DcmTag{0x0028, 0x3010}: "1",
+	DcmTag{0x0028, 0x3110}: "1",
+	DcmTag{0x0028, 0x4000}: "1-n",
+	DcmTag{0x0028, 0x5000}: "1",
+	DcmTag{0x0028, 0x6010}: "1",
+	DcmTag{0x0028, 0x6020}: "1-n",
+	DcmTag{0x0028, 0x6022}: "1-n",
+	DcmTag{0x0028, 0x6023}: "1-n",
+	DcmTag{0x0028, 0x6030}: "1-n",
+	DcmTag{0x0028, 0x6040}: "1-n",
+	DcmTag{0x0028, 0x6100}: "1",
+	DcmTag{0x0028, 0x6101}: "1",
+	DcmTag{0x0028, 0x6102}: "2-2n",
+	DcmTag{0x0028, 0x6110}: "1-n",
+	DcmTag{0x0028, 0x6112}: "1",
+	DcmTag{0x0028, 0x6114}: "2",
+	DcmTag{0x0028, 0x6120}: "1",
+	DcmTag{0x0028, 0x6190}: "1",
+	DcmTag{0x0028, 0x7fe0}: "1",
+	DcmTag{0x0028, 0x9001}: "1",
+	DcmTag{0x0028, 0x9002}: "1",
+	DcmTag{0x0028, 0x9003}: "1",
+	DcmTag{0x0028, 0x9099}: "1",
+	DcmTag{0x0028, 0x9108}: "1",
+	DcmTag{0x0028, 0x9110}: "1",
+	DcmTag{0x0028, 0x9132}: "1",
+	DcmTag{0x0028, 0x9145}: "1",
+	DcmTag{0x0028, 0x9235}: "1",
+	DcmTag{0x0028, 0x9411}: "1",
+	DcmTag{0x0028, 0x9415}: "1",
+	DcmTag{0x0028, 0x9416}: "1",
+	DcmTag{0x0028, 0x9422}: "1",
+	DcmTag{0x0028, 0x9443}: "1",
+	DcmTag{0x0028, 0x9444}: "1",
+	DcmTag{0x0028, 0x9445}: "1",
+	DcmTag{0x0028, 0x9446}: "1-n",
+	DcmTag{0x0028, 0x9454}: "1",
+	DcmTag{0x0028, 0x9474}: "1",
+	DcmTag{0x0028, 0x9478}: "1",
+	DcmTag{0x0028, 0x9501}: "1",
+	DcmTag{0x0028, 0x9502}: "1",
+	DcmTag{0x0028, 0x9503}: "2-2n",
+	DcmTag{0x0028, 0x9505}: "1",
+	DcmTag{0x0028, 0x9506}: "2-2n",
+	DcmTag{0x0028, 0x9507}: "2-2n",
+	DcmTag{0x0028, 0x9520}: "16",
+	DcmTag{0x0028, 0x9537}: "1",
+	DcmTag{0x0032, 0x000a}: "1",
+	DcmTag{0x0032, 0x000c}: "1",
+	DcmTag{0x0032, 0x0012}: "1",
+	DcmTag{0x0032, 0x0032}: "1",
+	DcmTag{0x0032, 0x0033}: "1",
+	DcmTag{0x0032, 0x0034}: "1",
+	DcmTag{0x0032, 0x0035}: "1",
+	DcmTag{0x0032, 0x1000}: "1",
+	DcmTag{0x0032, 0x1001}: "1",
+	DcmTag{0x0032, 0x1010}: "1",
+	DcmTag{0x0032, 0x1011}: "1",
+	DcmTag{0x0032, 0x1020}: "1",
+	DcmTag{0x0032, 0x1021}: "1-n",
+	DcmTag{0x0032, 0x1030}: "1",
+	DcmTag{0x0032, 0x1031}: "1",
+	DcmTag{0x0032, 0x1032}: "1",
+	DcmTag{0x0032, 0x1033}: "1",
+	DcmTag{0x0032, 0x1034}: "1",
+	DcmTag{0x0032, 0x1040}: "1",
+	DcmTag{0x0032, 0x1041}: "1",
+	DcmTag{0x0032, 0x1050}: "1",
+	DcmTag{0x0032, 0x1051}: "1",
+	DcmTag{0x0032, 0x1055}: "1",
+	DcmTag{0x0032, 0x1060}: "1",
+	DcmTag{0x0032, 0x1064}: "1",
+	DcmTag{0x0032, 0x1070}: "1",
+	DcmTag{0x0032, 0x4000}: "1",
+	DcmTag{0x0038, 0x0004}: "1",
+	DcmTag{0x0038, 0x0008}: "1",
+	DcmTag{0x0038, 0x0010}: "1",
+	DcmTag{0x0038, 0x0011}: "1",
+	DcmTag{0x0038, 0x0014}: "1",
+	DcmTag{0x0038, 0x0016}: "1",
+	DcmTag{0x0038, 0x001a}: "1",
+	DcmTag{0x0038, 0x001b}: "1",
+	DcmTag{0x0038, 0x001c}: "1",
+	DcmTag{0x0038, 0x001d}: "1",
+	DcmTag{0x0038, 0x001e}: "1",
+	DcmTag{0x0038, 0x0020}: "1",
+	DcmTag{0x0038, 0x0021}: "1",
+	DcmTag{0x0038, 0x0030}: "1",
+	DcmTag{0x0038, 0x0032}: "1",
+	DcmTag{0x0038, 0x0040}: "1",
+	DcmTag{0x0038, 0x0044}: "1",
+	DcmTag{0x0038, 0x0050}: "1",
+	DcmTag{0x0038, 0x0060}: "1",
+	DcmTag{0x0038, 0x0061}: "1",
+	DcmTag{0x0038, 0x0062}: "1",
+	DcmTag{0x0038, 0x0064}: "1",
+	DcmTag{0x0038, 0x0100}: "1",
+	DcmTag{0x0038, 0x0300}: "1",
+	DcmTag{0x0038, 0x0400}: "1",
+	DcmTag{0x0038, 0x0500}: "1",
+	DcmTag{0x0038, 0x0502}: "1",
+	DcmTag{0x0038, 0x4000}: "1",
+	DcmTag{0x003a, 0x0004}: "1",
+	DcmTag{0x003a, 0x0005}: "1",
+	DcmTag{0x003a, 0x0010}: "1",
+	DcmTag{0x003a, 0x001a}: "1",
+	DcmTag{0x003a, 0x0020}: "1",
+	DcmTag{0x003a, 0x0200}: "1",
+	DcmTag{0x003a, 0x0202}: "1",
+	DcmTag{0x003a, 0x0203}: "1",
+	DcmTag{0x003a, 0x0205}: "1-n",
+	DcmTag{0x003a, 0x0208}: "1",
+	DcmTag{0x003a, 0x0209}: "1",
+	DcmTag{0x003a, 0x020a}: "1",
+	DcmTag{0x003a, 0x020c}: "1",
+	DcmTag{0x003a, 0x0210}: "1",
+	DcmTag{0x003a, 0x0211}: "1",
+	DcmTag{0x003a, 0x0212}: "1",
+	DcmTag{0x003a, 0x0213}: "1",
+	DcmTag{0x003a, 0x0214}: "1",
+	DcmTag{0x003a, 0x0215}: "1",
+	DcmTag{0x003a, 0x0218}: "1",
+	DcmTag{0x003a, 0x021a}: "1",
+	DcmTag{0x003a, 0x0220}: "1",
+	DcmTag{0x003a, 0x0221}: "1",
+	DcmTag{0x003a, 0x0222}: "1",
+	DcmTag{0x003a, 0x0223}: "1",
+	DcmTag{0x003a, 0x0230}: "1",
+	DcmTag{0x003a, 0x0231}: "3",
+	DcmTag{0x003a, 0x0240}: "1",
+	DcmTag{0x003a, 0x0241}: "1",
+	DcmTag{0x003a, 0x0242}: "1",
+	DcmTag{0x003a, 0x0244}: "3",
+	DcmTag{0x003a, 0x0245}: "1",
+	DcmTag{0x003a, 0x0246}: "1",
+	DcmTag{0x003a, 0x0247}: "1",
+	DcmTag{0x003a, 0x0248}: "1",
+	DcmTag{0x003a, 0x0300}: "1",
+	DcmTag{0x003a, 0x0301}: "1",
+	DcmTag{0x003a, 0x0302}: "1",
+	DcmTag{0x0040, 0x0001}: "1-n",
+	DcmTag{0x0040, 0x0002}: "1",
+	DcmTag{0x0040, 0x0003}: "1",
+	DcmTag{0x0040, 0x0004}: "1",
+	DcmTag{0x0040, 0x0005}: "1",
+	DcmTag{0x0040, 0x0006}: "1",
+	DcmTag{0x0040, 0x0007}: "1",
+	DcmTag{0x0040, 0x0008}: "1",
+	DcmTag{0x0040, 0x0009}: "1",
+	DcmTag{0x0040, 0x000a}: "1",
+	DcmTag{0x0040, 0x000b}: "1",
+	DcmTag{0x0040, 0x0010}: "1-n",
+	DcmTag{0x0040, 0x0011}: "1",
+	DcmTag{0x0040, 0x0012}: "1",
+	DcmTag{0x0040, 0x0020}: "1",
+	DcmTag{0x0040, 0x0026}: "1",
+	DcmTag{0x0040, 0x0027}: "1",
+	DcmTag{0x0040, 0x0031}: "1",
+	DcmTag{0x0040, 0x0032}: "1",
+	DcmTag{0x0040, 0x0033}: "1",
+	DcmTag{0x0040, 0x0035}: "1",
+	DcmTag{0x0040, 0x0036}: "1",
+	DcmTag{0x0040, 0x0039}: "1",
+	DcmTag{0x0040, 0x003a}: "1",
+	DcmTag{0x0040, 0x0100}: "1",
+	DcmTag{0x0040, 0x0220}: "1",
+	DcmTag{0x0040, 0x0241}: "1",
+	DcmTag{0x0040, 0x0242}: "1",
+	DcmTag{0x0040, 0x0243}: "1",
+	DcmTag{0x0040, 0x0244}: "1",
+	DcmTag{0x0040, 0x0245}: "1",
+	DcmTag{0x0040, 0x0250}: "1",
+	DcmTag{0x0040, 0x0251}: "1",
+	DcmTag{0x0040, 0x0252}: "1",
+	DcmTag{0x0040, 0x0253}: "1",
+	DcmTag{0x0040, 0x0254}: "1",
+	DcmTag{0x0040, 0x0255}: "1",
+	DcmTag{0x0040, 0x0260}: "1",
+	DcmTag{0x0040, 0x0261}: "1",
+	DcmTag{0x0040, 0x0270}: "1",
+	DcmTag{0x0040, 0x0275}: "1",
+	DcmTag{0x0040, 0x0280}: "1",
+	DcmTag{0x0040, 0x0281}: "1",
+	DcmTag{0x0040, 0x0293}: "1",
+	DcmTag{0x0040, 0x0294}: "1",
+	DcmTag{0x0040, 0x0295}: "1",
+	DcmTag{0x0040, 0x0296}: "1",
+	DcmTag{0x0040, 0x0300}: "1",
+	DcmTag{0x0040, 0x0301}: "1",
+	DcmTag{0x0040, 0x0302}: "1",
+	DcmTag{0x0040, 0x0303}: "1-2",
+	DcmTag{0x0040, 0x0306}: "1",
+	DcmTag{0x0040, 0x0307}: "1",
+	DcmTag{0x0040, 0x030e}: "1",
+	DcmTag{0x0040, 0x0310}: "1",
+	DcmTag{0x0040, 0x0312}: "1",
+	DcmTag{0x0040, 0x0314}: "1",
+	DcmTag{0x0040, 0x0316}: "1",
+	DcmTag{0x0040, 0x0318}: "1",
+	DcmTag{0x0040, 0x0320}: "1",
+	DcmTag{0x0040, 0x0321}: "1",
+	DcmTag{0x0040, 0x0324}: "1",
+	DcmTag{0x0040, 0x0330}: "1",
+	DcmTag{0x0040, 0x0340}: "1",
+	DcmTag{0x0040, 0x0400}: "1",
+	DcmTag{0x0040, 0x0440}: "1",
+	DcmTag{0x0040, 0x0441}: "1",
+	DcmTag{0x0040, 0x0500}: "1",
+	DcmTag{0x0040, 0x050a}: "1",
+	DcmTag{0x0040, 0x0512}: "1",
+	DcmTag{0x0040, 0x0513}: "1",
+	DcmTag{0x0040, 0x0515}: "1",
+	DcmTag{0x0040, 0x0518}: "1",
+	DcmTag{0x0040, 0x051a}: "1",
+	DcmTag{0x0040, 0x0520}: "1",
+	DcmTag{0x0040, 0x0550}: "1",
+	DcmTag{0x0040, 0x0551}: "1",
+	DcmTag{0x0040, 0x0552}: "1",
+	DcmTag{0x0040, 0x0553}: "1",
+	DcmTag{0x0040, 0x0554}: "1",
+	DcmTag{0x0040, 0x0555}: "1",
+	DcmTag{0x0040, 0x0556}: "1",
+	DcmTag{0x0040, 0x0560}: "1",
+	DcmTag{0x0040, 0x0562}: "1",
+	DcmTag{0x0040, 0x059a}: "1",
+	DcmTag{0x0040, 0x0600}: "1",
+	DcmTag{0x0040, 0x0602}: "1",
+	DcmTag{0x0040, 0x0610}: "1",
+	DcmTag{0x0040, 0x0612}: "1",
+	DcmTag{0x0040, 0x0620}: "1",
+	DcmTag{0x0040, 0x06fa}: "1",
+	DcmTag{0x0040, 0x071a}: "1",
+	DcmTag{0x0040, 0x072a}: "1",
+	DcmTag{0x0040, 0x073a}: "1",
+	DcmTag{0x0040, 0x074a}: "1",
+	DcmTag{0x0040, 0x08d8}: "1",
+	DcmTag{0x0040, 0x08da}: "1",
+	DcmTag{0x0040, 0x08ea}: "1",
+	DcmTag{0x0040, 0x09f8}: "1",
+	DcmTag{0x0040, 0x1001}: "1",
+	DcmTag{0x0040, 0x1002}: "1",
+	DcmTag{0x0040, 0x1003}: "1",
+	DcmTag{0x0040, 0x1004}: "1",
+	DcmTag{0x0040, 0x1005}: "1",
+	DcmTag{0x0040, 0x1006}: "1",
+	DcmTag{0x0040, 0x1007}: "1",
+	DcmTag{0x0040, 0x1008}: "1",
+	DcmTag{0x0040, 0x1009}: "1",
+	DcmTag{0x0040, 0x100a}: "1",
+	DcmTag{0x0040, 0x1010}: "1-n",
+	DcmTag{0x0040, 0x1011}: "1",
+	DcmTag{0x0040, 0x1012}: "1",
+	DcmTag{0x0040, 0x1101}: "1",
+	DcmTag{0x0040, 0x1102}: "1",
+	DcmTag{0x0040, 0x1103}: "1-n",
+	DcmTag{0x0040, 0x1400}: "1",
+	DcmTag{0x0040, 0x2001}: "1",
+	DcmTag{0x0040, 0x2004}: "1",
+	DcmTag{0x0040, 0x2005}: "1",
+	DcmTag{0x0040, 0x2006}: "1",
+	DcmTag{0x0040, 0x2007}: "1",
+	DcmTag{0x0040, 0x2008}: "1",
+	DcmTag{0x0040, 0x2009}: "1",
+	DcmTag{0x0040, 0x2010}: "1",
+	DcmTag{0x0040, 0x2016}: "1",
+	DcmTag{0x0040, 0x2017}: "1",
+	DcmTag{0x0040, 0x2400}: "1",
+	DcmTag{0x0040, 0x3001}: "1",
+	DcmTag{0x0040, 0x4001}: "1",
+	DcmTag{0x0040, 0x4002}: "1",
+	DcmTag{0x0040, 0x4003}: "1",
+	DcmTag{0x0040, 0x4004}: "1",
+	DcmTag{0x0040, 0x4005}: "1",
+	DcmTag{0x0040, 0x4006}: "1",
+	DcmTag{0x0040, 0x4007}: "1",
+	DcmTag{0x0040, 0x4009}: "1",
+	DcmTag{0x0040, 0x4010}: "1",
+	DcmTag{0x0040, 0x4011}: "1",
+	DcmTag{0x0040, 0x4015}: "1",
+	DcmTag{0x0040, 0x4016}: "1",
+	DcmTag{0x0040, 0x4018}: "1",
+	DcmTag{0x0040, 0x4019}: "1",
+	DcmTag{0x0040, 0x4020}: "1",
+	DcmTag{0x0040, 0x4021}: "1",
+	DcmTag{0x0040, 0x4022}: "1",
+	DcmTag{0x0040, 0x4023}: "1",
+	DcmTag{0x0040, 0x4025}: "1",
+	DcmTag{0x0040, 0x4026}: "1",
+	DcmTag{0x0040, 0x4027}: "1",
+	DcmTag{0x0040, 0x4028}: "1",
+	DcmTag{0x0040, 0x4029}: "1",
+	DcmTag{0x0040, 0x4030}: "1",
+	DcmTag{0x0040, 0x4031}: "1",
+	DcmTag{0x0040, 0x4032}: "1",
+	DcmTag{0x0040, 0x4033}: "1",
+	DcmTag{0x0040, 0x4034}: "1",
+	DcmTag{0x0040, 0x4035}: "1",
+	DcmTag{0x0040, 0x4036}: "1",
+	DcmTag{0x0040, 0x4037}: "1",
+	DcmTag{0x0040, 0x4040}: "1",
+	DcmTag{0x0040, 0x8302}: "1",
+	DcmTag{0x0040, 0x9094}: "1",
+	DcmTag{0x0040, 0x9096}: "1",
+	DcmTag{0x0040, 0x9098}: "1",
+	DcmTag{0x0040, 0x9210}: "1",
+	DcmTag{0x0040, 0x9211}: "1",
+	DcmTag{0x0040, 0x9212}: "1-n",
+	DcmTag{0x0040, 0x9216}: "1",
+	DcmTag{0x0040, 0x9224}: "1",
+	DcmTag{0x0040, 0x9225}: "1",
+	DcmTag{0x0040, 0xa010}: "1",
+	DcmTag{0x0040, 0xa027}: "1",
+	DcmTag{0x0040, 0xa030}: "1",
+	DcmTag{0x0040, 0xa032}: "1",
+	DcmTag{0x0040, 0xa040}: "1",
+	DcmTag{0x0040, 0xa043}: "1",
+	DcmTag{0x0040, 0xa050}: "1",
+	DcmTag{0x0040, 0xa073}: "1",
+	DcmTag{0x0040, 0xa075}: "1",
+	DcmTag{0x0040, 0xa078}: "1",
+	DcmTag{0x0040, 0xa07a}: "1",
+	DcmTag{0x0040, 0xa07c}: "1",
+	DcmTag{0x0040, 0xa080}: "1",
+	DcmTag{0x0040, 0xa082}: "1",
+	DcmTag{0x0040, 0xa084}: "1",
+	DcmTag{0x0040, 0xa088}: "1",
+	DcmTag{0x0040, 0xa090}: "1",
+	DcmTag{0x0040, 0xa0b0}: "2-2n",
+	DcmTag{0x0040, 0xa120}: "1",
+	DcmTag{0x0040, 0xa121}: "1",
+	DcmTag{0x0040, 0xa122}: "1",
+	DcmTag{0x0040, 0xa123}: "1",
+	DcmTag{0x0040, 0xa124}: "1",
+	DcmTag{0x0040, 0xa130}: "1",
+	DcmTag{0x0040, 0xa132}: "1-n",
+	DcmTag{0x0040, 0xa136}: "1-n",
+	DcmTag{0x0040, 0xa138}: "1-n",
+	DcmTag{0x0040, 0xa13a}: "1-n",
+	DcmTag{0x0040, 0xa160}: "1",
+	DcmTag{0x0040, 0xa168}: "1",
+	DcmTag{0x0040, 0xa170}: "1",
+	DcmTag{0x0040, 0xa180}: "1",
+	DcmTag{0x0040, 0xa195}: "1",
+	DcmTag{0x0040, 0xa300}: "1",
+	DcmTag{0x0040, 0xa301}: "1",
+	DcmTag{0x0040, 0xa30a}: "1-n",
+	DcmTag{0x0040, 0xa353}: "1",
+	DcmTag{0x0040, 0xa354}: "1",
+	DcmTag{0x0040, 0xa360}: "1",
+	DcmTag{0x0040, 0xa370}: "1",
+	DcmTag{0x0040, 0xa372}: "1",
+	DcmTag{0x0040, 0xa375}: "1",
+	DcmTag{0x0040, 0xa385}: "1",
+	DcmTag{0x0040, 0xa390}: "1",
+	DcmTag{0x0040, 0xa491}: "1",
+	DcmTag{0x0040, 0xa492}: "1",
+	DcmTag{0x0040, 0xa493}: "1",
+	DcmTag{0x0040, 0xa494}: "1",
+	DcmTag{0x0040, 0xa496}: "1",
+	DcmTag{0x0040, 0xa504}: "1",
+	DcmTag{0x0040, 0xa525}: "1",
+	DcmTag{0x0040, 0xa730}: "1",
+	DcmTag{0x0040, 0xb020}: "1",
+	DcmTag{0x0040, 0xdb00}: "1",
+	DcmTag{0x0040, 0xdb06}: "1",
+	DcmTag{0x0040, 0xdb07}: "1",
+	DcmTag{0x0040, 0xdb0b}: "1",
+	DcmTag{0x0040, 0xdb0c}: "1",
+	DcmTag{0x0040, 0xdb0d}: "1",
+	DcmTag{0x0040, 0xdb73}: "1-n",
+	DcmTag{0x0040, 0xe001}: "1",
+	DcmTag{0x0040, 0xe004}: "1",
+	DcmTag{0x0040, 0xe006}: "1",
+	DcmTag{0x0040, 0xe010}: "1",
+	DcmTag{0x0040, 0xe011}: "1",
+	DcmTag{0x0042, 0x0010}: "1",
+	DcmTag{0x0042, 0x0011}: "1",
+	DcmTag{0x0042, 0x0012}: "1",
+	DcmTag{0x0042, 0x0013}: "1",
+	DcmTag{0x0042, 0x0014}: "1-n",
+	DcmTag{0x0044, 0x0001}: "1",
+	DcmTag{0x0044, 0x0002}: "1",
+	DcmTag{0x0044, 0x0003}: "1",
+	DcmTag{0x0044, 0x0004}: "1",
+	DcmTag{0x0044, 0x0007}: "1",
+	DcmTag{0x0044, 0x0008}: "1-n",
+	DcmTag{0x0044, 0x0009}: "1",
+	DcmTag{0x0044, 0x000a}: "1",
+	DcmTag{0x0044, 0x000b}: "1",
+	DcmTag{0x0044, 0x0010}: "1",
+	DcmTag{0x0044, 0x0011}: "1",
+	DcmTag{0x0044, 0x0012}: "1",
+	DcmTag{0x0044, 0x0013}: "1",
+	DcmTag{0x0044, 0x0019}: "1",
+	DcmTag{0x0046, 0x0012}: "1",
+	DcmTag{0x0046, 0x0014}: "1",
+	DcmTag{0x0046, 0x0015}: "1",
+	DcmTag{0x0046, 0x0016}: "1",
+	DcmTag{0x0046, 0x0018}: "1",
+	DcmTag{0x0046, 0x0028}: "1",
+	DcmTag{0x0046, 0x0030}: "1",
+	DcmTag{0x0046, 0x0032}: "1",
+	DcmTag{0x0046, 0x0034}: "1",
+	DcmTag{0x0046, 0x0036}: "1",
+	DcmTag{0x0046, 0x0038}: "1",
+	DcmTag{0x0046, 0x0040}: "1",
+	DcmTag{0x0046, 0x0042}: "1",
+	DcmTag{0x0046, 0x0044}: "1",
+	DcmTag{0x0046, 0x0046}: "1",
+	DcmTag{0x0046, 0x0050}: "1",
+	DcmTag{0x0046, 0x0052}: "1",
+	DcmTag{0x0046, 0x0060}: "1",
+	DcmTag{0x0046, 0x0062}: "1",
+	DcmTag{0x0046, 0x0063}: "1",
+	DcmTag{0x0046, 0x0064}: "1",
+	DcmTag{0x0046, 0x0070}: "1",
+	DcmTag{0x0046, 0x0071}: "1",
+	DcmTag{0x0046, 0x0074}: "1",
+	DcmTag{0x0046, 0x0075}: "1",
+	DcmTag{0x0046, 0x0076}: "1",
+	DcmTag{0x0046, 0x0077}: "1",
+	DcmTag{0x0046, 0x0080}: "1",
+	DcmTag{0x0046, 0x0092}: "1",
+	DcmTag{0x0046, 0x0094}: "1",
+	DcmTag{0x0046, 0x0095}: "1",
+	DcmTag{0x0046, 0x0097}: "1",
+	DcmTag{0x0046, 0x0098}: "1",
+	DcmTag{0x0046, 0x0100}: "1",
+	DcmTag{0x0046, 0x0101}: "1",
+	DcmTag{0x0046, 0x0102}: "1",
+	DcmTag{0x0046, 0x0104}: "1",
+	DcmTag{0x0046, 0x0106}: "1",
+	DcmTag{0x0046, 0x0121}: "1",
+	DcmTag{0x0046, 0x0122}: "1",
+	DcmTag{0x0046, 0x0123}: "1",
+	DcmTag{0x0046, 0x0124}: "1",
+	DcmTag{0x0046, 0x0125}: "1",
+	DcmTag{0x0046, 0x0135}: "2",
+	DcmTag{0x0046, 0x0137}: "1",
+	DcmTag{0x0046, 0x0139}: "1",
+	DcmTag{0x0046, 0x0145}: "1",
+	DcmTag{0x0046, 0x0146}: "1",
+	DcmTag{0x0046, 0x0147}: "1",
+	DcmTag{0x0048, 0x0001}: "1",
+	DcmTag{0x0048, 0x0002}: "1",
+	DcmTag{0x0048, 0x0003}: "1",
+	DcmTag{0x0048, 0x0006}: "1",
+	DcmTag{0x0048, 0x0007}: "1",
+	DcmTag{0x0048, 0x0008}: "1",
+	DcmTag{0x0048, 0x0010}: "1",
+	DcmTag{0x0048, 0x0011}: "1",
+	DcmTag{0x0048, 0x0012}: "1",
+	DcmTag{0x0048, 0x0013}: "1",
+	DcmTag{0x0048, 0x0014}: "1",
+	DcmTag{0x0048, 0x0015}: "3",
+	DcmTag{0x0048, 0x0100}: "1",
+	DcmTag{0x0048, 0x0102}: "6",
+	DcmTag{0x0048, 0x0105}: "1",
+	DcmTag{0x0048, 0x0106}: "1",
+	DcmTag{0x0048, 0x0107}: "1",
+	DcmTag{0x0048, 0x0108}: "1",
+	DcmTag{0x0048, 0x0110}: "1",
+	DcmTag{0x0048, 0x0111}: "1",
+	DcmTag{0x0048, 0x0112}: "1",
+	DcmTag{0x0048, 0x0113}: "1",
+	DcmTag{0x0048, 0x0120}: "1",
+	DcmTag{0x0048, 0x0200}: "1",
+	DcmTag{0x0048, 0x0201}: "2",
+	DcmTag{0x0048, 0x0202}: "2",
+	DcmTag{0x0048, 0x0207}: "1",
+	DcmTag{0x0048, 0x021a}: "1",
+	DcmTag{0x0048, 0x021e}: "1",
+	DcmTag{0x0048, 0x021f}: "1",
+	DcmTag{0x0048, 0x0301}: "1",
+	DcmTag{0x0050, 0x0004}: "1",
+	DcmTag{0x0050, 0x0010}: "1",
+	DcmTag{0x0050, 0x0012}: "1",
+	DcmTag{0x0050, 0x0013}: "1",
+	DcmTag{0x0050, 0x0014}: "1",
+	DcmTag{0x0050, 0x0015}: "1",
+	DcmTag{0x0050, 0x0016}: "1",
+	DcmTag{0x0050, 0x0017}: "1",
+	DcmTag{0x0050, 0x0018}: "1",
+	DcmTag{0x0050, 0x0019}: "1",
+	DcmTag{0x0050, 0x001a}: "1",
+	DcmTag{0x0050, 0x001b}: "1",
+	DcmTag{0x0050, 0x001c}: "1",
+	DcmTag{0x0050, 0x001d}: "1",
+	DcmTag{0x0050, 0x001e}: "1",
+	DcmTag{0x0050, 0x0020}: "1",
+	DcmTag{0x0054, 0x0010}: "1-n",
+	DcmTag{0x0054, 0x0011}: "1",
+	DcmTag{0x0054, 0x0012}: "1",
+	DcmTag{0x0054, 0x0013}: "1",
+	DcmTag{0x0054, 0x0014}: "1",
+	DcmTag{0x0054, 0x0015}: "1",
+	DcmTag{0x0054, 0x0016}: "1",
+	DcmTag{0x0054, 0x0017}: "1",
+	DcmTag{0x0054, 0x0018}: "1",
+	DcmTag{0x0054, 0x0020}: "1-n",
+	DcmTag{0x0054, 0x0021}: "1",
+	DcmTag{0x0054, 0x0022}: "1",
+	DcmTag{0x0054, 0x0030}: "1-n",
+	DcmTag{0x0054, 0x0031}: "1",
+	DcmTag{0x0054, 0x0032}: "1",
+	DcmTag{0x0054, 0x0033}: "1",
+	DcmTag{0x0054, 0x0036}: "1",
+	DcmTag{0x0054, 0x0038}: "1",
+	DcmTag{0x0054, 0x0039}: "1",
+	DcmTag{0x0054, 0x0050}: "1-n",
+	DcmTag{0x0054, 0x0051}: "1",
+	DcmTag{0x0054, 0x0052}: "1",
+	DcmTag{0x0054, 0x0053}: "1",
+	DcmTag{0x0054, 0x0060}: "1-n",
+	DcmTag{0x0054, 0x0061}: "1",
+	DcmTag{0x0054, 0x0062}: "1",
+	DcmTag{0x0054, 0x0063}: "1",
+	DcmTag{0x0054, 0x0070}: "1-n",
+	DcmTag{0x0054, 0x0071}: "1",
+	DcmTag{0x0054, 0x0072}: "1",
+	DcmTag{0x0054, 0x0073}: "1",
+	DcmTag{0x0054, 0x0080}: "1-n",
+	DcmTag{0x0054, 0x0081}: "1",
+	DcmTag{0x0054, 0x0090}: "1-n",
+	DcmTag{0x0054, 0x0100}: "1-n",
+	DcmTag{0x0054, 0x0101}: "1",
+	DcmTag{0x0054, 0x0200}: "1",
+	DcmTag{0x0054, 0x0202}: "1",
+	DcmTag{0x0054, 0x0210}: "1-n",
+	DcmTag{0x0054, 0x0211}: "1",
+	DcmTag{0x0054, 0x0220}: "1",
+	DcmTag{0x0054, 0x0222}: "1",
+	DcmTag{0x0054, 0x0300}: "1",
+	DcmTag{0x0054, 0x0302}: "1",
+	DcmTag{0x0054, 0x0304}: "1",
+	DcmTag{0x0054, 0x0306}: "1",
+	DcmTag{0x0054, 0x0308}: "1",
+	DcmTag{0x0054, 0x0400}: "1",
+	DcmTag{0x0054, 0x0410}: "1",
+	DcmTag{0x0054, 0x0412}: "1",
+	DcmTag{0x0054, 0x0414}: "1",
+	DcmTag{0x0054, 0x0500}: "1",
+	DcmTag{0x0054, 0x1000}: "2",
+	DcmTag{0x0054, 0x1001}: "1",
+	DcmTag{0x0054, 0x1002}: "1",
+	DcmTag{0x0054, 0x1004}: "1",
+	DcmTag{0x0054, 0x1100}: "1",
+	DcmTag{0x0054, 0x1101}: "1",
+	DcmTag{0x0054, 0x1102}: "1",
+	DcmTag{0x0054, 0x1103}: "1",
+	DcmTag{0x0054, 0x1104}: "1",
+	DcmTag{0x0054, 0x1105}: "1",
+	DcmTag{0x0054, 0x1200}: "1",
+	DcmTag{0x0054, 0x1201}: "2",
+	DcmTag{0x0054, 0x1202}: "1",
+	DcmTag{0x0054, 0x1203}: "2",
+	DcmTag{0x0054, 0x1210}: "1",
+	DcmTag{0x0054, 0x1220}: "1-n",
+	DcmTag{0x0054, 0x1300}: "1",
+	DcmTag{0x0054, 0x1310}: "1",
+	DcmTag{0x0054, 0x1311}: "1-n",
+	DcmTag{0x0054, 0x1320}: "1",
+	DcmTag{0x0054, 0x1321}: "1",
+	DcmTag{0x0054, 0x1322}: "1",
+	DcmTag{0x0054, 0x1323}: "1",
+	DcmTag{0x0054, 0x1324}: "1",
+	DcmTag{0x0054, 0x1330}: "1",
+	DcmTag{0x0054, 0x1400}: "1-n",
+	DcmTag{0x0054, 0x1401}: "1",
+	DcmTag{0x0060, 0x3000}: "1",
+	DcmTag{0x0060, 0x3002}: "1",
+	DcmTag{0x0060, 0x3004}: "1",
+	DcmTag{0x0060, 0x3006}: "1",
+	DcmTag{0x0060, 0x3008}: "1",
+	DcmTag{0x0060, 0x3010}: "1",
+	DcmTag{0x0060, 0x3020}: "1-n",
+	DcmTag{0x0062, 0x0001}: "1",
+	DcmTag{0x0062, 0x0002}: "1",
+	DcmTag{0x0062, 0x0003}: "1",
+	DcmTag{0x0062, 0x0004}: "1",
+	DcmTag{0x0062, 0x0005}: "1",
+	DcmTag{0x0062, 0x0006}: "1",
+	DcmTag{0x0062, 0x0008}: "1",
+	DcmTag{0x0062, 0x0009}: "1",
+	DcmTag{0x0062, 0x000a}: "1",
+	DcmTag{0x0062, 0x000b}: "1-n",
+	DcmTag{0x0062, 0x000c}: "1",
+	DcmTag{0x0062, 0x000d}: "3",
+	DcmTag{0x0062, 0x000e}: "1",
+	DcmTag{0x0062, 0x000f}: "1",
+	DcmTag{0x0062, 0x0010}: "1",
+	DcmTag{0x0064, 0x0002}: "1",
+	DcmTag{0x0064, 0x0003}: "1",
+	DcmTag{0x0064, 0x0005}: "1",
+	DcmTag{0x0064, 0x0007}: "3",
+	DcmTag{0x0064, 0x0008}: "3",
+	DcmTag{0x0064, 0x0009}: "1",
+	DcmTag{0x0064, 0x000f}: "1",
+	DcmTag{0x0064, 0x0010}: "1",
+	DcmTag{0x0066, 0x0001}: "1",
+	DcmTag{0x0066, 0x0002}: "1",
+	DcmTag{0x0066, 0x0003}: "1",
+	DcmTag{0x0066, 0x0004}: "1",
+	DcmTag{0x0066, 0x0009}: "1",
+	DcmTag{0x0066, 0x000a}: "1",
+	DcmTag{0x0066, 0x000b}: "1",
+	DcmTag{0x0066, 0x000c}: "1",
+	DcmTag{0x0066, 0x000d}: "1",
+	DcmTag{0x0066, 0x000e}: "1",
+	DcmTag{0x0066, 0x0010}: "1",
+	DcmTag{0x0066, 0x0011}: "1",
+	DcmTag{0x0066, 0x0012}: "1",
+	DcmTag{0x0066, 0x0013}: "1",
+	DcmTag{0x0066, 0x0015}: "1",
+	DcmTag{0x0066, 0x0016}: "1",
+	DcmTag{0x0066, 0x0017}: "3",
+	DcmTag{0x0066, 0x0018}: "1",
+	DcmTag{0x0066, 0x0019}: "1",
+	DcmTag{0x0066, 0x001a}: "6",
+	DcmTag{0x0066, 0x001b}: "3",
+	DcmTag{0x0066, 0x001c}: "3",
+	DcmTag{0x0066, 0x001e}: "1",
+	DcmTag{0x0066, 0x001f}: "1",
+	DcmTag{0x0066, 0x0020}: "1-n",
+	DcmTag{0x0066, 0x0021}: "1",
+	DcmTag{0x0066, 0x0023}: "1",
+	DcmTag{0x0066, 0x0024}: "1",
+	DcmTag{0x0066, 0x0025}: "1",
+	DcmTag{0x0066, 0x0026}: "1",
+	DcmTag{0x0066, 0x0027}: "1",
+	DcmTag{0x0066, 0x0028}: "1",
+	DcmTag{0x0066, 0x0029}: "1",
+	DcmTag{0x0066, 0x002a}: "1",
+	DcmTag{0x0066, 0x002b}: "1",
+	DcmTag{0x0066, 0x002c}: "1",
+	DcmTag{0x0066, 0x002d}: "1",
+	DcmTag{0x0066, 0x002e}: "1",
+	DcmTag{0x0066, 0x002f}: "1",
+	DcmTag{0x0066, 0x0030}: "1",
+	DcmTag{0x0066, 0x0031}: "1",
+	DcmTag{0x0066, 0x0032}: "1",
+	DcmTag{0x0066, 0x0034}: "1",
+	DcmTag{0x0066, 0x0035}: "1",
+	DcmTag{0x0066, 0x0036}: "1",
+	DcmTag{0x0068, 0x6210}: "1",
+	DcmTag{0x0068, 0x6221}: "1",
+	DcmTag{0x0068, 0x6222}: "1",
+	DcmTag{0x0068, 0x6223}: "1",
+	DcmTag{0x0068, 0x6224}: "1",
+	DcmTag{0x0068, 0x6225}: "1",
+	DcmTag{0x0068, 0x6226}: "1",
+	DcmTag{0x0068, 0x6230}: "1",
+	DcmTag{0x0068, 0x6260}: "1",
+	DcmTag{0x0068, 0x6265}: "1",
+	DcmTag{0x0068, 0x6270}: "1",
+	DcmTag{0x0068, 0x6280}: "1",
+	DcmTag{0x0068, 0x62a0}: "1",
+	DcmTag{0x0068, 0x62a5}: "1",
+	DcmTag{0x0068, 0x62c0}: "1",
+	DcmTag{0x0068, 0x62d0}: "1",
+	DcmTag{0x0068, 0x62d5}: "1",
+	DcmTag{0x0068, 0x62e0}: "1",
+	DcmTag{0x0068, 0x62f0}: "9",
+	DcmTag{0x0068, 0x62f2}: "1",
+	DcmTag{0x0068, 0x6300}: "1",
+	DcmTag{0x0068, 0x6310}: "1",
+	DcmTag{0x0068, 0x6320}: "1",
+	DcmTag{0x0068, 0x6330}: "1",
+	DcmTag{0x0068, 0x6340}: "1",
+	DcmTag{0x0068, 0x6345}: "1",
+	DcmTag{0x0068, 0x6346}: "2",
+	DcmTag{0x0068, 0x6347}: "4",
+	DcmTag{0x0068, 0x6350}: "1-n",
+	DcmTag{0x0068, 0x6360}: "1",
+	DcmTag{0x0068, 0x6380}: "1",
+	DcmTag{0x0068, 0x6390}: "1",
+	DcmTag{0x0068, 0x63a0}: "1",
+	DcmTag{0x0068, 0x63a4}: "1",
+	DcmTag{0x0068, 0x63a8}: "1",
+	DcmTag{0x0068, 0x63ac}: "1",
+	DcmTag{0x0068, 0x63b0}: "1",
+	DcmTag{0x0068, 0x63c0}: "1",
+	DcmTag{0x0068, 0x63d0}: "1",
+	DcmTag{0x0068, 0x63e0}: "1",
+	DcmTag{0x0068, 0x63f0}: "1",
+	DcmTag{0x0068, 0x6400}: "1",
+	DcmTag{0x0068, 0x6410}: "1",
+	DcmTag{0x0068, 0x6420}: "1",
+	DcmTag{0x0068, 0x6430}: "1",
+	DcmTag{0x0068, 0x6440}: "1",
+	DcmTag{0x0068, 0x6450}: "2",
+	DcmTag{0x0068, 0x6460}: "4",
+	DcmTag{0x0068, 0x6470}: "1",
+	DcmTag{0x0068, 0x6490}: "3",
+	DcmTag{0x0068, 0x64a0}: "2",
+	DcmTag{0x0068, 0x64c0}: "3",
+	DcmTag{0x0068, 0x64d0}: "9",
+	DcmTag{0x0068, 0x64f0}: "3",
+	DcmTag{0x0068, 0x6500}: "1",
+	DcmTag{0x0068, 0x6510}: "1",
+	DcmTag{0x0068, 0x6520}: "1",
+	DcmTag{0x0068, 0x6530}: "1",
+	DcmTag{0x0068, 0x6540}: "1",
+	DcmTag{0x0068, 0x6545}: "1",
+	DcmTag{0x0068, 0x6550}: "1",
+	DcmTag{0x0068, 0x6560}: "2",
+	DcmTag{0x0068, 0x6590}: "3",
+	DcmTag{0x0068, 0x65a0}: "1",
+	DcmTag{0x0068, 0x65b0}: "4",
+	DcmTag{0x0068, 0x65d0}: "6",
+	DcmTag{0x0068, 0x65e0}: "1",
+	DcmTag{0x0068, 0x65f0}: "4",
+	DcmTag{0x0068, 0x6610}: "3",
+	DcmTag{0x0068, 0x6620}: "3",
+	DcmTag{0x0070, 0x0001}: "1",
+	DcmTag{0x0070, 0x0002}: "1",
+	DcmTag{0x0070, 0x0003}: "1",
+	DcmTag{0x0070, 0x0004}: "1",
+	DcmTag{0x0070, 0x0005}: "1",
+	DcmTag{0x0070, 0x0006}: "1",
+	DcmTag{0x0070, 0x0008}: "1",
+	DcmTag{0x0070, 0x0009}: "1",
+	DcmTag{0x0070, 0x0010}: "2",
+	DcmTag{0x0070, 0x0011}: "2",
+	DcmTag{0x0070, 0x0012}: "1",
+	DcmTag{0x0070, 0x0014}: "2",
+	DcmTag{0x0070, 0x0015}: "1",
+	DcmTag{0x0070, 0x0020}: "1",
+	DcmTag{0x0070, 0x0021}: "1",
+	DcmTag{0x0070, 0x0022}: "2-n",
+	DcmTag{0x0070, 0x0023}: "1",
+	DcmTag{0x0070, 0x0024}: "1",
+	DcmTag{0x0070, 0x0040}: "1",
+	DcmTag{0x0070, 0x0041}: "1",
+	DcmTag{0x0070, 0x0042}: "1",
+	DcmTag{0x0070, 0x0050}: "2",
+	DcmTag{0x0070, 0x0051}: "2",
+	DcmTag{0x0070, 0x0052}: "2",
+	DcmTag{0x0070, 0x0053}: "2",
+	DcmTag{0x0070, 0x005a}: "1",
+	DcmTag{0x0070, 0x0060}: "1",
+	DcmTag{0x0070, 0x0062}: "1",
+	DcmTag{0x0070, 0x0066}: "1",
+	DcmTag{0x0070, 0x0067}: "3",
+	DcmTag{0x0070, 0x0068}: "1",
+	DcmTag{0x0070, 0x0080}: "1",
+	DcmTag{0x0070, 0x0081}: "1",
+	DcmTag{0x0070, 0x0082}: "1",
+	DcmTag{0x0070, 0x0083}: "1",
+	DcmTag{0x0070, 0x0084}: "1",
+	DcmTag{0x0070, 0x0086}: "1",
+	DcmTag{0x0070, 0x0087}: "1",
+	DcmTag{0x0070, 0x0100}: "1",
+	DcmTag{0x0070, 0x0101}: "2",
+	DcmTag{0x0070, 0x0102}: "2",
+	DcmTag{0x0070, 0x0103}: "1",
+	DcmTag{0x0070, 0x0207}: "1",
+	DcmTag{0x0070, 0x0208}: "1",
+	DcmTag{0x0070, 0x0209}: "1",
+	DcmTag{0x0070, 0x0226}: "1",
+	DcmTag{0x0070, 0x0227}: "1",
+	DcmTag{0x0070, 0x0228}: "1",
+	DcmTag{0x0070, 0x0229}: "1",
+	DcmTag{0x0070, 0x0230}: "1",
+	DcmTag{0x0070, 0x0231}: "1",
+	DcmTag{0x0070, 0x0232}: "1",
+	DcmTag{0x0070, 0x0233}: "1",
+	DcmTag{0x0070, 0x0234}: "1",
+	DcmTag{0x0070, 0x0241}: "3",
+	DcmTag{0x0070, 0x0242}: "1",
+	DcmTag{0x0070, 0x0243}: "1",
+	DcmTag{0x0070, 0x0244}: "1",
+	DcmTag{0x0070, 0x0245}: "1",
+	DcmTag{0x0070, 0x0246}: "1",
+	DcmTag{0x0070, 0x0247}: "3",
+	DcmTag{0x0070, 0x0248}: "1",
+	DcmTag{0x0070, 0x0249}: "1",
+	DcmTag{0x0070, 0x0250}: "1",
+	DcmTag{0x0070, 0x0251}: "3",
+	DcmTag{0x0070, 0x0252}: "3",
+	DcmTag{0x0070, 0x0253}: "1",
+	DcmTag{0x0070, 0x0254}: "1",
+	DcmTag{0x0070, 0x0255}: "1",
+	DcmTag{0x0070, 0x0256}: "1",
+	DcmTag{0x0070, 0x0257}: "1",
+	DcmTag{0x0070, 0x0258}: "1",
+	DcmTag{0x0070, 0x0261}: "1",
+	DcmTag{0x0070, 0x0262}: "1",
+	DcmTag{0x0070, 0x0273}: "2",
+	DcmTag{0x0070, 0x0274}: "1",
+	DcmTag{0x0070, 0x0278}: "1",
+	DcmTag{0x0070, 0x0279}: "1",
+	DcmTag{0x0070, 0x0282}: "1",
+	DcmTag{0x0070, 0x0284}: "1",
+	DcmTag{0x0070, 0x0285}: "1",
+	DcmTag{0x0070, 0x0287}: "1",
+	DcmTag{0x0070, 0x0288}: "1",
+	DcmTag{0x0070, 0x0289}: "1",
+	DcmTag{0x0070, 0x0294}: "1",
+	DcmTag{0x0070, 0x0295}: "1",
+	DcmTag{0x0070, 0x0306}: "1",
+	DcmTag{0x0070, 0x0308}: "1",
+	DcmTag{0x0070, 0x0309}: "1",
+	DcmTag{0x0070, 0x030a}: "1",
+	DcmTag{0x0070, 0x030c}: "1",
+	DcmTag{0x0070, 0x030d}: "1",
+	DcmTag{0x0070, 0x030f}: "1",
+	DcmTag{0x0070, 0x0310}: "1",
+	DcmTag{0x0070, 0x0311}: "1",
+	DcmTag{0x0070, 0x0312}: "1",
+	DcmTag{0x0070, 0x0314}: "1",
+	DcmTag{0x0070, 0x0318}: "1",
+	DcmTag{0x0070, 0x031a}: "1",
+	DcmTag{0x0070, 0x031c}: "1",
+	DcmTag{0x0070, 0x031e}: "1",
+	DcmTag{0x0070, 0x0401}: "3",
+	DcmTag{0x0070, 0x0402}: "1",
+	DcmTag{0x0070, 0x0403}: "1",
+	DcmTag{0x0070, 0x0404}: "1",
+	DcmTag{0x0070, 0x0405}: "1",
+	DcmTag{0x0072, 0x0002}: "1",
+	DcmTag{0x0072, 0x0004}: "1",
+	DcmTag{0x0072, 0x0006}: "1",
+	DcmTag{0x0072, 0x0008}: "1",
+	DcmTag{0x0072, 0x000a}: "1",
+	DcmTag{0x0072, 0x000c}: "1",
+	DcmTag{0x0072, 0x000e}: "1",
+	DcmTag{0x0072, 0x0010}: "1",
+	DcmTag{0x0072, 0x0012}: "1",
+	DcmTag{0x0072, 0x0014}: "1",
+	DcmTag{0x0072, 0x0020}: "1",
+	DcmTag{0x0072, 0x0022}: "1",
+	DcmTag{0x0072, 0x0024}: "1",
+	DcmTag{0x0072, 0x0026}: "1",
+	DcmTag{0x0072, 0x0028}: "1",
+	DcmTag{0x0072, 0x0030}: "1",
+	DcmTag{0x0072, 0x0032}: "1",
+	DcmTag{0x0072, 0x0034}: "1",
+	DcmTag{0x0072, 0x0038}: "2",
+	DcmTag{0x0072, 0x003a}: "1",
+	DcmTag{0x0072, 0x003c}: "2",
+	DcmTag{0x0072, 0x003e}: "1",
+	DcmTag{0x0072, 0x0040}: "1",
+	DcmTag{0x0072, 0x0050}: "1",
+	DcmTag{0x0072, 0x0052}: "1",
+	DcmTag{0x0072, 0x0054}: "1",
+	DcmTag{0x0072, 0x0056}: "1",
+	DcmTag{0x0072, 0x0060}: "1-n",
+	DcmTag{0x0072, 0x0062}: "1-n",
+	DcmTag{0x0072, 0x0064}: "1-n",
+	DcmTag{0x0072, 0x0066}: "1-n",
+	DcmTag{0x0072, 0x0068}: "1",
+	DcmTag{0x0072, 0x006a}: "1-n",
+	DcmTag{0x0072, 0x006c}: "1-n",
+	DcmTag{0x0072, 0x006e}: "1",
+	DcmTag{0x0072, 0x0070}: "1",
+	DcmTag{0x0072, 0x0072}: "1-n",
+	DcmTag{0x0072, 0x0074}: "1-n",
+	DcmTag{0x0072, 0x0076}: "1-n",
+	DcmTag{0x0072, 0x0078}: "1-n",
+	DcmTag{0x0072, 0x007a}: "1-n",
+	DcmTag{0x0072, 0x007c}: "1-n",
+	DcmTag{0x0072, 0x007e}: "1-n",
+	DcmTag{0x0072, 0x0080}: "1",
+	DcmTag{0x0072, 0x0100}: "1",
+	DcmTag{0x0072, 0x0102}: "1",
+	DcmTag{0x0072, 0x0104}: "1",
+	DcmTag{0x0072, 0x0106}: "1",
+	DcmTag{0x0072, 0x0108}: "4",
+	DcmTag{0x0072, 0x010a}: "1",
+	DcmTag{0x0072, 0x010c}: "1",
+	DcmTag{0x0072, 0x010e}: "1",
+	DcmTag{0x0072, 0x0200}: "1",
+	DcmTag{0x0072, 0x0202}: "1",
+	DcmTag{0x0072, 0x0203}: "1",
+	DcmTag{0x0072, 0x0204}: "1",
+	DcmTag{0x0072, 0x0206}: "1",
+	DcmTag{0x0072, 0x0208}: "1",
+	DcmTag{0x0072, 0x0210}: "1",
+	DcmTag{0x0072, 0x0212}: "2-n",
+	DcmTag{0x0072, 0x0214}: "1",
+	DcmTag{0x0072, 0x0216}: "1",
+	DcmTag{0x0072, 0x0218}: "1-n",
+	DcmTag{0x0072, 0x0300}: "1",
+	DcmTag{0x0072, 0x0302}: "1",
+	DcmTag{0x0072, 0x0304}: "1",
+	DcmTag{0x0072, 0x0306}: "1",
+	DcmTag{0x0072, 0x0308}: "1",
+	DcmTag{0x0072, 0x0310}: "1",
+	DcmTag{0x0072, 0x0312}: "1",
+	DcmTag{0x0072, 0x0314}: "1",
+	DcmTag{0x0072, 0x0316}: "1",
+	DcmTag{0x0072, 0x0318}: "1",
+	DcmTag{0x0072, 0x0320}: "1",
+	DcmTag{0x0072, 0x0330}: "1",
+	DcmTag{0x0072, 0x0400}: "1",
+	DcmTag{0x0072, 0x0402}: "1",
+	DcmTag{0x0072, 0x0404}: "1",
+	DcmTag{0x0072, 0x0406}: "1",
+	DcmTag{0x0072, 0x0420}: "3",
+	DcmTag{0x0072, 0x0421}: "3",
+	DcmTag{0x0072, 0x0422}: "1",
+	DcmTag{0x0072, 0x0424}: "1",
+	DcmTag{0x0072, 0x0427}: "1",
+	DcmTag{0x0072, 0x0430}: "1",
+	DcmTag{0x0072, 0x0432}: "2-n",
+	DcmTag{0x0072, 0x0434}: "1",
+	DcmTag{0x0072, 0x0500}: "1",
+	DcmTag{0x0072, 0x0510}: "1",
+	DcmTag{0x0072, 0x0512}: "1",
+	DcmTag{0x0072, 0x0514}: "1",
+	DcmTag{0x0072, 0x0516}: "1",
+	DcmTag{0x0072, 0x0520}: "1-n",
+	DcmTag{0x0072, 0x0600}: "1",
+	DcmTag{0x0072, 0x0602}: "1",
+	DcmTag{0x0072, 0x0604}: "1",
+	DcmTag{0x0072, 0x0700}: "2",
+	DcmTag{0x0072, 0x0702}: "1",
+	DcmTag{0x0072, 0x0704}: "1",
+	DcmTag{0x0072, 0x0706}: "1",
+	DcmTag{0x0072, 0x0710}: "1",
+	DcmTag{0x0072, 0x0712}: "1",
+	DcmTag{0x0072, 0x0714}: "1",
+	DcmTag{0x0072, 0x0716}: "1",
+	DcmTag{0x0072, 0x0717}: "1",
+	DcmTag{0x0072, 0x0718}: "1",
+	DcmTag{0x0074, 0x1000}: "1",
+	DcmTag{0x0074, 0x1002}: "1",
+	DcmTag{0x0074, 0x1004}: "1",
+	DcmTag{0x0074, 0x1006}: "1",
+	DcmTag{0x0074, 0x1008}: "1",
+	DcmTag{0x0074, 0x100a}: "1",
+	DcmTag{0x0074, 0x100c}: "1",
+	DcmTag{0x0074, 0x100e}: "1",
+	DcmTag{0x0074, 0x1020}: "1",
+	DcmTag{0x0074, 0x1022}: "1",
+	DcmTag{0x0074, 0x1024}: "1",
+	DcmTag{0x0074, 0x1030}: "1",
+	DcmTag{0x0074, 0x1032}: "1",
+	DcmTag{0x0074, 0x1034}: "1",
+	DcmTag{0x0074, 0x1036}: "1",
+	DcmTag{0x0074, 0x1038}: "1",
+	DcmTag{0x0074, 0x103a}: "4",
+	DcmTag{0x0074, 0x1040}: "1",
+	DcmTag{0x0074, 0x1042}: "1",
+	DcmTag{0x0074, 0x1044}: "1",
+	DcmTag{0x0074, 0x1046}: "1",
+	DcmTag{0x0074, 0x1048}: "1",
+	DcmTag{0x0074, 0x104a}: "1",
+	DcmTag{0x0074, 0x104c}: "1",
+	DcmTag{0x0074, 0x104e}: "1",
+	DcmTag{0x0074, 0x1050}: "1",
+	DcmTag{0x0074, 0x1052}: "1",
+	DcmTag{0x0074, 0x1054}: "1",
+	DcmTag{0x0074, 0x1056}: "1",
+	DcmTag{0x0074, 0x1200}: "1",
+	DcmTag{0x0074, 0x1202}: "1",
+	DcmTag{0x0074, 0x1204}: "1",
+	DcmTag{0x0074, 0x1210}: "1",
+	DcmTag{0x0074, 0x1212}: "1",
+	DcmTag{0x0074, 0x1216}: "1",
+	DcmTag{0x0074, 0x1220}: "1",
+	DcmTag{0x0074, 0x1222}: "1",
+	DcmTag{0x0074, 0x1230}: "1",
+	DcmTag{0x0074, 0x1234}: "1",
+	DcmTag{0x0074, 0x1236}: "1",
+	DcmTag{0x0074, 0x1238}: "1",
+	DcmTag{0x0074, 0x1242}: "1",
+	DcmTag{0x0074, 0x1244}: "1",
+	DcmTag{0x0074, 0x1246}: "1",
+	DcmTag{0x0076, 0x0001}: "1",
+	DcmTag{0x0076, 0x0003}: "1",
+	DcmTag{0x0076, 0x0006}: "1",
+	DcmTag{0x0076, 0x0008}: "1",
+	DcmTag{0x0076, 0x000a}: "1",
+	DcmTag{0x0076, 0x000c}: "1",
+	DcmTag{0x0076, 0x000e}: "1",
+	DcmTag{0x0076, 0x0010}: "1",
+	DcmTag{0x0076, 0x0020}: "1",
+	DcmTag{0x0076, 0x0030}: "1",
+	DcmTag{0x0076, 0x0032}: "1",
+	DcmTag{0x0076, 0x0034}: "1",
+	DcmTag{0x0076, 0x0036}: "1",
+	DcmTag{0x0076, 0x0038}: "1",
+	DcmTag{0x0076, 0x0040}: "1",
+	DcmTag{0x0076, 0x0055}: "1",
+	DcmTag{0x0076, 0x0060}: "1",
+	DcmTag{0x0076, 0x0070}: "1",
+	DcmTag{0x0076, 0x0080}: "1",
+	DcmTag{0x0076, 0x0090}: "1",
+	DcmTag{0x0076, 0x00a0}: "1",
+	DcmTag{0x0076, 0x00b0}: "1",
+	DcmTag{0x0076, 0x00c0}: "1",
+	DcmTag{0x0078, 0x0000}: "1",
+	DcmTag{0x0078, 0x0010}: "1",
+	DcmTag{0x0078, 0x0020}: "1",
+	DcmTag{0x0078, 0x0024}: "1",
+	DcmTag{0x0078, 0x0026}: "1",
+	DcmTag{0x0078, 0x0028}: "1",
+	DcmTag{0x0078, 0x002a}: "1",
+	DcmTag{0x0078, 0x002e}: "1",
+	DcmTag{0x0078, 0x0050}: "3",
+	DcmTag{0x0078, 0x0060}: "9",
+	DcmTag{0x0078, 0x0070}: "1",
+	DcmTag{0x0078, 0x0090}: "2",
+	DcmTag{0x0078, 0x00a0}: "4",
+	DcmTag{0x0078, 0x00b0}: "1",
+	DcmTag{0x0078, 0x00b2}: "1",
+	DcmTag{0x0078, 0x00b4}: "1",
+	DcmTag{0x0078, 0x00b6}: "1",
+	DcmTag{0x0078, 0x00b8}: "1",
+	DcmTag{0x0088, 0x0130}: "1",
+	DcmTag{0x0088, 0x0140}: "1",
+	DcmTag{0x0088, 0x0200}: "1",
+	DcmTag{0x0088, 0x0904}: "1",
+	DcmTag{0x0088, 0x0906}: "1",
+	DcmTag{0x0088, 0x0910}: "1",
+	DcmTag{0x0088, 0x0912}: "1-32",
+	DcmTag{0x0100, 0x0410}: "1",
+	DcmTag{0x0100, 0x0420}: "1",
+	DcmTag{0x0100, 0x0424}: "1",
+	DcmTag{0x0100, 0x0426}: "1",
+	DcmTag{0x0400, 0x0005}: "1",
+	DcmTag{0x0400, 0x0010}: "1",
+	DcmTag{0x0400, 0x0015}: "1",
+	DcmTag{0x0400, 0x0020}: "1-n",
+	DcmTag{0x0400, 0x0100}: "1",
+	DcmTag{0x0400, 0x0105}: "1",
+	DcmTag{0x0400, 0x0110}: "1",
+	DcmTag{0x0400, 0x0115}: "1",
+	DcmTag{0x0400, 0x0120}: "1",
+	DcmTag{0x0400, 0x0305}: "1",
+	DcmTag{0x0400, 0x0310}: "1",
+	DcmTag{0x0400, 0x0401}: "1",
+	DcmTag{0x0400, 0x0402}: "1",
+	DcmTag{0x0400, 0x0403}: "1",
+	DcmTag{0x0400, 0x0404}: "1",
+	DcmTag{0x0400, 0x0500}: "1",
+	DcmTag{0x0400, 0x0510}: "1",
+	DcmTag{0x0400, 0x0520}: "1",
+	DcmTag{0x0400, 0x0550}: "1",
+	DcmTag{0x0400, 0x0561}: "1",
+	DcmTag{0x0400, 0x0562}: "1",
+	DcmTag{0x0400, 0x0563}: "1",
+	DcmTag{0x0400, 0x0564}: "1",
+	DcmTag{0x0400, 0x0565}: "1",
+	DcmTag{0x1000, 0x0000}: "1",
+	DcmTag{0x1000, 0x0010}: "3",
+	DcmTag{0x1000, 0x0011}: "3",
+	DcmTag{0x1000, 0x0012}: "1",
+	DcmTag{0x1000, 0x0013}: "3",
+	DcmTag{0x1000, 0x0014}: "1",
+	DcmTag{0x1000, 0x0015}: "3",
+	DcmTag{0x1010, 0x0000}: "1",
+	DcmTag{0x1010, 0x0004}: "1-n",
+	DcmTag{0x2000, 0x0010}: "1",
+	DcmTag{0x2000, 0x001e}: "1",
+	DcmTag{0x2000, 0x0020}: "1",
+	DcmTag{0x2000, 0x0030}: "1",
+	DcmTag{0x2000, 0x0040}: "1",
+	DcmTag{0x2000, 0x0050}: "1",
+	DcmTag{0x2000, 0x0060}: "1",
+	DcmTag{0x2000, 0x0061}: "1",
+	DcmTag{0x2000, 0x0062}: "1",
+	DcmTag{0x2000, 0x0063}: "1",
+	DcmTag{0x2000, 0x0065}: "1",
+	DcmTag{0x2000, 0x0067}: "1",
+	DcmTag{0x2000, 0x0069}: "1",
+	DcmTag{0x2000, 0x006a}: "1",
+	DcmTag{0x2000, 0x00a0}: "1",
+	DcmTag{0x2000, 0x00a1}: "1",
+	DcmTag{0x2000, 0x00a2}: "1",
+	DcmTag{0x2000, 0x00a4}: "1",
+	DcmTag{0x2000, 0x00a8}: "1",
+	DcmTag{0x2000, 0x0500}: "1",
+	DcmTag{0x2000, 0x0510}: "1",
+	DcmTag{0x2010, 0x0010}: "1",
+	DcmTag{0x2010, 0x0030}: "1",
+	DcmTag{0x2010, 0x0040}: "1",
+	DcmTag{0x2010, 0x0050}: "1",
+	DcmTag{0x2010, 0x0052}: "1",
+	DcmTag{0x2010, 0x0054}: "1",
+	DcmTag{0x2010, 0x0060}: "1",
+	DcmTag{0x2010, 0x0080}: "1",
+	DcmTag{0x2010, 0x00a6}: "1",
+	DcmTag{0x2010, 0x00a7}: "1-n",
+	DcmTag{0x2010, 0x00a8}: "1",
+	DcmTag{0x2010, 0x00a9}: "1-n",
+	DcmTag{0x2010, 0x0100}: "1",
+	DcmTag{0x2010, 0x0110}: "1",
+	DcmTag{0x2010, 0x0120}: "1",
+	DcmTag{0x2010, 0x0130}: "1",
+	DcmTag{0x2010, 0x0140}: "1",
+	DcmTag{0x2010, 0x0150}: "1",
+	DcmTag{0x2010, 0x0152}: "1",
+	DcmTag{0x2010, 0x0154}: "1",
+	DcmTag{0x2010, 0x015e}: "1",
+	DcmTag{0x2010, 0x0160}: "1",
+	DcmTag{0x2010, 0x0376}: "2",
+	DcmTag{0x2010, 0x0500}: "1",
+	DcmTag{0x2010, 0x0510}: "1",
+	DcmTag{0x2010, 0x0520}: "1",
+	DcmTag{0x2020, 0x0010}: "1",
+	DcmTag{0x2020, 0x0020}: "1",
+	DcmTag{0x2020, 0x0030}: "1",
+	DcmTag{0x2020, 0x0040}: "1",
+	DcmTag{0x2020, 0x0050}: "1",
+	DcmTag{0x2020, 0x00a0}: "1",
+	DcmTag{0x2020, 0x00a2}: "1",
+	DcmTag{0x2020, 0x0110}: "1",
+	DcmTag{0x2020, 0x0111}: "1",
+	DcmTag{0x2020, 0x0130}: "1",
+	DcmTag{0x2020, 0x0140}: "1",
+	DcmTag{0x2030, 0x0010}: "1",
+	DcmTag{0x2030, 0x0020}: "1",
+	DcmTag{0x2040, 0x0010}: "1",
+	DcmTag{0x2040, 0x0011}: "1-99",
+	DcmTag{0x2040, 0x0020}: "1",
+	DcmTag{0x2040, 0x0060}: "1",
+	DcmTag{0x2040, 0x0070}: "1",
+	DcmTag{0x2040, 0x0072}: "1",
+	DcmTag{0x2040, 0x0074}: "1",
+	DcmTag{0x2040, 0x0080}: "1",
+	DcmTag{0x2040, 0x0082}: "1",
+	DcmTag{0x2040, 0x0090}: "1",
+	DcmTag{0x2040, 0x0100}: "1",
+	DcmTag{0x2040, 0x0500}: "1",
+	DcmTag{0x2050, 0x0010}: "1",
+	DcmTag{0x2050, 0x0020}: "1",
+	DcmTag{0x2050, 0x0500}: "1",
+	DcmTag{0x2100, 0x0010}: "1",
+	DcmTag{0x2100, 0x0020}: "1",
+	DcmTag{0x2100, 0x0030}: "1",
+	DcmTag{0x2100, 0x0040}: "1",
+	DcmTag{0x2100, 0x0050}: "1",
+	DcmTag{0x2100, 0x0070}: "1",
+	DcmTag{0x2100, 0x0140}: "1",
+	DcmTag{0x2100, 0x0160}: "1",
+	DcmTag{0x2100, 0x0170}: "1",
+	DcmTag{0x2100, 0x0500}: "1",
+	DcmTag{0x2110, 0x0010}: "1",
+	DcmTag{0x2110, 0x0020}: "1",
+	DcmTag{0x2110, 0x0030}: "1",
+	DcmTag{0x2110, 0x0099}: "1",
+	DcmTag{0x2120, 0x0010}: "1",
+	DcmTag{0x2120, 0x0050}: "1",
+	DcmTag{0x2120, 0x0070}: "1",
+	DcmTag{0x2130, 0x0010}: "1",
+	DcmTag{0x2130, 0x0015}: "1",
+	DcmTag{0x2130, 0x0030}: "1",
+	DcmTag{0x2130, 0x0040}: "1",
+	DcmTag{0x2130, 0x0050}: "1",
+	DcmTag{0x2130, 0x0060}: "1",
+	DcmTag{0x2130, 0x0080}: "1",
+	DcmTag{0x2130, 0x00a0}: "1",
+	DcmTag{0x2130, 0x00c0}: "1",
+	DcmTag{0x2200, 0x0001}: "1",
+	DcmTag{0x2200, 0x0002}: "1",
+	DcmTag{0x2200, 0x0003}: "1",
+	DcmTag{0x2200, 0x0004}: "1",
+	DcmTag{0x2200, 0x0005}: "1",
+	DcmTag{0x2200, 0x0006}: "1",
+	DcmTag{0x2200, 0x0007}: "1",
+	DcmTag{0x2200, 0x0008}: "1",
+	DcmTag{0x2200, 0x0009}: "1",
+	DcmTag{0x2200, 0x000a}: "1",
+	DcmTag{0x2200, 0x000b}: "1",
+	DcmTag{0x2200, 0x000c}: "1",
+	DcmTag{0x2200, 0x000d}: "1",
+	DcmTag{0x2200, 0x000e}: "1-n",
+	DcmTag{0x2200, 0x000f}: "1",
+	DcmTag{0x2200, 0x0020}: "1",
+	DcmTag{0x3002, 0x0002}: "1",
+	DcmTag{0x3002, 0x0003}: "1",
+	DcmTag{0x3002, 0x0004}: "1",
+	DcmTag{0x3002, 0x000a}: "1",
+	DcmTag{0x3002, 0x000c}: "1",
+	DcmTag{0x3002, 0x000d}: "3",
+	DcmTag{0x3002, 0x000e}: "1",
+	DcmTag{0x3002, 0x0010}: "6",
+	DcmTag{0x3002, 0x0011}: "2",
+	DcmTag{0x3002, 0x0012}: "2",
+	DcmTag{0x3002, 0x0020}: "1",
+	DcmTag{0x3002, 0x0022}: "1",
+	DcmTag{0x3002, 0x0024}: "1",
+	DcmTag{0x3002, 0x0026}: "1",
+	DcmTag{0x3002, 0x0028}: "1",
+	DcmTag{0x3002, 0x0029}: "1",
+	DcmTag{0x3002, 0x0030}: "1",
+	DcmTag{0x3002, 0x0032}: "1",
+	DcmTag{0x3002, 0x0034}: "4",
+	DcmTag{0x3002, 0x0040}: "1",
+	DcmTag{0x3002, 0x0041}: "1",
+	DcmTag{0x3002, 0x0042}: "1",
+	DcmTag{0x3002, 0x0050}: "1",
+	DcmTag{0x3002, 0x0051}: "1",
+	DcmTag{0x3002, 0x0052}: "1",
+	DcmTag{0x3004, 0x0001}: "1",
+	DcmTag{0x3004, 0x0002}: "1",
+	DcmTag{0x3004, 0x0004}: "1",
+	DcmTag{0x3004, 0x0006}: "1",
+	DcmTag{0x3004, 0x0008}: "3",
+	DcmTag{0x3004, 0x000a}: "1",
+	DcmTag{0x3004, 0x000c}: "2-n",
+	DcmTag{0x3004, 0x000e}: "1",
+	DcmTag{0x3004, 0x0010}: "1",
+	DcmTag{0x3004, 0x0012}: "1",
+	DcmTag{0x3004, 0x0014}: "1-3",
+	DcmTag{0x3004, 0x0040}: "3",
+	DcmTag{0x3004, 0x0042}: "1",
+	DcmTag{0x3004, 0x0050}: "1",
+	DcmTag{0x3004, 0x0052}: "1",
+	DcmTag{0x3004, 0x0054}: "1",
+	DcmTag{0x3004, 0x0056}: "1",
+	DcmTag{0x3004, 0x0058}: "2-2n",
+	DcmTag{0x3004, 0x0060}: "1",
+	DcmTag{0x3004, 0x0062}: "1",
+	DcmTag{0x3004, 0x0070}: "1",
+	DcmTag{0x3004, 0x0072}: "1",
+	DcmTag{0x3004, 0x0074}: "1",
+	DcmTag{0x3006, 0x0002}: "1",
+	DcmTag{0x3006, 0x0004}: "1",
+	DcmTag{0x3006, 0x0006}: "1",
+	DcmTag{0x3006, 0x0008}: "1",
+	DcmTag{0x3006, 0x0009}: "1",
+	DcmTag{0x3006, 0x0010}: "1",
+	DcmTag{0x3006, 0x0012}: "1",
+	DcmTag{0x3006, 0x0014}: "1",
+	DcmTag{0x3006, 0x0016}: "1",
+	DcmTag{0x3006, 0x0020}: "1",
+	DcmTag{0x3006, 0x0022}: "1",
+	DcmTag{0x3006, 0x0024}: "1",
+	DcmTag{0x3006, 0x0026}: "1",
+	DcmTag{0x3006, 0x0028}: "1",
+	DcmTag{0x3006, 0x002a}: "3",
+	DcmTag{0x3006, 0x002c}: "1",
+	DcmTag{0x3006, 0x0030}: "1",
+	DcmTag{0x3006, 0x0033}: "1",
+	DcmTag{0x3006, 0x0036}: "1",
+	DcmTag{0x3006, 0x0038}: "1",
+	DcmTag{0x3006, 0x0039}: "1",
+	DcmTag{0x3006, 0x0040}: "1",
+	DcmTag{0x3006, 0x0042}: "1",
+	DcmTag{0x3006, 0x0044}: "1",
+	DcmTag{0x3006, 0x0045}: "3",
+	DcmTag{0x3006, 0x0046}: "1",
+	DcmTag{0x3006, 0x0048}: "1",
+	DcmTag{0x3006, 0x0049}: "1-n",
+	DcmTag{0x3006, 0x0050}: "3-3n",
+	DcmTag{0x3006, 0x0080}: "1",
+	DcmTag{0x3006, 0x0082}: "1",
+	DcmTag{0x3006, 0x0084}: "1",
+	DcmTag{0x3006, 0x0085}: "1",
+	DcmTag{0x3006, 0x0086}: "1",
+	DcmTag{0x3006, 0x0088}: "1",
+	DcmTag{0x3006, 0x00a0}: "1",
+	DcmTag{0x3006, 0x00a4}: "1",
+	DcmTag{0x3006, 0x00a6}: "1",
+	DcmTag{0x3006, 0x00b0}: "1",
+	DcmTag{0x3006, 0x00b2}: "1",
+	DcmTag{0x3006, 0x00b4}: "1",
+	DcmTag{0x3006, 0x00b6}: "1",
+	DcmTag{0x3006, 0x00b7}: "1",
+	DcmTag{0x3006, 0x00b8}: "1",
+	DcmTag{0x3006, 0x00c0}: "1",
+	DcmTag{0x3006, 0x00c2}: "1",
+	DcmTag{0x3006, 0x00c4}: "1",
+	DcmTag{0x3006, 0x00c6}: "16",
+	DcmTag{0x3006, 0x00c8}: "1",
+	DcmTag{0x3008, 0x0010}: "1",
+	DcmTag{0x3008, 0x0012}: "1",
+	DcmTag{0x3008, 0x0014}: "1",
+	DcmTag{0x3008, 0x0016}: "1",
+	DcmTag{0x3008, 0x0020}: "1",
+	DcmTag{0x3008, 0x0021}: "1",
+	DcmTag{0x3008, 0x0022}: "1",
+	DcmTag{0x3008, 0x0024}: "1",
+	DcmTag{0x3008, 0x0025}: "1",
+	DcmTag{0x3008, 0x002a}: "1",
+	DcmTag{0x3008, 0x002b}: "1",
+	DcmTag{0x3008, 0x002c}: "1",
+	DcmTag{0x3008, 0x0030}: "1",
+	DcmTag{0x3008, 0x0032}: "1",
+	DcmTag{0x3008, 0x0033}: "1",
+	DcmTag{0x3008, 0x0036}: "1",
+	DcmTag{0x3008, 0x0037}: "1",
+	DcmTag{0x3008, 0x003a}: "1",
+	DcmTag{0x3008, 0x003b}: "1",
+	DcmTag{0x3008, 0x0040}: "1",
+	DcmTag{0x3008, 0x0041}: "1",
+	DcmTag{0x3008, 0x0042}: "1",
+	DcmTag{0x3008, 0x0044}: "1",
+	DcmTag{0x3008, 0x0045}: "1",
+	DcmTag{0x3008, 0x0046}: "1",
+	DcmTag{0x3008, 0x0047}: "1-n",
+	DcmTag{0x3008, 0x0048}: "1",
+	DcmTag{0x3008, 0x0050}: "1",
+	DcmTag{0x3008, 0x0052}: "1",
+	DcmTag{0x3008, 0x0054}: "1",
+	DcmTag{0x3008, 0x0056}: "1",
+	DcmTag{0x3008, 0x005a}: "1",
+	DcmTag{0x3008, 0x0060}: "1",
+	DcmTag{0x3008, 0x0061}: "1",
+	DcmTag{0x3008, 0x0062}: "1",
+	DcmTag{0x3008, 0x0063}: "1",
+	DcmTag{0x3008, 0x0064}: "1",
+	DcmTag{0x3008, 0x0065}: "1",
+	DcmTag{0x3008, 0x0066}: "1",
+	DcmTag{0x3008, 0x0068}: "1",
+	DcmTag{0x3008, 0x006a}: "1",
+	DcmTag{0x3008, 0x0070}: "1",
+	DcmTag{0x3008, 0x0072}: "1",
+	DcmTag{0x3008, 0x0074}: "1",
+	DcmTag{0x3008, 0x0076}: "1",
+	DcmTag{0x3008, 0x0078}: "1",
+	DcmTag{0x3008, 0x007a}: "1",
+	DcmTag{0x3008, 0x0080}: "1",
+	DcmTag{0x3008, 0x0082}: "1",
+	DcmTag{0x3008, 0x0090}: "1",
+	DcmTag{0x3008, 0x0092}: "1",
+	DcmTag{0x3008, 0x00a0}: "1",
+	DcmTag{0x3008, 0x00b0}: "1",
+	DcmTag{0x3008, 0x00c0}: "1",
+	DcmTag{0x3008, 0x00d0}: "1",
+	DcmTag{0x3008, 0x00e0}: "1",
+	DcmTag{0x3008, 0x00f0}: "1",
+	DcmTag{0x3008, 0x00f2}: "1",
+	DcmTag{0x3008, 0x00f4}: "1",
+	DcmTag{0x3008, 0x00f6}: "1",
+	DcmTag{0x3008, 0x0100}: "1",
+	DcmTag{0x3008, 0x0105}: "1",
+	DcmTag{0x3008, 0x0110}: "1",
+	DcmTag{0x3008, 0x0116}: "1",
+	DcmTag{0x3008, 0x0120}: "1",
+	DcmTag{0x3008, 0x0122}: "1",
+	DcmTag{0x3008, 0x0130}: "1",
+	DcmTag{0x3008, 0x0132}: "1",
+	DcmTag{0x3008, 0x0134}: "1",
+	DcmTag{0x3008, 0x0136}: "1",
+	DcmTag{0x3008, 0x0138}: "1",
+	DcmTag{0x3008, 0x013a}: "1",
+	DcmTag{0x3008, 0x013c}: "1",
+	DcmTag{0x3008, 0x0140}: "1",
+	DcmTag{0x3008, 0x0142}: "1",
+	DcmTag{0x3008, 0x0150}: "1",
+	DcmTag{0x3008, 0x0152}: "1",
+	DcmTag{0x3008, 0x0160}: "1",
+	DcmTag{0x3008, 0x0162}: "1",
+	DcmTag{0x3008, 0x0164}: "1",
+	DcmTag{0x3008, 0x0166}: "1",
+	DcmTag{0x3008, 0x0168}: "1",
+	DcmTag{0x3008, 0x0200}: "1",
+	DcmTag{0x3008, 0x0202}: "1",
+	DcmTag{0x3008, 0x0220}: "1",
+	DcmTag{0x3008, 0x0223}: "1",
+	DcmTag{0x3008, 0x0224}: "1",
+	DcmTag{0x3008, 0x0230}: "1",
+	DcmTag{0x3008, 0x0240}: "1",
+	DcmTag{0x3008, 0x0250}: "1",
+	DcmTag{0x3008, 0x0251}: "1",
+	DcmTag{0x300a, 0x0002}: "1",
+	DcmTag{0x300a, 0x0003}: "1",
+	DcmTag{0x300a, 0x0004}: "1",
+	DcmTag{0x300a, 0x0006}: "1",
+	DcmTag{0x300a, 0x0007}: "1",
+	DcmTag{0x300a, 0x0009}: "1-n",
+	DcmTag{0x300a, 0x000a}: "1",
+	DcmTag{0x300a, 0x000b}: "1-n",
+	DcmTag{0x300a, 0x000c}: "1",
+	DcmTag{0x300a, 0x000e}: "1",
+	DcmTag{0x300a, 0x0010}: "1",
+	DcmTag{0x300a, 0x0012}: "1",
+	DcmTag{0x300a, 0x0013}: "1",
+	DcmTag{0x300a, 0x0014}: "1",
+	DcmTag{0x300a, 0x0015}: "1",
+	DcmTag{0x300a, 0x0016}: "1",
+	DcmTag{0x300a, 0x0018}: "3",
+	DcmTag{0x300a, 0x001a}: "1",
+	DcmTag{0x300a, 0x0020}: "1",
+	DcmTag{0x300a, 0x0021}: "1",
+	DcmTag{0x300a, 0x0022}: "1",
+	DcmTag{0x300a, 0x0023}: "1",
+	DcmTag{0x300a, 0x0025}: "1",
+	DcmTag{0x300a, 0x0026}: "1",
+	DcmTag{0x300a, 0x0027}: "1",
+	DcmTag{0x300a, 0x0028}: "1",
+	DcmTag{0x300a, 0x002a}: "1",
+	DcmTag{0x300a, 0x002b}: "1",
+	DcmTag{0x300a, 0x002c}: "1",
+	DcmTag{0x300a, 0x002d}: "1",
+	DcmTag{0x300a, 0x0040}: "1",
+	DcmTag{0x300a, 0x0042}: "1",
+	DcmTag{0x300a, 0x0043}: "1",
+	DcmTag{0x300a, 0x0044}: "1",
+	DcmTag{0x300a, 0x0046}: "1",
+	DcmTag{0x300a, 0x0048}: "1",
+	DcmTag{0x300a, 0x004a}: "1",
+	DcmTag{0x300a, 0x004b}: "1",
+	DcmTag{0x300a, 0x004c}: "1",
+	DcmTag{0x300a, 0x004e}: "1",
+	DcmTag{0x300a, 0x004f}: "1",
+	DcmTag{0x300a, 0x0050}: "1",
+	DcmTag{0x300a, 0x0051}: "1",
+	DcmTag{0x300a, 0x0052}: "1",
+	DcmTag{0x300a, 0x0053}: "1",
+	DcmTag{0x300a, 0x0055}: "1",
+	DcmTag{0x300a, 0x0070}: "1",
+	DcmTag{0x300a, 0x0071}: "1",
+	DcmTag{0x300a, 0x0072}: "1",
+	DcmTag{0x300a, 0x0078}: "1",
+	DcmTag{0x300a, 0x0079}: "1",
+	DcmTag{0x300a, 0x007a}: "1",
+	DcmTag{0x300a, 0x007b}: "1",
+	DcmTag{0x300a, 0x0080}: "1",
+	DcmTag{0x300a, 0x0082}: "3",
+	DcmTag{0x300a, 0x0084}: "1",
+	DcmTag{0x300a, 0x0086}: "1",
+	DcmTag{0x300a, 0x0088}: "1",
+	DcmTag{0x300a, 0x0089}: "1",
+	DcmTag{0x300a, 0x008a}: "1",
+	DcmTag{0x300a, 0x00a0}: "1",
+	DcmTag{0x300a, 0x00a2}: "3",
+	DcmTag{0x300a, 0x00a4}: "1",
+	DcmTag{0x300a, 0x00b0}: "1",
+	DcmTag{0x300a, 0x00b2}: "1",
+	DcmTag{0x300a, 0x00b3}: "1",
+	DcmTag{0x300a, 0x00b4}: "1",
+	DcmTag{0x300a, 0x00b6}: "1",
+	DcmTag{0x300a, 0x00b8}: "1",
+	DcmTag{0x300a, 0x00ba}: "1",
+	DcmTag{0x300a, 0x00bb}: "1",
+	DcmTag{0x300a, 0x00bc}: "1",
+	DcmTag{0x300a, 0x00be}: "3-n",
+	DcmTag{0x300a, 0x00c0}: "1",
+	DcmTag{0x300a, 0x00c2}: "1",
+	DcmTag{0x300a, 0x00c3}: "1",
+	DcmTag{0x300a, 0x00c4}: "1",
+	DcmTag{0x300a, 0x00c6}: "1",
+	DcmTag{0x300a, 0x00c7}: "1",
+	DcmTag{0x300a, 0x00c8}: "1",
+	DcmTag{0x300a, 0x00ca}: "1",
+	DcmTag{0x300a, 0x00cc}: "1-n",
+	DcmTag{0x300a, 0x00ce}: "1",
+	DcmTag{0x300a, 0x00d0}: "1",
+	DcmTag{0x300a, 0x00d1}: "1",
+	DcmTag{0x300a, 0x00d2}: "1",
+	DcmTag{0x300a, 0x00d3}: "1",
+	DcmTag{0x300a, 0x00d4}: "1",
+	DcmTag{0x300a, 0x00d5}: "1",
+	DcmTag{0x300a, 0x00d6}: "1",
+	DcmTag{0x300a, 0x00d7}: "1",
+	DcmTag{0x300a, 0x00d8}: "1",
+	DcmTag{0x300a, 0x00d9}: "1",
+	DcmTag{0x300a, 0x00da}: "1",
+	DcmTag{0x300a, 0x00db}: "1",
+	DcmTag{0x300a, 0x00dc}: "1",
+	DcmTag{0x300a, 0x00dd}: "1",
+	DcmTag{0x300a, 0x00e0}: "1",
+	DcmTag{0x300a, 0x00e1}: "1",
+	DcmTag{0x300a, 0x00e2}: "1",
+	DcmTag{0x300a, 0x00e3}: "1",
+	DcmTag{0x300a, 0x00e4}: "1",
+	DcmTag{0x300a, 0x00e5}: "1",
+	DcmTag{0x300a, 0x00e6}: "1",
+	DcmTag{0x300a, 0x00e7}: "1",
+	DcmTag{0x300a, 0x00e8}: "1",
+	DcmTag{0x300a, 0x00e9}: "2",
+	DcmTag{0x300a, 0x00ea}: "2",
+	DcmTag{0x300a, 0x00eb}: "1-n",
+	DcmTag{0x300a, 0x00ec}: "1-n",
+	DcmTag{0x300a, 0x00ed}: "1",
+	DcmTag{0x300a, 0x00ee}: "1",
+	DcmTag{0x300a, 0x00f0}: "1",
+	DcmTag{0x300a, 0x00f2}: "1",
+	DcmTag{0x300a, 0x00f3}: "1",
+	DcmTag{0x300a, 0x00f4}: "1",
+	DcmTag{0x300a, 0x00f5}: "1",
+	DcmTag{0x300a, 0x00f6}: "1",
+	DcmTag{0x300a, 0x00f7}: "1",
+	DcmTag{0x300a, 0x00f8}: "1",
+	DcmTag{0x300a, 0x00f9}: "1",
+	DcmTag{0x300a, 0x00fa}: "1",
+	DcmTag{0x300a, 0x00fb}: "1",
+	DcmTag{0x300a, 0x00fc}: "1",
+	DcmTag{0x300a, 0x00fe}: "1",
+	DcmTag{0x300a, 0x0100}: "1",
+	DcmTag{0x300a, 0x0102}: "1",
+	DcmTag{0x300a, 0x0104}: "1",
+	DcmTag{0x300a, 0x0106}: "2-2n",
+	DcmTag{0x300a, 0x0107}: "1",
+	DcmTag{0x300a, 0x0108}: "1",
+	DcmTag{0x300a, 0x0109}: "1",
+	DcmTag{0x300a, 0x010a}: "1",
+	DcmTag{0x300a, 0x010c}: "1",
+	DcmTag{0x300a, 0x010e}: "1",
+	DcmTag{0x300a, 0x0110}: "1",
+	DcmTag{0x300a, 0x0111}: "1",
+	DcmTag{0x300a, 0x0112}: "1",
+	DcmTag{0x300a, 0x0114}: "1",
+	DcmTag{0x300a, 0x0115}: "1",
+	DcmTag{0x300a, 0x0116}: "1",
+	DcmTag{0x300a, 0x0118}: "1",
+	DcmTag{0x300a, 0x011a}: "1",
+	DcmTag{0x300a, 0x011c}: "2-2n",
+	DcmTag{0x300a, 0x011e}: "1",
+	DcmTag{0x300a, 0x011f}: "1",
+	DcmTag{0x300a, 0x0120}: "1",
+	DcmTag{0x300a, 0x0121}: "1",
+	DcmTag{0x300a, 0x0122}: "1",
+	DcmTag{0x300a, 0x0123}: "1",
+	DcmTag{0x300a, 0x0124}: "1",
+	DcmTag{0x300a, 0x0125}: "1",
+	DcmTag{0x300a, 0x0126}: "1",
+	DcmTag{0x300a, 0x0128}: "1",
+	DcmTag{0x300a, 0x0129}: "1",
+	DcmTag{0x300a, 0x012a}: "1",
+	DcmTag{0x300a, 0x012c}: "3",
+	DcmTag{0x300a, 0x012e}: "3",
+	DcmTag{0x300a, 0x0130}: "1",
+	DcmTag{0x300a, 0x0134}: "1",
+	DcmTag{0x300a, 0x0140}: "1",
+	DcmTag{0x300a, 0x0142}: "1",
+	DcmTag{0x300a, 0x0144}: "1",
+	DcmTag{0x300a, 0x0146}: "1",
+	DcmTag{0x300a, 0x0148}: "1",
+	DcmTag{0x300a, 0x014a}: "1",
+	DcmTag{0x300a, 0x014c}: "1",
+	DcmTag{0x300a, 0x014e}: "1",
+	DcmTag{0x300a, 0x0180}: "1",
+	DcmTag{0x300a, 0x0182}: "1",
+	DcmTag{0x300a, 0x0183}: "1",
+	DcmTag{0x300a, 0x0184}: "1",
+	DcmTag{0x300a, 0x0190}: "1",
+	DcmTag{0x300a, 0x0192}: "1",
+	DcmTag{0x300a, 0x0194}: "1",
+	DcmTag{0x300a, 0x0196}: "1",
+	DcmTag{0x300a, 0x0198}: "1",
+	DcmTag{0x300a, 0x0199}: "1",
+	DcmTag{0x300a, 0x019a}: "1",
+	DcmTag{0x300a, 0x01a0}: "1",
+	DcmTag{0x300a, 0x01a2}: "1",
+	DcmTag{0x300a, 0x01a4}: "1",
+	DcmTag{0x300a, 0x01a6}: "1",
+	DcmTag{0x300a, 0x01a8}: "1",
+	DcmTag{0x300a, 0x01b0}: "1",
+	DcmTag{0x300a, 0x01b2}: "1",
+	DcmTag{0x300a, 0x01b4}: "1",
+	DcmTag{0x300a, 0x01b6}: "1",
+	DcmTag{0x300a, 0x01b8}: "1",
+	DcmTag{0x300a, 0x01ba}: "1",
+	DcmTag{0x300a, 0x01bc}: "1",
+	DcmTag{0x300a, 0x01d0}: "1",
+	DcmTag{0x300a, 0x01d2}: "1",
+	DcmTag{0x300a, 0x01d4}: "1",
+	DcmTag{0x300a, 0x01d6}: "1",
+	DcmTag{0x300a, 0x0200}: "1",
+	DcmTag{0x300a, 0x0202}: "1",
+	DcmTag{0x300a, 0x0206}: "1",
+	DcmTag{0x300a, 0x0210}: "1",
+	DcmTag{0x300a, 0x0212}: "1",
+	DcmTag{0x300a, 0x0214}: "1",
+	DcmTag{0x300a, 0x0216}: "1",
+	DcmTag{0x300a, 0x0218}: "1",
+	DcmTag{0x300a, 0x021a}: "1",
+	DcmTag{0x300a, 0x0222}: "1",
+	DcmTag{0x300a, 0x0224}: "1",
+	DcmTag{0x300a, 0x0226}: "1",
+	DcmTag{0x300a, 0x0228}: "1",
+	DcmTag{0x300a, 0x0229}: "1",
+	DcmTag{0x300a, 0x022a}: "1",
+	DcmTag{0x300a, 0x022b}: "1",
+	DcmTag{0x300a, 0x022c}: "1",
+	DcmTag{0x300a, 0x022e}: "1",
+	DcmTag{0x300a, 0x0230}: "1",
+	DcmTag{0x300a, 0x0232}: "1",
+	DcmTag{0x300a, 0x0234}: "1",
+	DcmTag{0x300a, 0x0236}: "1",
+	DcmTag{0x300a, 0x0238}: "1",
+	DcmTag{0x300a, 0x0240}: "1",
+	DcmTag{0x300a, 0x0242}: "1",
+	DcmTag{0x300a, 0x0244}: "1",
+	DcmTag{0x300a, 0x0250}: "1",
+	DcmTag{0x300a, 0x0260}: "1",
+	DcmTag{0x300a, 0x0262}: "1",
+	DcmTag{0x300a, 0x0263}: "1",
+	DcmTag{0x300a, 0x0264}: "1",
+	DcmTag{0x300a, 0x0266}: "1",
+	DcmTag{0x300a, 0x026a}: "1",
+	DcmTag{0x300a, 0x026c}: "1",
+	DcmTag{0x300a, 0x0280}: "1",
+	DcmTag{0x300a, 0x0282}: "1",
+	DcmTag{0x300a, 0x0284}: "1",
+	DcmTag{0x300a, 0x0286}: "1",
+	DcmTag{0x300a, 0x0288}: "1",
+	DcmTag{0x300a, 0x028a}: "1",
+	DcmTag{0x300a, 0x028c}: "1",
+	DcmTag{0x300a, 0x0290}: "1",
+	DcmTag{0x300a, 0x0291}: "1",
+	DcmTag{0x300a, 0x0292}: "1",
+	DcmTag{0x300a, 0x0294}: "1",
+	DcmTag{0x300a, 0x0296}: "1",
+	DcmTag{0x300a, 0x0298}: "1",
+	DcmTag{0x300a, 0x029c}: "1",
+	DcmTag{0x300a, 0x029e}: "1",
+	DcmTag{0x300a, 0x02a0}: "1",
+	DcmTag{0x300a, 0x02a2}: "1",
+	DcmTag{0x300a, 0x02a4}: "1",
+	DcmTag{0x300a, 0x02b0}: "1",
+	DcmTag{0x300a, 0x02b2}: "1",
+	DcmTag{0x300a, 0x02b3}: "1",
+	DcmTag{0x300a, 0x02b4}: "1",
+	DcmTag{0x300a, 0x02b8}: "1",
+	DcmTag{0x300a, 0x02ba}: "1",
+	DcmTag{0x300a, 0x02c8}: "1",
+	DcmTag{0x300a, 0x02d0}: "1",
+	DcmTag{0x300a, 0x02d2}: "1",
+	DcmTag{0x300a, 0x02d4}: "3",
+	DcmTag{0x300a, 0x02d6}: "1",
+	DcmTag{0x300a, 0x02e0}: "1",
+	DcmTag{0x300a, 0x02e1}: "1",
+	DcmTag{0x300a, 0x02e2}: "1-n",
+	DcmTag{0x300a, 0x02e3}: "1",
+	DcmTag{0x300a, 0x02e4}: "1",
+	DcmTag{0x300a, 0x02e5}: "1",
+	DcmTag{0x300a, 0x02e6}: "1-n",
+	DcmTag{0x300a, 0x02e7}: "1",
+	DcmTag{0x300a, 0x02e8}: "1",
+	DcmTag{0x300a, 0x02ea}: "1",
+	DcmTag{0x300a, 0x02eb}: "1",
+	DcmTag{0x300a, 0x0302}: "1",
+	DcmTag{0x300a, 0x0304}: "1",
+	DcmTag{0x300a, 0x0306}: "1",
+	DcmTag{0x300a, 0x0308}: "1",
+	DcmTag{0x300a, 0x030a}: "2",
+	DcmTag{0x300a, 0x030c}: "1",
+	DcmTag{0x300a, 0x030d}: "1",
+	DcmTag{0x300a, 0x030f}: "1",
+	DcmTag{0x300a, 0x0312}: "1",
+	DcmTag{0x300a, 0x0314}: "1",
+	DcmTag{0x300a, 0x0316}: "1",
+	DcmTag{0x300a, 0x0318}: "1",
+	DcmTag{0x300a, 0x0320}: "1",
+	DcmTag{0x300a, 0x0322}: "1",
+	DcmTag{0x300a, 0x0330}: "1",
+	DcmTag{0x300a, 0x0332}: "1",
+	DcmTag{0x300a, 0x0334}: "1",
+	DcmTag{0x300a, 0x0336}: "1",
+	DcmTag{0x300a, 0x0338}: "1",
+	DcmTag{0x300a, 0x033a}: "1",
+	DcmTag{0x300a, 0x033c}: "1",
+	DcmTag{0x300a, 0x0340}: "1",
+	DcmTag{0x300a, 0x0342}: "1",
+	DcmTag{0x300a, 0x0344}: "1",
+	DcmTag{0x300a, 0x0346}: "1",
+	DcmTag{0x300a, 0x0348}: "1",
+	DcmTag{0x300a, 0x034a}: "1",
+	DcmTag{0x300a, 0x034c}: "1",
+	DcmTag{0x300a, 0x0350}: "1",
+	DcmTag{0x300a, 0x0352}: "1",
+	DcmTag{0x300a, 0x0354}: "1",
+	DcmTag{0x300a, 0x0356}: "1",
+	DcmTag{0x300a, 0x0358}: "1",
+	DcmTag{0x300a, 0x035a}: "1",
+	DcmTag{0x300a, 0x0360}: "1",
+	DcmTag{0x300a, 0x0362}: "1",
+	DcmTag{0x300a, 0x0364}: "1",
+	DcmTag{0x300a, 0x0366}: "1",
+	DcmTag{0x300a, 0x0370}: "1",
+	DcmTag{0x300a, 0x0372}: "1",
+	DcmTag{0x300a, 0x0374}: "1",
+	DcmTag{0x300a, 0x0380}: "1",
+	DcmTag{0x300a, 0x0382}: "1",
+	DcmTag{0x300a, 0x0384}: "1",
+	DcmTag{0x300a, 0x0386}: "1",
+	DcmTag{0x300a, 0x0388}: "1",
+	DcmTag{0x300a, 0x038a}: "1",
+	DcmTag{0x300a, 0x0390}: "1",
+	DcmTag{0x300a, 0x0392}: "1",
+	DcmTag{0x300a, 0x0394}: "1-n",
+	DcmTag{0x300a, 0x0396}: "1-n",
+	DcmTag{0x300a, 0x0398}: "2",
+	DcmTag{0x300a, 0x039a}: "1",
+	DcmTag{0x300a, 0x03a0}: "1",
+	DcmTag{0x300a, 0x03a2}: "1",
+	DcmTag{0x300a, 0x03a4}: "1",
+	DcmTag{0x300a, 0x03a6}: "1",
+	DcmTag{0x300a, 0x03a8}: "1",
+	DcmTag{0x300a, 0x03aa}: "1",
+	DcmTag{0x300a, 0x03ac}: "1",
+	DcmTag{0x300a, 0x0401}: "1",
+	DcmTag{0x300a, 0x0402}: "1",
+	DcmTag{0x300a, 0x0410}: "1",
+	DcmTag{0x300a, 0x0412}: "3",
+	DcmTag{0x300a, 0x0420}: "1",
+	DcmTag{0x300a, 0x0421}: "1",
+	DcmTag{0x300a, 0x0422}: "1",
+	DcmTag{0x300a, 0x0423}: "1",
+	DcmTag{0x300a, 0x0424}: "1",
+	DcmTag{0x300a, 0x0431}: "1",
+	DcmTag{0x300a, 0x0432}: "1",
+	DcmTag{0x300a, 0x0433}: "1",
+	DcmTag{0x300a, 0x0434}: "1",
+	DcmTag{0x300a, 0x0435}: "1",
+	DcmTag{0x300a, 0x0436}: "1",
+	DcmTag{0x300c, 0x0002}: "1",
+	DcmTag{0x300c, 0x0004}: "1",
+	DcmTag{0x300c, 0x0006}: "1",
+	DcmTag{0x300c, 0x0007}: "1",
+	DcmTag{0x300c, 0x0008}: "1",
+	DcmTag{0x300c, 0x0009}: "1",
+	DcmTag{0x300c, 0x000a}: "1",
+	DcmTag{0x300c, 0x000c}: "1",
+	DcmTag{0x300c, 0x000e}: "1",
+	DcmTag{0x300c, 0x0020}: "1",
+	DcmTag{0x300c, 0x0022}: "1",
+	DcmTag{0x300c, 0x0040}: "1",
+	DcmTag{0x300c, 0x0042}: "1",
+	DcmTag{0x300c, 0x0050}: "1",
+	DcmTag{0x300c, 0x0051}: "1",
+	DcmTag{0x300c, 0x0055}: "1",
+	DcmTag{0x300c, 0x0060}: "1",
+	DcmTag{0x300c, 0x006a}: "1",
+	DcmTag{0x300c, 0x0080}: "1",
+	DcmTag{0x300c, 0x00a0}: "1",
+	DcmTag{0x300c, 0x00b0}: "1",
+	DcmTag{0x300c, 0x00c0}: "1",
+	DcmTag{0x300c, 0x00d0}: "1",
+	DcmTag{0x300c, 0x00e0}: "1",
+	DcmTag{0x300c, 0x00f0}: "1",
+	DcmTag{0x300c, 0x00f2}: "1",
+	DcmTag{0x300c, 0x00f4}: "1",
+	DcmTag{0x300c, 0x00f6}: "1",
+	DcmTag{0x300c, 0x0100}: "1",
+	DcmTag{0x300c, 0x0102}: "1",
+	DcmTag{0x300c, 0x0104}: "1",
+	DcmTag{0x300e, 0x0002}: "1",
+	DcmTag{0x300e, 0x0004}: "1",
+	DcmTag{0x300e, 0x0005}: "1",
+	DcmTag{0x300e, 0x0008}: "1",
+	DcmTag{0x4000, 0x0000}: "1",
+	DcmTag{0x4000, 0x0010}: "1-n",
+	DcmTag{0x4000, 0x4000}: "1-n",
+	DcmTag{0x4008, 0x0040}: "1",
+	DcmTag{0x4008, 0x0042}: "1",
+	DcmTag{0x4008, 0x0050}: "1",
+	DcmTag{0x4008, 0x0100}: "1",
+	DcmTag{0x4008, 0x0101}: "1",
+	DcmTag{0x4008, 0x0102}: "1",
+	DcmTag{0x4008, 0x0103}: "1",
+	DcmTag{0x4008, 0x0108}: "1",
+	DcmTag{0x4008, 0x0109}: "1",
+	DcmTag{0x4008, 0x010a}: "1",
+	DcmTag{0x4008, 0x010b}: "1",
+	DcmTag{0x4008, 0x010c}: "1",
+	DcmTag{0x4008, 0x0111}: "1",
+	DcmTag{0x4008, 0x0112}: "1",
+	DcmTag{0x4008, 0x0113}: "1",
+	DcmTag{0x4008, 0x0114}: "1",
+	DcmTag{0x4008, 0x0115}: "1",
+	DcmTag{0x4008, 0x0117}: "1",
+	DcmTag{0x4008, 0x0118}: "1",
+	DcmTag{0x4008, 0x0119}: "1",
+	DcmTag{0x4008, 0x011a}: "1",
+	DcmTag{0x4008, 0x0200}: "1",
+	DcmTag{0x4008, 0x0202}: "1",
+	DcmTag{0x4008, 0x0210}: "1",
+	DcmTag{0x4008, 0x0212}: "1",
+	DcmTag{0x4008, 0x0300}: "1",
+	DcmTag{0x4008, 0x4000}: "1",
+	DcmTag{0x4ffe, 0x0001}: "1",
+	DcmTag{0x5000, 0x0005}: "1",
+	DcmTag{0x5000, 0x0010}: "1",
+	DcmTag{0x5000, 0x0020}: "1",
+	DcmTag{0x5000, 0x0022}: "1",
+	DcmTag{0x5000, 0x0030}: "1-n",
+	DcmTag{0x5000, 0x0040}: "1-n",
+	DcmTag{0x5000, 0x0103}: "1",
+	DcmTag{0x5000, 0x0104}: "1-n",
+	DcmTag{0x5000, 0x0105}: "1-n",
+	DcmTag{0x5000, 0x0106}: "1-n",
+	DcmTag{0x5000, 0x0110}: "1-n",
+	DcmTag{0x5000, 0x0112}: "1-n",
+	DcmTag{0x5000, 0x0114}: "1-n",
+	DcmTag{0x5000, 0x1001}: "1",
+	DcmTag{0x5000, 0x2000}: "1",
+	DcmTag{0x5000, 0x2002}: "1",
+	DcmTag{0x5000, 0x2004}: "1",
+	DcmTag{0x5000, 0x2006}: "1",
+	DcmTag{0x5000, 0x2008}: "1",
+	DcmTag{0x5000, 0x200a}: "1",
+	DcmTag{0x5000, 0x200c}: "1",
+	DcmTag{0x5000, 0x200e}: "1",
+	DcmTag{0x5000, 0x2500}: "1",
+	DcmTag{0x5000, 0x2600}: "1",
+	DcmTag{0x5000, 0x2610}: "1",
+	DcmTag{0x5000, 0x3000}: "1",
+	DcmTag{0x5200, 0x9229}: "1",
+	DcmTag{0x5200, 0x9230}: "1",
+	DcmTag{0x5400, 0x0100}: "1",
+	DcmTag{0x5400, 0x0110}: "1",
+	DcmTag{0x5400, 0x0112}: "1",
+	DcmTag{0x5400, 0x1004}: "1",
+	DcmTag{0x5400, 0x1006}: "1",
+	DcmTag{0x5400, 0x100a}: "1",
+	DcmTag{0x5400, 0x1010}: "1",
+	DcmTag{0x5600, 0x0010}: "1",
+	DcmTag{0x5600, 0x0020}: "1",
+	DcmTag{0x6000, 0x0010}: "1",
+	DcmTag{0x6000, 0x0011}: "1",
+	DcmTag{0x6000, 0x0012}: "1",
+	DcmTag{0x6000, 0x0015}: "1",
+	DcmTag{0x6000, 0x0022}: "1",
+	DcmTag{0x6000, 0x0040}: "1",
+	DcmTag{0x6000, 0x0045}: "1",
+	DcmTag{0x6000, 0x0050}: "2",
+	DcmTag{0x6000, 0x0051}: "1",
+	DcmTag{0x6000, 0x0052}: "1",
+	DcmTag{0x6000, 0x0060}: "1",
+	DcmTag{0x6000, 0x0061}: "1",
+	DcmTag{0x6000, 0x0062}: "1",
+	DcmTag{0x6000, 0x0063}: "1",
+	DcmTag{0x6000, 0x0066}: "1-n",
+	DcmTag{0x6000, 0x0068}: "1",
+	DcmTag{0x6000, 0x0069}: "1",
+	DcmTag{0x6000, 0x0100}: "1",
+	DcmTag{0x6000, 0x0102}: "1",
+	DcmTag{0x6000, 0x0110}: "1",
+	DcmTag{0x6000, 0x0200}: "1",
+	DcmTag{0x6000, 0x0800}: "1-n",
+	DcmTag{0x6000, 0x0802}: "1",
+	DcmTag{0x6000, 0x0803}: "1-n",
+	DcmTag{0x6000, 0x0804}: "1",
+	DcmTag{0x6000, 0x1001}: "1",
+	DcmTag{0x6000, 0x1100}: "1",
+	DcmTag{0x6000, 0x1101}: "1",
+	DcmTag{0x6000, 0x1102}: "1",
+	DcmTag{0x6000, 0x1103}: "1",
+	DcmTag{0x6000, 0x1200}: "1-n",
+	DcmTag{0x6000, 0x1201}: "1-n",
+	DcmTag{0x6000, 0x1202}: "1-n",
+	DcmTag{0x6000, 0x1203}: "1-n",
+	DcmTag{0x6000, 0x1301}: "1",
+	DcmTag{0x6000, 0x1302}: "1",
+	DcmTag{0x6000, 0x1303}: "1",
+	DcmTag{0x6000, 0x1500}: "1",
+	DcmTag{0x6000, 0x3000}: "1",
+	DcmTag{0x6000, 0x4000}: "1-n",
+	DcmTag{0x7f00, 0x0000}: "1",
+	DcmTag{0x7f00, 0x0010}: "1",
+	DcmTag{0x7f00, 0x0011}: "1",
+	DcmTag{0x7f00, 0x0020}: "1-n",
+	DcmTag{0x7f00, 0x0030}: "1-n",
+	DcmTag{0x7f00, 0x0040}: "1-n",
+	DcmTag{0x7fe0, 0x0010}: "1",
+	DcmTag{0x7fe0, 0x0020}: "1-n",
+	DcmTag{0x7fe0, 0x0030}: "1-n",
+	DcmTag{0x7fe0, 0x0040}: "1-n",
+	DcmTag{0xfffa, 0xfffa}: "1",
+	DcmTag{0xfffc, 0xfffc}: "1",
+	DcmTag{0xfffe, 0xe000}: "1",
+	DcmTag{0xfffe, 0xe00d}: "1",
+	DcmTag{0xfffe, 0xe0dd}: "1",
+}
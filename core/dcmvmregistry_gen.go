@@ -0,0 +1,104 @@
+//go:build ignore
+
+// This program generates dcmvmregistry.go from the DCMTK data dictionary
+// dcmdata/dicom.dic. It is run by go generate in the core folder.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type tag struct {
+	group   uint16
+	element uint16
+}
+
+// parseLower parses the lower limit of a group or element, e.g. "6000" of "6000-60FF"
+// or "0009" of "0009-o-ffff".
+func parseLower(s string) (uint16, error) {
+	if len(s) < 4 {
+		return 0, fmt.Errorf("invalid group or element '%s'", s)
+	}
+	v, err := strconv.ParseUint(s[:4], 16, 16)
+	return uint16(v), err
+}
+
+func main() {
+	f, err := os.Open("../dcmdata/dicom.dic")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	// the ranges are stored by their lower limit, as the lower limit is in the range
+	vms := make(map[tag]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 4 || strings.Contains(fields[0], "\"") {
+			// the private tags are not in the registry
+			continue
+		}
+		parts := strings.Split(strings.Trim(fields[0], "()"), ",")
+		if len(parts) != 2 {
+			log.Fatalf("invalid tag '%s'", fields[0])
+		}
+		var t tag
+		t.group, err = parseLower(parts[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		t.element, err = parseLower(parts[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		// the later entries override the earlier ones, as in DCMTK
+		vms[t] = fields[3]
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	tags := make([]tag, 0, len(vms))
+	for t := range vms {
+		tags = append(tags, t)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].group != tags[j].group {
+			return tags[i].group < tags[j].group
+		}
+		return tags[i].element < tags[j].element
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by dcmvmregistry_gen.go from dcmdata/dicom.dic; DO NOT EDIT.\n\n")
+	buf.WriteString("package core\n\n")
+	buf.WriteString("// dcmVMRegistry contains the value multiplicity of the DICOM Data Elements. The VM of\n")
+	buf.WriteString("// the repeating groups or elements is stored by the lower limit of the range.\n")
+	buf.WriteString("var dcmVMRegistry = map[DcmTag]string{\n")
+	for _, t := range tags {
+		fmt.Fprintf(&buf, "\tDcmTag{0x%04x, 0x%04x}: %q,\n", t.group, t.element, vms[t])
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("dcmvmregistry.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
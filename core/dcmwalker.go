@@ -0,0 +1,377 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// EWalkAction is the action of DcmReader.Walk on the value of an element.
+type EWalkAction int
+
+const (
+	// WalkSkip skips the value of the element, or the whole sequence.
+	WalkSkip EWalkAction = iota
+	// WalkRead reads the value and passes it to DcmVisitor.VisitValue.
+	WalkRead
+	// WalkStream passes a reader of the value to DcmVisitor.StreamValue.
+	WalkStream
+	// WalkStop stops walking, and Walk returns without error.
+	WalkStop
+)
+
+// dcmWalkWindow is the number of bytes kept behind the position while walking a
+// stream that cannot seek.
+const dcmWalkWindow = 4 * 1024
+
+// errWalkStop unwinds the walker when the visitor returns WalkStop.
+var errWalkStop = errors.New("DcmReader: walk stopped")
+
+// DcmElementHeader is the header of an element found by DcmReader.Walk.
+type DcmElementHeader struct {
+	Tag DcmTag
+	VR  string
+
+	// Length is 0xFFFFFFFF if the length is undefined.
+	Length int64
+
+	// Offset is the position of the value in the stream. For a deflated data set, it is
+	// the position in the inflated data set.
+	Offset int64
+
+	// Depth is the number of the sequences containing the element, 0 for the elements
+	// of the top level data set.
+	Depth int
+
+	PrivateCreator string
+}
+
+// IsUndefinedLength is to check whether the length of the element is undefined.
+func (h DcmElementHeader) IsUndefinedLength() bool {
+	return h.Length == 0xFFFFFFFF
+}
+
+// DcmVisitor receives the elements of a data set from DcmReader.Walk, in the order of
+// the stream.
+type DcmVisitor interface {
+	// VisitElement is called with the header of each element, and of each fragment of
+	// encapsulated pixel data. For a sequence, any action other than WalkSkip and
+	// WalkStop enters the items of the sequence.
+	VisitElement(header DcmElementHeader) (EWalkAction, error)
+
+	// VisitValue receives the value of the element if VisitElement returns WalkRead.
+	VisitValue(header DcmElementHeader, value []byte) error
+
+	// StreamValue reads the value of the element if VisitElement returns WalkStream.
+	// The bytes which are not read are skipped.
+	StreamValue(header DcmElementHeader, r io.Reader) error
+
+	// EnterSequence and ExitSequence are called before and after the items of a SQ
+	// element, of encapsulated pixel data, or of an element with undefined length.
+	EnterSequence(header DcmElementHeader) error
+	ExitSequence(header DcmElementHeader) error
+
+	// EnterItem and ExitItem are called before and after the elements of each item.
+	EnterItem(header DcmElementHeader) error
+	ExitItem(header DcmElementHeader) error
+}
+
+// DcmBaseVisitor skips all the elements. It can be embedded by the visitors which
+// only implement some of the methods of DcmVisitor.
+type DcmBaseVisitor struct{}
+
+// VisitElement skips the element.
+func (DcmBaseVisitor) VisitElement(header DcmElementHeader) (EWalkAction, error) {
+	return WalkSkip, nil
+}
+
+// VisitValue does nothing.
+func (DcmBaseVisitor) VisitValue(header DcmElementHeader, value []byte) error {
+	return nil
+}
+
+// StreamValue does nothing.
+func (DcmBaseVisitor) StreamValue(header DcmElementHeader, r io.Reader) error {
+	return nil
+}
+
+// EnterSequence does nothing.
+func (DcmBaseVisitor) EnterSequence(header DcmElementHeader) error {
+	return nil
+}
+
+// ExitSequence does nothing.
+func (DcmBaseVisitor) ExitSequence(header DcmElementHeader) error {
+	return nil
+}
+
+// EnterItem does nothing.
+func (DcmBaseVisitor) EnterItem(header DcmElementHeader) error {
+	return nil
+}
+
+// ExitItem does nothing.
+func (DcmBaseVisitor) ExitItem(header DcmElementHeader) error {
+	return nil
+}
+
+// WalkFile is to walk the data set of the dicom file with the visitor. Unlike
+// ReadFile, the elements are not kept in memory. The meta information is read into Meta.
+func (reader *DcmReader) WalkFile(filename string, visitor DcmVisitor) error {
+	reader.fs.FileName = filename
+	err := reader.fs.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.fs.Close()
+	return reader.walk(&reader.fs, visitor)
+}
+
+// Walk is to walk the data set read from r with the visitor. If r is not an
+// io.ReadSeeker, only a small window of the data is kept in memory while reading.
+func (reader *DcmReader) Walk(r io.Reader, visitor DcmVisitor) error {
+	err := reader.fs.OpenReader(r)
+	if err != nil {
+		return err
+	}
+	return reader.walk(&reader.fs, visitor)
+}
+
+func (reader *DcmReader) walk(stream *DcmFileStream, visitor DcmVisitor) error {
+	stream, isExplicitVR, byteOrder, err := reader.openDataset(stream)
+	if err != nil {
+		return err
+	}
+	// the walker only reads forward, so the bytes behind are not kept for a pipe
+	// or a deflated data set
+	for _, s := range []*DcmFileStream{&reader.fs, stream} {
+		if buf, ok := s.handler.(*dcmStreamBuffer); ok {
+			buf.window = dcmWalkWindow
+		}
+	}
+	w := dcmWalker{stream: stream, visitor: visitor}
+	err = w.walkDataset(isExplicitVR, byteOrder, -1, 0)
+	if err == errWalkStop {
+		return nil
+	}
+	return err
+}
+
+// dcmWalker passes the elements read from the stream to the visitor.
+type dcmWalker struct {
+	stream  *DcmFileStream
+	visitor DcmVisitor
+}
+
+// walkDataset walks the elements until the position end, or until the end of the
+// stream or the item delimitation tag if end is negative.
+func (w *dcmWalker) walkDataset(isExplicitVR bool, byteOrder EByteOrder, end int64, depth int) error {
+	creators := make(dcmPrivateCreators)
+	for !w.stream.Eos() && (end < 0 || w.stream.Position < end) {
+		var elem DcmElement
+		elem.isExplicitVR = isExplicitVR
+		elem.byteOrder = byteOrder
+		err := elem.ReadDcmTag(w.stream)
+		if err != nil {
+			return err
+		}
+		if elem.Tag == DCMItemDelimitationItem {
+			// skip the item delimitation length
+			_, err = w.stream.Skip(4)
+			return err
+		}
+
+		elem.PrivateCreator = creators.find(elem.Tag)
+		if isExplicitVR {
+			err = elem.ReadDcmVR(w.stream)
+			if err != nil {
+				return err
+			}
+			err = elem.ReadValueLengthWithExplicitVR(w.stream)
+		} else {
+			// the VR is empty if the tag is not found in the dictionaries
+			FindDcmElmentByTag(&elem)
+			err = elem.ReadValueLengthWithImplicitVR(w.stream)
+		}
+		if err != nil {
+			return err
+		}
+
+		header := DcmElementHeader{
+			Tag:            elem.Tag,
+			VR:             elem.VR,
+			Length:         elem.Length,
+			Offset:         w.stream.Position,
+			Depth:          depth,
+			PrivateCreator: elem.PrivateCreator,
+		}
+		action, err := w.visitor.VisitElement(header)
+		if err != nil {
+			return err
+		}
+		if action == WalkStop {
+			return errWalkStop
+		}
+		if elem.VR == "SQ" || header.IsUndefinedLength() {
+			err = w.walkSequence(header, action, isExplicitVR, byteOrder)
+		} else {
+			err = w.walkValue(header, action, creators)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkSequence enters or skips the items of the element.
+func (w *dcmWalker) walkSequence(header DcmElementHeader, action EWalkAction, isExplicitVR bool, byteOrder EByteOrder) error {
+	if header.VR == "UN" {
+		// the items of UN with undefined length are always implicit VR little endian
+		isExplicitVR, byteOrder = false, EBOLittleEndian
+	}
+	if action == WalkSkip {
+		if !header.IsUndefinedLength() {
+			_, err := w.stream.Skip(header.Length)
+			return err
+		}
+		// the end of the sequence is only found by walking the items
+		skipper := dcmWalker{stream: w.stream, visitor: DcmBaseVisitor{}}
+		return skipper.walkItems(header, isExplicitVR, byteOrder)
+	}
+
+	err := w.visitor.EnterSequence(header)
+	if err != nil {
+		return err
+	}
+	err = w.walkItems(header, isExplicitVR, byteOrder)
+	if err != nil {
+		return err
+	}
+	return w.visitor.ExitSequence(header)
+}
+
+// walkItems walks the items of the element until the sequence delimitation tag, or
+// the end of the value if the length is defined. The items of encapsulated pixel data
+// are fragments, which are visited as elements.
+func (w *dcmWalker) walkItems(header DcmElementHeader, isExplicitVR bool, byteOrder EByteOrder) error {
+	isFragments := header.Tag == DCMPixelData
+	for !w.stream.Eos() && (header.IsUndefinedLength() || w.stream.Position-header.Offset < header.Length) {
+		var item DcmElement
+		item.byteOrder = byteOrder
+		err := item.ReadDcmTag(w.stream)
+		if err != nil {
+			return err
+		}
+		err = item.ReadValueLengthUint32(w.stream)
+		if err != nil {
+			return err
+		}
+		if item.Tag == DCMSequenceDelimitationItem {
+			return nil
+		}
+		if item.Tag != DCMItem {
+			str := "DcmReader: unexpected tag '" + item.Tag.String() + "' in the sequence '" + header.Tag.String() + "'"
+			return errors.New(str)
+		}
+
+		itemHeader := DcmElementHeader{
+			Tag:    DCMItem,
+			Length: item.Length,
+			Offset: w.stream.Position,
+			Depth:  header.Depth + 1,
+		}
+		if isFragments {
+			action, err := w.visitor.VisitElement(itemHeader)
+			if err != nil {
+				return err
+			}
+			if action == WalkStop {
+				return errWalkStop
+			}
+			err = w.walkValue(itemHeader, action, nil)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = w.visitor.EnterItem(itemHeader)
+		if err != nil {
+			return err
+		}
+		end := int64(-1)
+		if !itemHeader.IsUndefinedLength() {
+			end = itemHeader.Offset + itemHeader.Length
+		}
+		err = w.walkDataset(isExplicitVR, byteOrder, end, header.Depth+1)
+		if err != nil {
+			return err
+		}
+		err = w.visitor.ExitItem(itemHeader)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkValue reads, streams or skips the value of the element.
+func (w *dcmWalker) walkValue(header DcmElementHeader, action EWalkAction, creators dcmPrivateCreators) error {
+	if header.Tag.IsPrivateCreator() {
+		// the private creators are needed by the private elements
+		value, err := w.stream.Read(header.Length)
+		if err != nil {
+			return err
+		}
+		creators.update(DcmElement{Tag: header.Tag, Value: value})
+		switch action {
+		case WalkRead:
+			return w.visitor.VisitValue(header, value)
+		case WalkStream:
+			return w.visitor.StreamValue(header, bytes.NewReader(value))
+		}
+		return nil
+	}
+
+	switch action {
+	case WalkRead:
+		value, err := w.stream.Read(header.Length)
+		if err != nil {
+			return err
+		}
+		return w.visitor.VisitValue(header, value)
+	case WalkStream:
+		r := &dcmValueReader{stream: w.stream, remaining: header.Length}
+		err := w.visitor.StreamValue(header, r)
+		if err != nil {
+			return err
+		}
+		_, err = w.stream.Skip(r.remaining)
+		return err
+	}
+	_, err := w.stream.Skip(header.Length)
+	return err
+}
+
+// dcmValueReader reads the value of an element from the stream.
+type dcmValueReader struct {
+	stream    *DcmFileStream
+	remaining int64
+}
+
+func (r *dcmValueReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.stream.handler.Read(p)
+	r.stream.Position += int64(n)
+	r.remaining -= int64(n)
+	if err == io.EOF && r.remaining > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
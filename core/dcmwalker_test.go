@@ -0,0 +1,246 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+// recordVisitor reads the values of the top level elements, and streams the rest.
+type recordVisitor struct {
+	DcmBaseVisitor
+	headers   []DcmElementHeader
+	values    map[DcmTag][]byte
+	streamed  map[int64][]byte
+	sequences int
+	items     int
+	depth     int
+	maxDepth  int
+}
+
+func (v *recordVisitor) VisitElement(header DcmElementHeader) (EWalkAction, error) {
+	v.headers = append(v.headers, header)
+	if header.Depth == 0 {
+		return WalkRead, nil
+	}
+	return WalkStream, nil
+}
+
+func (v *recordVisitor) VisitValue(header DcmElementHeader, value []byte) error {
+	v.values[header.Tag] = value
+	return nil
+}
+
+func (v *recordVisitor) StreamValue(header DcmElementHeader, r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	v.streamed[header.Offset] = b
+	return err
+}
+
+func (v *recordVisitor) EnterSequence(header DcmElementHeader) error {
+	v.sequences++
+	v.depth++
+	if v.depth > v.maxDepth {
+		v.maxDepth = v.depth
+	}
+	return nil
+}
+
+func (v *recordVisitor) ExitSequence(header DcmElementHeader) error {
+	v.depth--
+	return nil
+}
+
+func (v *recordVisitor) EnterItem(header DcmElementHeader) error {
+	v.items++
+	return nil
+}
+
+func TestDcmReaderWalkFile(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "MR-MONO2-8-16x-heart.dcm",
+		util.GetTestDataFolder() + "US-RGB-8-esopecho.dcm",
+		util.GetTestDataFolder() + "CT1_J2KI",
+		util.GetTestDataFolder() + "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+		util.GetTestDataFolder() + "GH064.dcm",
+		util.GetTestDataFolder() + "DICOMDIR",
+	}
+	for _, c := range cases {
+		want := readTestFile(t, c)
+		data, _ := ioutil.ReadFile(c)
+
+		var reader DcmReader
+		v := &recordVisitor{values: make(map[DcmTag][]byte), streamed: make(map[int64][]byte)}
+		err := reader.WalkFile(c, v)
+		if err != nil {
+			t.Errorf("DcmReader.WalkFile(%s): %s", c, err.Error())
+			continue
+		}
+		if reader.Meta.TransferSyntaxUID() != want.Meta.TransferSyntaxUID() {
+			t.Errorf("DcmReader.WalkFile(%s), want transfer syntax '%s' got '%s'", c, want.Meta.TransferSyntaxUID(), reader.Meta.TransferSyntaxUID())
+		}
+		if v.depth != 0 {
+			t.Errorf("DcmReader.WalkFile(%s), the sequences are not balanced", c)
+		}
+
+		var top []DcmTag
+		for _, h := range v.headers {
+			if h.Depth == 0 {
+				top = append(top, h.Tag)
+			}
+			if h.IsUndefinedLength() {
+				continue
+			}
+			if h.Offset+h.Length > int64(len(data)) {
+				t.Errorf("DcmReader.WalkFile(%s), the value of '%s' is beyond the end of the file", c, h.Tag)
+				continue
+			}
+			got, ok := v.streamed[h.Offset]
+			if !ok {
+				got, ok = v.values[h.Tag]
+			}
+			if ok && !bytes.Equal(got, data[h.Offset:h.Offset+h.Length]) {
+				t.Errorf("DcmReader.WalkFile(%s), the value of '%s' is not at offset %d", c, h.Tag, h.Offset)
+			}
+		}
+		if len(top) != len(want.Dataset.Elements) {
+			t.Errorf("DcmReader.WalkFile(%s), want %d elements got %d", c, len(want.Dataset.Elements), len(top))
+			continue
+		}
+		for i, e := range want.Dataset.Elements {
+			if top[i] != e.Tag {
+				t.Errorf("DcmReader.WalkFile(%s), want tag '%s' got '%s'", c, e.Tag, top[i])
+				break
+			}
+			if e.Squence == nil && !bytes.Equal(v.values[e.Tag], e.Value) {
+				t.Errorf("DcmReader.WalkFile(%s), the value of '%s' is not the same as ReadFile", c, e.Tag)
+			}
+		}
+	}
+}
+
+func TestDcmReaderWalkSequences(t *testing.T) {
+	filename := util.GetTestDataFolder() + "CT1_J2KI"
+	want := readTestFile(t, filename)
+	pd, err := want.Dataset.EncapsulatedPixelData()
+	if err != nil {
+		t.Fatalf("EncapsulatedPixelData(): %s", err.Error())
+	}
+
+	var reader DcmReader
+	v := &recordVisitor{values: make(map[DcmTag][]byte), streamed: make(map[int64][]byte)}
+	err = reader.WalkFile(filename, v)
+	if err != nil {
+		t.Fatalf("DcmReader.WalkFile(): %s", err.Error())
+	}
+	var fragments [][]byte
+	for _, h := range v.headers {
+		if h.Tag == DCMItem && h.Depth == 1 {
+			fragments = append(fragments, v.streamed[h.Offset])
+		}
+	}
+	// the first item is the Basic Offset Table
+	if len(fragments) != len(pd.Fragments)+1 {
+		t.Fatalf("DcmReader.WalkFile(), want %d fragments got %d", len(pd.Fragments)+1, len(fragments))
+	}
+	for i, f := range pd.Fragments {
+		if !bytes.Equal(f, fragments[i+1]) {
+			t.Errorf("DcmReader.WalkFile(), the fragment %d is not the same as ReadFile", i)
+		}
+	}
+}
+
+// stopVisitor stops at the tag, and skips all the sequences.
+type stopVisitor struct {
+	DcmBaseVisitor
+	tag  DcmTag
+	tags []DcmTag
+}
+
+func (v *stopVisitor) VisitElement(header DcmElementHeader) (EWalkAction, error) {
+	if header.Tag == v.tag {
+		return WalkStop, nil
+	}
+	v.tags = append(v.tags, header.Tag)
+	return WalkSkip, nil
+}
+
+func TestDcmReaderWalkStop(t *testing.T) {
+	filename := util.GetTestDataFolder() + "GH064.dcm"
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reader DcmReader
+	v := &stopVisitor{tag: DCMPixelData}
+	err = reader.Walk(onlyReader{bytes.NewReader(data)}, v)
+	if err != nil {
+		t.Fatalf("DcmReader.Walk(): %s", err.Error())
+	}
+	want := readTestFile(t, filename)
+	if len(v.tags) == 0 || len(v.tags) != len(want.Dataset.Elements)-1 {
+		t.Errorf("DcmReader.Walk(), want %d elements before the pixel data got %d", len(want.Dataset.Elements)-1, len(v.tags))
+	}
+}
+
+// windowVisitor skips all the values, and records the bytes kept by the stream
+// of the reader.
+type windowVisitor struct {
+	DcmBaseVisitor
+	reader    *DcmReader
+	maxKept   int
+	pixelData int64
+}
+
+func (v *windowVisitor) VisitElement(header DcmElementHeader) (EWalkAction, error) {
+	if buf, ok := v.reader.fs.handler.(*dcmStreamBuffer); ok && len(buf.buf) > v.maxKept {
+		v.maxKept = len(buf.buf)
+	}
+	if header.Tag == DCMPixelData {
+		v.pixelData = header.Length
+	}
+	return WalkSkip, nil
+}
+
+func TestDcmReaderWalkWindow(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	dataset := DcmDataset{Elements: append([]DcmElement(nil), reader.Dataset.Elements...)}
+	// random pixels, so that the deflated data set is as large
+	pixels := make([]byte, 8*1024*1024)
+	rand.New(rand.NewSource(1)).Read(pixels)
+	err := dataset.Set(DCMPixelData, "OW", pixels)
+	if err != nil {
+		t.Fatalf("Set(%s): %s", DCMPixelData, err.Error())
+	}
+	err = dataset.Set(DCMDataSetTrailingPadding, "OB", []byte{0, 0})
+	if err != nil {
+		t.Fatalf("Set(%s): %s", DCMDataSetTrailingPadding, err.Error())
+	}
+
+	for _, xfer := range []string{UIDLittleEndianExplicitTransferSyntax, UIDDeflatedExplicitVRLittleEndianTransferSyntax} {
+		var data bytes.Buffer
+		writer := DcmWriter{Meta: reader.Meta, Dataset: dataset}
+		err = writer.Write(&data, xfer)
+		if err != nil {
+			t.Fatalf("DcmWriter.Write(%s): %s", xfer, err.Error())
+		}
+
+		var walker DcmReader
+		v := &windowVisitor{reader: &walker}
+		err = walker.Walk(onlyReader{&data}, v)
+		if err != nil {
+			t.Errorf("DcmReader.Walk(%s): %s", xfer, err.Error())
+			continue
+		}
+		if v.pixelData != int64(len(pixels)) {
+			t.Errorf("DcmReader.Walk(%s), want the pixel data of %d bytes got %d", xfer, len(pixels), v.pixelData)
+		}
+		if v.maxKept > dcmWalkWindow+dcmStreamMaxChunk {
+			t.Errorf("DcmReader.Walk(%s), want at most %d bytes kept got %d", xfer, dcmWalkWindow+dcmStreamMaxChunk, v.maxKept)
+		}
+	}
+}
@@ -0,0 +1,457 @@
+package core
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// DcmWriter is to write DICOM file
+type DcmWriter struct {
+	Meta    DcmMetaInfo
+	Dataset DcmDataset
+}
+
+// NewDcmWriter returns a writer for the meta information and data set parsed by the reader.
+func NewDcmWriter(reader DcmReader) *DcmWriter {
+	return &DcmWriter{Meta: reader.Meta, Dataset: reader.Dataset}
+}
+
+// WriteFile is to write dicom file. The data set is encoded with the transfer
+// syntax xferID, or with the one of the meta information if xferID is empty.
+func (writer DcmWriter) WriteFile(filename string, xferID string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = writer.Write(f, xferID)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Write is to write the preamble, the meta information and the data set to w.
+func (writer DcmWriter) Write(w io.Writer, xferID string) error {
+	var src DcmXfer
+	src.XferID = writer.Meta.TransferSyntaxUID()
+	err := src.GetDcmXferByID()
+	if err != nil {
+		return err
+	}
+
+	dst := src
+	if xferID != "" && xferID != src.XferID {
+		dst.XferID = xferID
+		err = dst.GetDcmXferByID()
+		if err != nil {
+			return err
+		}
+		err = writer.checkEncapsulation(src, dst)
+		if err != nil {
+			return err
+		}
+	}
+
+	meta, err := writer.encodeMetaInfo(dst.XferID)
+	if err != nil {
+		return err
+	}
+
+	enc := newDcmEncoder(dst, writer.Dataset)
+	dataset, err := enc.encodeDataset(writer.Dataset.Elements)
+	if err != nil {
+		return err
+	}
+	if dst.IsDeflated() {
+		dataset, err = deflate(dataset)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(meta)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(dataset)
+	return err
+}
+
+// checkEncapsulation refuses to change the transfer syntax of encapsulated pixel data,
+// since that requires decompressing or compressing the pixel data.
+func (writer DcmWriter) checkEncapsulation(src DcmXfer, dst DcmXfer) error {
+	var elem DcmElement
+	elem.Tag = DCMPixelData
+	if writer.Dataset.FindElement(&elem) != nil {
+		return nil
+	}
+	if src.IsCompressed() || dst.IsCompressed() {
+		str := fmt.Sprintf("DcmWriter: cannot write the pixel data of '%s' as '%s'", src.XferName, dst.XferName)
+		return errors.New(str)
+	}
+	return nil
+}
+
+// encodeMetaInfo encodes the preamble, the prefix and the meta information group
+// with the given transfer syntax UID. The group length is recalculated.
+func (writer DcmWriter) encodeMetaInfo(xferID string) ([]byte, error) {
+	var elements []DcmElement
+	isFoundXfer := false
+	for _, v := range writer.Meta.Elements {
+		if v.Tag == DCMFileMetaInformationGroupLength {
+			continue
+		}
+		if !isFoundXfer && DCMTransferSyntaxUID.Element <= v.Tag.Element {
+			elements = append(elements, newUIElement(DCMTransferSyntaxUID, xferID))
+			isFoundXfer = true
+			if v.Tag == DCMTransferSyntaxUID {
+				continue
+			}
+		}
+		elements = append(elements, v)
+	}
+	if !isFoundXfer {
+		elements = append(elements, newUIElement(DCMTransferSyntaxUID, xferID))
+	}
+
+	var groupLength DcmElement
+	groupLength.Tag = DCMFileMetaInformationGroupLength
+	groupLength.VR = "UL"
+	groupLength.Value = make([]byte, 4)
+	elements = append([]DcmElement{groupLength}, elements...)
+
+	enc := dcmEncoder{isExplicitVR: true, byteOrder: EBOLittleEndian}
+	group, err := enc.encodeDataset(elements)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if len(writer.Meta.Preamble) == 128 {
+		buf.Write(writer.Meta.Preamble)
+	} else {
+		buf.Write(make([]byte, 128))
+	}
+	buf.WriteString(DICOM3FILEIDENTIFIER)
+	buf.Write(group)
+	return buf.Bytes(), nil
+}
+
+// deflate compresses the encoded data set with deflate (RFC 1951), without zlib header.
+// The compressed data is padded to even length.
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	if buf.Len()%2 != 0 {
+		buf.WriteByte(0x00)
+	}
+	return buf.Bytes(), nil
+}
+
+// newUIElement creates an UI element, padded to even length with a trailing null.
+func newUIElement(tag DcmTag, uid string) DcmElement {
+	var elem DcmElement
+	elem.Tag = tag
+	elem.VR = "UI"
+	elem.Value = []byte(uid)
+	if len(elem.Value)%2 != 0 {
+		elem.Value = append(elem.Value, 0x00)
+	}
+	elem.Length = int64(len(elem.Value))
+	return elem
+}
+
+// dcmEncoder encodes data elements with the VR encoding and byte order of a transfer syntax.
+type dcmEncoder struct {
+	isExplicitVR bool
+	byteOrder    EByteOrder
+
+	// used to resolve the ambiguous VRs of implicit VR data sets
+	pixelRepresentation string
+	bitsAllocated       uint64
+}
+
+func newDcmEncoder(xfer DcmXfer, dataset DcmDataset) dcmEncoder {
+	bitsAllocated, _ := strconv.ParseUint(dataset.BitsAllocated(), 10, 16)
+	return dcmEncoder{
+		isExplicitVR:        xfer.IsExplicitVR(),
+		byteOrder:           xfer.ByteOrder,
+		pixelRepresentation: dataset.PixelRepresentation(),
+		bitsAllocated:       bitsAllocated,
+	}
+}
+
+func (enc dcmEncoder) order() binary.ByteOrder {
+	if enc.byteOrder == EBOBigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}
+
+func (enc dcmEncoder) writeTag(buf *bytes.Buffer, tag DcmTag) {
+	b := make([]byte, 4)
+	enc.order().PutUint16(b[0:2], tag.Group)
+	enc.order().PutUint16(b[2:4], tag.Element)
+	buf.Write(b)
+}
+
+func (enc dcmEncoder) writeUint16(buf *bytes.Buffer, v uint16) {
+	b := make([]byte, 2)
+	enc.order().PutUint16(b, v)
+	buf.Write(b)
+}
+
+func (enc dcmEncoder) writeUint32(buf *bytes.Buffer, v uint32) {
+	b := make([]byte, 4)
+	enc.order().PutUint32(b, v)
+	buf.Write(b)
+}
+
+// writeHeader writes the tag, the VR (explicit VR only) and the value length.
+func (enc dcmEncoder) writeHeader(buf *bytes.Buffer, tag DcmTag, vr string, length uint32) {
+	enc.writeTag(buf, tag)
+	if !enc.isExplicitVR {
+		enc.writeUint32(buf, length)
+		return
+	}
+	buf.WriteString(vr)
+	if isLongLengthVR(vr) {
+		// reserved 2 bytes
+		buf.Write([]byte{0x00, 0x00})
+		enc.writeUint32(buf, length)
+		return
+	}
+	enc.writeUint16(buf, uint16(length))
+}
+
+// writeDelimiter writes an item or sequence delimitation item.
+func (enc dcmEncoder) writeDelimiter(buf *bytes.Buffer, tag DcmTag) {
+	enc.writeTag(buf, tag)
+	enc.writeUint32(buf, 0)
+}
+
+// encodeDataset encodes the elements in order and recalculates the group length elements.
+func (enc dcmEncoder) encodeDataset(elements []DcmElement) ([]byte, error) {
+	encoded := make([][]byte, len(elements))
+	var err error
+	for i, e := range elements {
+		encoded[i], err = enc.encodeElement(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, e := range elements {
+		if e.Tag.Element != 0x0000 {
+			continue
+		}
+		var length int
+		for j := i + 1; j < len(elements) && elements[j].Tag.Group == e.Tag.Group; j++ {
+			length += len(encoded[j])
+		}
+		e.VR = "UL"
+		e.byteOrder = enc.byteOrder
+		e.Value = make([]byte, 4)
+		enc.order().PutUint32(e.Value, uint32(length))
+		encoded[i], err = enc.encodeElement(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bytes.Join(encoded, nil), nil
+}
+
+// encodeElement encodes one data element.
+func (enc dcmEncoder) encodeElement(e DcmElement) ([]byte, error) {
+	var buf bytes.Buffer
+	vr := enc.resolveVR(e)
+
+	if e.Squence != nil {
+		itemEnc := enc.itemEncoder(e)
+		items, err := itemEnc.encodeItems(e)
+		if err != nil {
+			return nil, err
+		}
+		if e.Tag == DCMPixelData && vr != "OB" && vr != "OW" {
+			vr = "OB"
+		}
+		if e.Length == 0xFFFFFFFF || e.Tag == DCMPixelData {
+			enc.writeHeader(&buf, e.Tag, vr, 0xFFFFFFFF)
+			buf.Write(items)
+			itemEnc.writeDelimiter(&buf, DCMSequenceDelimitationItem)
+		} else {
+			enc.writeHeader(&buf, e.Tag, vr, uint32(len(items)))
+			buf.Write(items)
+		}
+		return buf.Bytes(), nil
+	}
+
+	if e.Value == nil && e.Length > 0 {
+		str := "DcmWriter: the value of the tag '" + e.Tag.String() + "' is not read"
+		return nil, errors.New(str)
+	}
+	if vr == "SQ" {
+		// sequence without items
+		enc.writeHeader(&buf, e.Tag, vr, 0)
+		return buf.Bytes(), nil
+	}
+	value := e.Value
+	if enc.isExplicitVR && !isLongLengthVR(vr) && len(value) > 0xFFFF {
+		vr = "UN"
+	}
+	if byteOrderOf(e.byteOrder) != byteOrderOf(enc.byteOrder) {
+		value = swapBytes(value, vrUnitSize(vr))
+	}
+	enc.writeHeader(&buf, e.Tag, vr, uint32(len(value)))
+	buf.Write(value)
+	return buf.Bytes(), nil
+}
+
+// itemEncoder gets the encoder for the items of the sequence.
+func (enc dcmEncoder) itemEncoder(e DcmElement) dcmEncoder {
+	if e.VR == "UN" {
+		// the items of UN with undefined length are always implicit VR little endian
+		enc.isExplicitVR, enc.byteOrder = false, EBOLittleEndian
+	}
+	return enc
+}
+
+// encodeItems encodes the items of a sequence or the fragments of encapsulated pixel data.
+func (enc dcmEncoder) encodeItems(e DcmElement) ([]byte, error) {
+	// only the items of SQ contain data elements, which need to be re-encoded
+	isReEncode := e.VR == "SQ" && e.Tag != DCMPixelData
+
+	var buf bytes.Buffer
+	for _, item := range e.Squence.Item {
+		if item.Tag != DCMItem {
+			continue
+		}
+		if item.Value == nil && item.Length > 0 && item.Length != 0xFFFFFFFF {
+			str := "DcmWriter: the items of the tag '" + e.Tag.String() + "' are not read"
+			return nil, errors.New(str)
+		}
+		value := item.Value
+		if isReEncode {
+			var nested DcmDataset
+			err := nested.Read(newDcmBufferStream(item.Value), e.isExplicitVR, byteOrderOf(e.byteOrder), true, true)
+			if err != nil {
+				return nil, err
+			}
+			value, err = enc.encodeDataset(nested.Elements)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if item.Length == 0xFFFFFFFF {
+			enc.writeTag(&buf, DCMItem)
+			enc.writeUint32(&buf, 0xFFFFFFFF)
+			buf.Write(value)
+			enc.writeDelimiter(&buf, DCMItemDelimitationItem)
+			continue
+		}
+		enc.writeTag(&buf, DCMItem)
+		enc.writeUint32(&buf, uint32(len(value)))
+		buf.Write(value)
+	}
+	return buf.Bytes(), nil
+}
+
+// resolveVR gets a VR which is valid for explicit VR encoding.
+func (enc dcmEncoder) resolveVR(e DcmElement) string {
+	if e.Tag.Element == 0x0000 {
+		return "UL"
+	}
+	vr := e.VR
+	if _, ok := vrUnitSizes[vr]; !ok {
+		// implicit VR, or an illegal VR read from the file
+		elem := e
+		if FindDcmElmentByTag(&elem) == nil {
+			vr = elem.VR
+		}
+	}
+	switch vr {
+	case "US or SS":
+		if enc.pixelRepresentation == "1" {
+			return "SS"
+		}
+		return "US"
+	case "OB or OW":
+		if e.Tag == DCMPixelData && enc.bitsAllocated > 0 && enc.bitsAllocated <= 8 {
+			return "OB"
+		}
+		return "OW"
+	case "US or OW", "US or SS or OW":
+		return "OW"
+	}
+	if _, ok := vrUnitSizes[vr]; ok {
+		return vr
+	}
+	return "UN"
+}
+
+// vrUnitSizes contains the byte size of one binary value of each VR,
+// 1 for the string and byte VRs which are not affected by the byte order.
+var vrUnitSizes = map[string]int{
+	"AE": 1, "AS": 1, "CS": 1, "DA": 1, "DS": 1, "DT": 1, "IS": 1, "LO": 1,
+	"LT": 1, "OB": 1, "PN": 1, "SH": 1, "ST": 1, "TM": 1, "UC": 1, "UI": 1,
+	"UN": 1, "UR": 1, "UT": 1, "SQ": 1,
+	"AT": 2, "OW": 2, "SS": 2, "US": 2,
+	"FL": 4, "OF": 4, "OL": 4, "SL": 4, "UL": 4,
+	"FD": 8, "OD": 8, "OV": 8, "SV": 8, "UV": 8,
+}
+
+func vrUnitSize(vr string) int {
+	size, ok := vrUnitSizes[vr]
+	if !ok {
+		return 1
+	}
+	return size
+}
+
+// isLongLengthVR checks whether the VR has a 4 bytes value length with explicit VR.
+func isLongLengthVR(vr string) bool {
+	switch vr {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UC", "UR", "UT", "UN", "UV":
+		return true
+	}
+	return false
+}
+
+func byteOrderOf(bo EByteOrder) EByteOrder {
+	if bo == EBOBigEndian {
+		return EBOBigEndian
+	}
+	return EBOLittleEndian
+}
+
+// swapBytes reverses the byte order of every value with the given size.
+func swapBytes(value []byte, size int) []byte {
+	if size <= 1 {
+		return value
+	}
+	result := make([]byte, len(value))
+	copy(result, value)
+	for i := 0; i+size <= len(result); i += size {
+		for l, r := i, i+size-1; l < r; l, r = l+1, r-1 {
+			result[l], result[r] = result[r], result[l]
+		}
+	}
+	return result
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grayzone/godcm/util"
+)
+
+func readTestFile(t *testing.T, filename string) DcmReader {
+	var reader DcmReader
+	reader.IsReadValue = true
+	reader.IsReadPixel = true
+	err := reader.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("DcmReader.ReadFile(%s): %s", filename, err.Error())
+	}
+	return reader
+}
+
+func TestDcmWriterWriteFile(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "MR-MONO2-8-16x-heart.dcm",
+		util.GetTestDataFolder() + "US-RGB-8-esopecho.dcm",
+		util.GetTestDataFolder() + "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+		util.GetTestDataFolder() + "GH064.dcm",
+		util.GetTestDataFolder() + "CT1_J2KI",
+		util.GetTestDataFolder() + "DICOMDIR",
+	}
+	dir, err := ioutil.TempDir("", "godcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, c := range cases {
+		reader := readTestFile(t, c)
+		newfile := filepath.Join(dir, filepath.Base(c))
+		err := NewDcmWriter(reader).WriteFile(newfile, "")
+		if err != nil {
+			t.Errorf("DcmWriter.WriteFile(%s): %s", c, err.Error())
+			continue
+		}
+		want, _ := ioutil.ReadFile(c)
+		got, _ := ioutil.ReadFile(newfile)
+		if !bytes.Equal(want, got) {
+			t.Errorf("DcmWriter.WriteFile(%s), the file is not the same as the original", c)
+		}
+	}
+}
+
+func TestDcmWriterTranscode(t *testing.T) {
+	all := []string{
+		UIDLittleEndianImplicitTransferSyntax,
+		UIDLittleEndianExplicitTransferSyntax,
+		UIDBigEndianExplicitTransferSyntax,
+	}
+	// the VR of private and UN elements can not be kept with implicit VR
+	explicit := []string{
+		UIDLittleEndianExplicitTransferSyntax,
+		UIDBigEndianExplicitTransferSyntax,
+	}
+	cases := []struct {
+		in    string
+		xfers []string
+	}{
+		{util.GetTestDataFolder() + "CT-MONO2-16-ankle", all},
+		{util.GetTestDataFolder() + "MR-MONO2-8-16x-heart.dcm", all},
+		{util.GetTestDataFolder() + "GH179A.dcm", all},
+		{util.GetTestDataFolder() + "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm", explicit},
+		{util.GetTestDataFolder() + "IM0.dcm", explicit},
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, c.in)
+		enc := newDcmEncoder(*NewDcmXfer(EXSLittleEndianExplicit), reader.Dataset)
+		want, err := enc.encodeDataset(reader.Dataset.Elements)
+		if err != nil {
+			t.Fatalf("encodeDataset(%s): %s", c.in, err.Error())
+		}
+		for _, xfer := range c.xfers {
+			var buf bytes.Buffer
+			err = NewDcmWriter(reader).Write(&buf, xfer)
+			if err != nil {
+				t.Errorf("DcmWriter.Write(%s, %s): %s", c.in, xfer, err.Error())
+				continue
+			}
+			var result DcmReader
+			result.IsReadValue = true
+			result.IsReadPixel = true
+			err = result.Read(&buf)
+			if err != nil {
+				t.Errorf("read %s written with %s: %s", c.in, xfer, err.Error())
+				continue
+			}
+			if result.Meta.TransferSyntaxUID() != xfer {
+				t.Errorf("TransferSyntaxUID() %s, want '%s' got '%s'", c.in, xfer, result.Meta.TransferSyntaxUID())
+			}
+			got, err := enc.encodeDataset(result.Dataset.Elements)
+			if err != nil {
+				t.Errorf("encodeDataset(%s, %s): %s", c.in, xfer, err.Error())
+				continue
+			}
+			if !bytes.Equal(want, got) {
+				t.Errorf("DcmWriter.Write(%s, %s), the data set is changed", c.in, xfer)
+			}
+		}
+	}
+}
+
+func TestDcmWriterMetaGroupLength(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT-MONO2-16-ankle")
+	var buf bytes.Buffer
+	err := NewDcmWriter(reader).Write(&buf, UIDLittleEndianExplicitTransferSyntax)
+	if err != nil {
+		t.Fatalf("DcmWriter.Write(): %s", err.Error())
+	}
+	var result DcmReader
+	result.IsReadValue = true
+	err = result.Read(&buf)
+	if err != nil {
+		t.Fatalf("read: %s", err.Error())
+	}
+	// the transfer syntax UID 1.2.840.10008.1.2.1 is 2 bytes longer than 1.2.840.10008.1.2
+	want := "194"
+	got := result.Meta.FileMetaInformationGroupLength()
+	if got != want {
+		t.Errorf("FileMetaInformationGroupLength(), want '%s' got '%s'", want, got)
+	}
+}
+
+func TestDcmWriterEncapsulated(t *testing.T) {
+	reader := readTestFile(t, util.GetTestDataFolder()+"CT1_J2KI")
+	var buf bytes.Buffer
+	err := NewDcmWriter(reader).Write(&buf, UIDLittleEndianExplicitTransferSyntax)
+	if err == nil {
+		t.Errorf("DcmWriter.Write() should fail to write JPEG 2000 pixel data as Little Endian Explicit")
+	}
+}
+
+func TestDcmWriterDeflated(t *testing.T) {
+	cases := []string{
+		util.GetTestDataFolder() + "CT-MONO2-16-ankle",
+		util.GetTestDataFolder() + "GH179A.dcm",
+		util.GetTestDataFolder() + "US-RGB-8-esopecho.dcm",
+	}
+	for _, c := range cases {
+		reader := readTestFile(t, c)
+		var explicit, deflated bytes.Buffer
+		err := NewDcmWriter(reader).Write(&explicit, UIDLittleEndianExplicitTransferSyntax)
+		if err != nil {
+			t.Fatalf("DcmWriter.Write(%s): %s", c, err.Error())
+		}
+		err = NewDcmWriter(reader).Write(&deflated, UIDDeflatedExplicitVRLittleEndianTransferSyntax)
+		if err != nil {
+			t.Fatalf("DcmWriter.Write(%s): %s", c, err.Error())
+		}
+		if deflated.Len() >= explicit.Len() {
+			t.Errorf("DcmWriter.Write(%s), the deflated file is not smaller than the explicit one", c)
+		}
+
+		var result DcmReader
+		result.IsReadValue = true
+		result.IsReadPixel = true
+		err = result.Read(&deflated)
+		if err != nil {
+			t.Errorf("read deflated %s: %s", c, err.Error())
+			continue
+		}
+		if result.Meta.TransferSyntaxUID() != UIDDeflatedExplicitVRLittleEndianTransferSyntax {
+			t.Errorf("TransferSyntaxUID() %s, want '%s' got '%s'", c, UIDDeflatedExplicitVRLittleEndianTransferSyntax, result.Meta.TransferSyntaxUID())
+		}
+		enc := newDcmEncoder(*NewDcmXfer(EXSLittleEndianExplicit), reader.Dataset)
+		want, _ := enc.encodeDataset(reader.Dataset.Elements)
+		got, _ := enc.encodeDataset(result.Dataset.Elements)
+		if !bytes.Equal(want, got) {
+			t.Errorf("DcmWriter.Write(%s, deflated), the data set is changed", c)
+		}
+	}
+}
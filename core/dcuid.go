@@ -97,6 +97,21 @@ var (
 	// UIDJPIPReferencedDeflateTransferSyntax :  JPIP Referenced Deflate
 	UIDJPIPReferencedDeflateTransferSyntax = "1.2.840.10008.1.2.4.95"
 
+	// UIDHighThroughputJPEG2000LosslessOnlyTransferSyntax :  High-Throughput JPEG 2000 Image Compression (Lossless Only)
+	UIDHighThroughputJPEG2000LosslessOnlyTransferSyntax = "1.2.840.10008.1.2.4.201"
+
+	// UIDHighThroughputJPEG2000RPCLLosslessOnlyTransferSyntax :  High-Throughput JPEG 2000 with RPCL Options Image Compression (Lossless Only)
+	UIDHighThroughputJPEG2000RPCLLosslessOnlyTransferSyntax = "1.2.840.10008.1.2.4.202"
+
+	// UIDHighThroughputJPEG2000TransferSyntax :  High-Throughput JPEG 2000 Image Compression
+	UIDHighThroughputJPEG2000TransferSyntax = "1.2.840.10008.1.2.4.203"
+
+	// UIDJPIPHTJ2KReferencedTransferSyntax :  JPIP HTJ2K Referenced
+	UIDJPIPHTJ2KReferencedTransferSyntax = "1.2.840.10008.1.2.4.204"
+
+	// UIDJPIPHTJ2KReferencedDeflateTransferSyntax :  JPIP HTJ2K Referenced Deflate
+	UIDJPIPHTJ2KReferencedDeflateTransferSyntax = "1.2.840.10008.1.2.4.205"
+
 	// UIDMPEG2MainProfileAtMainLevelTransferSyntax :  MPEG2 Main Profile @ Main Level
 	UIDMPEG2MainProfileAtMainLevelTransferSyntax = "1.2.840.10008.1.2.4.100"
 
@@ -106,3 +121,35 @@ var (
 	// UIDRLELosslessTransferSyntax :  RLE Lossless
 	UIDRLELosslessTransferSyntax = "1.2.840.10008.1.2.5"
 )
+
+/*
+** Defined Storage SOP Class UIDs
+ */
+var (
+	// UIDComputedRadiographyImageStorage : Computed Radiography Image Storage
+	UIDComputedRadiographyImageStorage = "1.2.840.10008.5.1.4.1.1.1"
+
+	// UIDCTImageStorage : CT Image Storage
+	UIDCTImageStorage = "1.2.840.10008.5.1.4.1.1.2"
+
+	// UIDRetiredUltrasoundMultiframeImageStorage : Ultrasound Multi-frame Image Storage (Retired)
+	UIDRetiredUltrasoundMultiframeImageStorage = "1.2.840.10008.5.1.4.1.1.3"
+
+	// UIDUltrasoundMultiframeImageStorage : Ultrasound Multi-frame Image Storage
+	UIDUltrasoundMultiframeImageStorage = "1.2.840.10008.5.1.4.1.1.3.1"
+
+	// UIDMRImageStorage : MR Image Storage
+	UIDMRImageStorage = "1.2.840.10008.5.1.4.1.1.4"
+
+	// UIDRetiredUltrasoundImageStorage : Ultrasound Image Storage (Retired)
+	UIDRetiredUltrasoundImageStorage = "1.2.840.10008.5.1.4.1.1.6"
+
+	// UIDUltrasoundImageStorage : Ultrasound Image Storage
+	UIDUltrasoundImageStorage = "1.2.840.10008.5.1.4.1.1.6.1"
+
+	// UIDSecondaryCaptureImageStorage : Secondary Capture Image Storage
+	UIDSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7"
+
+	// UIDPositronEmissionTomographyImageStorage : Positron Emission Tomography Image Storage
+	UIDPositronEmissionTomographyImageStorage = "1.2.840.10008.5.1.4.1.1.128"
+)
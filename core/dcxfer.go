@@ -79,6 +79,16 @@ const (
 	EXSJPIPReferenced = 32
 	/// JPIP Referenced Deflate
 	EXSJPIPReferencedDeflate = 33
+	/// High-Throughput JPEG 2000 (lossless)
+	EXSHTJ2KLosslessOnly = 34
+	/// High-Throughput JPEG 2000 with RPCL options (lossless)
+	EXSHTJ2KRPCLLosslessOnly = 35
+	/// High-Throughput JPEG 2000 (lossless or lossy)
+	EXSHTJ2K = 36
+	/// JPIP HTJ2K Referenced
+	EXSJPIPHTJ2KReferenced = 37
+	/// JPIP HTJ2K Referenced Deflate
+	EXSJPIPHTJ2KReferencedDeflate = 38
 )
 
 /** enumeration of byte orders
@@ -156,7 +166,7 @@ const (
 	ESCunsupported = 1
 
 	/// zlib stream compression
-//	ESCzlib = 2 // not supported
+	ESCzlib = 2
 )
 
 // DcmXfer allows for a lookup of Transfer Syntax properties and readable descriptions
@@ -429,7 +439,7 @@ var xferList = []DcmXfer{
 		EJENotEncapsulated,
 		0, 0,
 		false,
-		ESCunsupported},
+		ESCzlib},
 	{UIDJPEG2000LosslessOnlyTransferSyntax,
 		"JPEG 2000 (Lossless only)",
 		EXSJPEG2000osslessOnly,
@@ -501,7 +511,52 @@ var xferList = []DcmXfer{
 		EJENotEncapsulated, // in fact, pixel data shall be referenced via (0028,7FE0) Pixel Data Provider URL
 		0, 0,
 		false,
-		ESCunsupported},
+		ESCzlib},
+	{UIDHighThroughputJPEG2000LosslessOnlyTransferSyntax,
+		"High-Throughput JPEG 2000 (Lossless only)",
+		EXSHTJ2KLosslessOnly,
+		EBOLittleEndian,
+		EVTExplicit,
+		EJEEncapsulated,
+		0, 0,
+		false,
+		ESCnone},
+	{UIDHighThroughputJPEG2000RPCLLosslessOnlyTransferSyntax,
+		"High-Throughput JPEG 2000 with RPCL Options (Lossless only)",
+		EXSHTJ2KRPCLLosslessOnly,
+		EBOLittleEndian,
+		EVTExplicit,
+		EJEEncapsulated,
+		0, 0,
+		false,
+		ESCnone},
+	{UIDHighThroughputJPEG2000TransferSyntax,
+		"High-Throughput JPEG 2000 (Lossless or Lossy)",
+		EXSHTJ2K,
+		EBOLittleEndian,
+		EVTExplicit,
+		EJEEncapsulated,
+		0, 0,
+		false,
+		ESCnone},
+	{UIDJPIPHTJ2KReferencedTransferSyntax,
+		"JPIP HTJ2K Referenced",
+		EXSJPIPHTJ2KReferenced,
+		EBOLittleEndian,
+		EVTExplicit,
+		EJENotEncapsulated, // in fact, pixel data shall be referenced via (0028,7FE0) Pixel Data Provider URL
+		0, 0,
+		false,
+		ESCnone},
+	{UIDJPIPHTJ2KReferencedDeflateTransferSyntax,
+		"JPIP HTJ2K Referenced Deflate",
+		EXSJPIPHTJ2KReferencedDeflate,
+		EBOLittleEndian,
+		EVTExplicit,
+		EJENotEncapsulated, // in fact, pixel data shall be referenced via (0028,7FE0) Pixel Data Provider URL
+		0, 0,
+		false,
+		ESCzlib},
 }
 
 // NewDcmXfer returns an new instance of DcmXfer
@@ -549,6 +604,11 @@ func (xfer DcmXfer) IsCompressed() bool {
 	return xfer.Encapsulated == EJEEncapsulated
 }
 
+// IsDeflated check whether the data set is compressed with deflate
+func (xfer DcmXfer) IsDeflated() bool {
+	return xfer.StreamCompression == ESCzlib
+}
+
 // IsBigEndian check whether pixel data need to be swapped
 func (xfer DcmXfer) IsBigEndian() bool {
 	return xfer.ByteOrder == EBOBigEndian
@@ -100,6 +100,11 @@ func TestIsExplicitVR(t *testing.T) {
 		{EXSJPEG2000Multicomponent, true},
 		{EXSJPIPReferenced, true},
 		{EXSJPIPReferencedDeflate, true},
+		{EXSHTJ2KLosslessOnly, true},
+		{EXSHTJ2KRPCLLosslessOnly, true},
+		{EXSHTJ2K, true},
+		{EXSJPIPHTJ2KReferenced, true},
+		{EXSJPIPHTJ2KReferencedDeflate, true},
 	}
 	for _, c := range cases {
 		got := NewDcmXfer(c.in).IsExplicitVR()
@@ -109,3 +114,27 @@ func TestIsExplicitVR(t *testing.T) {
 	}
 
 }
+
+func TestIsDeflated(t *testing.T) {
+	cases := []struct {
+		in   ETransferSyntax
+		want bool
+	}{
+		{EXSLittleEndianImplicit, false},
+		{EXSLittleEndianExplicit, false},
+		{EXSBigEndianExplicit, false},
+		{EXSJPEGProcess1TransferSyntax, false},
+		{EXSRLELossless, false},
+		{EXSDeflatedLittleEndianExplicit, true},
+		{EXSJPIPReferenced, false},
+		{EXSJPIPReferencedDeflate, true},
+		{EXSHTJ2K, false},
+		{EXSJPIPHTJ2KReferencedDeflate, true},
+	}
+	for _, c := range cases {
+		got := NewDcmXfer(c.in).IsDeflated()
+		if got != c.want {
+			t.Errorf("IsDeflated(%v), want %v got %v", c.in, c.want, got)
+		}
+	}
+}
@@ -33,10 +33,13 @@ type BitmapInfoHeader struct {
 }
 
 // WriteBMP write pixel data to BMP file
-func (di DcmImage) WriteBMP(filename string, bits uint16, frame int) error {
+func (di *DcmImage) WriteBMP(filename string, bits uint16, frame int) error {
 	if di.IsCompressed {
-		err := errors.New("not support compressed format")
-		return err
+		native, err := di.Decompress(frame)
+		if err != nil {
+			return err
+		}
+		return native.WriteBMP(filename, bits, 0)
 	}
 	switch bits {
 	case 8:
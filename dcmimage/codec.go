@@ -0,0 +1,164 @@
+package dcmimage
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Codec is to decode the compressed pixel data of a transfer syntax.
+type Codec interface {
+	// Decode decodes the compressed bit stream of one frame into native pixel data.
+	// The samples are interleaved (color-by-pixel), and stored in BitsAllocated bits in
+	// little endian. The codec may update the PhotometricInterpretation of the image if
+	// the color space is converted.
+	Decode(src []byte, di *DcmImage) ([]byte, error)
+}
+
+// Encoder is to encode native pixel data into the compressed bit stream of a transfer syntax.
+type Encoder interface {
+	// Encode encodes the native pixel data of one frame. The byte order of the samples
+	// is given by IsBigEndian, and the planes by PlanarConfiguration of the image.
+	Encode(src []byte, di DcmImage) ([]byte, error)
+}
+
+// EncodeParams are the parameters of the encoders. The zero value uses the defaults of
+// the transfer syntax.
+type EncodeParams struct {
+	// JPEGLSNear is the NEAR parameter of JPEG-LS Lossy (Near-Lossless), the maximum
+	// difference of a sample from the original. 0 means the default 2.
+	JPEGLSNear int
+}
+
+// ParamEncoder is implemented by the encoders which take EncodeParams.
+type ParamEncoder interface {
+	// WithParams gets the encoder using the parameters.
+	WithParams(params EncodeParams) Encoder
+}
+
+// LossyEncoder is implemented by the encoders which may not preserve the pixel data.
+type LossyEncoder interface {
+	// LossyMethod gets the Lossy Image Compression Method (0028,2114) of the encoder,
+	// e.g. "ISO_14495_1", or an empty string if the encoder is configured to be lossless.
+	LossyMethod() string
+}
+
+var codecs = make(map[string]Codec)
+
+// RegisterCodec is to register the codec of a transfer syntax.
+func RegisterCodec(transferSyntaxUID string, codec Codec) {
+	codecs[transferSyntaxUID] = codec
+}
+
+// FindCodec gets the codec registered for the transfer syntax.
+func FindCodec(transferSyntaxUID string) (Codec, error) {
+	codec, ok := codecs[transferSyntaxUID]
+	if !ok {
+		str := fmt.Sprintf("not supported compressed format: '%s'", transferSyntaxUID)
+		return nil, errors.New(str)
+	}
+	return codec, nil
+}
+
+// Decompress decodes the given frame of the compressed pixel data, and returns
+// a native image containing the frame only.
+func (di DcmImage) Decompress(frame int) (DcmImage, error) {
+	if !di.IsCompressed {
+		return di, errors.New("Decompress: the pixel data is not compressed")
+	}
+	codec, err := FindCodec(di.TransferSyntaxUID)
+	if err != nil {
+		return di, err
+	}
+
+	var src []byte
+	switch {
+	case frame >= 0 && frame < len(di.Frames):
+		src = di.Frames[frame]
+	case len(di.Frames) == 0 && frame == 0:
+		src = di.PixelData
+	default:
+		str := fmt.Sprintf("Decompress: frame %d out of range", frame)
+		return di, errors.New(str)
+	}
+
+	result := di
+	result.Frames = nil
+	pixelData, err := codec.Decode(src, &result)
+	if err != nil {
+		return di, err
+	}
+	result.PixelData = pixelData
+	result.IsCompressed = false
+	result.IsBigEndian = false
+	result.NumberOfFrames = 1
+	return result, nil
+}
+
+// FindEncoder gets the encoder registered for the transfer syntax.
+func FindEncoder(transferSyntaxUID string) (Encoder, error) {
+	codec, err := FindCodec(transferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	encoder, ok := codec.(Encoder)
+	if !ok {
+		str := fmt.Sprintf("not supported to encode compressed format: '%s'", transferSyntaxUID)
+		return nil, errors.New(str)
+	}
+	return encoder, nil
+}
+
+// NewEncoder gets the encoder registered for the transfer syntax, using the parameters.
+func NewEncoder(transferSyntaxUID string, params EncodeParams) (Encoder, error) {
+	encoder, err := FindEncoder(transferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := encoder.(ParamEncoder); ok {
+		encoder = p.WithParams(params)
+	}
+	return encoder, nil
+}
+
+// Compress encodes each frame of the native pixel data with the encoder of the transfer syntax.
+func (di DcmImage) Compress(transferSyntaxUID string) ([][]byte, error) {
+	return di.CompressWithParams(transferSyntaxUID, EncodeParams{})
+}
+
+// CompressWithParams is the same as Compress, except the encoder uses the parameters.
+func (di DcmImage) CompressWithParams(transferSyntaxUID string, params EncodeParams) ([][]byte, error) {
+	if di.IsCompressed {
+		return nil, errors.New("Compress: the pixel data is already compressed")
+	}
+	encoder, err := NewEncoder(transferSyntaxUID, params)
+	if err != nil {
+		return nil, err
+	}
+	size := di.frameSize()
+	if size == 0 {
+		return nil, errors.New("Compress: the size of the frame is zero")
+	}
+	num := di.NumberOfFrames
+	if num < 1 {
+		num = 1
+	}
+	if len(di.PixelData) < size*num {
+		str := fmt.Sprintf("Compress: the pixel data is %d bytes, want %d", len(di.PixelData), size*num)
+		return nil, errors.New(str)
+	}
+
+	var result [][]byte
+	for i := 0; i < num; i++ {
+		frame, err := encoder.Encode(di.PixelData[size*i:size*(i+1)], di)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, frame)
+	}
+	return result, nil
+}
+
+// frameSize gets the number of bytes of a native frame.
+func (di DcmImage) frameSize() int {
+	return int(di.Rows) * int(di.Columns) * int(di.SamplesPerPixel) * int(di.BitsAllocated) / 8
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"image"
 	"image/color"
+	"io"
 	_ "log" // for debug
 )
 
@@ -25,11 +26,11 @@ type DcmImage struct {
 	PhotometricInterpretation string
 	SamplesPerPixel           uint16
 	PixelRepresentation       uint16
-	//	PlanarConfiguration       uint16
-	RescaleIntercept float64
-	RescaleSlope     float64
-	WindowCenter     float64
-	WindowWidth      float64
+	PlanarConfiguration       uint16
+	RescaleIntercept          float64
+	RescaleSlope              float64
+	WindowCenter              float64
+	WindowWidth               float64
 
 	IsReverse    bool
 	IsCompressed bool
@@ -38,8 +39,10 @@ type DcmImage struct {
 	//	RescaleType          string
 	//	PresentationLUTShape string
 
-	minValue int16
-	maxValue int16
+	// minValue and maxValue are determined once, from the first frame
+	minValue     int16
+	maxValue     int16
+	isMinMaxRead bool
 
 	//	AbsMinimum float64
 	//	AbsMaximum float64
@@ -49,6 +52,16 @@ type DcmImage struct {
 
 	NumberOfFrames int
 	PixelData      []byte
+
+	// PixelDataReader reads the native pixel data of PixelDataSize bytes if PixelData
+	// is nil, so that each frame is read only when it is converted.
+	PixelDataReader io.ReaderAt
+	PixelDataSize   int64
+
+	// TransferSyntaxUID and Frames are used to decode the compressed pixel data.
+	// Frames contains the compressed bit stream of each frame.
+	TransferSyntaxUID string
+	Frames            [][]byte
 }
 
 func maxval(bits uint16, pos uint32) uint32 {
@@ -162,7 +175,7 @@ func (di DcmImage) int16Touint8(pixelData []byte) []uint8 {
 	return result
 }
 
-func (di DcmImage) convertTo8Bit(pixel []byte) []uint8 {
+func (di *DcmImage) convertTo8Bit(pixel []byte) []uint8 {
 	di.determinReverse()
 	if di.BitsAllocated <= 8 {
 		return di.byteTouint8(pixel)
@@ -236,23 +249,33 @@ func (di *DcmImage) determineMinMax() {
 
 	// skip to find the max/min value if window level is not 0
 
-	if (di.WindowCenter != 0.0) || (di.WindowWidth != 0.0) {
+	if (di.WindowCenter != 0.0) || (di.WindowWidth != 0.0) || di.isMinMaxRead {
 		return
 	}
 
 	//	di.findAbsMaxMinValue()
+	// the min/max value of the first frame is used for all the frames, so it is read
+	// only once
+	pixelData := di.PixelData
+	if pixelData == nil && di.PixelDataReader != nil {
+		var err error
+		pixelData, err = di.readPixelDataOfFrame(0)
+		if err != nil {
+			return
+		}
+	}
 	count := di.Columns * di.Rows
 	for i := uint32(0); i < count; i++ {
 		var pixel int16
 		if di.BitsAllocated > 8 {
-			b := di.PixelData[2*i : 2*i+2]
+			b := pixelData[2*i : 2*i+2]
 			if di.IsBigEndian {
 				pixel = int16(binary.BigEndian.Uint16(b))
 			} else {
 				pixel = int16(binary.LittleEndian.Uint16(b))
 			}
 		} else {
-			pixel = int16(di.PixelData[i])
+			pixel = int16(pixelData[i])
 		}
 
 		if i == 0 {
@@ -266,6 +289,7 @@ func (di *DcmImage) determineMinMax() {
 			di.maxValue = pixel
 		}
 	}
+	di.isMinMaxRead = true
 
 	//	log.Println("min", di.minValue, "max", di.maxValue)
 }
@@ -277,6 +301,9 @@ func (di DcmImage) getPixelDataOfFrame(frame int) ([]byte, error) {
 		err := errors.New("getPixelDataOfFrame : SamplesPerPixel is zero")
 		return nil, err
 	}
+	if di.PixelData == nil && di.PixelDataReader != nil {
+		return di.readPixelDataOfFrame(frame)
+	}
 	num := len(di.PixelData) / size
 
 	if frame > num {
@@ -286,11 +313,30 @@ func (di DcmImage) getPixelDataOfFrame(frame int) ([]byte, error) {
 	return di.PixelData[size*frame : size*frame+size], nil
 }
 
-func (di DcmImage) convertToImage(frame int) (image.Image, error) {
-	if di.IsCompressed {
-		err := errors.New("not supported compressed format")
+// readPixelDataOfFrame reads the frame from PixelDataReader.
+func (di DcmImage) readPixelDataOfFrame(frame int) ([]byte, error) {
+	size := di.frameSize()
+	num := int(di.PixelDataSize) / size
+	if frame < 0 || frame >= num {
+		err := errors.New("getPixelDataOfFrame : out of range")
 		return nil, err
 	}
+	result := make([]byte, size)
+	_, err := di.PixelDataReader.ReadAt(result, int64(size*frame))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (di *DcmImage) convertToImage(frame int) (image.Image, error) {
+	if di.IsCompressed {
+		native, err := di.Decompress(frame)
+		if err != nil {
+			return nil, err
+		}
+		return native.convertToImage(0)
+	}
 	pixelData, err := di.getPixelDataOfFrame(frame)
 	if err != nil {
 		return nil, err
@@ -1,11 +1,15 @@
-package dcmimage
+package dcmimage_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"math"
 	"os"
 	"strconv"
 	"testing"
 
 	"github.com/grayzone/godcm/core"
+	"github.com/grayzone/godcm/dcmimage"
 	"github.com/grayzone/godcm/util"
 )
 
@@ -35,7 +39,7 @@ func gettestdataset() []testCase {
 	return result
 }
 
-func readpixel(t *testing.T, filename string, want bool) DcmImage {
+func readpixel(t *testing.T, filename string, want bool) dcmimage.DcmImage {
 	var reader core.DcmReader
 	reader.IsReadPixel = true
 	reader.IsReadValue = true
@@ -54,7 +58,7 @@ func readpixel(t *testing.T, filename string, want bool) DcmImage {
 
 	pixeldata := reader.Dataset.PixelData()
 
-	var img DcmImage
+	var img dcmimage.DcmImage
 
 	img.IsCompressed = isCompressed
 	if want != img.IsCompressed {
@@ -239,3 +243,200 @@ func TestWriteJPG16(t *testing.T) {
 	}
 }
 */
+
+func TestDecompress(t *testing.T) {
+	cases := []struct {
+		in          string
+		photometric string
+		size        int
+	}{
+		{"GH195.dcm", "RGB", 795 * 445 * 3},
+		{"xr_chicken2.dcm", "MONOCHROME1", 2505 * 3015 * 2},
+		{"GH133.dcm", "MONOCHROME2", 2394 * 3062 * 2},
+		{"GH184.dcm", "MONOCHROME2", 128 * 128 * 2},
+		{"GH064.dcm", "MONOCHROME2", 256 * 256 * 2},
+		{"IM-0001-0010.dcm", "MONOCHROME2", 512 * 288 * 2},
+		{"CT1_J2KI", "MONOCHROME2", 512 * 512 * 2},
+	}
+	for _, c := range cases {
+		var reader core.DcmReader
+		reader.IsReadPixel = true
+		reader.IsReadValue = true
+		err := reader.ReadFile(util.GetTestDataFolder() + c.in)
+		if err != nil {
+			t.Fatalf("ReadFile(%s): %s", c.in, err.Error())
+		}
+		img := reader.GetImageInfo()
+		native, err := img.Decompress(0)
+		if err != nil {
+			t.Errorf("Decompress(%s): %s", c.in, err.Error())
+			continue
+		}
+		if native.IsCompressed || native.PhotometricInterpretation != c.photometric {
+			t.Errorf("Decompress(%s), want uncompressed %s got %v %s", c.in, c.photometric, native.IsCompressed, native.PhotometricInterpretation)
+		}
+		if len(native.PixelData) != c.size {
+			t.Errorf("Decompress(%s), want %d bytes got %d", c.in, c.size, len(native.PixelData))
+		}
+		_, err = img.Decompress(1)
+		if err == nil {
+			t.Errorf("Decompress(%s) frame 1 should be out of range", c.in)
+		}
+
+		newfile := c.in + "_decompress.png"
+		err = img.ConvertToPNG(newfile, 0)
+		if err != nil {
+			t.Errorf("ConvertToPNG(%s): %s", c.in, err.Error())
+		}
+		os.Remove(newfile)
+	}
+}
+
+func readTestPixels(t *testing.T, filename string) []int16 {
+	var reader core.DcmReader
+	reader.IsReadPixel = true
+	reader.IsReadValue = true
+	err := reader.ReadFile(util.GetTestDataFolder() + filename)
+	if err != nil {
+		t.Fatalf("ReadFile(%s): %s", filename, err.Error())
+	}
+	img := reader.GetImageInfo()
+	if img.IsCompressed {
+		img, err = img.Decompress(0)
+		if err != nil {
+			t.Fatalf("Decompress(%s): %s", filename, err.Error())
+		}
+	}
+	pixels := make([]int16, len(img.PixelData)/2)
+	for i := range pixels {
+		if img.IsBigEndian {
+			pixels[i] = int16(binary.BigEndian.Uint16(img.PixelData[2*i:]))
+		} else {
+			pixels[i] = int16(binary.LittleEndian.Uint16(img.PixelData[2*i:]))
+		}
+	}
+	return pixels
+}
+
+func TestDecompressJPEG2000Irreversible(t *testing.T) {
+	// CT1_J2KI is the lossy compressed image of CT1 with the 9-7 wavelet
+	got := readTestPixels(t, "CT1_J2KI")
+	want := readTestPixels(t, "GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm")
+	if len(got) != len(want) {
+		t.Fatalf("Decompress(CT1_J2KI), want %d pixels got %d", len(want), len(got))
+	}
+	var sum float64
+	for i := range got {
+		d := float64(got[i]) - float64(want[i])
+		sum += d * d
+	}
+	rmse := math.Sqrt(sum / float64(len(got)))
+	if rmse > 50 {
+		t.Errorf("Decompress(CT1_J2KI), the root mean square error to CT1 is %f", rmse)
+	}
+}
+
+func TestCompressLossless(t *testing.T) {
+	uids := []string{
+		core.UIDRLELosslessTransferSyntax,
+		core.UIDJPEGLSLosslessTransferSyntax,
+	}
+	cases := []string{
+		"MR-MONO2-8-16x-heart.dcm",
+		"US-RGB-8-esopecho.dcm",
+		"CT-MONO2-16-ankle",
+		"GH177_D_CLUNIE_CT1_IVRLE_BigEndian_undefined_length.dcm",
+	}
+	for _, uid := range uids {
+		for _, c := range cases {
+			var reader core.DcmReader
+			reader.IsReadPixel = true
+			reader.IsReadValue = true
+			err := reader.ReadFile(util.GetTestDataFolder() + c)
+			if err != nil {
+				t.Fatalf("ReadFile(%s): %s", c, err.Error())
+			}
+			img := reader.GetImageInfo()
+			frames, err := img.Compress(uid)
+			if err != nil {
+				t.Errorf("Compress(%s, %s): %s", c, uid, err.Error())
+				continue
+			}
+			if len(frames) != img.NumberOfFrames {
+				t.Errorf("Compress(%s, %s), want %d frames got %d", c, uid, img.NumberOfFrames, len(frames))
+				continue
+			}
+
+			compressed := img
+			compressed.IsCompressed = true
+			compressed.TransferSyntaxUID = uid
+			compressed.Frames = frames
+			compressed.PixelData = nil
+			size := len(img.PixelData) / img.NumberOfFrames
+			for i := range frames {
+				native, err := compressed.Decompress(i)
+				if err != nil {
+					t.Errorf("Decompress(%s, %s, %d): %s", c, uid, i, err.Error())
+					continue
+				}
+				want := img.PixelData[size*i : size*(i+1)]
+				if img.IsBigEndian {
+					want = make([]byte, size)
+					for j := 0; j+1 < size; j += 2 {
+						want[j] = img.PixelData[size*i+j+1]
+						want[j+1] = img.PixelData[size*i+j]
+					}
+				}
+				if !bytes.Equal(native.PixelData, want) {
+					t.Errorf("Decompress(%s, %s, %d), the pixel data is changed", c, uid, i)
+				}
+			}
+		}
+	}
+}
+
+// countReader counts the reads of the first frame.
+type countReader struct {
+	*bytes.Reader
+	firstFrame int
+}
+
+func (r *countReader) ReadAt(p []byte, off int64) (int, error) {
+	if off == 0 {
+		r.firstFrame++
+	}
+	return r.Reader.ReadAt(p, off)
+}
+
+func TestConvertMinMaxOnce(t *testing.T) {
+	pixels := make([]byte, 3*4*2)
+	for i := range pixels {
+		pixels[i] = byte(i * 10)
+	}
+	reader := &countReader{Reader: bytes.NewReader(pixels)}
+	img := dcmimage.DcmImage{
+		Rows:                      2,
+		Columns:                   2,
+		BitsAllocated:             16,
+		BitsStored:                16,
+		HighBit:                   15,
+		PhotometricInterpretation: "MONOCHROME2",
+		SamplesPerPixel:           1,
+		RescaleSlope:              1,
+		NumberOfFrames:            3,
+		PixelDataReader:           reader,
+		PixelDataSize:             int64(len(pixels)),
+	}
+	for i := 0; i < img.NumberOfFrames; i++ {
+		newfile := "minmax_" + strconv.Itoa(i) + ".png"
+		err := img.ConvertToPNG(newfile, i)
+		if err != nil {
+			t.Fatalf("ConvertToPNG(%d): %s", i, err.Error())
+		}
+		defer os.Remove(newfile)
+	}
+	// the first frame is read once for the min/max value, and once to be converted
+	if reader.firstFrame != 2 {
+		t.Errorf("ConvertToPNG(), want the first frame read 2 times got %d", reader.firstFrame)
+	}
+}
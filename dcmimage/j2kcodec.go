@@ -0,0 +1,659 @@
+package dcmimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+func init() {
+	// JPEG 2000 Image Compression (Lossless Only)
+	RegisterCodec("1.2.840.10008.1.2.4.90", j2kCodec{})
+	// JPEG 2000 Image Compression
+	RegisterCodec("1.2.840.10008.1.2.4.91", j2kCodec{})
+	// the High-Throughput JPEG 2000 transfer syntaxes (.201, .202 and .203) are not
+	// registered, as the HTJ2K block coder is not supported
+}
+
+// JPEG 2000 markers
+const (
+	j2kSOC = 0xFF4F
+	j2kCAP = 0xFF50
+	j2kSIZ = 0xFF51
+	j2kCOD = 0xFF52
+	j2kCOC = 0xFF53
+	j2kQCD = 0xFF5C
+	j2kQCC = 0xFF5D
+	j2kRGN = 0xFF5E
+	j2kPOC = 0xFF5F
+	j2kPPM = 0xFF60
+	j2kPPT = 0xFF61
+	j2kSOT = 0xFF90
+	j2kSOP = 0xFF91
+	j2kEPH = 0xFF92
+	j2kSOD = 0xFF93
+	j2kEOC = 0xFFD9
+)
+
+// JPEG 2000 progression orders
+const (
+	j2kLRCP = iota
+	j2kRLCP
+	j2kRPCL
+	j2kPCRL
+	j2kCPRL
+)
+
+// code-block styles
+const (
+	j2kBypass    = 0x01
+	j2kReset     = 0x02
+	j2kTermAll   = 0x04
+	j2kCausal    = 0x08
+	j2kSegSymbol = 0x20
+	j2kHT        = 0x40
+)
+
+// j2kCodec decodes the JPEG 2000 codestream defined in ISO/IEC 15444-1, and encodes
+// it losslessly. The High-Throughput block coder of ISO/IEC 15444-15 is not supported.
+type j2kCodec struct{}
+
+// Decode decodes a JPEG 2000 codestream or a JP2 file. The YBR_RCT and YBR_ICT
+// color spaces are converted to RGB.
+func (j2kCodec) Decode(src []byte, di *DcmImage) ([]byte, error) {
+	var d j2kDecoder
+	err := d.decode(src)
+	if err != nil {
+		return nil, err
+	}
+	if d.isMCT {
+		di.PhotometricInterpretation = "RGB"
+	}
+	return d.pixelData(di)
+}
+
+type j2kComponentInfo struct {
+	depth    int
+	isSigned bool
+	dx       int
+	dy       int
+}
+
+// j2kCodingStyle contains the parameters of COD, shared by all components of a tile.
+type j2kCodingStyle struct {
+	isSOP       bool
+	isEPH       bool
+	progression int
+	layers      int
+	mct         int
+}
+
+// j2kComponentStyle contains the parameters of COD or COC for a component.
+type j2kComponentStyle struct {
+	levels    int
+	xcb       int
+	ycb       int
+	cbStyle   byte
+	transform byte // 0: 9-7 irreversible, 1: 5-3 reversible
+	ppx       []int
+	ppy       []int
+}
+
+type j2kQuantization struct {
+	style     int // 0: no quantization, 1: scalar derived, 2: scalar expounded
+	guard     int
+	exponents []int
+	mantissas []int
+}
+
+// j2kProgressionChange contains a progression of POC, or the default progression.
+type j2kProgressionChange struct {
+	resStart    int
+	compStart   int
+	layerEnd    int
+	resEnd      int
+	compEnd     int
+	progression int
+}
+
+// j2kHeader contains the markers of the main header or a tile header.
+type j2kHeader struct {
+	cod       *j2kCodingStyle
+	compStyle *j2kComponentStyle
+	coc       map[int]*j2kComponentStyle
+	qcd       *j2kQuantization
+	qcc       map[int]*j2kQuantization
+	rgn       map[int]int
+	pocs      []j2kProgressionChange
+}
+
+func newJ2KHeader() *j2kHeader {
+	var h j2kHeader
+	h.coc = make(map[int]*j2kComponentStyle)
+	h.qcc = make(map[int]*j2kQuantization)
+	h.rgn = make(map[int]int)
+	return &h
+}
+
+type j2kTilePart struct {
+	header *j2kHeader
+	data   []byte
+}
+
+// j2kDecoder decodes the JPEG 2000 codestream.
+type j2kDecoder struct {
+	data []byte
+	pos  int
+
+	// image and tile size from SIZ
+	x0, y0, x1, y1 int
+	tx0, ty0       int
+	tw, th         int
+	numTilesX      int
+	numTilesY      int
+	components     []j2kComponentInfo
+
+	main  *j2kHeader
+	tiles map[int]*j2kTilePart
+
+	isMCT bool
+
+	// the decoded samples of each component
+	planes [][]int32
+}
+
+func (d *j2kDecoder) decode(data []byte) error {
+	codestream, err := j2kCodestream(data)
+	if err != nil {
+		return err
+	}
+	d.data = codestream
+	if len(d.data) < 2 || binary.BigEndian.Uint16(d.data) != j2kSOC {
+		return errors.New("j2kDecoder: missing SOC marker")
+	}
+	d.pos = 2
+	d.main = newJ2KHeader()
+	d.tiles = make(map[int]*j2kTilePart)
+
+	err = d.readMainHeader()
+	if err != nil {
+		return err
+	}
+	for d.pos+2 <= len(d.data) {
+		marker := binary.BigEndian.Uint16(d.data[d.pos:])
+		if marker == j2kEOC {
+			break
+		}
+		if marker != j2kSOT {
+			str := fmt.Sprintf("j2kDecoder: unexpected marker 0x%X, want SOT", marker)
+			return errors.New(str)
+		}
+		err = d.readTilePart()
+		if err != nil {
+			return err
+		}
+	}
+
+	d.planes = make([][]int32, len(d.components))
+	for c, comp := range d.components {
+		w := ceilDiv(d.x1, comp.dx) - ceilDiv(d.x0, comp.dx)
+		h := ceilDiv(d.y1, comp.dy) - ceilDiv(d.y0, comp.dy)
+		d.planes[c] = make([]int32, w*h)
+	}
+	for i := 0; i < d.numTilesX*d.numTilesY; i++ {
+		tile, ok := d.tiles[i]
+		if !ok {
+			continue
+		}
+		err = d.decodeTile(i, tile)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// j2kCodestream gets the contiguous codestream box of a JP2 file, or the codestream itself.
+func j2kCodestream(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[4:8]) != "jP  " {
+		return data, nil
+	}
+	pos := 0
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		boxType := string(data[pos+4 : pos+8])
+		header := 8
+		switch length {
+		case 0:
+			length = len(data) - pos
+		case 1:
+			if pos+16 > len(data) {
+				return nil, errors.New("j2kDecoder: invalid JP2 box")
+			}
+			length = int(binary.BigEndian.Uint64(data[pos+8:]))
+			header = 16
+		}
+		if length < header || pos+length > len(data) {
+			return nil, errors.New("j2kDecoder: invalid JP2 box")
+		}
+		if boxType == "jp2c" {
+			return data[pos+header : pos+length], nil
+		}
+		pos += length
+	}
+	return nil, errors.New("j2kDecoder: missing contiguous codestream box in JP2 file")
+}
+
+// readSegment reads the marker segment at the current position.
+func (d *j2kDecoder) readSegment() (uint16, []byte, error) {
+	if d.pos+4 > len(d.data) {
+		return 0, nil, errors.New("j2kDecoder: unexpected end of codestream")
+	}
+	marker := binary.BigEndian.Uint16(d.data[d.pos:])
+	length := int(binary.BigEndian.Uint16(d.data[d.pos+2:]))
+	if length < 2 || d.pos+2+length > len(d.data) {
+		str := fmt.Sprintf("j2kDecoder: invalid length of marker 0x%X", marker)
+		return 0, nil, errors.New(str)
+	}
+	segment := d.data[d.pos+4 : d.pos+2+length]
+	d.pos += 2 + length
+	return marker, segment, nil
+}
+
+func (d *j2kDecoder) readMainHeader() error {
+	marker, segment, err := d.readSegment()
+	if err != nil {
+		return err
+	}
+	if marker != j2kSIZ {
+		return errors.New("j2kDecoder: missing SIZ marker")
+	}
+	err = d.parseSIZ(segment)
+	if err != nil {
+		return err
+	}
+	for d.pos+2 <= len(d.data) && binary.BigEndian.Uint16(d.data[d.pos:]) != j2kSOT {
+		marker, segment, err := d.readSegment()
+		if err != nil {
+			return err
+		}
+		if marker == j2kCAP {
+			// the Pcap bit for ISO/IEC 15444-15
+			if len(segment) >= 4 && binary.BigEndian.Uint32(segment)&(1<<(32-15)) != 0 {
+				return errors.New("j2kDecoder: the HTJ2K block coder is not supported")
+			}
+			continue
+		}
+		err = d.parseHeaderMarker(d.main, marker, segment)
+		if err != nil {
+			return err
+		}
+	}
+	if d.main.cod == nil || d.main.qcd == nil {
+		return errors.New("j2kDecoder: missing COD or QCD marker in the main header")
+	}
+	return nil
+}
+
+func (d *j2kDecoder) parseSIZ(segment []byte) error {
+	if len(segment) < 36 {
+		return errors.New("j2kDecoder: invalid SIZ marker")
+	}
+	d.x1 = int(binary.BigEndian.Uint32(segment[2:]))
+	d.y1 = int(binary.BigEndian.Uint32(segment[6:]))
+	d.x0 = int(binary.BigEndian.Uint32(segment[10:]))
+	d.y0 = int(binary.BigEndian.Uint32(segment[14:]))
+	d.tw = int(binary.BigEndian.Uint32(segment[18:]))
+	d.th = int(binary.BigEndian.Uint32(segment[22:]))
+	d.tx0 = int(binary.BigEndian.Uint32(segment[26:]))
+	d.ty0 = int(binary.BigEndian.Uint32(segment[30:]))
+	num := int(binary.BigEndian.Uint16(segment[34:]))
+	if d.x1 <= d.x0 || d.y1 <= d.y0 || d.tw == 0 || d.th == 0 || d.tx0 > d.x0 || d.ty0 > d.y0 {
+		return errors.New("j2kDecoder: invalid image or tile size in SIZ marker")
+	}
+	if num == 0 || len(segment) < 36+3*num {
+		return errors.New("j2kDecoder: invalid number of components in SIZ marker")
+	}
+	for i := 0; i < num; i++ {
+		var comp j2kComponentInfo
+		ssiz := segment[36+3*i]
+		comp.depth = int(ssiz&0x7F) + 1
+		comp.isSigned = ssiz&0x80 != 0
+		comp.dx = int(segment[37+3*i])
+		comp.dy = int(segment[38+3*i])
+		if comp.depth > 31 || comp.dx == 0 || comp.dy == 0 {
+			return errors.New("j2kDecoder: invalid component in SIZ marker")
+		}
+		d.components = append(d.components, comp)
+	}
+	d.numTilesX = ceilDiv(d.x1-d.tx0, d.tw)
+	d.numTilesY = ceilDiv(d.y1-d.ty0, d.th)
+	return nil
+}
+
+// componentIndex reads the component index of COC, QCC and RGN, in 1 or 2 bytes.
+func (d *j2kDecoder) componentIndex(segment []byte) (int, []byte, error) {
+	size := 1
+	if len(d.components) >= 257 {
+		size = 2
+	}
+	if len(segment) < size {
+		return 0, nil, errors.New("j2kDecoder: invalid component index")
+	}
+	c := int(segment[0])
+	if size == 2 {
+		c = int(binary.BigEndian.Uint16(segment))
+	}
+	if c >= len(d.components) {
+		return 0, nil, errors.New("j2kDecoder: invalid component index")
+	}
+	return c, segment[size:], nil
+}
+
+func (d *j2kDecoder) parseHeaderMarker(h *j2kHeader, marker uint16, segment []byte) error {
+	switch marker {
+	case j2kCOD:
+		if len(segment) < 5 {
+			return errors.New("j2kDecoder: invalid COD marker")
+		}
+		var cod j2kCodingStyle
+		cod.isSOP = segment[0]&0x02 != 0
+		cod.isEPH = segment[0]&0x04 != 0
+		cod.progression = int(segment[1])
+		cod.layers = int(binary.BigEndian.Uint16(segment[2:]))
+		cod.mct = int(segment[4])
+		if cod.progression > j2kCPRL || cod.layers == 0 {
+			return errors.New("j2kDecoder: invalid COD marker")
+		}
+		style, err := parseJ2KComponentStyle(segment[0]&0x01 != 0, segment[5:])
+		if err != nil {
+			return err
+		}
+		h.cod = &cod
+		h.compStyle = style
+	case j2kCOC:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		if len(rest) < 1 {
+			return errors.New("j2kDecoder: invalid COC marker")
+		}
+		style, err := parseJ2KComponentStyle(rest[0]&0x01 != 0, rest[1:])
+		if err != nil {
+			return err
+		}
+		h.coc[c] = style
+	case j2kQCD:
+		q, err := parseJ2KQuantization(segment)
+		if err != nil {
+			return err
+		}
+		h.qcd = q
+	case j2kQCC:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		q, err := parseJ2KQuantization(rest)
+		if err != nil {
+			return err
+		}
+		h.qcc[c] = q
+	case j2kRGN:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		if len(rest) < 2 || rest[0] != 0 {
+			return errors.New("j2kDecoder: invalid RGN marker")
+		}
+		h.rgn[c] = int(rest[1])
+	case j2kPOC:
+		size := 7
+		if len(d.components) >= 257 {
+			size = 9
+		}
+		for len(segment) >= size {
+			var poc j2kProgressionChange
+			poc.resStart = int(segment[0])
+			if size == 7 {
+				poc.compStart = int(segment[1])
+				poc.layerEnd = int(binary.BigEndian.Uint16(segment[2:]))
+				poc.resEnd = int(segment[4])
+				poc.compEnd = int(segment[5])
+				poc.progression = int(segment[6])
+			} else {
+				poc.compStart = int(binary.BigEndian.Uint16(segment[1:]))
+				poc.layerEnd = int(binary.BigEndian.Uint16(segment[3:]))
+				poc.resEnd = int(segment[5])
+				poc.compEnd = int(binary.BigEndian.Uint16(segment[6:]))
+				poc.progression = int(segment[8])
+			}
+			if poc.compEnd == 0 {
+				poc.compEnd = 256
+			}
+			if poc.progression > j2kCPRL {
+				return errors.New("j2kDecoder: invalid POC marker")
+			}
+			h.pocs = append(h.pocs, poc)
+			segment = segment[size:]
+		}
+	case j2kPPM, j2kPPT:
+		return errors.New("j2kDecoder: the packed packet headers are not supported")
+	}
+	// the other markers, e.g. TLM, PLM, PLT, CRG and COM, are informational
+	return nil
+}
+
+func parseJ2KComponentStyle(isPrecinct bool, segment []byte) (*j2kComponentStyle, error) {
+	if len(segment) < 5 {
+		return nil, errors.New("j2kDecoder: invalid coding style")
+	}
+	var style j2kComponentStyle
+	style.levels = int(segment[0])
+	style.xcb = int(segment[1]&0x0F) + 2
+	style.ycb = int(segment[2]&0x0F) + 2
+	style.cbStyle = segment[3]
+	style.transform = segment[4]
+	if style.levels > 32 || style.xcb+style.ycb > 12 || style.transform > 1 {
+		return nil, errors.New("j2kDecoder: invalid coding style")
+	}
+	if style.cbStyle&j2kHT != 0 {
+		return nil, errors.New("j2kDecoder: the HTJ2K block coder is not supported")
+	}
+	for r := 0; r <= style.levels; r++ {
+		ppx, ppy := 15, 15
+		if isPrecinct {
+			if len(segment) < 6+r {
+				return nil, errors.New("j2kDecoder: invalid precinct size")
+			}
+			ppx = int(segment[5+r] & 0x0F)
+			ppy = int(segment[5+r] >> 4)
+			if r > 0 && (ppx == 0 || ppy == 0) {
+				return nil, errors.New("j2kDecoder: invalid precinct size")
+			}
+		}
+		style.ppx = append(style.ppx, ppx)
+		style.ppy = append(style.ppy, ppy)
+	}
+	return &style, nil
+}
+
+func parseJ2KQuantization(segment []byte) (*j2kQuantization, error) {
+	if len(segment) < 1 {
+		return nil, errors.New("j2kDecoder: invalid quantization")
+	}
+	var q j2kQuantization
+	q.style = int(segment[0] & 0x1F)
+	q.guard = int(segment[0] >> 5)
+	segment = segment[1:]
+	switch q.style {
+	case 0:
+		for _, v := range segment {
+			q.exponents = append(q.exponents, int(v>>3))
+			q.mantissas = append(q.mantissas, 0)
+		}
+	case 1, 2:
+		for len(segment) >= 2 {
+			v := int(binary.BigEndian.Uint16(segment))
+			q.exponents = append(q.exponents, v>>11)
+			q.mantissas = append(q.mantissas, v&0x7FF)
+			segment = segment[2:]
+		}
+	default:
+		return nil, errors.New("j2kDecoder: invalid quantization style")
+	}
+	if len(q.exponents) == 0 {
+		return nil, errors.New("j2kDecoder: invalid quantization")
+	}
+	return &q, nil
+}
+
+func (d *j2kDecoder) readTilePart() error {
+	start := d.pos
+	marker, segment, err := d.readSegment()
+	if err != nil {
+		return err
+	}
+	if marker != j2kSOT || len(segment) < 8 {
+		return errors.New("j2kDecoder: invalid SOT marker")
+	}
+	index := int(binary.BigEndian.Uint16(segment))
+	length := int(binary.BigEndian.Uint32(segment[2:]))
+	if index >= d.numTilesX*d.numTilesY {
+		str := fmt.Sprintf("j2kDecoder: invalid tile index %d", index)
+		return errors.New(str)
+	}
+	end := start + length
+	if length == 0 {
+		// the last tile-part extends to the EOC marker
+		end = len(d.data)
+		if end >= 2 && binary.BigEndian.Uint16(d.data[end-2:]) == j2kEOC {
+			end -= 2
+		}
+	}
+	if end > len(d.data) {
+		// a truncated codestream
+		end = len(d.data)
+	}
+
+	tile, ok := d.tiles[index]
+	if !ok {
+		tile = &j2kTilePart{header: newJ2KHeader()}
+		d.tiles[index] = tile
+	}
+	for {
+		if d.pos+2 > end {
+			return errors.New("j2kDecoder: missing SOD marker")
+		}
+		if binary.BigEndian.Uint16(d.data[d.pos:]) == j2kSOD {
+			d.pos += 2
+			break
+		}
+		marker, segment, err := d.readSegment()
+		if err != nil {
+			return err
+		}
+		err = d.parseHeaderMarker(tile.header, marker, segment)
+		if err != nil {
+			return err
+		}
+	}
+	if d.pos > end {
+		return errors.New("j2kDecoder: invalid tile-part length")
+	}
+	tile.data = append(tile.data, d.data[d.pos:end]...)
+	d.pos = end
+	return nil
+}
+
+// codingStyle gets the coding style of the tile.
+func (d *j2kDecoder) codingStyle(h *j2kHeader) *j2kCodingStyle {
+	if h.cod != nil {
+		return h.cod
+	}
+	return d.main.cod
+}
+
+// componentStyle gets the coding style of the component of the tile, COC of the tile
+// takes precedence over COD of the tile, COC of the main header and COD of the main header.
+func (d *j2kDecoder) componentStyle(h *j2kHeader, c int) *j2kComponentStyle {
+	if style, ok := h.coc[c]; ok {
+		return style
+	}
+	if h.compStyle != nil {
+		return h.compStyle
+	}
+	if style, ok := d.main.coc[c]; ok {
+		return style
+	}
+	return d.main.compStyle
+}
+
+// quantization gets the quantization of the component of the tile in the same precedence.
+func (d *j2kDecoder) quantization(h *j2kHeader, c int) *j2kQuantization {
+	if q, ok := h.qcc[c]; ok {
+		return q
+	}
+	if h.qcd != nil {
+		return h.qcd
+	}
+	if q, ok := d.main.qcc[c]; ok {
+		return q
+	}
+	return d.main.qcd
+}
+
+func (d *j2kDecoder) roiShift(h *j2kHeader, c int) int {
+	if shift, ok := h.rgn[c]; ok {
+		return shift
+	}
+	return d.main.rgn[c]
+}
+
+// pixelData interleaves the samples of the components.
+func (d *j2kDecoder) pixelData(di *DcmImage) ([]byte, error) {
+	width := d.x1 - d.x0
+	height := d.y1 - d.y0
+	if di.Columns != 0 && (int(di.Columns) != width || int(di.Rows) != height) {
+		str := fmt.Sprintf("j2kDecoder: the image size %dx%d does not match %dx%d", width, height, di.Columns, di.Rows)
+		return nil, errors.New(str)
+	}
+	if di.SamplesPerPixel != 0 && int(di.SamplesPerPixel) != len(d.components) {
+		str := fmt.Sprintf("j2kDecoder: the number of components %d does not match SamplesPerPixel %d", len(d.components), di.SamplesPerPixel)
+		return nil, errors.New(str)
+	}
+	bytesPerSample := int(di.BitsAllocated+7) / 8
+	if bytesPerSample == 0 {
+		bytesPerSample = (d.components[0].depth + 7) / 8
+	}
+
+	result := make([]byte, width*height*len(d.components)*bytesPerSample)
+	index := 0
+	for y := d.y0; y < d.y1; y++ {
+		for x := d.x0; x < d.x1; x++ {
+			for c, comp := range d.components {
+				cw := ceilDiv(d.x1, comp.dx) - ceilDiv(d.x0, comp.dx)
+				cx := ceilDiv(x, comp.dx) - ceilDiv(d.x0, comp.dx)
+				cy := ceilDiv(y, comp.dy) - ceilDiv(d.y0, comp.dy)
+				v := d.planes[c][cy*cw+cx]
+				for b := 0; b < bytesPerSample; b++ {
+					result[index+b] = byte(v >> uint(8*b))
+				}
+				index += bytesPerSample
+			}
+		}
+	}
+	return result, nil
+}
+
+func ceilDiv(a int, b int) int {
+	return (a + b - 1) / b
+}
+
+// j2kStepSize gets the quantization step size of a subband.
+func j2kStepSize(depth int, gain int, exponent int, mantissa int) float64 {
+	return math.Ldexp(1+float64(mantissa)/2048, depth+gain-exponent)
+}
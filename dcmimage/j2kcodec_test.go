@@ -0,0 +1,331 @@
+package dcmimage
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestJ2KMQDecoder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, p := range []float64{0.5, 0.1, 0.01, 0.9} {
+		contexts := make([]int, 5000)
+		bits := make([]uint8, len(contexts))
+		enc := newJ2KMQEncoder()
+		for i := range contexts {
+			contexts[i] = r.Intn(j2kNumCtx)
+			if r.Float64() < p {
+				bits[i] = 1
+			}
+			enc.encode(contexts[i], bits[i])
+		}
+		data := enc.flush()
+
+		var dec j2kMQDecoder
+		dec.resetContexts()
+		dec.init(data)
+		for i := range contexts {
+			if got := dec.decode(contexts[i]); got != int(bits[i]) {
+				t.Errorf("decode() probability %f, symbol %d want %d got %d", p, i, bits[i], got)
+				break
+			}
+		}
+	}
+}
+
+func TestJ2KRawDecoder(t *testing.T) {
+	// the bit after 0xFF is stuffed
+	var raw j2kRawDecoder
+	raw.init([]byte{0xFF, 0x55})
+	want := []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 0, 1, 0, 1}
+	for i, w := range want {
+		if got := raw.decode(); got != w {
+			t.Errorf("decode() bit %d, want %d got %d", i, w, got)
+		}
+	}
+}
+
+func TestJ2KBitReader(t *testing.T) {
+	cases := []struct {
+		in   []byte
+		want []int
+	}{
+		// 0, 10, 1100, 1101 1110, 1111 00000, 1111 11111 0000000
+		{[]byte{0x59, 0xBD, 0xE0, 0xFF, 0x40, 0x7F}, []int{1, 2, 3, 4, 5, 6, 37}},
+		// 1111 11110, the first bit of the byte after 0xFF is stuffed
+		{[]byte{0xFF, 0x3F, 0x80}, []int{36}},
+	}
+	for _, c := range cases {
+		br := j2kBitReader{data: c.in}
+		for i, w := range c.want {
+			got, err := br.numPasses()
+			if err != nil || got != w {
+				t.Errorf("numPasses(% X) %d, want %d got %d (%v)", c.in, i, w, got, err)
+				break
+			}
+		}
+	}
+
+	// the header ends after the byte following 0xFF
+	br := j2kBitReader{data: []byte{0xFF, 0x00, 0x12}}
+	br.readBits(8)
+	br.align()
+	if br.pos != 2 {
+		t.Errorf("align(), want position 2 got %d", br.pos)
+	}
+}
+
+func TestJ2KTagTree(t *testing.T) {
+	// the leaves 1 and 3 with the root 1, coded as
+	// leaf 0: root 0 1, leaf 1; leaf 1: leaf 0 0 1
+	values := []int{1, 3}
+	tree := newJ2KTagTree(2, 1)
+	br := j2kBitReader{data: []byte{0x64}}
+	for i, want := range values {
+		v := 0
+		for {
+			known, err := tree.decode(&br, i, v+1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if known {
+				break
+			}
+			v++
+		}
+		if v != want {
+			t.Errorf("decode() leaf %d, want %d got %d", i, want, v)
+		}
+	}
+}
+
+func TestJ2KInverseTransform1D(t *testing.T) {
+	for _, isReversible := range []bool{true, false} {
+		for n := 1; n < 12; n++ {
+			for i0 := 0; i0 < 3; i0++ {
+				want := make([]float64, n)
+				for i := range want {
+					want[i] = float64((i*37+11)%64 - 20)
+				}
+				got := append([]float64(nil), want...)
+				forwardTransform1D(got, i0, isReversible)
+				inverseTransform1D(got, i0, isReversible)
+				for i := range want {
+					if math.Abs(got[i]-want[i]) > 1e-6 {
+						t.Errorf("inverseTransform1D() reversible %v, length %d from %d, want %v got %v", isReversible, n, i0, want, got)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestJ2KInverseRCT(t *testing.T) {
+	r := []float64{0, 255, 17, -3}
+	g := []float64{0, 128, 200, 5}
+	b := []float64{0, 1, 99, -7}
+	// the forward reversible color transform
+	c0 := make([]float64, len(r))
+	c1 := make([]float64, len(r))
+	c2 := make([]float64, len(r))
+	for i := range r {
+		c0[i] = math.Floor((r[i] + 2*g[i] + b[i]) / 4)
+		c1[i] = b[i] - g[i]
+		c2[i] = r[i] - g[i]
+	}
+	inverseRCT(c0, c1, c2)
+	for i := range r {
+		if c0[i] != r[i] || c1[i] != g[i] || c2[i] != b[i] {
+			t.Errorf("inverseRCT(), want %v %v %v got %v %v %v", r[i], g[i], b[i], c0[i], c1[i], c2[i])
+		}
+	}
+}
+
+func TestJ2KCodecDecodeErrors(t *testing.T) {
+	// SOC, SIZ of 8x8 with one 8 bits component, COD, QCD, SOT, SOD
+	header := []byte{
+		0xFF, 0x4F,
+		0xFF, 0x51, 0x00, 0x29, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x01, 0x07, 0x01, 0x01,
+	}
+	cod := []byte{0xFF, 0x52, 0x00, 0x0C, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x04, 0x04, 0x00, 0x01}
+	qcd := []byte{0xFF, 0x5C, 0x00, 0x04, 0x40, 0x40}
+	htCOD := append([]byte(nil), cod...)
+	htCOD[12] = j2kHT
+	cases := []struct {
+		name string
+		in   []byte
+	}{
+		{"empty", nil},
+		{"missing SOC", header[2:]},
+		{"missing COD", append(append([]byte(nil), header...), qcd...)},
+		{"HT block coder", append(append(append([]byte(nil), header...), htCOD...), qcd...)},
+		{"JP2 without codestream", []byte{0x00, 0x00, 0x00, 0x0C, 'j', 'P', ' ', ' ', 0x0D, 0x0A, 0x87, 0x0A}},
+	}
+	for _, c := range cases {
+		var di DcmImage
+		di.BitsAllocated = 8
+		_, err := j2kCodec{}.Decode(c.in, &di)
+		if err == nil {
+			t.Errorf("Decode() %s should fail", c.name)
+		}
+	}
+
+	// an empty tile decodes to the DC level
+	var stream []byte
+	stream = append(stream, header...)
+	stream = append(stream, cod...)
+	stream = append(stream, qcd...)
+	stream = append(stream, 0xFF, 0x90, 0x00, 0x0A, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0F, 0x00, 0x01)
+	stream = append(stream, 0xFF, 0x93, 0x00, 0xFF, 0xD9)
+	var di DcmImage
+	di.Columns = 8
+	di.Rows = 8
+	di.SamplesPerPixel = 1
+	di.BitsAllocated = 8
+	got, err := j2kCodec{}.Decode(stream, &di)
+	if err != nil {
+		t.Fatalf("Decode() empty tile: %s", err.Error())
+	}
+	if len(got) != 64 || got[0] != 0x80 || got[63] != 0x80 {
+		t.Errorf("Decode() empty tile, want 64 bytes of 0x80 got % X", got)
+	}
+
+	di.Columns = 16
+	_, err = j2kCodec{}.Decode(stream, &di)
+	if err == nil {
+		t.Errorf("Decode() should fail if the image size does not match")
+	}
+}
+
+func TestJ2KCodec(t *testing.T) {
+	cases := []struct {
+		bitsAllocated uint16
+		bitsStored    uint16
+		isSigned      bool
+		samples       uint16
+		planar        uint16
+		isBigEndian   bool
+		rows          int
+		columns       int
+	}{
+		{8, 8, false, 1, 0, false, 23, 37},
+		{8, 8, true, 1, 0, false, 23, 37},
+		{16, 12, false, 1, 0, false, 23, 37},
+		{16, 12, true, 1, 0, false, 23, 37},
+		{16, 16, false, 1, 0, false, 70, 130},
+		{16, 16, true, 1, 0, true, 70, 130},
+		{8, 8, false, 3, 0, false, 23, 37},
+		{8, 8, false, 3, 1, false, 23, 37},
+		{8, 8, false, 1, 0, false, 1, 1},
+		{8, 8, false, 1, 0, false, 1, 5},
+		{16, 16, false, 1, 0, false, 9, 1},
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, c := range cases {
+		var di DcmImage
+		di.Rows = uint32(c.rows)
+		di.Columns = uint32(c.columns)
+		di.BitsAllocated = c.bitsAllocated
+		di.BitsStored = c.bitsStored
+		di.SamplesPerPixel = c.samples
+		di.PlanarConfiguration = c.planar
+		di.IsBigEndian = c.isBigEndian
+		min := 0
+		max := 1<<c.bitsStored - 1
+		if c.isSigned {
+			di.PixelRepresentation = 1
+			min = -1 << (c.bitsStored - 1)
+			max = 1<<(c.bitsStored-1) - 1
+		}
+
+		// smooth areas, edges of the extreme values and noise
+		samples := make([]int, c.rows*c.columns*int(c.samples))
+		for i := range samples {
+			p := i / int(c.samples)
+			x := p % c.columns
+			y := p / c.columns
+			switch {
+			case y < 5:
+				samples[i] = max / 3
+			case x < 10:
+				samples[i] = min + (x*y*97+i%int(c.samples)*1000)%(max-min+1)
+			case x < 30 && (x+y)%2 == 0:
+				samples[i] = min
+			case x < 30:
+				samples[i] = max
+			default:
+				samples[i] = min + r.Intn(max-min+1)
+			}
+		}
+		bytesPerSample := int(c.bitsAllocated) / 8
+		src := make([]byte, len(samples)*bytesPerSample)
+		for i, v := range samples {
+			pos := i * bytesPerSample
+			if c.planar == 1 {
+				pos = (i%int(c.samples)*c.rows*c.columns + i/int(c.samples)) * bytesPerSample
+			}
+			switch {
+			case bytesPerSample == 1:
+				src[pos] = byte(v)
+			case c.isBigEndian:
+				binary.BigEndian.PutUint16(src[pos:], uint16(v))
+			default:
+				binary.LittleEndian.PutUint16(src[pos:], uint16(v))
+			}
+		}
+
+		encoded, err := j2kCodec{}.Encode(src, di)
+		if err != nil {
+			t.Errorf("Encode() %+v: %s", c, err.Error())
+			continue
+		}
+		got, err := j2kCodec{}.Decode(encoded, &di)
+		if err != nil {
+			t.Errorf("Decode() %+v: %s", c, err.Error())
+			continue
+		}
+		if len(got) != len(samples)*bytesPerSample {
+			t.Errorf("Decode() %+v, want %d bytes got %d", c, len(samples)*bytesPerSample, len(got))
+			continue
+		}
+		for i, v := range samples {
+			var d int
+			switch {
+			case bytesPerSample == 1 && c.isSigned:
+				d = int(int8(got[i]))
+			case bytesPerSample == 1:
+				d = int(got[i])
+			case c.isSigned:
+				d = int(int16(binary.LittleEndian.Uint16(got[2*i:])))
+			default:
+				d = int(binary.LittleEndian.Uint16(got[2*i:]))
+			}
+			if d != v {
+				t.Errorf("Decode() %+v, sample %d want %d got %d", c, i, v, d)
+				break
+			}
+		}
+	}
+
+	var di DcmImage
+	di.Rows = 4
+	di.Columns = 4
+	di.BitsAllocated = 8
+	di.SamplesPerPixel = 1
+	_, err := j2kCodec{}.Encode(make([]byte, 8), di)
+	if err == nil {
+		t.Errorf("Encode() should fail if the frame is short")
+	}
+	di.BitsAllocated = 32
+	_, err = j2kCodec{}.Encode(make([]byte, 64), di)
+	if err == nil {
+		t.Errorf("Encode() should fail if BitsAllocated is 32")
+	}
+}
@@ -0,0 +1,284 @@
+package dcmimage
+
+import "math"
+
+// the lifting parameters of the 9-7 irreversible filter, Table F.4 of ISO/IEC 15444-1
+const (
+	j2kAlpha = -1.586134342059924
+	j2kBeta  = -0.052980118572961
+	j2kGamma = 0.882911075530934
+	j2kDelta = 0.443506852043971
+	j2kK     = 1.230174104914001
+)
+
+// inverseTransform reconstructs the samples of the tile-component from the subbands.
+func (tc *j2kTileComponent) inverseTransform() []float64 {
+	res := tc.resolutions[0]
+	samples := res.bands[0].coefficients
+	isReversible := tc.style.transform == 1
+	for r := 1; r < len(tc.resolutions); r++ {
+		low := res
+		res = tc.resolutions[r]
+		samples = inverseTransform2D(samples, low, res, isReversible)
+	}
+	return samples
+}
+
+// inverseTransform2D interleaves the LL band of the lower resolution with the HL, LH
+// and HH bands, and performs the horizontal and the vertical 1D inverse transform.
+func inverseTransform2D(ll []float64, low *j2kResolution, res *j2kResolution, isReversible bool) []float64 {
+	w := res.x1 - res.x0
+	h := res.y1 - res.y0
+	result := make([]float64, w*h)
+	if w == 0 || h == 0 {
+		return result
+	}
+	bands := []struct {
+		data []float64
+		x0   int
+		y0   int
+		w    int
+	}{
+		{ll, low.x0, low.y0, low.x1 - low.x0},
+	}
+	for _, band := range res.bands {
+		bands = append(bands, struct {
+			data []float64
+			x0   int
+			y0   int
+			w    int
+		}{band.coefficients, band.x0, band.y0, band.x1 - band.x0})
+	}
+	for y := res.y0; y < res.y1; y++ {
+		for x := res.x0; x < res.x1; x++ {
+			// LL, HL, LH and HH by the parity of the position
+			band := bands[x&1|(y&1)<<1]
+			result[(y-res.y0)*w+x-res.x0] = band.data[(y>>1-band.y0)*band.w+x>>1-band.x0]
+		}
+	}
+
+	line := make([]float64, maxInt(w, h))
+	for y := 0; y < h; y++ {
+		copy(line, result[y*w:(y+1)*w])
+		inverseTransform1D(line[:w], res.x0, isReversible)
+		copy(result[y*w:], line[:w])
+	}
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			line[y] = result[y*w+x]
+		}
+		inverseTransform1D(line[:h], res.y0, isReversible)
+		for y := 0; y < h; y++ {
+			result[y*w+x] = line[y]
+		}
+	}
+	return result
+}
+
+// inverseTransform1D performs the 1D inverse transform of the interleaved samples starting
+// at the position i0, with the periodic symmetric extension.
+func inverseTransform1D(y []float64, i0 int, isReversible bool) {
+	n := len(y)
+	if n == 1 {
+		if i0&1 == 1 {
+			y[0] /= 2
+		}
+		return
+	}
+	const pad = 4
+	// the extended signal starts at the position i0 - pad
+	x := make([]float64, n+2*pad)
+	for i := range x {
+		k := i - pad
+		period := 2 * (n - 1)
+		k %= period
+		if k < 0 {
+			k += period
+		}
+		if k >= n {
+			k = period - k
+		}
+		x[i] = y[k]
+	}
+	// the parity of the absolute position of x[i]
+	offset := (i0 - pad) & 1
+	lift := func(parity int, from int, to int, fn func(i int)) {
+		start := from
+		if (start+offset)&1 != parity {
+			start++
+		}
+		for i := start; i < to; i += 2 {
+			fn(i)
+		}
+	}
+
+	if isReversible {
+		lift(0, 1, len(x)-1, func(i int) {
+			x[i] -= math.Floor((x[i-1] + x[i+1] + 2) / 4)
+		})
+		lift(1, 2, len(x)-2, func(i int) {
+			x[i] += math.Floor((x[i-1] + x[i+1]) / 2)
+		})
+	} else {
+		lift(0, 0, len(x), func(i int) {
+			x[i] *= j2kK
+		})
+		lift(1, 0, len(x), func(i int) {
+			x[i] /= j2kK
+		})
+		lift(0, 1, len(x)-1, func(i int) {
+			x[i] -= j2kDelta * (x[i-1] + x[i+1])
+		})
+		lift(1, 2, len(x)-2, func(i int) {
+			x[i] -= j2kGamma * (x[i-1] + x[i+1])
+		})
+		lift(0, 3, len(x)-3, func(i int) {
+			x[i] -= j2kBeta * (x[i-1] + x[i+1])
+		})
+		lift(1, 4, len(x)-4, func(i int) {
+			x[i] -= j2kAlpha * (x[i-1] + x[i+1])
+		})
+	}
+	copy(y, x[pad:pad+n])
+}
+
+// forwardTransform1D performs the 1D forward transform of the samples starting at the
+// position i0, with the periodic symmetric extension. The low-pass and the high-pass
+// coefficients are interleaved at the even and the odd positions.
+func forwardTransform1D(x []float64, i0 int, isReversible bool) {
+	n := len(x)
+	if n == 1 {
+		if i0&1 == 1 {
+			x[0] *= 2
+		}
+		return
+	}
+	const pad = 4
+	y := make([]float64, n+2*pad)
+	for i := range y {
+		k := i - pad
+		period := 2 * (n - 1)
+		k %= period
+		if k < 0 {
+			k += period
+		}
+		if k >= n {
+			k = period - k
+		}
+		y[i] = x[k]
+	}
+	offset := (i0 - pad) & 1
+	lift := func(parity int, from int, to int, fn func(i int)) {
+		start := from
+		if (start+offset)&1 != parity {
+			start++
+		}
+		for i := start; i < to; i += 2 {
+			fn(i)
+		}
+	}
+	if isReversible {
+		lift(1, 1, len(y)-1, func(i int) {
+			y[i] -= math.Floor((y[i-1] + y[i+1]) / 2)
+		})
+		lift(0, 2, len(y)-2, func(i int) {
+			y[i] += math.Floor((y[i-1] + y[i+1] + 2) / 4)
+		})
+	} else {
+		lift(1, 1, len(y)-1, func(i int) {
+			y[i] += j2kAlpha * (y[i-1] + y[i+1])
+		})
+		lift(0, 2, len(y)-2, func(i int) {
+			y[i] += j2kBeta * (y[i-1] + y[i+1])
+		})
+		lift(1, 3, len(y)-3, func(i int) {
+			y[i] += j2kGamma * (y[i-1] + y[i+1])
+		})
+		lift(0, 4, len(y)-4, func(i int) {
+			y[i] += j2kDelta * (y[i-1] + y[i+1])
+		})
+		lift(0, 0, len(y), func(i int) {
+			y[i] /= j2kK
+		})
+		lift(1, 0, len(y), func(i int) {
+			y[i] *= j2kK
+		})
+	}
+	copy(x, y[pad:pad+n])
+}
+
+// forwardTransform decomposes the samples of the tile-component into the subbands.
+func (tc *j2kTileComponent) forwardTransform(samples []float64) {
+	isReversible := tc.style.transform == 1
+	for r := len(tc.resolutions) - 1; r > 0; r-- {
+		samples = forwardTransform2D(samples, tc.resolutions[r-1], tc.resolutions[r], isReversible)
+	}
+	tc.resolutions[0].bands[0].coefficients = samples
+}
+
+// forwardTransform2D performs the vertical and the horizontal 1D forward transform, which
+// is the reverse of inverseTransform2D, and splits the result into the HL, LH and HH bands
+// of the resolution. It returns the LL band, i.e. the samples of the lower resolution.
+func forwardTransform2D(samples []float64, low *j2kResolution, res *j2kResolution, isReversible bool) []float64 {
+	w := res.x1 - res.x0
+	h := res.y1 - res.y0
+	lw := low.x1 - low.x0
+	ll := make([]float64, lw*(low.y1-low.y0))
+	for _, band := range res.bands {
+		band.coefficients = make([]float64, (band.x1-band.x0)*(band.y1-band.y0))
+	}
+	if w == 0 || h == 0 {
+		return ll
+	}
+
+	line := make([]float64, maxInt(w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			line[y] = samples[y*w+x]
+		}
+		forwardTransform1D(line[:h], res.y0, isReversible)
+		for y := 0; y < h; y++ {
+			samples[y*w+x] = line[y]
+		}
+	}
+	for y := 0; y < h; y++ {
+		copy(line, samples[y*w:(y+1)*w])
+		forwardTransform1D(line[:w], res.x0, isReversible)
+		copy(samples[y*w:], line[:w])
+	}
+
+	for y := res.y0; y < res.y1; y++ {
+		for x := res.x0; x < res.x1; x++ {
+			v := samples[(y-res.y0)*w+x-res.x0]
+			// LL, HL, LH and HH by the parity of the position
+			o := x&1 | (y&1)<<1
+			if o == 0 {
+				ll[(y>>1-low.y0)*lw+x>>1-low.x0] = v
+				continue
+			}
+			band := res.bands[o-1]
+			band.coefficients[(y>>1-band.y0)*(band.x1-band.x0)+x>>1-band.x0] = v
+		}
+	}
+	return ll
+}
+
+// inverseRCT converts the components of the reversible color transform to RGB.
+func inverseRCT(c0 []float64, c1 []float64, c2 []float64) {
+	for i := range c0 {
+		g := c0[i] - math.Floor((c1[i]+c2[i])/4)
+		r := c2[i] + g
+		b := c1[i] + g
+		c0[i], c1[i], c2[i] = r, g, b
+	}
+}
+
+// inverseICT converts the components of the irreversible color transform to RGB.
+func inverseICT(c0 []float64, c1 []float64, c2 []float64) {
+	for i := range c0 {
+		y, cb, cr := c0[i], c1[i], c2[i]
+		c0[i] = y + 1.402*cr
+		c1[i] = y - 0.34413*cb - 0.71414*cr
+		c2[i] = y + 1.772*cb
+	}
+}
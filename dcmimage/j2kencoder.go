@@ -0,0 +1,651 @@
+package dcmimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// the size of the code-blocks of the encoder, 64x64
+const j2kEncodeCodeBlockSize = 6
+
+// Encode encodes the native pixel data of a frame into a JPEG 2000 codestream of one
+// tile and one quality layer, with the reversible 5-3 wavelet, so that the frame is
+// coded losslessly. The color components are coded without the multiple component
+// transform, so that the Photometric Interpretation of the image is unchanged.
+func (j2kCodec) Encode(src []byte, di DcmImage) ([]byte, error) {
+	if di.BitsAllocated != 8 && di.BitsAllocated != 16 {
+		str := fmt.Sprintf("j2kCodec: not supported BitsAllocated %d", di.BitsAllocated)
+		return nil, errors.New(str)
+	}
+	bytesPerSample := int(di.BitsAllocated) / 8
+	depth := int(di.BitsStored)
+	if depth == 0 || depth > int(di.BitsAllocated) {
+		depth = int(di.BitsAllocated)
+	}
+	rows := int(di.Rows)
+	columns := int(di.Columns)
+	samples := int(di.SamplesPerPixel)
+	if samples == 0 {
+		samples = 1
+	}
+	if rows == 0 || columns == 0 {
+		str := fmt.Sprintf("j2kCodec: not supported image size %dx%d", columns, rows)
+		return nil, errors.New(str)
+	}
+	if len(src) < rows*columns*samples*bytesPerSample {
+		str := fmt.Sprintf("j2kCodec: the frame is %d bytes, want %d", len(src), rows*columns*samples*bytesPerSample)
+		return nil, errors.New(str)
+	}
+
+	isSigned := di.PixelRepresentation == 1
+	mask := 1<<uint(depth) - 1
+	planes := make([][]float64, samples)
+	for s := 0; s < samples; s++ {
+		planes[s] = make([]float64, rows*columns)
+		for i := range planes[s] {
+			pos := (i*samples + s) * bytesPerSample
+			if di.PlanarConfiguration == 1 {
+				pos = (s*rows*columns + i) * bytesPerSample
+			}
+			v := int(src[pos])
+			if bytesPerSample == 2 {
+				if di.IsBigEndian {
+					v = int(binary.BigEndian.Uint16(src[pos:]))
+				} else {
+					v = int(binary.LittleEndian.Uint16(src[pos:]))
+				}
+			}
+			v &= mask
+			// the signed samples are sign extended, and the unsigned samples are shifted
+			// to the DC level
+			if isSigned {
+				if v >= 1<<uint(depth-1) {
+					v -= 1 << uint(depth)
+				}
+			} else {
+				v -= 1 << uint(depth-1)
+			}
+			planes[s][i] = float64(v)
+		}
+	}
+
+	e := j2kEncoder{width: columns, height: rows, depth: depth, isSigned: isSigned}
+	return e.encode(planes)
+}
+
+// j2kEncoder encodes the components of an image into the JPEG 2000 codestream.
+type j2kEncoder struct {
+	width, height int
+	depth         int
+	isSigned      bool
+
+	style j2kComponentStyle
+	q     j2kQuantization
+	tile  j2kTile
+}
+
+func (e *j2kEncoder) encode(planes [][]float64) ([]byte, error) {
+	// the lowest resolution is at least one sample
+	levels := 0
+	for levels < 5 && minInt(e.width, e.height)>>uint(levels+1) > 0 {
+		levels++
+	}
+	e.style = j2kComponentStyle{
+		levels:    levels,
+		xcb:       j2kEncodeCodeBlockSize,
+		ycb:       j2kEncodeCodeBlockSize,
+		transform: 1,
+	}
+	for r := 0; r <= levels; r++ {
+		e.style.ppx = append(e.style.ppx, 15)
+		e.style.ppy = append(e.style.ppy, 15)
+	}
+
+	// the exponents of the subbands without quantization are the bit depth and the gain
+	e.q.guard = 2
+	e.q.exponents = []int{e.depth}
+	e.q.mantissas = []int{0}
+	for r := 1; r <= levels; r++ {
+		e.q.exponents = append(e.q.exponents, e.depth+1, e.depth+1, e.depth+2)
+		e.q.mantissas = append(e.q.mantissas, 0, 0, 0)
+	}
+
+	e.tile.x1 = e.width
+	e.tile.y1 = e.height
+	e.tile.cod = &j2kCodingStyle{progression: j2kLRCP, layers: 1}
+	for _, samples := range planes {
+		var tc j2kTileComponent
+		tc.x1 = e.width
+		tc.y1 = e.height
+		tc.dx = 1
+		tc.dy = 1
+		tc.style = &e.style
+		err := tc.newResolutions(&e.q, e.depth)
+		if err != nil {
+			return nil, err
+		}
+		tc.forwardTransform(samples)
+		e.tile.components = append(e.tile.components, &tc)
+	}
+
+	err := e.setGuardBits()
+	if err != nil {
+		return nil, err
+	}
+	for _, tc := range e.tile.components {
+		for _, res := range tc.resolutions {
+			for _, band := range res.bands {
+				encodeCodeBlocks(band)
+			}
+		}
+	}
+
+	var data []byte
+	prog := j2kProgressionChange{0, 0, 1, levels + 1, len(planes), j2kLRCP}
+	err = e.tile.iteratePackets(prog, func(l int, r int, c int, p int) error {
+		data = append(data, encodePacket(e.tile.components[c].resolutions[r], p)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e.codestream(data), nil
+}
+
+// setGuardBits increases the guard bits if the magnitude of a coefficient exceeds the
+// bit-planes of its subband, which may happen in the low-pass subbands.
+func (e *j2kEncoder) setGuardBits() error {
+	extra := 0
+	for _, tc := range e.tile.components {
+		for _, res := range tc.resolutions {
+			for _, band := range res.bands {
+				for _, v := range band.coefficients {
+					extra = maxInt(extra, bitLength(int(math.Abs(v)))-band.magnitudeBits)
+				}
+			}
+		}
+	}
+	if e.q.guard+extra > 7 {
+		return errors.New("j2kCodec: too many guard bits")
+	}
+	e.q.guard += extra
+	for _, tc := range e.tile.components {
+		for _, res := range tc.resolutions {
+			for _, band := range res.bands {
+				band.magnitudeBits += extra
+			}
+		}
+	}
+	return nil
+}
+
+// codestream writes the main header, the tile-part header and the packets of the tile.
+func (e *j2kEncoder) codestream(data []byte) []byte {
+	result := []byte{0xFF, byte(j2kSOC & 0xFF)}
+	segment := func(marker uint16, payload []byte) {
+		result = append(result, byte(marker>>8), byte(marker), byte((len(payload)+2)>>8), byte(len(payload)+2))
+		result = append(result, payload...)
+	}
+
+	siz := make([]byte, 36)
+	binary.BigEndian.PutUint32(siz[2:], uint32(e.width))
+	binary.BigEndian.PutUint32(siz[6:], uint32(e.height))
+	binary.BigEndian.PutUint32(siz[18:], uint32(e.width))
+	binary.BigEndian.PutUint32(siz[22:], uint32(e.height))
+	binary.BigEndian.PutUint16(siz[34:], uint16(len(e.tile.components)))
+	ssiz := byte(e.depth - 1)
+	if e.isSigned {
+		ssiz |= 0x80
+	}
+	for range e.tile.components {
+		siz = append(siz, ssiz, 1, 1)
+	}
+	segment(j2kSIZ, siz)
+
+	// LRCP, one layer, no multiple component transform
+	cod := []byte{0, j2kLRCP, 0, 1, 0}
+	cod = append(cod, byte(e.style.levels), byte(e.style.xcb-2), byte(e.style.ycb-2), e.style.cbStyle, e.style.transform)
+	segment(j2kCOD, cod)
+
+	qcd := []byte{byte(e.q.guard << 5)}
+	for _, exponent := range e.q.exponents {
+		qcd = append(qcd, byte(exponent<<3))
+	}
+	segment(j2kQCD, qcd)
+
+	// the tile-part from SOT to the end of the packets
+	sot := make([]byte, 8)
+	binary.BigEndian.PutUint32(sot[2:], uint32(12+2+len(data)))
+	sot[7] = 1
+	segment(j2kSOT, sot)
+	result = append(result, byte(j2kSOD>>8), byte(j2kSOD&0xFF))
+	result = append(result, data...)
+	result = append(result, byte(j2kEOC>>8), byte(j2kEOC&0xFF))
+	return result
+}
+
+// encodeCodeBlocks encodes the code-blocks of the subband, and sets the values of the tag trees.
+func encodeCodeBlocks(band *j2kBand) {
+	var t1 j2kT1Encoder
+	t1.orientation = band.orientation
+	for _, prc := range band.precincts {
+		for i, cb := range prc.codeblocks {
+			numPlanes, data := t1.encode(cb, band)
+			if numPlanes == 0 {
+				// the code-block is not included in the layer
+				prc.inclusion.setValue(i, 1)
+				continue
+			}
+			cb.zeroBitplanes = band.magnitudeBits - numPlanes
+			cb.passes = 3*numPlanes - 2
+			cb.segments = []*j2kSegment{{data: data, passes: cb.passes}}
+			prc.inclusion.setValue(i, 0)
+			prc.zeroBitplanes.setValue(i, cb.zeroBitplanes)
+		}
+	}
+}
+
+// encodePacket writes the packet header and the code-block contributions of a precinct
+// in the only layer.
+func encodePacket(res *j2kResolution, p int) []byte {
+	var bw j2kBitWriter
+	var body []byte
+	isPresent := false
+	for _, band := range res.bands {
+		for _, cb := range band.precincts[p].codeblocks {
+			isPresent = isPresent || cb.passes > 0
+		}
+	}
+	if !isPresent {
+		bw.writeBit(0)
+		return bw.flush()
+	}
+
+	bw.writeBit(1)
+	for _, band := range res.bands {
+		prc := band.precincts[p]
+		for i, cb := range prc.codeblocks {
+			prc.inclusion.encode(&bw, i, 1)
+			if cb.passes == 0 {
+				continue
+			}
+			for threshold := 1; ; threshold++ {
+				prc.zeroBitplanes.encode(&bw, i, threshold)
+				if cb.zeroBitplanes < threshold {
+					break
+				}
+			}
+			bw.writePasses(cb.passes)
+			data := cb.segments[0].data
+			bits := floorLog2(cb.passes)
+			for bitLength(len(data)) > cb.lblock+bits {
+				bw.writeBit(1)
+				cb.lblock++
+			}
+			bw.writeBit(0)
+			bw.writeBits(len(data), cb.lblock+bits)
+			body = append(body, data...)
+		}
+	}
+	return append(bw.flush(), body...)
+}
+
+// setValue sets the value of the leaf, the value of a node is the minimum of its children.
+func (tree *j2kTagTree) setValue(leaf int, v int) {
+	for node := leaf; node >= 0 && v < tree.values[node]; node = tree.parents[node] {
+		tree.values[node] = v
+	}
+}
+
+// encode writes the bits of the leaf until its value is known to be less than the
+// threshold or not, which are read by decode.
+func (tree *j2kTagTree) encode(bw *j2kBitWriter, leaf int, threshold int) {
+	if tree.known == nil {
+		tree.known = make([]bool, len(tree.values))
+	}
+	var stack []int
+	node := leaf
+	for tree.parents[node] >= 0 {
+		stack = append(stack, node)
+		node = tree.parents[node]
+	}
+	low := 0
+	for {
+		if low > tree.lows[node] {
+			tree.lows[node] = low
+		} else {
+			low = tree.lows[node]
+		}
+		for low < threshold && !tree.known[node] {
+			if low >= tree.values[node] {
+				bw.writeBit(1)
+				tree.known[node] = true
+			} else {
+				bw.writeBit(0)
+				low++
+			}
+		}
+		tree.lows[node] = low
+		if len(stack) == 0 {
+			break
+		}
+		node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+	}
+}
+
+// j2kBitWriter writes the packet headers with bit stuffing.
+type j2kBitWriter struct {
+	data []byte
+	ct   uint
+}
+
+func (bw *j2kBitWriter) writeBit(bit int) {
+	if bw.ct == 0 {
+		// only 7 bits follow 0xFF
+		bw.ct = 8
+		if n := len(bw.data); n > 0 && bw.data[n-1] == 0xFF {
+			bw.ct = 7
+		}
+		bw.data = append(bw.data, 0)
+	}
+	bw.ct--
+	bw.data[len(bw.data)-1] |= byte(bit << bw.ct)
+}
+
+func (bw *j2kBitWriter) writeBits(v int, n int) {
+	for i := n - 1; i >= 0; i-- {
+		bw.writeBit(v >> uint(i) & 1)
+	}
+}
+
+// writePasses writes the number of new coding passes, which is read by numPasses.
+func (bw *j2kBitWriter) writePasses(n int) {
+	switch {
+	case n == 1:
+		bw.writeBit(0)
+	case n == 2:
+		bw.writeBits(2, 2)
+	case n <= 5:
+		bw.writeBits(3, 2)
+		bw.writeBits(n-3, 2)
+	case n <= 36:
+		bw.writeBits(15, 4)
+		bw.writeBits(n-6, 5)
+	default:
+		bw.writeBits(511, 9)
+		bw.writeBits(n-37, 7)
+	}
+}
+
+// flush ends the packet header, a byte 0xFF is followed by a stuffed byte.
+func (bw *j2kBitWriter) flush() []byte {
+	if n := len(bw.data); n > 0 && bw.data[n-1] == 0xFF {
+		bw.data = append(bw.data, 0)
+	}
+	bw.ct = 0
+	return bw.data
+}
+
+// j2kT1Encoder encodes the coding passes of a code-block with the contexts of j2kT1.
+type j2kT1Encoder struct {
+	j2kT1
+	values []int32
+	coder  *j2kMQEncoder
+}
+
+// encode encodes the coefficients of the code-block in all coding passes, and returns
+// the number of bit-planes and the codeword segment.
+func (t1 *j2kT1Encoder) encode(cb *j2kCodeBlock, band *j2kBand) (int, []byte) {
+	t1.w = cb.x1 - cb.x0
+	t1.h = cb.y1 - cb.y0
+	t1.values = make([]int32, t1.w*t1.h)
+	t1.flags = make([]uint8, (t1.w+2)*(t1.h+2))
+	bandWidth := band.x1 - band.x0
+	maxValue := 0
+	for y := 0; y < t1.h; y++ {
+		for x := 0; x < t1.w; x++ {
+			v := int32(band.coefficients[(cb.y0-band.y0+y)*bandWidth+cb.x0-band.x0+x])
+			t1.values[y*t1.w+x] = v
+			if v < 0 {
+				v = -v
+			}
+			maxValue = maxInt(maxValue, int(v))
+		}
+	}
+	numPlanes := bitLength(maxValue)
+	if numPlanes == 0 {
+		return 0, nil
+	}
+
+	t1.coder = newJ2KMQEncoder()
+	t1.cleanupPass(uint(numPlanes - 1))
+	for plane := numPlanes - 2; plane >= 0; plane-- {
+		t1.significancePass(uint(plane))
+		t1.refinementPass(uint(plane))
+		t1.cleanupPass(uint(plane))
+	}
+	return numPlanes, t1.coder.flush()
+}
+
+// bit gets the bit of the magnitude of the coefficient at the bit-plane.
+func (t1 *j2kT1Encoder) bit(x int, y int, plane uint) uint8 {
+	v := t1.values[y*t1.w+x]
+	if v < 0 {
+		v = -v
+	}
+	return uint8(v>>plane) & 1
+}
+
+// encodeSign encodes the sign of the coefficient becoming significant.
+func (t1 *j2kT1Encoder) encodeSign(x int, y int) {
+	var negative uint8
+	if t1.values[y*t1.w+x] < 0 {
+		negative = 1
+	}
+	cx, xor := t1.signContext(x, y)
+	t1.coder.encode(cx, negative^uint8(xor))
+	t1.setFlag(x, y, j2kSignificant)
+	if negative == 1 {
+		t1.setFlag(x, y, j2kNegative)
+	}
+}
+
+func (t1 *j2kT1Encoder) significancePass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			for y := y0; y < y0+4 && y < t1.h; y++ {
+				if t1.flag(x, y)&j2kSignificant != 0 {
+					continue
+				}
+				cx := t1.zeroContext(x, y)
+				if cx == 0 {
+					continue
+				}
+				bit := t1.bit(x, y, plane)
+				t1.coder.encode(j2kCtxZC+cx, bit)
+				if bit == 1 {
+					t1.encodeSign(x, y)
+				}
+				t1.setFlag(x, y, j2kVisited)
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1Encoder) refinementPass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			for y := y0; y < y0+4 && y < t1.h; y++ {
+				f := t1.flag(x, y)
+				if f&j2kSignificant == 0 || f&j2kVisited != 0 {
+					continue
+				}
+				cx := j2kCtxMR + 2
+				if f&j2kRefined == 0 {
+					cx = j2kCtxMR
+					if h, v, d := t1.neighbours(x, y); h+v+d > 0 {
+						cx = j2kCtxMR + 1
+					}
+				}
+				t1.coder.encode(cx, t1.bit(x, y, plane))
+				t1.setFlag(x, y, j2kRefined)
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1Encoder) cleanupPass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			y := y0
+			if y0+4 <= t1.h && t1.isRunLength(x, y0) {
+				k := 0
+				for k < 4 && t1.bit(x, y0+k, plane) == 0 {
+					k++
+				}
+				if k == 4 {
+					t1.coder.encode(j2kCtxRL, 0)
+					continue
+				}
+				t1.coder.encode(j2kCtxRL, 1)
+				t1.coder.encode(j2kCtxUniform, uint8(k>>1))
+				t1.coder.encode(j2kCtxUniform, uint8(k&1))
+				y = y0 + k
+				t1.encodeSign(x, y)
+				y++
+			}
+			for ; y < y0+4 && y < t1.h; y++ {
+				f := t1.flag(x, y)
+				if f&(j2kSignificant|j2kVisited) == 0 {
+					bit := t1.bit(x, y, plane)
+					t1.coder.encode(j2kCtxZC+t1.zeroContext(x, y), bit)
+					if bit == 1 {
+						t1.encodeSign(x, y)
+					}
+				}
+			}
+		}
+	}
+	for i := range t1.flags {
+		t1.flags[i] &^= j2kVisited
+	}
+}
+
+// bitLength gets the number of bits of a non-negative value.
+func bitLength(v int) int {
+	n := 0
+	for v > 0 {
+		v >>= 1
+		n++
+	}
+	return n
+}
+
+// j2kMQEncoder is the MQ arithmetic encoder of Annex C of ISO/IEC 15444-1.
+type j2kMQEncoder struct {
+	out    []byte
+	c      uint32
+	a      uint32
+	ct     int
+	states [j2kNumCtx]uint8
+	mps    [j2kNumCtx]uint8
+}
+
+func newJ2KMQEncoder() *j2kMQEncoder {
+	var mq j2kMQEncoder
+	// the first byte is the byte before the codeword
+	mq.out = []byte{0}
+	mq.a = 0x8000
+	mq.ct = 12
+	// the same initial states as the decoder
+	var d j2kMQDecoder
+	d.resetContexts()
+	mq.states = d.states
+	return &mq
+}
+
+func (mq *j2kMQEncoder) byteOut() {
+	last := len(mq.out) - 1
+	if mq.out[last] == 0xFF {
+		mq.out = append(mq.out, byte(mq.c>>20))
+		mq.c &= 0xFFFFF
+		mq.ct = 7
+		return
+	}
+	if mq.c&0x8000000 != 0 {
+		mq.out[last]++
+		if mq.out[last] == 0xFF {
+			mq.c &= 0x7FFFFFF
+			mq.out = append(mq.out, byte(mq.c>>20))
+			mq.c &= 0xFFFFF
+			mq.ct = 7
+			return
+		}
+	}
+	mq.out = append(mq.out, byte(mq.c>>19))
+	mq.c &= 0x7FFFF
+	mq.ct = 8
+}
+
+func (mq *j2kMQEncoder) renormalize() {
+	for {
+		mq.a <<= 1
+		mq.c <<= 1
+		mq.ct--
+		if mq.ct == 0 {
+			mq.byteOut()
+		}
+		if mq.a&0x8000 != 0 {
+			break
+		}
+	}
+}
+
+func (mq *j2kMQEncoder) encode(cx int, d uint8) {
+	state := &j2kMQStates[mq.states[cx]]
+	mq.a -= state.qe
+	if d == mq.mps[cx] {
+		if mq.a&0x8000 != 0 {
+			mq.c += state.qe
+			return
+		}
+		if mq.a < state.qe {
+			mq.a = state.qe
+		} else {
+			mq.c += state.qe
+		}
+		mq.states[cx] = state.nmps
+	} else {
+		if mq.a < state.qe {
+			mq.c += state.qe
+		} else {
+			mq.a = state.qe
+		}
+		if state.isSwitch {
+			mq.mps[cx] = 1 - mq.mps[cx]
+		}
+		mq.states[cx] = state.nlps
+	}
+	mq.renormalize()
+}
+
+// flush terminates the codeword, and returns the codeword without the trailing 0xFF.
+func (mq *j2kMQEncoder) flush() []byte {
+	temp := mq.c + mq.a
+	mq.c |= 0xFFFF
+	if mq.c >= temp {
+		mq.c -= 0x8000
+	}
+	mq.c <<= uint(mq.ct)
+	mq.byteOut()
+	mq.c <<= uint(mq.ct)
+	mq.byteOut()
+	result := mq.out[1:]
+	if result[len(result)-1] == 0xFF {
+		result = result[:len(result)-1]
+	}
+	return result
+}
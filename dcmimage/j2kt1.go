@@ -0,0 +1,502 @@
+package dcmimage
+
+import "errors"
+
+// the states of the MQ coder, Table C.2 of ISO/IEC 15444-1
+var j2kMQStates = []struct {
+	qe       uint32
+	nmps     uint8
+	nlps     uint8
+	isSwitch bool
+}{
+	{0x5601, 1, 1, true}, {0x3401, 2, 6, false}, {0x1801, 3, 9, false}, {0x0AC1, 4, 12, false},
+	{0x0521, 5, 29, false}, {0x0221, 38, 33, false}, {0x5601, 7, 6, true}, {0x5401, 8, 14, false},
+	{0x4801, 9, 14, false}, {0x3801, 10, 14, false}, {0x3001, 11, 17, false}, {0x2401, 12, 18, false},
+	{0x1C01, 13, 20, false}, {0x1601, 29, 21, false}, {0x5601, 15, 14, true}, {0x5401, 16, 14, false},
+	{0x5101, 17, 15, false}, {0x4801, 18, 16, false}, {0x3801, 19, 17, false}, {0x3401, 20, 18, false},
+	{0x3001, 21, 19, false}, {0x2801, 22, 19, false}, {0x2401, 23, 20, false}, {0x2201, 24, 21, false},
+	{0x1C01, 25, 22, false}, {0x1801, 26, 23, false}, {0x1601, 27, 24, false}, {0x1401, 28, 25, false},
+	{0x1201, 29, 26, false}, {0x1101, 30, 27, false}, {0x0AC1, 31, 28, false}, {0x09C1, 32, 29, false},
+	{0x08A1, 33, 30, false}, {0x0521, 34, 31, false}, {0x0441, 35, 32, false}, {0x02A1, 36, 33, false},
+	{0x0221, 37, 34, false}, {0x0141, 38, 35, false}, {0x0111, 39, 36, false}, {0x0085, 40, 37, false},
+	{0x0049, 41, 38, false}, {0x0025, 42, 39, false}, {0x0015, 43, 40, false}, {0x0009, 44, 41, false},
+	{0x0005, 45, 42, false}, {0x0001, 45, 43, false}, {0x5601, 46, 46, false},
+}
+
+// the contexts of the coding passes
+const (
+	j2kCtxZC      = 0  // 0 - 8, zero coding
+	j2kCtxSC      = 9  // 9 - 13, sign coding
+	j2kCtxMR      = 14 // 14 - 16, magnitude refinement
+	j2kCtxRL      = 17 // run-length
+	j2kCtxUniform = 18
+	j2kNumCtx     = 19
+)
+
+// j2kMQDecoder is the MQ arithmetic decoder of Annex C of ISO/IEC 15444-1.
+type j2kMQDecoder struct {
+	data   []byte
+	pos    int
+	c      uint32
+	a      uint32
+	ct     int
+	states [j2kNumCtx]uint8
+	mps    [j2kNumCtx]uint8
+}
+
+func (mq *j2kMQDecoder) resetContexts() {
+	for i := range mq.states {
+		mq.states[i] = 0
+		mq.mps[i] = 0
+	}
+	mq.states[j2kCtxUniform] = 46
+	mq.states[j2kCtxRL] = 3
+	mq.states[j2kCtxZC] = 4
+}
+
+// byteAt gets the byte of the segment, the segment is terminated by 0xFFFF.
+func (mq *j2kMQDecoder) byteAt(pos int) uint32 {
+	if pos < len(mq.data) {
+		return uint32(mq.data[pos])
+	}
+	return 0xFF
+}
+
+func (mq *j2kMQDecoder) init(data []byte) {
+	mq.data = data
+	mq.pos = 0
+	mq.c = mq.byteAt(0) << 16
+	mq.byteIn()
+	mq.c <<= 7
+	mq.ct -= 7
+	mq.a = 0x8000
+}
+
+func (mq *j2kMQDecoder) byteIn() {
+	if mq.byteAt(mq.pos) == 0xFF {
+		if mq.byteAt(mq.pos+1) > 0x8F {
+			mq.c += 0xFF00
+			mq.ct = 8
+		} else {
+			mq.pos++
+			mq.c += mq.byteAt(mq.pos) << 9
+			mq.ct = 7
+		}
+	} else {
+		mq.pos++
+		mq.c += mq.byteAt(mq.pos) << 8
+		mq.ct = 8
+	}
+}
+
+func (mq *j2kMQDecoder) decode(cx int) int {
+	state := &j2kMQStates[mq.states[cx]]
+	mq.a -= state.qe
+	var d uint8
+	if mq.c>>16 < state.qe {
+		// LPS exchange
+		if mq.a < state.qe {
+			d = mq.mps[cx]
+			mq.states[cx] = state.nmps
+		} else {
+			d = 1 - mq.mps[cx]
+			if state.isSwitch {
+				mq.mps[cx] = 1 - mq.mps[cx]
+			}
+			mq.states[cx] = state.nlps
+		}
+		mq.a = state.qe
+	} else {
+		mq.c -= state.qe << 16
+		if mq.a&0x8000 != 0 {
+			return int(mq.mps[cx])
+		}
+		// MPS exchange
+		if mq.a < state.qe {
+			d = 1 - mq.mps[cx]
+			if state.isSwitch {
+				mq.mps[cx] = 1 - mq.mps[cx]
+			}
+			mq.states[cx] = state.nlps
+		} else {
+			d = mq.mps[cx]
+			mq.states[cx] = state.nmps
+		}
+	}
+	// renormalization
+	for {
+		if mq.ct == 0 {
+			mq.byteIn()
+		}
+		mq.a <<= 1
+		mq.c <<= 1
+		mq.ct--
+		if mq.a&0x8000 != 0 {
+			break
+		}
+	}
+	return int(d)
+}
+
+// j2kRawDecoder reads the raw coding passes of the selective arithmetic coding bypass.
+type j2kRawDecoder struct {
+	data []byte
+	pos  int
+	c    uint32
+	ct   uint
+}
+
+func (raw *j2kRawDecoder) init(data []byte) {
+	raw.data = data
+	raw.pos = 0
+	raw.c = 0
+	raw.ct = 0
+}
+
+func (raw *j2kRawDecoder) decode() int {
+	if raw.ct == 0 {
+		next := uint32(0xFF)
+		if raw.pos < len(raw.data) {
+			next = uint32(raw.data[raw.pos])
+		}
+		if raw.c == 0xFF {
+			if next > 0x8F {
+				raw.c = 0xFF
+				raw.ct = 8
+			} else {
+				raw.c = next
+				raw.pos++
+				raw.ct = 7
+			}
+		} else {
+			raw.c = next
+			raw.pos++
+			raw.ct = 8
+		}
+	}
+	raw.ct--
+	return int(raw.c>>raw.ct) & 1
+}
+
+// the states of the coefficients
+const (
+	j2kSignificant = 1 << iota
+	j2kNegative
+	j2kVisited
+	j2kRefined
+)
+
+// j2kT1 decodes the coding passes of a code-block. The magnitudes are stored in
+// half units, so that a coefficient is reconstructed at the midpoint of its interval.
+type j2kT1 struct {
+	w, h        int
+	magnitudes  []int32
+	flags       []uint8 // with a border of one coefficient
+	orientation int
+	cbStyle     byte
+	mq          j2kMQDecoder
+	raw         j2kRawDecoder
+	isRaw       bool
+}
+
+func (t1 *j2kT1) flag(x int, y int) uint8 {
+	return t1.flags[(y+1)*(t1.w+2)+x+1]
+}
+
+func (t1 *j2kT1) setFlag(x int, y int, f uint8) {
+	t1.flags[(y+1)*(t1.w+2)+x+1] |= f
+}
+
+func (t1 *j2kT1) significant(x int, y int) int {
+	return int(t1.flag(x, y) & j2kSignificant)
+}
+
+// neighbours counts the significant neighbours horizontally, vertically and diagonally.
+// The coefficients of the next stripe are ignored in the vertically causal mode.
+func (t1 *j2kT1) neighbours(x int, y int) (int, int, int) {
+	h := t1.significant(x-1, y) + t1.significant(x+1, y)
+	v := t1.significant(x, y-1)
+	d := t1.significant(x-1, y-1) + t1.significant(x+1, y-1)
+	if t1.cbStyle&j2kCausal == 0 || y%4 != 3 {
+		v += t1.significant(x, y+1)
+		d += t1.significant(x-1, y+1) + t1.significant(x+1, y+1)
+	}
+	return h, v, d
+}
+
+// zeroContext gets the context of the zero coding, Table D.1 of ISO/IEC 15444-1.
+func (t1 *j2kT1) zeroContext(x int, y int) int {
+	h, v, d := t1.neighbours(x, y)
+	switch t1.orientation {
+	case 1:
+		h, v = v, h
+	case 3:
+		hv := h + v
+		switch {
+		case d >= 3:
+			return 8
+		case d == 2:
+			if hv >= 1 {
+				return 7
+			}
+			return 6
+		case d == 1:
+			return 3 + minInt(hv, 2)
+		}
+		return minInt(hv, 2)
+	}
+	switch {
+	case h == 2:
+		return 8
+	case h == 1:
+		if v >= 1 {
+			return 7
+		}
+		if d >= 1 {
+			return 6
+		}
+		return 5
+	case v == 2:
+		return 4
+	case v == 1:
+		return 3
+	}
+	return minInt(d, 2)
+}
+
+// signContribution gets 1 for a positive, -1 for a negative and 0 for an insignificant neighbour.
+func (t1 *j2kT1) signContribution(x int, y int) int {
+	f := t1.flag(x, y)
+	if f&j2kSignificant == 0 {
+		return 0
+	}
+	if f&j2kNegative != 0 {
+		return -1
+	}
+	return 1
+}
+
+// signContext gets the context and the XOR bit of the sign coding, Table D.3 of ISO/IEC 15444-1.
+func (t1 *j2kT1) signContext(x int, y int) (int, int) {
+	h := t1.signContribution(x-1, y) + t1.signContribution(x+1, y)
+	v := t1.signContribution(x, y-1)
+	if t1.cbStyle&j2kCausal == 0 || y%4 != 3 {
+		v += t1.signContribution(x, y+1)
+	}
+	h = maxInt(-1, minInt(1, h))
+	v = maxInt(-1, minInt(1, v))
+	xor := 0
+	if h < 0 || (h == 0 && v < 0) {
+		h = -h
+		v = -v
+		xor = 1
+	}
+	if h == 0 {
+		return j2kCtxSC + v, xor
+	}
+	return j2kCtxSC + 3 + v, xor
+}
+
+func (t1 *j2kT1) decodeBit(cx int) int {
+	if t1.isRaw {
+		return t1.raw.decode()
+	}
+	return t1.mq.decode(cx)
+}
+
+// decodeSign decodes the sign of the coefficient becoming significant at the bit-plane.
+func (t1 *j2kT1) decodeSign(x int, y int, plane uint) {
+	var negative int
+	if t1.isRaw {
+		negative = t1.raw.decode()
+	} else {
+		cx, xor := t1.signContext(x, y)
+		negative = t1.mq.decode(cx) ^ xor
+	}
+	t1.magnitudes[y*t1.w+x] = 3 << plane
+	t1.setFlag(x, y, j2kSignificant)
+	if negative == 1 {
+		t1.setFlag(x, y, j2kNegative)
+	}
+}
+
+func (t1 *j2kT1) significancePass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			for y := y0; y < y0+4 && y < t1.h; y++ {
+				if t1.flag(x, y)&j2kSignificant != 0 {
+					continue
+				}
+				cx := t1.zeroContext(x, y)
+				if cx == 0 {
+					continue
+				}
+				if t1.decodeBit(j2kCtxZC+cx) == 1 {
+					t1.decodeSign(x, y, plane)
+				}
+				t1.setFlag(x, y, j2kVisited)
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1) refinementPass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			for y := y0; y < y0+4 && y < t1.h; y++ {
+				f := t1.flag(x, y)
+				if f&j2kSignificant == 0 || f&j2kVisited != 0 {
+					continue
+				}
+				cx := j2kCtxMR + 2
+				if f&j2kRefined == 0 {
+					cx = j2kCtxMR
+					if h, v, d := t1.neighbours(x, y); h+v+d > 0 {
+						cx = j2kCtxMR + 1
+					}
+				}
+				if t1.decodeBit(cx) == 1 {
+					t1.magnitudes[y*t1.w+x] += 1 << plane
+				} else {
+					t1.magnitudes[y*t1.w+x] -= 1 << plane
+				}
+				t1.setFlag(x, y, j2kRefined)
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1) cleanupPass(plane uint) {
+	for y0 := 0; y0 < t1.h; y0 += 4 {
+		for x := 0; x < t1.w; x++ {
+			y := y0
+			if y0+4 <= t1.h && t1.isRunLength(x, y0) {
+				if t1.mq.decode(j2kCtxRL) == 0 {
+					continue
+				}
+				y = y0 + t1.mq.decode(j2kCtxUniform)<<1
+				y += t1.mq.decode(j2kCtxUniform)
+				t1.decodeSign(x, y, plane)
+				y++
+			}
+			for ; y < y0+4 && y < t1.h; y++ {
+				f := t1.flag(x, y)
+				if f&(j2kSignificant|j2kVisited) == 0 {
+					if t1.mq.decode(j2kCtxZC+t1.zeroContext(x, y)) == 1 {
+						t1.decodeSign(x, y, plane)
+					}
+				}
+			}
+		}
+	}
+	for i := range t1.flags {
+		t1.flags[i] &^= j2kVisited
+	}
+	if t1.cbStyle&j2kSegSymbol != 0 {
+		for i := 0; i < 4; i++ {
+			t1.mq.decode(j2kCtxUniform)
+		}
+	}
+}
+
+// isRunLength checks whether the column of the stripe is coded in the run-length mode.
+func (t1 *j2kT1) isRunLength(x int, y0 int) bool {
+	for y := y0; y < y0+4; y++ {
+		if t1.flag(x, y) != 0 || t1.zeroContext(x, y) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// decode decodes the coding passes of the code-block from the most significant bit-plane.
+func (t1 *j2kT1) decode(cb *j2kCodeBlock, numPlanes int) error {
+	t1.w = cb.x1 - cb.x0
+	t1.h = cb.y1 - cb.y0
+	t1.magnitudes = make([]int32, t1.w*t1.h)
+	t1.flags = make([]uint8, (t1.w+2)*(t1.h+2))
+	t1.mq.resetContexts()
+	if numPlanes > 30 {
+		return errors.New("j2kDecoder: too many bit-planes in a code-block")
+	}
+
+	plane := numPlanes - 1
+	passType := 2 // starts with the cleanup pass
+	pass := 0
+	for _, seg := range cb.segments {
+		isInit := false
+		for i := 0; i < seg.passes && plane >= 0; i++ {
+			t1.isRaw = t1.cbStyle&j2kBypass != 0 && pass >= 10 && passType != 2
+			if !isInit {
+				if t1.isRaw {
+					t1.raw.init(seg.data)
+				} else {
+					t1.mq.init(seg.data)
+				}
+				isInit = true
+			}
+			switch passType {
+			case 0:
+				t1.significancePass(uint(plane))
+			case 1:
+				t1.refinementPass(uint(plane))
+			case 2:
+				t1.cleanupPass(uint(plane))
+			}
+			if t1.cbStyle&j2kReset != 0 && !t1.isRaw {
+				t1.mq.resetContexts()
+			}
+			pass++
+			passType++
+			if passType == 3 {
+				passType = 0
+				plane--
+			}
+		}
+	}
+	return nil
+}
+
+// decodeCodeBlocks decodes the code-blocks of the subband and dequantizes the coefficients.
+func (tc *j2kTileComponent) decodeCodeBlocks(band *j2kBand, cod *j2kCodingStyle) error {
+	w := band.x1 - band.x0
+	h := band.y1 - band.y0
+	band.coefficients = make([]float64, w*h)
+	var t1 j2kT1
+	t1.orientation = band.orientation
+	t1.cbStyle = tc.style.cbStyle
+	for _, prc := range band.precincts {
+		for _, cb := range prc.codeblocks {
+			if cb.passes == 0 {
+				continue
+			}
+			numPlanes := band.magnitudeBits + tc.roiShift - cb.zeroBitplanes
+			err := t1.decode(cb, numPlanes)
+			if err != nil {
+				return err
+			}
+			for y := 0; y < t1.h; y++ {
+				for x := 0; x < t1.w; x++ {
+					m := t1.magnitudes[y*t1.w+x]
+					if m == 0 {
+						continue
+					}
+					if tc.roiShift > 0 && m >= 2<<uint(tc.roiShift) {
+						m >>= uint(tc.roiShift)
+					}
+					var v float64
+					if tc.style.transform == 1 {
+						v = float64(m >> 1)
+					} else {
+						v = float64(m) / 2 * band.stepSize
+					}
+					if t1.flag(x, y)&j2kNegative != 0 {
+						v = -v
+					}
+					band.coefficients[(cb.y0-band.y0+y)*w+cb.x0-band.x0+x] = v
+				}
+			}
+		}
+	}
+	return nil
+}
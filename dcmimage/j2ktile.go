@@ -0,0 +1,780 @@
+package dcmimage
+
+import (
+	"errors"
+	"math"
+)
+
+type j2kSegment struct {
+	data   []byte
+	passes int
+}
+
+type j2kCodeBlock struct {
+	x0, y0, x1, y1 int
+
+	isIncluded    bool
+	zeroBitplanes int
+	lblock        int
+	passes        int
+	segments      []*j2kSegment
+}
+
+type j2kPrecinct struct {
+	cbw           int
+	cbh           int
+	codeblocks    []*j2kCodeBlock
+	inclusion     *j2kTagTree
+	zeroBitplanes *j2kTagTree
+}
+
+type j2kBand struct {
+	orientation    int // 0: LL, 1: HL, 2: LH, 3: HH
+	x0, y0, x1, y1 int
+	magnitudeBits  int
+	stepSize       float64
+	precincts      []*j2kPrecinct
+	coefficients   []float64
+}
+
+type j2kResolution struct {
+	x0, y0, x1, y1 int
+	ppx, ppy       int
+	numPrecinctsX  int
+	numPrecinctsY  int
+	bands          []*j2kBand
+}
+
+type j2kTileComponent struct {
+	x0, y0, x1, y1 int
+	dx, dy         int
+	style          *j2kComponentStyle
+	roiShift       int
+	resolutions    []*j2kResolution
+	layersDone     [][]int
+}
+
+// j2kTile contains the structures of a tile for decoding the packets.
+type j2kTile struct {
+	x0, y0, x1, y1 int
+	cod            *j2kCodingStyle
+	components     []*j2kTileComponent
+}
+
+// decodeTile decodes the packets, the code-blocks and the wavelet transform of a tile.
+func (d *j2kDecoder) decodeTile(index int, part *j2kTilePart) error {
+	p := index % d.numTilesX
+	q := index / d.numTilesX
+	var tile j2kTile
+	tile.x0 = maxInt(d.tx0+p*d.tw, d.x0)
+	tile.y0 = maxInt(d.ty0+q*d.th, d.y0)
+	tile.x1 = minInt(d.tx0+(p+1)*d.tw, d.x1)
+	tile.y1 = minInt(d.ty0+(q+1)*d.th, d.y1)
+	tile.cod = d.codingStyle(part.header)
+
+	for c, comp := range d.components {
+		tc, err := d.newTileComponent(&tile, part.header, c, comp)
+		if err != nil {
+			return err
+		}
+		tile.components = append(tile.components, tc)
+	}
+
+	err := d.decodePackets(&tile, part)
+	if err != nil {
+		return err
+	}
+
+	var samples [][]float64
+	for _, tc := range tile.components {
+		for _, res := range tc.resolutions {
+			for _, band := range res.bands {
+				err = tc.decodeCodeBlocks(band, tile.cod)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		samples = append(samples, tc.inverseTransform())
+	}
+
+	isMCT := tile.cod.mct != 0 && len(samples) >= 3
+	if isMCT {
+		for c := 1; c < 3; c++ {
+			if len(samples[c]) != len(samples[0]) || tile.components[c].style.transform != tile.components[0].style.transform {
+				return errors.New("j2kDecoder: the multiple component transform needs the same size and wavelet")
+			}
+		}
+		if tile.components[0].style.transform == 1 {
+			inverseRCT(samples[0], samples[1], samples[2])
+		} else {
+			inverseICT(samples[0], samples[1], samples[2])
+		}
+		d.isMCT = true
+	}
+
+	for c, tc := range tile.components {
+		comp := d.components[c]
+		shift := 0.0
+		min := 0.0
+		max := math.Ldexp(1, comp.depth) - 1
+		if comp.isSigned {
+			min = -math.Ldexp(1, comp.depth-1)
+			max = math.Ldexp(1, comp.depth-1) - 1
+		} else {
+			shift = math.Ldexp(1, comp.depth-1)
+		}
+		cx0 := ceilDiv(d.x0, comp.dx)
+		cy0 := ceilDiv(d.y0, comp.dy)
+		cw := ceilDiv(d.x1, comp.dx) - cx0
+		w := tc.x1 - tc.x0
+		for y := tc.y0; y < tc.y1; y++ {
+			for x := tc.x0; x < tc.x1; x++ {
+				v := samples[c][(y-tc.y0)*w+x-tc.x0] + shift
+				if tc.style.transform == 0 {
+					v = math.Floor(v + 0.5)
+				}
+				if v < min {
+					v = min
+				}
+				if v > max {
+					v = max
+				}
+				d.planes[c][(y-cy0)*cw+x-cx0] = int32(v)
+			}
+		}
+	}
+	return nil
+}
+
+func (d *j2kDecoder) newTileComponent(tile *j2kTile, h *j2kHeader, c int, comp j2kComponentInfo) (*j2kTileComponent, error) {
+	var tc j2kTileComponent
+	tc.x0 = ceilDiv(tile.x0, comp.dx)
+	tc.y0 = ceilDiv(tile.y0, comp.dy)
+	tc.x1 = ceilDiv(tile.x1, comp.dx)
+	tc.y1 = ceilDiv(tile.y1, comp.dy)
+	tc.dx = comp.dx
+	tc.dy = comp.dy
+	tc.style = d.componentStyle(h, c)
+	tc.roiShift = d.roiShift(h, c)
+	err := tc.newResolutions(d.quantization(h, c), comp.depth)
+	if err != nil {
+		return nil, err
+	}
+	return &tc, nil
+}
+
+// newResolutions partitions the tile-component into the resolutions and the subbands.
+func (tc *j2kTileComponent) newResolutions(q *j2kQuantization, depth int) error {
+	levels := tc.style.levels
+	numBands := 3*levels + 1
+	if q.style != 1 && len(q.exponents) < numBands {
+		return errors.New("j2kDecoder: the quantization does not match the decomposition levels")
+	}
+
+	for r := 0; r <= levels; r++ {
+		var res j2kResolution
+		scale := 1 << uint(levels-r)
+		res.x0 = ceilDiv(tc.x0, scale)
+		res.y0 = ceilDiv(tc.y0, scale)
+		res.x1 = ceilDiv(tc.x1, scale)
+		res.y1 = ceilDiv(tc.y1, scale)
+		res.ppx = tc.style.ppx[r]
+		res.ppy = tc.style.ppy[r]
+		if res.x1 > res.x0 {
+			res.numPrecinctsX = ceilDiv(res.x1, 1<<uint(res.ppx)) - res.x0>>uint(res.ppx)
+		}
+		if res.y1 > res.y0 {
+			res.numPrecinctsY = ceilDiv(res.y1, 1<<uint(res.ppy)) - res.y0>>uint(res.ppy)
+		}
+
+		orientations := []int{1, 2, 3}
+		if r == 0 {
+			orientations = []int{0}
+		}
+		for _, o := range orientations {
+			var band j2kBand
+			band.orientation = o
+			nb := levels - r + 1
+			if r == 0 {
+				nb = levels
+			}
+			xob := o & 1
+			yob := o >> 1
+			if nb == 0 {
+				band.x0, band.y0, band.x1, band.y1 = tc.x0, tc.y0, tc.x1, tc.y1
+			} else {
+				offset := 1 << uint(nb-1)
+				bscale := 1 << uint(nb)
+				band.x0 = ceilDiv(tc.x0-offset*xob, bscale)
+				band.y0 = ceilDiv(tc.y0-offset*yob, bscale)
+				band.x1 = ceilDiv(tc.x1-offset*xob, bscale)
+				band.y1 = ceilDiv(tc.y1-offset*yob, bscale)
+			}
+
+			// the quantization of the subband
+			b := 0
+			if r > 0 {
+				b = 3*(r-1) + o
+			}
+			exponent := 0
+			mantissa := 0
+			if q.style == 1 {
+				exponent = q.exponents[0] - levels + nb
+				mantissa = q.mantissas[0]
+			} else {
+				exponent = q.exponents[b]
+				mantissa = q.mantissas[b]
+			}
+			gain := []int{0, 1, 1, 2}[o]
+			band.magnitudeBits = q.guard + exponent - 1
+			band.stepSize = j2kStepSize(depth, gain, exponent, mantissa)
+
+			res.bands = append(res.bands, &band)
+			tc.newPrecincts(&res, &band, r)
+		}
+		tc.resolutions = append(tc.resolutions, &res)
+		tc.layersDone = append(tc.layersDone, make([]int, res.numPrecinctsX*res.numPrecinctsY))
+	}
+	return nil
+}
+
+// newPrecincts partitions the subband into the precincts and the code-blocks.
+func (tc *j2kTileComponent) newPrecincts(res *j2kResolution, band *j2kBand, r int) {
+	ppx := res.ppx
+	ppy := res.ppy
+	if r > 0 {
+		ppx--
+		ppy--
+	}
+	xcb := minInt(tc.style.xcb, ppx)
+	ycb := minInt(tc.style.ycb, ppy)
+	px0 := res.x0 >> uint(res.ppx)
+	py0 := res.y0 >> uint(res.ppy)
+	for j := 0; j < res.numPrecinctsY; j++ {
+		for i := 0; i < res.numPrecinctsX; i++ {
+			var prc j2kPrecinct
+			x0 := maxInt((px0+i)<<uint(ppx), band.x0)
+			y0 := maxInt((py0+j)<<uint(ppy), band.y0)
+			x1 := minInt((px0+i+1)<<uint(ppx), band.x1)
+			y1 := minInt((py0+j+1)<<uint(ppy), band.y1)
+			if x1 > x0 && y1 > y0 {
+				cbx0 := x0 >> uint(xcb)
+				cby0 := y0 >> uint(ycb)
+				prc.cbw = ceilDiv(x1, 1<<uint(xcb)) - cbx0
+				prc.cbh = ceilDiv(y1, 1<<uint(ycb)) - cby0
+				for y := 0; y < prc.cbh; y++ {
+					for x := 0; x < prc.cbw; x++ {
+						var cb j2kCodeBlock
+						cb.x0 = maxInt((cbx0+x)<<uint(xcb), x0)
+						cb.y0 = maxInt((cby0+y)<<uint(ycb), y0)
+						cb.x1 = minInt((cbx0+x+1)<<uint(xcb), x1)
+						cb.y1 = minInt((cby0+y+1)<<uint(ycb), y1)
+						cb.lblock = 3
+						prc.codeblocks = append(prc.codeblocks, &cb)
+					}
+				}
+				prc.inclusion = newJ2KTagTree(prc.cbw, prc.cbh)
+				prc.zeroBitplanes = newJ2KTagTree(prc.cbw, prc.cbh)
+			}
+			band.precincts = append(band.precincts, &prc)
+		}
+	}
+}
+
+// j2kTagTree is the tag tree coding the inclusion and the zero bit-planes of the code-blocks.
+type j2kTagTree struct {
+	parents []int
+	values  []int
+	lows    []int
+	known   []bool // the nodes whose value has been coded by encode
+}
+
+func newJ2KTagTree(w int, h int) *j2kTagTree {
+	var tree j2kTagTree
+	// the leaves of each level, from the bottom to the root
+	var starts []int
+	var widths []int
+	num := 0
+	for {
+		starts = append(starts, num)
+		widths = append(widths, w)
+		num += w * h
+		if w == 1 && h == 1 {
+			break
+		}
+		w = (w + 1) / 2
+		h = (h + 1) / 2
+	}
+	tree.parents = make([]int, num)
+	tree.values = make([]int, num)
+	tree.lows = make([]int, num)
+	for i := range tree.values {
+		tree.values[i] = math.MaxInt32
+		tree.parents[i] = -1
+	}
+	for level := 0; level+1 < len(starts); level++ {
+		width := widths[level]
+		height := (starts[level+1] - starts[level]) / width
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				tree.parents[starts[level]+y*width+x] = starts[level+1] + (y/2)*widths[level+1] + x/2
+			}
+		}
+	}
+	return &tree
+}
+
+// decode reads the bits of the leaf until its value is known to be less than the
+// threshold or not, and returns whether the value is less than the threshold.
+func (tree *j2kTagTree) decode(br *j2kBitReader, leaf int, threshold int) (bool, error) {
+	var stack []int
+	node := leaf
+	for tree.parents[node] >= 0 {
+		stack = append(stack, node)
+		node = tree.parents[node]
+	}
+	low := 0
+	for {
+		if low > tree.lows[node] {
+			tree.lows[node] = low
+		} else {
+			low = tree.lows[node]
+		}
+		for low < threshold && low < tree.values[node] {
+			bit, err := br.readBit()
+			if err != nil {
+				return false, err
+			}
+			if bit == 1 {
+				tree.values[node] = low
+			} else {
+				low++
+			}
+		}
+		tree.lows[node] = low
+		if len(stack) == 0 {
+			break
+		}
+		node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+	}
+	return tree.values[node] < threshold, nil
+}
+
+// j2kBitReader reads the packet headers with bit stuffing.
+type j2kBitReader struct {
+	data []byte
+	pos  int
+	buf  uint
+	ct   uint
+}
+
+func (br *j2kBitReader) readBit() (int, error) {
+	if br.ct == 0 {
+		if br.pos >= len(br.data) {
+			return 0, errors.New("j2kDecoder: unexpected end of packet header")
+		}
+		br.ct = 8
+		if br.buf == 0xFF {
+			br.ct = 7
+		}
+		br.buf = uint(br.data[br.pos])
+		br.pos++
+	}
+	br.ct--
+	return int(br.buf>>br.ct) & 1, nil
+}
+
+func (br *j2kBitReader) readBits(n int) (int, error) {
+	v := 0
+	for i := 0; i < n; i++ {
+		bit, err := br.readBit()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<1 | bit
+	}
+	return v, nil
+}
+
+// align skips the remaining bits of the packet header.
+func (br *j2kBitReader) align() {
+	if br.buf == 0xFF {
+		br.pos++
+	}
+	br.ct = 0
+}
+
+// numPasses reads the number of new coding passes.
+func (br *j2kBitReader) numPasses() (int, error) {
+	codes := []struct {
+		bits   int
+		escape int
+		offset int
+	}{
+		{1, 1, 1}, {1, 1, 2}, {2, 3, 3}, {5, 31, 6}, {7, -1, 37},
+	}
+	for i, code := range codes {
+		v, err := br.readBits(code.bits)
+		if err != nil {
+			return 0, err
+		}
+		if i < 2 {
+			if v == 0 {
+				return code.offset, nil
+			}
+			continue
+		}
+		if v != code.escape {
+			return code.offset + v, nil
+		}
+	}
+	return 0, nil
+}
+
+// maxSegmentPasses gets the maximum number of coding passes in a codeword segment.
+func maxSegmentPasses(cbStyle byte, segment int) int {
+	switch {
+	case cbStyle&j2kTermAll != 0:
+		return 1
+	case cbStyle&j2kBypass != 0:
+		if segment == 0 {
+			return 10
+		}
+		if segment%2 == 1 {
+			return 2
+		}
+		return 1
+	}
+	return math.MaxInt32
+}
+
+type j2kCodeBlockPart struct {
+	cb      *j2kCodeBlock
+	segment *j2kSegment
+	passes  int
+	length  int
+}
+
+// decodePacket decodes the packet of a layer, resolution, component and precinct.
+func (d *j2kDecoder) decodePacket(tile *j2kTile, data []byte, pos int, layer int, tc *j2kTileComponent, r int, p int) (int, error) {
+	if tile.cod.isSOP && pos+6 <= len(data) && data[pos] == 0xFF && data[pos+1] == 0x91 {
+		pos += 6
+	}
+	br := j2kBitReader{data: data, pos: pos}
+	present, err := br.readBit()
+	if err != nil {
+		return pos, err
+	}
+	var parts []j2kCodeBlockPart
+	if present == 1 {
+		for _, band := range tc.resolutions[r].bands {
+			prc := band.precincts[p]
+			for i, cb := range prc.codeblocks {
+				var included bool
+				if !cb.isIncluded {
+					included, err = prc.inclusion.decode(&br, i, layer+1)
+				} else {
+					var bit int
+					bit, err = br.readBit()
+					included = bit == 1
+				}
+				if err != nil {
+					return pos, err
+				}
+				if !included {
+					continue
+				}
+				if !cb.isIncluded {
+					threshold := 1
+					for {
+						known, err := prc.zeroBitplanes.decode(&br, i, threshold)
+						if err != nil {
+							return pos, err
+						}
+						if known {
+							break
+						}
+						threshold++
+					}
+					cb.zeroBitplanes = threshold - 1
+					cb.isIncluded = true
+				}
+				passes, err := br.numPasses()
+				if err != nil {
+					return pos, err
+				}
+				for {
+					bit, err := br.readBit()
+					if err != nil {
+						return pos, err
+					}
+					if bit == 0 {
+						break
+					}
+					cb.lblock++
+				}
+
+				// split the new passes into the codeword segments
+				for passes > 0 {
+					var seg *j2kSegment
+					used := 0
+					if n := len(cb.segments); n > 0 {
+						seg = cb.segments[n-1]
+						used = seg.passes
+						// the parts of this packet are not added to the segment yet
+						for _, part := range parts {
+							if part.segment == seg {
+								used += part.passes
+							}
+						}
+					}
+					if seg == nil || used >= maxSegmentPasses(tc.style.cbStyle, len(cb.segments)-1) {
+						seg = &j2kSegment{}
+						cb.segments = append(cb.segments, seg)
+						used = 0
+					}
+					num := minInt(passes, maxSegmentPasses(tc.style.cbStyle, len(cb.segments)-1)-used)
+					length, err := br.readBits(cb.lblock + floorLog2(num))
+					if err != nil {
+						return pos, err
+					}
+					parts = append(parts, j2kCodeBlockPart{cb, seg, num, length})
+					passes -= num
+				}
+			}
+		}
+	}
+	br.align()
+	pos = br.pos
+	if tile.cod.isEPH && pos+2 <= len(data) && data[pos] == 0xFF && data[pos+1] == 0x92 {
+		pos += 2
+	}
+
+	for _, part := range parts {
+		if pos+part.length > len(data) {
+			return pos, errors.New("j2kDecoder: unexpected end of packet data")
+		}
+		part.segment.data = append(part.segment.data, data[pos:pos+part.length]...)
+		part.segment.passes += part.passes
+		part.cb.passes += part.passes
+		pos += part.length
+	}
+	return pos, nil
+}
+
+// decodePackets decodes the packets of the tile in the progression orders.
+func (d *j2kDecoder) decodePackets(tile *j2kTile, part *j2kTilePart) error {
+	progressions := part.header.pocs
+	if len(progressions) == 0 {
+		progressions = d.main.pocs
+	}
+	if len(progressions) == 0 {
+		maxLevels := 0
+		for _, tc := range tile.components {
+			maxLevels = maxInt(maxLevels, tc.style.levels)
+		}
+		progressions = []j2kProgressionChange{{0, 0, tile.cod.layers, maxLevels + 1, len(tile.components), tile.cod.progression}}
+	}
+
+	pos := 0
+	for _, prog := range progressions {
+		prog.layerEnd = minInt(prog.layerEnd, tile.cod.layers)
+		prog.compEnd = minInt(prog.compEnd, len(tile.components))
+		err := tile.iteratePackets(prog, func(l int, r int, c int, p int) error {
+			tc := tile.components[c]
+			if l != tc.layersDone[r][p] {
+				// the packet has been decoded in a previous progression
+				return nil
+			}
+			tc.layersDone[r][p]++
+			var err error
+			pos, err = d.decodePacket(tile, part.data, pos, l, tc, r, p)
+			return err
+		})
+		if err != nil {
+			// decode the code-blocks of a truncated codestream
+			if pos >= len(part.data) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// iteratePackets calls the function with the layer, resolution, component and precinct
+// of each packet in the progression order.
+func (tile *j2kTile) iteratePackets(prog j2kProgressionChange, fn func(int, int, int, int) error) error {
+	precincts := func(l int, r int, c int) error {
+		tc := tile.components[c]
+		if r > tc.style.levels {
+			return nil
+		}
+		res := tc.resolutions[r]
+		for p := 0; p < res.numPrecinctsX*res.numPrecinctsY; p++ {
+			err := fn(l, r, c, p)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch prog.progression {
+	case j2kLRCP:
+		for l := 0; l < prog.layerEnd; l++ {
+			for r := prog.resStart; r < prog.resEnd; r++ {
+				for c := prog.compStart; c < prog.compEnd; c++ {
+					err := precincts(l, r, c)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case j2kRLCP:
+		for r := prog.resStart; r < prog.resEnd; r++ {
+			for l := 0; l < prog.layerEnd; l++ {
+				for c := prog.compStart; c < prog.compEnd; c++ {
+					err := precincts(l, r, c)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case j2kRPCL:
+		for r := prog.resStart; r < prog.resEnd; r++ {
+			err := tile.iteratePositions(prog, func(x int, y int) error {
+				for c := prog.compStart; c < prog.compEnd; c++ {
+					err := tile.precinctAt(prog, x, y, r, c, fn)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	case j2kPCRL:
+		return tile.iteratePositions(prog, func(x int, y int) error {
+			for c := prog.compStart; c < prog.compEnd; c++ {
+				for r := prog.resStart; r < prog.resEnd; r++ {
+					err := tile.precinctAt(prog, x, y, r, c, fn)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	case j2kCPRL:
+		for c := prog.compStart; c < prog.compEnd; c++ {
+			cprog := prog
+			cprog.compStart = c
+			cprog.compEnd = c + 1
+			err := tile.iteratePositions(cprog, func(x int, y int) error {
+				for r := prog.resStart; r < prog.resEnd; r++ {
+					err := tile.precinctAt(prog, x, y, r, c, fn)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// iteratePositions calls the function with the positions on the reference grid where a
+// precinct of the components may start.
+func (tile *j2kTile) iteratePositions(prog j2kProgressionChange, fn func(int, int) error) error {
+	dx := math.MaxInt32
+	dy := math.MaxInt32
+	for c := prog.compStart; c < prog.compEnd; c++ {
+		tc := tile.components[c]
+		for r, res := range tc.resolutions {
+			levels := uint(tc.style.levels - r)
+			dx = minInt(dx, tc.dx<<(uint(res.ppx)+levels))
+			dy = minInt(dy, tc.dy<<(uint(res.ppy)+levels))
+		}
+	}
+	if dx == math.MaxInt32 {
+		return nil
+	}
+	for y := tile.y0; y < tile.y1; y += dy - y%dy {
+		for x := tile.x0; x < tile.x1; x += dx - x%dx {
+			err := fn(x, y)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// precinctAt calls the function with the layers of the precinct starting at the position.
+func (tile *j2kTile) precinctAt(prog j2kProgressionChange, x int, y int, r int, c int, fn func(int, int, int, int) error) error {
+	tc := tile.components[c]
+	if r > tc.style.levels {
+		return nil
+	}
+	res := tc.resolutions[r]
+	if res.numPrecinctsX == 0 || res.numPrecinctsY == 0 {
+		return nil
+	}
+	sx := tc.dx
+	sy := tc.dy
+	levels := uint(tc.style.levels - r)
+	rpx := uint(res.ppx) + levels
+	rpy := uint(res.ppy) + levels
+	if !(y%(sy<<rpy) == 0 || (y == tile.y0 && (res.y0<<levels)%(1<<rpy) != 0)) {
+		return nil
+	}
+	if !(x%(sx<<rpx) == 0 || (x == tile.x0 && (res.x0<<levels)%(1<<rpx) != 0)) {
+		return nil
+	}
+	i := ceilDiv(x, sx<<levels)>>uint(res.ppx) - res.x0>>uint(res.ppx)
+	j := ceilDiv(y, sy<<levels)>>uint(res.ppy) - res.y0>>uint(res.ppy)
+	if i < 0 || i >= res.numPrecinctsX || j < 0 || j >= res.numPrecinctsY {
+		return nil
+	}
+	for l := 0; l < prog.layerEnd; l++ {
+		err := fn(l, r, c, i+j*res.numPrecinctsX)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func floorLog2(v int) int {
+	n := 0
+	for v > 1 {
+		v >>= 1
+		n++
+	}
+	return n
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,715 @@
+package dcmimage
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+func init() {
+	// JPEG Baseline (Process 1)
+	RegisterCodec("1.2.840.10008.1.2.4.50", jpegCodec{})
+	// JPEG Extended (Process 2 & 4)
+	RegisterCodec("1.2.840.10008.1.2.4.51", jpegCodec{})
+	// JPEG Lossless, Non-Hierarchical (Process 14)
+	RegisterCodec("1.2.840.10008.1.2.4.57", jpegCodec{})
+	// JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14, Selection Value 1)
+	RegisterCodec("1.2.840.10008.1.2.4.70", jpegCodec{})
+}
+
+// JPEG markers
+const (
+	jpegSOF0 = 0xC0 // baseline DCT
+	jpegSOF1 = 0xC1 // extended sequential DCT, Huffman coding
+	jpegSOF2 = 0xC2 // progressive DCT, Huffman coding
+	jpegSOF3 = 0xC3 // lossless, Huffman coding
+	jpegDHT  = 0xC4
+	jpegRST0 = 0xD0
+	jpegRST7 = 0xD7
+	jpegSOI  = 0xD8
+	jpegEOI  = 0xD9
+	jpegSOS  = 0xDA
+	jpegDQT  = 0xDB
+	jpegDRI  = 0xDD
+	jpegAPP0 = 0xE0
+	jpegAPPE = 0xEE
+)
+
+// the zig-zag order of the DCT coefficients
+var jpegZigzag = [64]int{
+	0, 1, 8, 16, 9, 2, 3, 10,
+	17, 24, 32, 25, 18, 11, 4, 5,
+	12, 19, 26, 33, 40, 48, 41, 34,
+	27, 20, 13, 6, 7, 14, 21, 28,
+	35, 42, 49, 56, 57, 50, 43, 36,
+	29, 22, 15, 23, 30, 37, 44, 51,
+	58, 59, 52, 45, 38, 31, 39, 46,
+	53, 60, 61, 54, 47, 55, 62, 63,
+}
+
+// the cosine table of the inverse DCT, including the normalization factors
+var jpegIDCTTable = func() [8][8]float64 {
+	var table [8][8]float64
+	for x := 0; x < 8; x++ {
+		for u := 0; u < 8; u++ {
+			c := 1.0
+			if u == 0 {
+				c = 1 / math.Sqrt2
+			}
+			table[x][u] = c / 2 * math.Cos(float64(2*x+1)*float64(u)*math.Pi/16)
+		}
+	}
+	return table
+}()
+
+// jpegCodec decodes the JPEG Baseline, Extended and Lossless processes.
+type jpegCodec struct{}
+
+// Decode decodes a JPEG bit stream. The YCbCr color space of the lossy processes is converted to RGB.
+func (jpegCodec) Decode(src []byte, di *DcmImage) ([]byte, error) {
+	var d jpegDecoder
+	err := d.decode(src)
+	if err != nil {
+		return nil, err
+	}
+	isYCbCr := !d.isLossless && d.isYCbCr(di.PhotometricInterpretation)
+	if isYCbCr {
+		di.PhotometricInterpretation = "RGB"
+	}
+	result, err := d.pixelData(di, isYCbCr)
+	if err != nil {
+		return nil, err
+	}
+	// the samples are interleaved by pixel
+	di.PlanarConfiguration = 0
+	return result, nil
+}
+
+type jpegHuffmanTable struct {
+	maxcode [18]int32
+	valptr  [17]int32
+	mincode [17]int32
+	values  []byte
+}
+
+type jpegComponent struct {
+	id int
+	h  int // horizontal sampling factor
+	v  int // vertical sampling factor
+	tq int // quantization table selector
+	td int // DC entropy coding table selector
+	ta int // AC entropy coding table selector
+
+	pred int32 // the DC predictor
+
+	// the decoded samples, aligned to the MCUs
+	stride  int
+	samples []int32
+}
+
+// jpegDecoder decodes the JPEG bit stream defined in ISO/IEC 10918-1.
+type jpegDecoder struct {
+	data []byte
+	pos  int
+
+	precision      int
+	width          int
+	height         int
+	components     []*jpegComponent
+	isLossless     bool
+	pointTransform uint
+
+	qt              [4][64]int32
+	dc              [4]*jpegHuffmanTable
+	ac              [4]*jpegHuffmanTable
+	restartInterval int
+
+	hmax          int
+	vmax          int
+	mcusPerLine   int
+	mcusPerColumn int
+
+	isJFIF         bool
+	isAdobe        bool
+	adobeTransform byte
+
+	// the entropy-coded data reader
+	bits  uint32
+	nbits uint
+}
+
+func (d *jpegDecoder) decode(data []byte) error {
+	d.data = data
+	if len(data) < 2 || data[0] != 0xFF || data[1] != jpegSOI {
+		return errors.New("jpegDecoder: missing SOI marker")
+	}
+	d.pos = 2
+	for {
+		marker, err := d.readMarker()
+		if err != nil {
+			return err
+		}
+		if marker == jpegEOI {
+			break
+		}
+		if marker >= jpegRST0 && marker <= jpegRST7 {
+			continue
+		}
+		segment, err := d.readSegment()
+		if err != nil {
+			return err
+		}
+		switch {
+		case marker == jpegSOF0 || marker == jpegSOF1:
+			err = d.parseFrame(segment)
+		case marker == jpegSOF3:
+			d.isLossless = true
+			err = d.parseFrame(segment)
+		case marker == jpegSOF2:
+			err = errors.New("jpegDecoder: the progressive process is not supported")
+		case marker > jpegSOF0 && marker <= 0xCF && marker != jpegDHT && marker != 0xC8 && marker != 0xCC:
+			str := fmt.Sprintf("jpegDecoder: not supported SOF marker 0xFF%X", marker)
+			err = errors.New(str)
+		case marker == jpegDHT:
+			err = d.parseHuffmanTables(segment)
+		case marker == jpegDQT:
+			err = d.parseQuantizationTables(segment)
+		case marker == jpegDRI:
+			if len(segment) < 2 {
+				return errors.New("jpegDecoder: invalid DRI segment")
+			}
+			d.restartInterval = int(segment[0])<<8 | int(segment[1])
+		case marker == jpegAPP0:
+			d.isJFIF = len(segment) >= 5 && string(segment[:5]) == "JFIF\x00"
+		case marker == jpegAPPE:
+			if len(segment) >= 12 && string(segment[:5]) == "Adobe" {
+				d.isAdobe = true
+				d.adobeTransform = segment[11]
+			}
+		case marker == jpegSOS:
+			err = d.parseScan(segment)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if d.components == nil {
+		return errors.New("jpegDecoder: missing SOF marker")
+	}
+	return nil
+}
+
+// readMarker skips the fill bytes and gets the next marker.
+func (d *jpegDecoder) readMarker() (byte, error) {
+	for d.pos+1 < len(d.data) {
+		if d.data[d.pos] != 0xFF {
+			d.pos++
+			continue
+		}
+		marker := d.data[d.pos+1]
+		if marker == 0xFF {
+			d.pos++
+			continue
+		}
+		d.pos += 2
+		if marker == 0x00 {
+			continue
+		}
+		return marker, nil
+	}
+	return 0, errors.New("jpegDecoder: missing EOI marker")
+}
+
+func (d *jpegDecoder) readSegment() ([]byte, error) {
+	if d.pos+2 > len(d.data) {
+		return nil, errors.New("jpegDecoder: unexpected end of data")
+	}
+	length := int(d.data[d.pos])<<8 | int(d.data[d.pos+1])
+	if length < 2 || d.pos+length > len(d.data) {
+		return nil, errors.New("jpegDecoder: invalid segment length")
+	}
+	segment := d.data[d.pos+2 : d.pos+length]
+	d.pos += length
+	return segment, nil
+}
+
+func (d *jpegDecoder) parseFrame(segment []byte) error {
+	if d.components != nil {
+		return errors.New("jpegDecoder: multiple frames are not supported")
+	}
+	if len(segment) < 6 {
+		return errors.New("jpegDecoder: invalid SOF segment")
+	}
+	d.precision = int(segment[0])
+	d.height = int(segment[1])<<8 | int(segment[2])
+	d.width = int(segment[3])<<8 | int(segment[4])
+	num := int(segment[5])
+	if d.width == 0 || d.height == 0 {
+		return errors.New("jpegDecoder: the image size is not defined in the SOF segment")
+	}
+	if num == 0 || len(segment) < 6+3*num {
+		return errors.New("jpegDecoder: invalid SOF segment")
+	}
+	if d.isLossless && (d.precision < 2 || d.precision > 16) {
+		str := fmt.Sprintf("jpegDecoder: not supported precision %d", d.precision)
+		return errors.New(str)
+	}
+	if !d.isLossless && d.precision != 8 && d.precision != 12 {
+		str := fmt.Sprintf("jpegDecoder: not supported precision %d", d.precision)
+		return errors.New(str)
+	}
+
+	d.hmax = 1
+	d.vmax = 1
+	for i := 0; i < num; i++ {
+		c := new(jpegComponent)
+		c.id = int(segment[6+3*i])
+		c.h = int(segment[7+3*i] >> 4)
+		c.v = int(segment[7+3*i] & 0x0F)
+		c.tq = int(segment[8+3*i] & 0x03)
+		if c.h < 1 || c.h > 4 || c.v < 1 || c.v > 4 {
+			return errors.New("jpegDecoder: invalid sampling factor")
+		}
+		if c.h > d.hmax {
+			d.hmax = c.h
+		}
+		if c.v > d.vmax {
+			d.vmax = c.v
+		}
+		d.components = append(d.components, c)
+	}
+
+	// the data unit is a sample in the lossless process, and a 8x8 block in the DCT process
+	unit := 8
+	if d.isLossless {
+		unit = 1
+	}
+	d.mcusPerLine = (d.width + unit*d.hmax - 1) / (unit * d.hmax)
+	d.mcusPerColumn = (d.height + unit*d.vmax - 1) / (unit * d.vmax)
+	for _, c := range d.components {
+		c.stride = d.mcusPerLine * c.h * unit
+		c.samples = make([]int32, c.stride*d.mcusPerColumn*c.v*unit)
+	}
+	return nil
+}
+
+func (d *jpegDecoder) parseHuffmanTables(segment []byte) error {
+	for len(segment) > 0 {
+		if len(segment) < 17 {
+			return errors.New("jpegDecoder: invalid DHT segment")
+		}
+		tc := segment[0] >> 4
+		th := segment[0] & 0x0F
+		if tc > 1 || th > 3 {
+			return errors.New("jpegDecoder: invalid Huffman table")
+		}
+		t := new(jpegHuffmanTable)
+		total := 0
+		code := int32(0)
+		for l := 1; l <= 16; l++ {
+			count := int32(segment[l])
+			t.valptr[l] = int32(total)
+			t.mincode[l] = code
+			code += count
+			total += int(count)
+			t.maxcode[l] = -1
+			if count > 0 {
+				t.maxcode[l] = code - 1
+			}
+			code <<= 1
+		}
+		t.maxcode[17] = math.MaxInt32
+		if len(segment) < 17+total {
+			return errors.New("jpegDecoder: invalid DHT segment")
+		}
+		t.values = segment[17 : 17+total]
+		if tc == 0 {
+			d.dc[th] = t
+		} else {
+			d.ac[th] = t
+		}
+		segment = segment[17+total:]
+	}
+	return nil
+}
+
+func (d *jpegDecoder) parseQuantizationTables(segment []byte) error {
+	for len(segment) > 0 {
+		pq := segment[0] >> 4
+		tq := segment[0] & 0x0F
+		if tq > 3 {
+			return errors.New("jpegDecoder: invalid quantization table")
+		}
+		size := 65
+		if pq == 1 {
+			size = 129
+		}
+		if len(segment) < size {
+			return errors.New("jpegDecoder: invalid DQT segment")
+		}
+		for k := 0; k < 64; k++ {
+			if pq == 1 {
+				d.qt[tq][k] = int32(segment[1+2*k])<<8 | int32(segment[2+2*k])
+			} else {
+				d.qt[tq][k] = int32(segment[1+k])
+			}
+		}
+		segment = segment[size:]
+	}
+	return nil
+}
+
+func (d *jpegDecoder) parseScan(segment []byte) error {
+	if d.components == nil {
+		return errors.New("jpegDecoder: SOS before SOF")
+	}
+	if len(segment) < 1 {
+		return errors.New("jpegDecoder: invalid SOS segment")
+	}
+	num := int(segment[0])
+	if num == 0 || len(segment) < 4+2*num {
+		return errors.New("jpegDecoder: invalid SOS segment")
+	}
+	var components []*jpegComponent
+	for i := 0; i < num; i++ {
+		id := int(segment[1+2*i])
+		var found *jpegComponent
+		for _, c := range d.components {
+			if c.id == id {
+				found = c
+			}
+		}
+		if found == nil {
+			str := fmt.Sprintf("jpegDecoder: unknown component %d in SOS", id)
+			return errors.New(str)
+		}
+		found.td = int(segment[2+2*i] >> 4)
+		found.ta = int(segment[2+2*i] & 0x0F)
+		if found.td > 3 || found.ta > 3 {
+			return errors.New("jpegDecoder: invalid Huffman table selector")
+		}
+		components = append(components, found)
+	}
+
+	d.bits = 0
+	d.nbits = 0
+	for _, c := range components {
+		c.pred = 0
+	}
+	if d.isLossless {
+		// the start of spectral selection is the predictor, and the successive
+		// approximation bit position low is the point transform
+		predictor := int(segment[1+2*num])
+		d.pointTransform = uint(segment[3+2*num] & 0x0F)
+		return d.decodeLosslessScan(components, predictor)
+	}
+	return d.decodeDCTScan(components)
+}
+
+func (d *jpegDecoder) decodeDCTScan(components []*jpegComponent) error {
+	for _, c := range components {
+		if d.dc[c.td] == nil || d.ac[c.ta] == nil {
+			return errors.New("jpegDecoder: missing Huffman table")
+		}
+	}
+
+	var block [64]int32
+	if len(components) == 1 {
+		// non-interleaved, the data unit is a single block
+		c := components[0]
+		blocksPerLine := ((d.width*c.h+d.hmax-1)/d.hmax + 7) / 8
+		blocksPerColumn := ((d.height*c.v+d.vmax-1)/d.vmax + 7) / 8
+		n := 0
+		for by := 0; by < blocksPerColumn; by++ {
+			for bx := 0; bx < blocksPerLine; bx++ {
+				err := d.processRestart(n, components)
+				if err != nil {
+					return err
+				}
+				err = d.decodeBlock(c, &block)
+				if err != nil {
+					return err
+				}
+				d.storeBlock(c, &block, bx, by)
+				n++
+			}
+		}
+		return nil
+	}
+
+	n := 0
+	for my := 0; my < d.mcusPerColumn; my++ {
+		for mx := 0; mx < d.mcusPerLine; mx++ {
+			err := d.processRestart(n, components)
+			if err != nil {
+				return err
+			}
+			for _, c := range components {
+				for v := 0; v < c.v; v++ {
+					for h := 0; h < c.h; h++ {
+						err = d.decodeBlock(c, &block)
+						if err != nil {
+							return err
+						}
+						d.storeBlock(c, &block, mx*c.h+h, my*c.v+v)
+					}
+				}
+			}
+			n++
+		}
+	}
+	return nil
+}
+
+// processRestart expects a RST marker before the nth MCU if the restart interval is defined.
+func (d *jpegDecoder) processRestart(n int, components []*jpegComponent) error {
+	if d.restartInterval == 0 || n == 0 || n%d.restartInterval != 0 {
+		return nil
+	}
+	d.bits = 0
+	d.nbits = 0
+	for d.pos+1 < len(d.data) {
+		if d.data[d.pos] == 0xFF && d.data[d.pos+1] >= jpegRST0 && d.data[d.pos+1] <= jpegRST7 {
+			d.pos += 2
+			for _, c := range components {
+				c.pred = 0
+			}
+			return nil
+		}
+		d.pos++
+	}
+	return errors.New("jpegDecoder: missing RST marker")
+}
+
+func (d *jpegDecoder) readBit() (int32, error) {
+	if d.nbits == 0 {
+		if d.pos >= len(d.data) {
+			return 0, errors.New("jpegDecoder: unexpected end of entropy-coded data")
+		}
+		b := d.data[d.pos]
+		if b == 0xFF {
+			if d.pos+1 < len(d.data) && d.data[d.pos+1] == 0x00 {
+				d.pos += 2
+			} else {
+				// a marker is reached, the remaining bits are filled with zeros
+				b = 0
+			}
+		} else {
+			d.pos++
+		}
+		d.bits = uint32(b)
+		d.nbits = 8
+	}
+	d.nbits--
+	return int32(d.bits>>d.nbits) & 1, nil
+}
+
+func (d *jpegDecoder) receive(s int) (int32, error) {
+	var v int32
+	for i := 0; i < s; i++ {
+		bit, err := d.readBit()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<1 | bit
+	}
+	return v, nil
+}
+
+// receiveExtend reads s bits and converts them to a signed value.
+func (d *jpegDecoder) receiveExtend(s int) (int32, error) {
+	if s == 0 {
+		return 0, nil
+	}
+	v, err := d.receive(s)
+	if err != nil {
+		return 0, err
+	}
+	if v < 1<<uint(s-1) {
+		v += -1<<uint(s) + 1
+	}
+	return v, nil
+}
+
+func (d *jpegDecoder) decodeHuffman(t *jpegHuffmanTable) (int, error) {
+	code, err := d.readBit()
+	if err != nil {
+		return 0, err
+	}
+	l := 1
+	for code > t.maxcode[l] {
+		bit, err := d.readBit()
+		if err != nil {
+			return 0, err
+		}
+		code = code<<1 | bit
+		l++
+		if l > 16 {
+			return 0, errors.New("jpegDecoder: invalid Huffman code")
+		}
+	}
+	index := int(t.valptr[l] + code - t.mincode[l])
+	if index >= len(t.values) {
+		return 0, errors.New("jpegDecoder: invalid Huffman code")
+	}
+	return int(t.values[index]), nil
+}
+
+// decodeBlock decodes and dequantizes the coefficients of a block in natural order.
+func (d *jpegDecoder) decodeBlock(c *jpegComponent, block *[64]int32) error {
+	*block = [64]int32{}
+	qt := &d.qt[c.tq]
+
+	s, err := d.decodeHuffman(d.dc[c.td])
+	if err != nil {
+		return err
+	}
+	diff, err := d.receiveExtend(s)
+	if err != nil {
+		return err
+	}
+	c.pred += diff
+	block[0] = c.pred * qt[0]
+
+	for k := 1; k < 64; k++ {
+		rs, err := d.decodeHuffman(d.ac[c.ta])
+		if err != nil {
+			return err
+		}
+		r := rs >> 4
+		s := rs & 0x0F
+		if s == 0 {
+			if r != 15 {
+				break // EOB
+			}
+			k += 15
+			continue
+		}
+		k += r
+		if k > 63 {
+			return errors.New("jpegDecoder: invalid AC coefficient")
+		}
+		v, err := d.receiveExtend(s)
+		if err != nil {
+			return err
+		}
+		block[jpegZigzag[k]] = v * qt[k]
+	}
+	return nil
+}
+
+// storeBlock applies the inverse DCT to the block, and stores the samples.
+func (d *jpegDecoder) storeBlock(c *jpegComponent, block *[64]int32, bx int, by int) {
+	var tmp [64]float64
+	// rows
+	for v := 0; v < 8; v++ {
+		for x := 0; x < 8; x++ {
+			var sum float64
+			for u := 0; u < 8; u++ {
+				sum += jpegIDCTTable[x][u] * float64(block[8*v+u])
+			}
+			tmp[8*v+x] = sum
+		}
+	}
+	// columns
+	shift := float64(int32(1) << uint(d.precision-1))
+	max := float64(int32(1)<<uint(d.precision) - 1)
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 8; y++ {
+			var sum float64
+			for v := 0; v < 8; v++ {
+				sum += jpegIDCTTable[y][v] * tmp[8*v+x]
+			}
+			sample := math.Floor(sum + shift + 0.5)
+			if sample < 0 {
+				sample = 0
+			} else if sample > max {
+				sample = max
+			}
+			c.samples[(8*by+y)*c.stride+8*bx+x] = int32(sample)
+		}
+	}
+}
+
+// isYCbCr checks whether the components are encoded in the YCbCr color space.
+func (d *jpegDecoder) isYCbCr(photometric string) bool {
+	if len(d.components) != 3 {
+		return false
+	}
+	switch photometric {
+	case "YBR_FULL", "YBR_FULL_422":
+		return true
+	case "RGB":
+		// the photometric interpretation is not always updated after compression
+		if d.isJFIF {
+			return true
+		}
+		if d.isAdobe {
+			return d.adobeTransform != 0
+		}
+		return !(d.components[0].id == 'R' && d.components[1].id == 'G' && d.components[2].id == 'B')
+	}
+	return false
+}
+
+// pixelData upsamples the components and interleaves the samples.
+func (d *jpegDecoder) pixelData(di *DcmImage, isYCbCr bool) ([]byte, error) {
+	if di.Columns != 0 && (int(di.Columns) != d.width || int(di.Rows) != d.height) {
+		str := fmt.Sprintf("jpegDecoder: the image size %dx%d does not match %dx%d", d.width, d.height, di.Columns, di.Rows)
+		return nil, errors.New(str)
+	}
+	if di.SamplesPerPixel != 0 && int(di.SamplesPerPixel) != len(d.components) {
+		str := fmt.Sprintf("jpegDecoder: the number of components %d does not match SamplesPerPixel %d", len(d.components), di.SamplesPerPixel)
+		return nil, errors.New(str)
+	}
+	bytesPerSample := 1
+	if di.BitsAllocated > 8 || (di.BitsAllocated == 0 && d.precision > 8) {
+		bytesPerSample = 2
+	}
+
+	num := len(d.components)
+	result := make([]byte, d.width*d.height*num*bytesPerSample)
+	max := int32(1)<<uint(d.precision) - 1
+	half := float64(int32(1) << uint(d.precision-1))
+	pixel := make([]int32, num)
+	index := 0
+	for y := 0; y < d.height; y++ {
+		for x := 0; x < d.width; x++ {
+			for i, c := range d.components {
+				cy := y * c.v / d.vmax
+				cx := x * c.h / d.hmax
+				pixel[i] = c.samples[cy*c.stride+cx]
+			}
+			if isYCbCr {
+				yy := float64(pixel[0])
+				cb := float64(pixel[1]) - half
+				cr := float64(pixel[2]) - half
+				pixel[0] = clampSample(yy+1.402*cr, max)
+				pixel[1] = clampSample(yy-0.344136*cb-0.714136*cr, max)
+				pixel[2] = clampSample(yy+1.772*cb, max)
+			}
+			for _, p := range pixel {
+				p <<= d.pointTransform
+				if bytesPerSample == 1 {
+					result[index] = byte(p)
+				} else {
+					result[index] = byte(p)
+					result[index+1] = byte(p >> 8)
+				}
+				index += bytesPerSample
+			}
+		}
+	}
+	return result, nil
+}
+
+func clampSample(v float64, max int32) int32 {
+	s := int32(math.Floor(v + 0.5))
+	if s < 0 {
+		return 0
+	}
+	if s > max {
+		return max
+	}
+	return s
+}
@@ -0,0 +1,176 @@
+package dcmimage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+func newTestImage(isGray bool, width int, height int) image.Image {
+	rect := image.Rect(0, 0, width, height)
+	if isGray {
+		m := image.NewGray(rect)
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				m.SetGray(x, y, color.Gray{uint8((x*7 + y*3) % 256)})
+			}
+		}
+		return m
+	}
+	m := image.NewRGBA(rect)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			m.SetRGBA(x, y, color.RGBA{uint8(x * 4), uint8(y * 4), uint8((x + y) * 2), 255})
+		}
+	}
+	return m
+}
+
+func absDiff(a uint8, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
+
+func TestJPEGCodecDecode(t *testing.T) {
+	cases := []struct {
+		isGray      bool
+		width       int
+		height      int
+		photometric string
+		want        string
+	}{
+		{true, 64, 48, "MONOCHROME2", "MONOCHROME2"},
+		{true, 37, 21, "MONOCHROME2", "MONOCHROME2"},
+		{false, 64, 48, "YBR_FULL_422", "RGB"},
+		{false, 37, 21, "YBR_FULL_422", "RGB"},
+		{false, 40, 24, "RGB", "RGB"},
+	}
+	for _, c := range cases {
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, newTestImage(c.isGray, c.width, c.height), &jpeg.Options{Quality: 90})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := jpeg.Decode(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var di DcmImage
+		di.Columns = uint32(c.width)
+		di.Rows = uint32(c.height)
+		di.BitsAllocated = 8
+		di.SamplesPerPixel = 3
+		if c.isGray {
+			di.SamplesPerPixel = 1
+		}
+		di.PhotometricInterpretation = c.photometric
+		di.PlanarConfiguration = 1
+		got, err := jpegCodec{}.Decode(buf.Bytes(), &di)
+		if err != nil {
+			t.Errorf("Decode() %dx%d %s: %s", c.width, c.height, c.photometric, err.Error())
+			continue
+		}
+		if di.PhotometricInterpretation != c.want {
+			t.Errorf("Decode() %dx%d %s, PhotometricInterpretation want %s got %s", c.width, c.height, c.photometric, c.want, di.PhotometricInterpretation)
+		}
+		if di.PlanarConfiguration != 0 {
+			t.Errorf("Decode() %dx%d %s, PlanarConfiguration want 0 got %d", c.width, c.height, c.photometric, di.PlanarConfiguration)
+		}
+		if len(got) != c.width*c.height*int(di.SamplesPerPixel) {
+			t.Errorf("Decode() %dx%d %s, want %d bytes got %d", c.width, c.height, c.photometric, c.width*c.height*int(di.SamplesPerPixel), len(got))
+			continue
+		}
+
+		maxDiff := 0
+		index := 0
+		for y := 0; y < c.height; y++ {
+			for x := 0; x < c.width; x++ {
+				if c.isGray {
+					g := ref.At(x, y).(color.Gray)
+					if d := absDiff(g.Y, got[index]); d > maxDiff {
+						maxDiff = d
+					}
+					index++
+					continue
+				}
+				r, g, b, _ := ref.At(x, y).RGBA()
+				for i, v := range []uint32{r, g, b} {
+					if d := absDiff(uint8(v>>8), got[index+i]); d > maxDiff {
+						maxDiff = d
+					}
+				}
+				index += 3
+			}
+		}
+		// the inverse DCT and the color conversion may be rounded differently
+		if maxDiff > 3 {
+			t.Errorf("Decode() %dx%d %s, the maximum difference to image/jpeg is %d", c.width, c.height, c.photometric, maxDiff)
+		}
+	}
+}
+
+func TestJPEGCodecDecodeErrors(t *testing.T) {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, newTestImage(true, 16, 16), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	cases := []struct {
+		name string
+		in   []byte
+	}{
+		{"empty", nil},
+		{"missing SOI", data[2:]},
+		{"truncated", data[:len(data)/2]},
+	}
+	for _, c := range cases {
+		var di DcmImage
+		di.BitsAllocated = 8
+		_, err := jpegCodec{}.Decode(c.in, &di)
+		if err == nil {
+			t.Errorf("Decode() %s should fail", c.name)
+		}
+	}
+
+	var di DcmImage
+	di.Columns = 8
+	di.Rows = 8
+	di.BitsAllocated = 8
+	_, err = jpegCodec{}.Decode(data, &di)
+	if err == nil {
+		t.Errorf("Decode() should fail if the image size does not match")
+	}
+}
+
+func TestFindCodec(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"1.2.840.10008.1.2.4.50", true},
+		{"1.2.840.10008.1.2.4.51", true},
+		{"1.2.840.10008.1.2.4.57", true},
+		{"1.2.840.10008.1.2.4.70", true},
+		{"1.2.840.10008.1.2.4.80", true},
+		{"1.2.840.10008.1.2.4.81", true},
+		{"1.2.840.10008.1.2.4.90", true},
+		{"1.2.840.10008.1.2.4.91", true},
+		{"1.2.840.10008.1.2.4.201", false},
+		{"1.2.840.10008.1.2.4.202", false},
+		{"1.2.840.10008.1.2.4.203", false},
+		{"1.2.840.10008.1.2.4.100", false},
+	}
+	for _, c := range cases {
+		_, err := FindCodec(c.in)
+		if (err == nil) != c.want {
+			t.Errorf("FindCodec(%s), want %v got %v", c.in, c.want, err)
+		}
+	}
+}
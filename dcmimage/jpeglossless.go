@@ -0,0 +1,136 @@
+package dcmimage
+
+import (
+	"errors"
+	"fmt"
+)
+
+// decodeLosslessScan decodes a scan of the lossless process defined in Annex H of ISO/IEC 10918-1.
+func (d *jpegDecoder) decodeLosslessScan(components []*jpegComponent, predictor int) error {
+	if predictor < 1 || predictor > 7 {
+		str := fmt.Sprintf("jpegDecoder: invalid lossless predictor %d", predictor)
+		return errors.New(str)
+	}
+	if int(d.pointTransform) >= d.precision {
+		return errors.New("jpegDecoder: invalid point transform")
+	}
+	for _, c := range components {
+		if d.dc[c.td] == nil {
+			return errors.New("jpegDecoder: missing Huffman table")
+		}
+	}
+
+	// the prediction of the first sample of each restart interval
+	initial := int32(1) << uint(d.precision-int(d.pointTransform)-1)
+
+	if len(components) == 1 {
+		// non-interleaved, the data unit is a single sample
+		c := components[0]
+		width := (d.width*c.h + d.hmax - 1) / d.hmax
+		height := (d.height*c.v + d.vmax - 1) / d.vmax
+		n := 0
+		restartLine := 0
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				if d.restartInterval != 0 && n != 0 && n%d.restartInterval == 0 {
+					err := d.processRestart(n, components)
+					if err != nil {
+						return err
+					}
+					restartLine = y
+				}
+				isFirst := d.restartInterval != 0 && n%d.restartInterval == 0 || n == 0
+				err := d.decodeSample(c, x, y, isFirst, y == restartLine, predictor, initial)
+				if err != nil {
+					return err
+				}
+				n++
+			}
+		}
+		return nil
+	}
+
+	n := 0
+	restartLine := 0
+	for my := 0; my < d.mcusPerColumn; my++ {
+		for mx := 0; mx < d.mcusPerLine; mx++ {
+			if d.restartInterval != 0 && n != 0 && n%d.restartInterval == 0 {
+				err := d.processRestart(n, components)
+				if err != nil {
+					return err
+				}
+				restartLine = my
+			}
+			isFirst := d.restartInterval != 0 && n%d.restartInterval == 0 || n == 0
+			for _, c := range components {
+				for v := 0; v < c.v; v++ {
+					for h := 0; h < c.h; h++ {
+						y := my*c.v + v
+						err := d.decodeSample(c, mx*c.h+h, y, isFirst && h == 0 && v == 0, y == restartLine*c.v, predictor, initial)
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+			n++
+		}
+	}
+	return nil
+}
+
+// decodeSample decodes the difference and reconstructs the sample at (x, y) of the component.
+func (d *jpegDecoder) decodeSample(c *jpegComponent, x int, y int, isFirst bool, isFirstLine bool, predictor int, initial int32) error {
+	s, err := d.decodeHuffman(d.dc[c.td])
+	if err != nil {
+		return err
+	}
+	var diff int32
+	switch {
+	case s == 16:
+		// no additional bits follow
+		diff = 32768
+	case s > 16:
+		return errors.New("jpegDecoder: invalid difference category")
+	default:
+		diff, err = d.receiveExtend(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	pos := y*c.stride + x
+	var pred int32
+	switch {
+	case isFirst:
+		pred = initial
+	case isFirstLine:
+		// the first line uses the sample to the left
+		pred = c.samples[pos-1]
+	case x == 0:
+		// the first column uses the sample above
+		pred = c.samples[pos-c.stride]
+	default:
+		ra := c.samples[pos-1]
+		rb := c.samples[pos-c.stride]
+		rc := c.samples[pos-c.stride-1]
+		switch predictor {
+		case 1:
+			pred = ra
+		case 2:
+			pred = rb
+		case 3:
+			pred = rc
+		case 4:
+			pred = ra + rb - rc
+		case 5:
+			pred = ra + (rb-rc)>>1
+		case 6:
+			pred = rb + (ra-rc)>>1
+		case 7:
+			pred = (ra + rb) >> 1
+		}
+	}
+	c.samples[pos] = (pred + diff) & 0xFFFF
+	return nil
+}
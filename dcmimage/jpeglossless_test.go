@@ -0,0 +1,242 @@
+package dcmimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// testBitWriter writes the entropy-coded data with byte stuffing.
+type testBitWriter struct {
+	buf   bytes.Buffer
+	bits  uint32
+	nbits uint
+}
+
+func (w *testBitWriter) write(v uint32, n uint) {
+	for i := int(n) - 1; i >= 0; i-- {
+		w.bits = w.bits<<1 | (v>>uint(i))&1
+		w.nbits++
+		if w.nbits == 8 {
+			w.buf.WriteByte(byte(w.bits))
+			if byte(w.bits) == 0xFF {
+				w.buf.WriteByte(0x00)
+			}
+			w.bits = 0
+			w.nbits = 0
+		}
+	}
+}
+
+func (w *testBitWriter) flush() {
+	for w.nbits != 0 {
+		w.write(1, 1)
+	}
+}
+
+// encodeTestLossless encodes the samples of the components with the lossless process. The
+// Huffman table assigns the 5 bits code i to the difference category i.
+func encodeTestLossless(planes [][]int32, width int, height int, precision int, predictor int, pt uint, restartInterval int) []byte {
+	var out bytes.Buffer
+	segment := func(marker byte, data []byte) {
+		out.Write([]byte{0xFF, marker})
+		binary.Write(&out, binary.BigEndian, uint16(len(data)+2))
+		out.Write(data)
+	}
+	out.Write([]byte{0xFF, jpegSOI})
+
+	sof := []byte{byte(precision), byte(height >> 8), byte(height), byte(width >> 8), byte(width), byte(len(planes))}
+	for i := range planes {
+		sof = append(sof, byte(i+1), 0x11, 0)
+	}
+	segment(jpegSOF3, sof)
+
+	dht := []byte{0x00}
+	counts := make([]byte, 16)
+	counts[4] = 17
+	dht = append(dht, counts...)
+	for i := 0; i < 17; i++ {
+		dht = append(dht, byte(i))
+	}
+	segment(jpegDHT, dht)
+
+	if restartInterval != 0 {
+		segment(jpegDRI, []byte{byte(restartInterval >> 8), byte(restartInterval)})
+	}
+
+	sos := []byte{byte(len(planes))}
+	for i := range planes {
+		sos = append(sos, byte(i+1), 0x00)
+	}
+	sos = append(sos, byte(predictor), 0, byte(pt))
+	segment(jpegSOS, sos)
+
+	var w testBitWriter
+	var initial int32
+	if precision > int(pt) {
+		initial = int32(1) << uint(precision-int(pt)-1)
+	}
+	n := 0
+	restartLine := 0
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if restartInterval != 0 && n != 0 && n%restartInterval == 0 {
+				w.flush()
+				w.buf.Write([]byte{0xFF, byte(jpegRST0 + (n/restartInterval-1)%8)})
+				restartLine = y
+			}
+			isFirst := restartInterval != 0 && n%restartInterval == 0 || n == 0
+			for _, plane := range planes {
+				at := func(x int, y int) int32 {
+					return plane[y*width+x] >> pt
+				}
+				var pred int32
+				switch {
+				case isFirst:
+					pred = initial
+				case y == restartLine:
+					pred = at(x-1, y)
+				case x == 0:
+					pred = at(x, y-1)
+				default:
+					ra, rb, rc := at(x-1, y), at(x, y-1), at(x-1, y-1)
+					pred = []int32{0, ra, rb, rc, ra + rb - rc, ra + (rb-rc)>>1, rb + (ra-rc)>>1, (ra + rb) >> 1}[predictor]
+				}
+				diff := (at(x, y) - pred) & 0xFFFF
+				if diff >= 0x8000 {
+					diff -= 0x10000
+				}
+				magnitude := diff
+				if magnitude < 0 {
+					magnitude = -magnitude
+				}
+				s := uint(0)
+				for ; magnitude > 0; magnitude >>= 1 {
+					s++
+				}
+				if s == 16 {
+					w.write(16, 5)
+					continue
+				}
+				w.write(uint32(s), 5)
+				if diff < 0 {
+					w.write(uint32(diff-1), s)
+				} else {
+					w.write(uint32(diff), s)
+				}
+			}
+			n++
+		}
+	}
+	w.flush()
+	out.Write(w.buf.Bytes())
+	out.Write([]byte{0xFF, jpegEOI})
+	return out.Bytes()
+}
+
+func newTestPlane(width int, height int, precision int, seed int) []int32 {
+	plane := make([]int32, width*height)
+	max := int32(1)<<uint(precision) - 1
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			plane[y*width+x] = int32(x*x*(seed+3)+y*(seed+7)+(x^y)*seed) & max
+		}
+	}
+	// extreme values
+	plane[0] = max
+	plane[len(plane)-1] = 0
+	return plane
+}
+
+func TestJPEGLosslessDecode(t *testing.T) {
+	cases := []struct {
+		precision       int
+		predictor       int
+		pt              uint
+		restartInterval int
+		components      int
+	}{
+		{16, 1, 0, 0, 1},
+		{16, 2, 0, 0, 1},
+		{16, 3, 0, 0, 1},
+		{16, 4, 0, 0, 1},
+		{16, 5, 0, 0, 1},
+		{16, 6, 0, 0, 1},
+		{16, 7, 0, 0, 1},
+		{12, 1, 0, 0, 1},
+		{12, 7, 0, 13, 1},
+		{8, 4, 0, 0, 1},
+		{8, 1, 0, 0, 3},
+		{8, 6, 0, 11, 3},
+		{2, 1, 0, 0, 1},
+		{16, 1, 2, 0, 1},
+	}
+	width := 23
+	height := 17
+	for _, c := range cases {
+		var planes [][]int32
+		for i := 0; i < c.components; i++ {
+			plane := newTestPlane(width, height, c.precision, i)
+			for j := range plane {
+				plane[j] = plane[j] >> c.pt << c.pt
+			}
+			planes = append(planes, plane)
+		}
+		data := encodeTestLossless(planes, width, height, c.precision, c.predictor, c.pt, c.restartInterval)
+
+		var di DcmImage
+		di.Columns = uint32(width)
+		di.Rows = uint32(height)
+		di.SamplesPerPixel = uint16(c.components)
+		di.BitsAllocated = 16
+		if c.precision <= 8 {
+			di.BitsAllocated = 8
+		}
+		di.PhotometricInterpretation = "RGB"
+		got, err := jpegCodec{}.Decode(data, &di)
+		if err != nil {
+			t.Errorf("Decode() %+v: %s", c, err.Error())
+			continue
+		}
+		if di.PhotometricInterpretation != "RGB" {
+			t.Errorf("Decode() %+v, the photometric interpretation should not be changed", c)
+		}
+		index := 0
+		for i := 0; i < width*height; i++ {
+			for _, plane := range planes {
+				var v int32
+				if di.BitsAllocated == 8 {
+					v = int32(got[index])
+					index++
+				} else {
+					v = int32(binary.LittleEndian.Uint16(got[index:]))
+					index += 2
+				}
+				if v != plane[i] {
+					t.Errorf("Decode() %+v, sample %d want %d got %d", c, i, plane[i], v)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestJPEGLosslessDecodeErrors(t *testing.T) {
+	plane := newTestPlane(8, 8, 12, 0)
+	cases := []struct {
+		name string
+		in   []byte
+	}{
+		{"invalid predictor", encodeTestLossless([][]int32{plane}, 8, 8, 12, 0, 0, 0)},
+		{"invalid precision", encodeTestLossless([][]int32{plane}, 8, 8, 17, 1, 0, 0)},
+		{"invalid point transform", encodeTestLossless([][]int32{plane}, 8, 8, 12, 1, 12, 0)},
+	}
+	for _, c := range cases {
+		var di DcmImage
+		di.BitsAllocated = 16
+		_, err := jpegCodec{}.Decode(c.in, &di)
+		if err == nil {
+			t.Errorf("Decode() %s should fail", c.name)
+		}
+	}
+}
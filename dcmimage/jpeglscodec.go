@@ -0,0 +1,1010 @@
+package dcmimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+func init() {
+	// JPEG-LS Lossless Image Compression
+	RegisterCodec("1.2.840.10008.1.2.4.80", jpegLSCodec{interleave: jpegLSInterleaveLine})
+	// JPEG-LS Lossy (Near-Lossless) Image Compression
+	RegisterCodec("1.2.840.10008.1.2.4.81", jpegLSCodec{near: jpegLSDefaultNear, interleave: jpegLSInterleaveLine})
+}
+
+// the NEAR parameter of JPEG-LS Lossy if it is not given by EncodeParams
+const jpegLSDefaultNear = 2
+
+// JPEG-LS markers
+const (
+	jpegSOF55 = 0xF7
+	jpegLSE   = 0xF8
+)
+
+// JPEG-LS interleave modes
+const (
+	jpegLSInterleaveNone   = 0
+	jpegLSInterleaveLine   = 1
+	jpegLSInterleaveSample = 2
+)
+
+// the number of regular contexts, followed by the two run interruption contexts
+const jpegLSContexts = 365
+
+// the order of the run-length codes, J of ISO/IEC 14495-1
+var jpegLSJ = [32]uint{0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// jpegLSCodec decodes and encodes JPEG-LS defined in ISO/IEC 14495-1. The encoder
+// writes the lossless mode if near is 0, otherwise the near-lossless mode in which
+// each sample differs from the original by at most near.
+type jpegLSCodec struct {
+	near int
+	// the interleave mode of the encoder for the images of more than one sample
+	interleave int
+}
+
+// WithParams gets the codec encoding with the NEAR parameter of the parameters. The
+// lossless codec is not changed.
+func (codec jpegLSCodec) WithParams(params EncodeParams) Encoder {
+	if codec.near != 0 && params.JPEGLSNear != 0 {
+		codec.near = params.JPEGLSNear
+	}
+	return codec
+}
+
+// LossyMethod gets the Lossy Image Compression Method if the near-lossless mode is used.
+func (codec jpegLSCodec) LossyMethod() string {
+	if codec.near == 0 {
+		return ""
+	}
+	return "ISO_14495_1"
+}
+
+// jpegLSParams contains the parameters of a scan.
+type jpegLSParams struct {
+	maxval int
+	near   int
+	t1     int
+	t2     int
+	t3     int
+	reset  int
+	rng    int // RANGE
+	qbpp   int
+	limit  int
+}
+
+// ceilLog2 gets the smallest number of bits holding v values.
+func ceilLog2(v int) int {
+	n := 0
+	for 1<<uint(n) < v {
+		n++
+	}
+	return n
+}
+
+// newJPEGLSParams computes the parameters of a scan. The thresholds and RESET of
+// the preset parameters replace the default values unless they are 0.
+func newJPEGLSParams(maxval int, near int, preset jpegLSParams) (jpegLSParams, error) {
+	var p jpegLSParams
+	p.maxval = maxval
+	p.near = near
+	if near < 0 || near > 255 || near > maxval/2 {
+		str := fmt.Sprintf("jpegLSCodec: invalid NEAR %d for MAXVAL %d", near, maxval)
+		return p, errors.New(str)
+	}
+
+	clamp := func(i int, j int) int {
+		if i > maxval || i < j {
+			return j
+		}
+		return i
+	}
+	if maxval >= 128 {
+		factor := (minInt(maxval, 4095) + 128) / 256
+		p.t1 = clamp(factor*(3-2)+2+3*near, near+1)
+		p.t2 = clamp(factor*(7-3)+3+5*near, p.t1)
+		p.t3 = clamp(factor*(21-4)+4+7*near, p.t2)
+	} else {
+		factor := 256 / (maxval + 1)
+		p.t1 = clamp(maxInt(2, 3/factor+3*near), near+1)
+		p.t2 = clamp(maxInt(3, 7/factor+5*near), p.t1)
+		p.t3 = clamp(maxInt(4, 21/factor+7*near), p.t2)
+	}
+	p.reset = 64
+	if preset.t1 != 0 {
+		p.t1 = preset.t1
+	}
+	if preset.t2 != 0 {
+		p.t2 = preset.t2
+	}
+	if preset.t3 != 0 {
+		p.t3 = preset.t3
+	}
+	if preset.reset != 0 {
+		p.reset = preset.reset
+	}
+
+	p.rng = (maxval+2*near)/(2*near+1) + 1
+	p.qbpp = ceilLog2(p.rng)
+	bpp := maxInt(2, ceilLog2(maxval+1))
+	p.limit = 2 * (bpp + maxInt(8, bpp))
+	return p, nil
+}
+
+// jpegLSCoder codes the samples of a scan. The same coder is used by the decoder and
+// the encoder, so that both update the contexts in the same way.
+type jpegLSCoder struct {
+	jpegLSParams
+	isEncoder bool
+	reader    jpegLSReader
+	writer    jpegLSWriter
+	err       error
+
+	a  [jpegLSContexts + 2]int
+	b  [jpegLSContexts]int
+	c  [jpegLSContexts]int
+	n  [jpegLSContexts + 2]int
+	nn [jpegLSContexts + 2]int
+}
+
+func (s *jpegLSCoder) resetContexts() {
+	initial := maxInt(2, (s.rng+32)/64)
+	for i := range s.a {
+		s.a[i] = initial
+		s.n[i] = 1
+		s.nn[i] = 0
+	}
+	for i := range s.b {
+		s.b[i] = 0
+		s.c[i] = 0
+	}
+}
+
+// quantizeGradient gets the region of a local gradient from -4 to 4.
+func (s *jpegLSCoder) quantizeGradient(d int) int {
+	switch {
+	case d <= -s.t3:
+		return -4
+	case d <= -s.t2:
+		return -3
+	case d <= -s.t1:
+		return -2
+	case d < -s.near:
+		return -1
+	case d <= s.near:
+		return 0
+	case d < s.t1:
+		return 1
+	case d < s.t2:
+		return 2
+	case d < s.t3:
+		return 3
+	}
+	return 4
+}
+
+// quantizeError quantizes the prediction error in the near-lossless mode.
+func (s *jpegLSCoder) quantizeError(e int) int {
+	if s.near == 0 {
+		return e
+	}
+	if e > 0 {
+		return (e + s.near) / (2*s.near + 1)
+	}
+	return -(s.near - e) / (2*s.near + 1)
+}
+
+// modRange reduces the error to the range from -RANGE/2 to RANGE/2.
+func (s *jpegLSCoder) modRange(e int) int {
+	if e < 0 {
+		e += s.rng
+	}
+	if e >= (s.rng+1)/2 {
+		e -= s.rng
+	}
+	return e
+}
+
+// reconstruct gets the reconstructed sample of the prediction and the quantized error.
+func (s *jpegLSCoder) reconstruct(px int, e int) int {
+	rx := px + e*(2*s.near+1)
+	if rx < -s.near {
+		rx += s.rng * (2*s.near + 1)
+	} else if rx > s.maxval+s.near {
+		rx -= s.rng * (2*s.near + 1)
+	}
+	if rx < 0 {
+		return 0
+	}
+	if rx > s.maxval {
+		return s.maxval
+	}
+	return rx
+}
+
+// golomb codes the value with the limited length Golomb code of the order k.
+func (s *jpegLSCoder) golomb(v int, k uint, limit int) int {
+	threshold := limit - s.qbpp - 1
+	if s.isEncoder {
+		high := v >> k
+		if high < threshold {
+			s.writer.writeBits(0, uint(high))
+			s.writer.writeBits(1, 1)
+			s.writer.writeBits(v&(1<<k-1), k)
+		} else {
+			s.writer.writeBits(0, uint(threshold))
+			s.writer.writeBits(1, 1)
+			s.writer.writeBits(v-1, uint(s.qbpp))
+		}
+		return v
+	}
+	high := 0
+	for s.reader.readBit() == 0 {
+		high++
+		if high > threshold {
+			s.setError(errors.New("jpegLSCodec: invalid Golomb code"))
+			return 0
+		}
+	}
+	if high < threshold {
+		return high<<k | s.reader.readBits(k)
+	}
+	return s.reader.readBits(uint(s.qbpp)) + 1
+}
+
+func (s *jpegLSCoder) setError(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// regular codes the sample x in the regular mode, and returns the reconstructed sample.
+func (s *jpegLSCoder) regular(q1 int, q2 int, q3 int, ra int, rb int, rc int, x int) int {
+	sign := 1
+	if q1 < 0 || (q1 == 0 && (q2 < 0 || (q2 == 0 && q3 < 0))) {
+		q1, q2, q3 = -q1, -q2, -q3
+		sign = -1
+	}
+	q := (q1*9+q2)*9 + q3
+
+	// the median edge detector
+	var px int
+	switch {
+	case rc >= maxInt(ra, rb):
+		px = minInt(ra, rb)
+	case rc <= minInt(ra, rb):
+		px = maxInt(ra, rb)
+	default:
+		px = ra + rb - rc
+	}
+	px += sign * s.c[q]
+	if px < 0 {
+		px = 0
+	} else if px > s.maxval {
+		px = s.maxval
+	}
+
+	k := uint(0)
+	for s.n[q]<<k < s.a[q] {
+		k++
+	}
+	isInverted := s.near == 0 && k == 0 && 2*s.b[q] <= -s.n[q]
+
+	var e int
+	if s.isEncoder {
+		e = s.modRange(s.quantizeError(sign * (x - px)))
+		var m int
+		if e >= 0 {
+			m = 2 * e
+		} else {
+			m = -2*e - 1
+		}
+		if isInverted {
+			if e >= 0 {
+				m = 2*e + 1
+			} else {
+				m = -2 * (e + 1)
+			}
+		}
+		s.golomb(m, k, s.limit)
+	} else {
+		m := s.golomb(0, k, s.limit)
+		if isInverted {
+			if m&1 == 1 {
+				e = (m - 1) / 2
+			} else {
+				e = -m/2 - 1
+			}
+		} else {
+			if m&1 == 0 {
+				e = m / 2
+			} else {
+				e = -(m + 1) / 2
+			}
+		}
+	}
+	rx := s.reconstruct(px, sign*e)
+
+	// update the context
+	s.b[q] += e * (2*s.near + 1)
+	s.a[q] += absInt(e)
+	if s.n[q] == s.reset {
+		s.a[q] >>= 1
+		s.b[q] >>= 1
+		s.n[q] >>= 1
+	}
+	s.n[q]++
+	if s.b[q] <= -s.n[q] {
+		s.b[q] += s.n[q]
+		if s.c[q] > -128 {
+			s.c[q]--
+		}
+		if s.b[q] <= -s.n[q] {
+			s.b[q] = -s.n[q] + 1
+		}
+	} else if s.b[q] > 0 {
+		s.b[q] -= s.n[q]
+		if s.c[q] < 127 {
+			s.c[q]++
+		}
+		if s.b[q] > 0 {
+			s.b[q] = 0
+		}
+	}
+	return rx
+}
+
+// runLength codes the length of a run of count samples among the remaining samples of
+// the line, and returns the length.
+func (s *jpegLSCoder) runLength(count int, remaining int, runIndex *int) int {
+	if s.isEncoder {
+		for count >= 1<<jpegLSJ[*runIndex] {
+			s.writer.writeBits(1, 1)
+			count -= 1 << jpegLSJ[*runIndex]
+			if *runIndex < 31 {
+				*runIndex++
+			}
+		}
+		if count == 0 && remaining == 0 {
+			return 0
+		}
+		if remaining == 0 {
+			// the run reaches the end of the line
+			s.writer.writeBits(1, 1)
+			return 0
+		}
+		s.writer.writeBits(0, 1)
+		s.writer.writeBits(count, jpegLSJ[*runIndex])
+		return 0
+	}
+
+	index := 0
+	for s.reader.readBit() == 1 {
+		n := minInt(1<<jpegLSJ[*runIndex], remaining-index)
+		index += n
+		if n == 1<<jpegLSJ[*runIndex] && *runIndex < 31 {
+			*runIndex++
+		}
+		if index == remaining {
+			return index
+		}
+		if s.reader.err != nil {
+			return index
+		}
+	}
+	index += s.reader.readBits(jpegLSJ[*runIndex])
+	if index >= remaining {
+		s.setError(errors.New("jpegLSCodec: invalid run length"))
+		return remaining - 1
+	}
+	return index
+}
+
+// interruption codes the sample x interrupting a run, and returns the reconstructed sample.
+// The type of the run interruption is always 0 in the sample interleaved mode.
+func (s *jpegLSCoder) interruption(ra int, rb int, x int, runIndex int, isType0 bool) int {
+	riType := 0
+	if !isType0 && absInt(ra-rb) <= s.near {
+		riType = 1
+	}
+	sign := 1
+	px := rb
+	if riType == 1 {
+		px = ra
+	} else if ra > rb {
+		sign = -1
+	}
+	q := jpegLSContexts + riType
+	temp := s.a[q]
+	if riType == 1 {
+		temp += s.n[q] >> 1
+	}
+	k := uint(0)
+	for s.n[q]<<k < temp {
+		k++
+	}
+	limit := s.limit - int(jpegLSJ[runIndex]) - 1
+
+	var e, m int
+	if s.isEncoder {
+		e = s.modRange(s.quantizeError(sign * (x - px)))
+		isMapped := 0
+		if (k == 0 && e > 0 && 2*s.nn[q] < s.n[q]) || (e < 0 && (2*s.nn[q] >= s.n[q] || k != 0)) {
+			isMapped = 1
+		}
+		m = 2*absInt(e) - riType - isMapped
+		s.golomb(m, k, limit)
+	} else {
+		m = s.golomb(0, k, limit)
+		temp := m + riType
+		isMapped := temp & 1
+		e = (temp + isMapped) / 2
+		if (k != 0 || 2*s.nn[q] >= s.n[q]) == (isMapped == 1) {
+			e = -e
+		}
+	}
+	rx := s.reconstruct(px, sign*e)
+
+	// update the context
+	if e < 0 {
+		s.nn[q]++
+	}
+	s.a[q] += (m + 1 - riType) >> 1
+	if s.n[q] == s.reset {
+		s.a[q] >>= 1
+		s.n[q] >>= 1
+		s.nn[q] >>= 1
+	}
+	s.n[q]++
+	return rx
+}
+
+// jpegLSScan codes the lines of the components of a scan. The lines contain a sample
+// on both sides for the neighbours at the edges.
+type jpegLSScan struct {
+	*jpegLSCoder
+	width    int
+	height   int
+	planes   [][]int // the samples of the components
+	prev     [][]int
+	cur      [][]int
+	runIndex []int
+}
+
+func (s *jpegLSScan) code(interleave int) error {
+	num := len(s.planes)
+	for i := 0; i < num; i++ {
+		s.prev = append(s.prev, make([]int, s.width+2))
+		s.cur = append(s.cur, make([]int, s.width+2))
+		s.runIndex = append(s.runIndex, 0)
+	}
+	for y := 0; y < s.height; y++ {
+		for c := 0; c < num; c++ {
+			s.prev[c][s.width+1] = s.prev[c][s.width]
+			s.cur[c][0] = s.prev[c][1]
+			if s.isEncoder {
+				copy(s.cur[c][1:], s.planes[c][y*s.width:(y+1)*s.width])
+			}
+		}
+		if interleave == jpegLSInterleaveSample {
+			s.codeSamples(s.runIndex)
+		} else {
+			for c := 0; c < num; c++ {
+				s.codeLine(c)
+			}
+		}
+		if s.err == nil && s.reader.err != nil {
+			s.err = s.reader.err
+		}
+		if s.err != nil {
+			return s.err
+		}
+		for c := 0; c < num; c++ {
+			copy(s.planes[c][y*s.width:], s.cur[c][1:s.width+1])
+			s.prev[c], s.cur[c] = s.cur[c], s.prev[c]
+		}
+	}
+	return nil
+}
+
+// contextOf gets the quantized gradients of the sample i of the component.
+func (s *jpegLSScan) contextOf(c int, i int) (int, int, int) {
+	ra := s.cur[c][i-1]
+	rb := s.prev[c][i]
+	rc := s.prev[c][i-1]
+	rd := s.prev[c][i+1]
+	return s.quantizeGradient(rd - rb), s.quantizeGradient(rb - rc), s.quantizeGradient(rc - ra)
+}
+
+// codeLine codes a line of a component. The samples to encode are in the current line,
+// and are replaced by the reconstructed samples.
+func (s *jpegLSScan) codeLine(c int) {
+	cur := s.cur[c]
+	prev := s.prev[c]
+	for i := 1; i <= s.width && s.err == nil; {
+		q1, q2, q3 := s.contextOf(c, i)
+		if q1 != 0 || q2 != 0 || q3 != 0 {
+			cur[i] = s.regular(q1, q2, q3, cur[i-1], prev[i], prev[i-1], cur[i])
+			i++
+			continue
+		}
+
+		// the run mode
+		ra := cur[i-1]
+		count := 0
+		if s.isEncoder {
+			for i+count <= s.width && absInt(cur[i+count]-ra) <= s.near {
+				count++
+			}
+			s.runLength(count, s.width+1-i-count, &s.runIndex[c])
+		} else {
+			count = s.runLength(0, s.width+1-i, &s.runIndex[c])
+		}
+		for j := 0; j < count; j++ {
+			cur[i+j] = ra
+		}
+		i += count
+		if i > s.width {
+			break
+		}
+		cur[i] = s.interruption(ra, prev[i], cur[i], s.runIndex[c], false)
+		if s.runIndex[c] > 0 {
+			s.runIndex[c]--
+		}
+		i++
+	}
+}
+
+// codeSamples codes a line of all components in the sample interleaved mode.
+func (s *jpegLSScan) codeSamples(runIndex []int) {
+	num := len(s.planes)
+	q := make([][3]int, num)
+	for i := 1; i <= s.width && s.err == nil; {
+		isRun := true
+		for c := 0; c < num; c++ {
+			q[c][0], q[c][1], q[c][2] = s.contextOf(c, i)
+			if q[c][0] != 0 || q[c][1] != 0 || q[c][2] != 0 {
+				isRun = false
+			}
+		}
+		if !isRun {
+			for c := 0; c < num; c++ {
+				s.cur[c][i] = s.regular(q[c][0], q[c][1], q[c][2], s.cur[c][i-1], s.prev[c][i], s.prev[c][i-1], s.cur[c][i])
+			}
+			i++
+			continue
+		}
+
+		// the run mode continues while all components are in the run
+		count := 0
+		if s.isEncoder {
+			for ; i+count <= s.width; count++ {
+				isSame := true
+				for c := 0; c < num; c++ {
+					if absInt(s.cur[c][i+count]-s.cur[c][i-1]) > s.near {
+						isSame = false
+					}
+				}
+				if !isSame {
+					break
+				}
+			}
+			s.runLength(count, s.width+1-i-count, &runIndex[0])
+		} else {
+			count = s.runLength(0, s.width+1-i, &runIndex[0])
+		}
+		for c := 0; c < num; c++ {
+			for j := 0; j < count; j++ {
+				s.cur[c][i+j] = s.cur[c][i-1]
+			}
+		}
+		i += count
+		if i > s.width {
+			break
+		}
+		for c := 0; c < num; c++ {
+			s.cur[c][i] = s.interruption(s.cur[c][i-1], s.prev[c][i], s.cur[c][i], runIndex[0], true)
+		}
+		if runIndex[0] > 0 {
+			runIndex[0]--
+		}
+		i++
+	}
+}
+
+// jpegLSReader reads the bits of a scan, a bit is stuffed after 0xFF.
+type jpegLSReader struct {
+	data  []byte
+	pos   int
+	cur   uint
+	nbits uint
+	isFF  bool
+	err   error
+}
+
+func (r *jpegLSReader) readBit() int {
+	if r.nbits == 0 {
+		if r.pos >= len(r.data) || (r.isFF && r.data[r.pos] >= 0x80) {
+			if r.err == nil {
+				r.err = errors.New("jpegLSCodec: unexpected end of scan")
+			}
+			// the end of the data is read as 1 to stop the unary codes
+			return 1
+		}
+		r.cur = uint(r.data[r.pos])
+		r.nbits = 8
+		if r.isFF {
+			r.nbits = 7
+		}
+		r.isFF = r.data[r.pos] == 0xFF
+		r.pos++
+	}
+	r.nbits--
+	return int(r.cur>>r.nbits) & 1
+}
+
+func (r *jpegLSReader) readBits(n uint) int {
+	v := 0
+	for i := uint(0); i < n; i++ {
+		v = v<<1 | r.readBit()
+	}
+	return v
+}
+
+// jpegLSWriter writes the bits of a scan, a bit is stuffed after 0xFF.
+type jpegLSWriter struct {
+	out   []byte
+	cur   uint
+	nbits uint
+	isFF  bool
+}
+
+func (w *jpegLSWriter) writeBits(v int, n uint) {
+	for i := int(n) - 1; i >= 0; i-- {
+		w.cur = w.cur<<1 | uint(v>>uint(i))&1
+		w.nbits++
+		if (w.isFF && w.nbits == 7) || w.nbits == 8 {
+			w.out = append(w.out, byte(w.cur))
+			w.isFF = byte(w.cur) == 0xFF
+			w.cur = 0
+			w.nbits = 0
+		}
+	}
+}
+
+// flush pads the last byte with 0, and a byte is appended after 0xFF.
+func (w *jpegLSWriter) flush() []byte {
+	for w.nbits != 0 {
+		w.writeBits(0, 1)
+	}
+	if w.isFF {
+		w.out = append(w.out, 0)
+	}
+	return w.out
+}
+
+// jpegLSFrame contains the frame header and the decoded samples.
+type jpegLSFrame struct {
+	precision int
+	width     int
+	height    int
+	ids       []byte
+	planes    [][]int
+	preset    jpegLSParams
+	isFound   bool
+	isDecoded []bool
+}
+
+// Decode decodes the JPEG-LS stream into interleaved samples in little endian.
+func (codec jpegLSCodec) Decode(src []byte, di *DcmImage) ([]byte, error) {
+	if len(src) < 2 || src[0] != 0xFF || src[1] != jpegSOI {
+		return nil, errors.New("jpegLSCodec: missing SOI marker")
+	}
+	var f jpegLSFrame
+	pos := 2
+	for {
+		// skip to the next marker
+		for pos+1 < len(src) && (src[pos] != 0xFF || src[pos+1] < 0x80 || src[pos+1] == 0xFF) {
+			pos++
+		}
+		if pos+1 >= len(src) {
+			return nil, errors.New("jpegLSCodec: missing EOI marker")
+		}
+		marker := src[pos+1]
+		pos += 2
+		if marker == jpegEOI {
+			break
+		}
+		if marker >= jpegRST0 && marker <= jpegRST7 {
+			continue
+		}
+		if pos+2 > len(src) {
+			return nil, errors.New("jpegLSCodec: unexpected end of data")
+		}
+		length := int(binary.BigEndian.Uint16(src[pos:]))
+		if length < 2 || pos+length > len(src) {
+			return nil, errors.New("jpegLSCodec: invalid segment length")
+		}
+		segment := src[pos+2 : pos+length]
+		pos += length
+
+		var err error
+		switch marker {
+		case jpegSOF55:
+			err = f.parseFrame(segment)
+		case jpegLSE:
+			err = f.parsePreset(segment)
+		case jpegDRI:
+			if len(segment) >= 2 && binary.BigEndian.Uint16(segment) != 0 {
+				err = errors.New("jpegLSCodec: the restart interval is not supported")
+			}
+		case jpegSOS:
+			var n int
+			n, err = f.decodeScan(segment, src[pos:])
+			pos += n
+		default:
+			if marker >= jpegSOF0 && marker <= 0xCF && marker != jpegDHT && marker != 0xC8 && marker != 0xCC {
+				str := fmt.Sprintf("jpegLSCodec: not supported SOF marker 0xFF%X", marker)
+				err = errors.New(str)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !f.isFound {
+		return nil, errors.New("jpegLSCodec: missing SOF55 marker")
+	}
+	for _, ok := range f.isDecoded {
+		if !ok {
+			return nil, errors.New("jpegLSCodec: missing scan of a component")
+		}
+	}
+
+	if di.Columns != 0 && (int(di.Columns) != f.width || int(di.Rows) != f.height) {
+		str := fmt.Sprintf("jpegLSCodec: the image size %dx%d does not match %dx%d", f.width, f.height, di.Columns, di.Rows)
+		return nil, errors.New(str)
+	}
+	if di.SamplesPerPixel != 0 && int(di.SamplesPerPixel) != len(f.planes) {
+		str := fmt.Sprintf("jpegLSCodec: the number of components %d does not match SamplesPerPixel %d", len(f.planes), di.SamplesPerPixel)
+		return nil, errors.New(str)
+	}
+	bytesPerSample := int(di.BitsAllocated+7) / 8
+	if bytesPerSample == 0 {
+		bytesPerSample = (f.precision + 7) / 8
+	}
+	result := make([]byte, f.width*f.height*len(f.planes)*bytesPerSample)
+	index := 0
+	for i := 0; i < f.width*f.height; i++ {
+		for _, plane := range f.planes {
+			for b := 0; b < bytesPerSample; b++ {
+				result[index] = byte(plane[i] >> uint(8*b))
+				index++
+			}
+		}
+	}
+	return result, nil
+}
+
+func (f *jpegLSFrame) parseFrame(segment []byte) error {
+	if len(segment) < 6 {
+		return errors.New("jpegLSCodec: invalid SOF55 segment")
+	}
+	f.precision = int(segment[0])
+	f.height = int(binary.BigEndian.Uint16(segment[1:]))
+	f.width = int(binary.BigEndian.Uint16(segment[3:]))
+	num := int(segment[5])
+	if f.precision < 2 || f.precision > 16 {
+		str := fmt.Sprintf("jpegLSCodec: not supported precision %d", f.precision)
+		return errors.New(str)
+	}
+	if f.width == 0 || f.height == 0 {
+		return errors.New("jpegLSCodec: invalid image size")
+	}
+	if num == 0 || len(segment) < 6+3*num {
+		return errors.New("jpegLSCodec: invalid number of components")
+	}
+	for i := 0; i < num; i++ {
+		if segment[7+3*i] != 0x11 {
+			return errors.New("jpegLSCodec: the subsampled components are not supported")
+		}
+		f.ids = append(f.ids, segment[6+3*i])
+		f.planes = append(f.planes, make([]int, f.width*f.height))
+		f.isDecoded = append(f.isDecoded, false)
+	}
+	f.isFound = true
+	return nil
+}
+
+func (f *jpegLSFrame) parsePreset(segment []byte) error {
+	if len(segment) < 1 {
+		return errors.New("jpegLSCodec: invalid LSE segment")
+	}
+	if segment[0] != 1 {
+		str := fmt.Sprintf("jpegLSCodec: not supported LSE parameters %d", segment[0])
+		return errors.New(str)
+	}
+	if len(segment) < 11 {
+		return errors.New("jpegLSCodec: invalid LSE segment")
+	}
+	f.preset.maxval = int(binary.BigEndian.Uint16(segment[1:]))
+	f.preset.t1 = int(binary.BigEndian.Uint16(segment[3:]))
+	f.preset.t2 = int(binary.BigEndian.Uint16(segment[5:]))
+	f.preset.t3 = int(binary.BigEndian.Uint16(segment[7:]))
+	f.preset.reset = int(binary.BigEndian.Uint16(segment[9:]))
+	return nil
+}
+
+// decodeScan decodes the scan, and returns the number of bytes of the entropy-coded data.
+func (f *jpegLSFrame) decodeScan(segment []byte, data []byte) (int, error) {
+	if !f.isFound {
+		return 0, errors.New("jpegLSCodec: SOS before SOF55")
+	}
+	if len(segment) < 1 {
+		return 0, errors.New("jpegLSCodec: invalid SOS segment")
+	}
+	num := int(segment[0])
+	if num == 0 || len(segment) < 1+2*num+3 {
+		return 0, errors.New("jpegLSCodec: invalid SOS segment")
+	}
+	var planes [][]int
+	for i := 0; i < num; i++ {
+		id := segment[1+2*i]
+		if segment[2+2*i] != 0 {
+			return 0, errors.New("jpegLSCodec: the mapping tables are not supported")
+		}
+		index := -1
+		for j, v := range f.ids {
+			if v == id {
+				index = j
+			}
+		}
+		if index < 0 {
+			str := fmt.Sprintf("jpegLSCodec: unknown component %d in SOS", id)
+			return 0, errors.New(str)
+		}
+		planes = append(planes, f.planes[index])
+		f.isDecoded[index] = true
+	}
+	near := int(segment[1+2*num])
+	interleave := int(segment[2+2*num])
+	if segment[3+2*num]&0x0F != 0 {
+		return 0, errors.New("jpegLSCodec: the point transform is not supported")
+	}
+	if interleave > jpegLSInterleaveSample || (interleave == jpegLSInterleaveNone && num != 1) {
+		str := fmt.Sprintf("jpegLSCodec: invalid interleave mode %d", interleave)
+		return 0, errors.New(str)
+	}
+
+	maxval := f.preset.maxval
+	if maxval == 0 {
+		maxval = 1<<uint(f.precision) - 1
+	}
+	params, err := newJPEGLSParams(maxval, near, f.preset)
+	if err != nil {
+		return 0, err
+	}
+	coder := &jpegLSCoder{jpegLSParams: params}
+	coder.reader.data = data
+	coder.resetContexts()
+	scan := jpegLSScan{jpegLSCoder: coder, width: f.width, height: f.height, planes: planes}
+	err = scan.code(interleave)
+	if err != nil {
+		return 0, err
+	}
+	return coder.reader.pos, nil
+}
+
+// Encode encodes the native pixel data of a frame into the JPEG-LS stream.
+func (codec jpegLSCodec) Encode(src []byte, di DcmImage) ([]byte, error) {
+	bytesPerSample := int(di.BitsAllocated) / 8
+	if di.BitsAllocated != 8 && di.BitsAllocated != 16 {
+		str := fmt.Sprintf("jpegLSCodec: not supported BitsAllocated %d", di.BitsAllocated)
+		return nil, errors.New(str)
+	}
+	precision := int(di.BitsStored)
+	if precision == 0 || precision > int(di.BitsAllocated) {
+		precision = int(di.BitsAllocated)
+	}
+	if precision < 2 {
+		str := fmt.Sprintf("jpegLSCodec: not supported BitsStored %d", precision)
+		return nil, errors.New(str)
+	}
+	rows := int(di.Rows)
+	columns := int(di.Columns)
+	samples := int(di.SamplesPerPixel)
+	if samples == 0 {
+		samples = 1
+	}
+	if rows == 0 || columns == 0 || rows > 0xFFFF || columns > 0xFFFF {
+		str := fmt.Sprintf("jpegLSCodec: not supported image size %dx%d", columns, rows)
+		return nil, errors.New(str)
+	}
+	if len(src) < rows*columns*samples*bytesPerSample {
+		str := fmt.Sprintf("jpegLSCodec: the frame is %d bytes, want %d", len(src), rows*columns*samples*bytesPerSample)
+		return nil, errors.New(str)
+	}
+
+	maxval := 1<<uint(precision) - 1
+	planes := make([][]int, samples)
+	for s := 0; s < samples; s++ {
+		planes[s] = make([]int, rows*columns)
+		for i := range planes[s] {
+			pos := (i*samples + s) * bytesPerSample
+			if di.PlanarConfiguration == 1 {
+				pos = (s*rows*columns + i) * bytesPerSample
+			}
+			v := int(src[pos])
+			if bytesPerSample == 2 {
+				if di.IsBigEndian {
+					v = int(binary.BigEndian.Uint16(src[pos:]))
+				} else {
+					v = int(binary.LittleEndian.Uint16(src[pos:]))
+				}
+			}
+			planes[s][i] = v & maxval
+		}
+	}
+
+	interleave := codec.interleave
+	if samples == 1 {
+		interleave = jpegLSInterleaveNone
+	}
+	params, err := newJPEGLSParams(maxval, codec.near, jpegLSParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []byte{0xFF, jpegSOI}
+	segment := func(marker byte, data []byte) {
+		result = append(result, 0xFF, marker, byte((len(data)+2)>>8), byte(len(data)+2))
+		result = append(result, data...)
+	}
+	sof := []byte{byte(precision), byte(rows >> 8), byte(rows), byte(columns >> 8), byte(columns), byte(samples)}
+	for s := 0; s < samples; s++ {
+		sof = append(sof, byte(s+1), 0x11, 0)
+	}
+	segment(jpegSOF55, sof)
+
+	scans := [][]int{}
+	if interleave == jpegLSInterleaveNone {
+		for s := 0; s < samples; s++ {
+			scans = append(scans, []int{s})
+		}
+	} else {
+		all := []int{}
+		for s := 0; s < samples; s++ {
+			all = append(all, s)
+		}
+		scans = append(scans, all)
+	}
+	for _, components := range scans {
+		sos := []byte{byte(len(components))}
+		var scanPlanes [][]int
+		for _, s := range components {
+			sos = append(sos, byte(s+1), 0)
+			scanPlanes = append(scanPlanes, append([]int(nil), planes[s]...))
+		}
+		sos = append(sos, byte(codec.near), byte(interleave), 0)
+		segment(jpegSOS, sos)
+
+		coder := &jpegLSCoder{jpegLSParams: params, isEncoder: true}
+		coder.resetContexts()
+		scan := jpegLSScan{jpegLSCoder: coder, width: columns, height: rows, planes: scanPlanes}
+		err = scan.code(interleave)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, coder.writer.flush()...)
+	}
+	result = append(result, 0xFF, jpegEOI)
+	return result, nil
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
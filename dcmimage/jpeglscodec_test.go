@@ -0,0 +1,214 @@
+package dcmimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+)
+
+func TestJPEGLSDecodeSample(t *testing.T) {
+	// the example of Annex H.3 of ISO/IEC 14495-1, 4x4 samples of 8 bits
+	in := []byte{
+		0xFF, 0xD8, 0xFF, 0xF7, 0x00, 0x0B, 0x08, 0x00, 0x04, 0x00, 0x04, 0x01, 0x01, 0x11, 0x00,
+		0xFF, 0xDA, 0x00, 0x08, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0xC0, 0x00, 0x00, 0x6C, 0x80, 0x20, 0x8E, 0x01, 0xC0, 0x00, 0x00, 0x57, 0x40, 0x00, 0x00, 0x6E,
+		0xE6, 0x00, 0x00, 0x01, 0xBC, 0x18, 0x00, 0x00, 0x05, 0xD8, 0x00, 0x00, 0x91, 0x60,
+		0xFF, 0xD9,
+	}
+	want := []byte{
+		0, 0, 90, 74,
+		68, 50, 43, 205,
+		64, 145, 145, 145,
+		100, 145, 145, 145,
+	}
+	var di DcmImage
+	di.Rows = 4
+	di.Columns = 4
+	di.SamplesPerPixel = 1
+	di.BitsAllocated = 8
+	got, err := jpegLSCodec{}.Decode(in, &di)
+	if err != nil {
+		t.Fatalf("Decode(): %s", err.Error())
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Decode(), want %v got %v", want, got)
+	}
+}
+
+func TestJPEGLSCodec(t *testing.T) {
+	cases := []struct {
+		bitsAllocated uint16
+		bitsStored    uint16
+		samples       uint16
+		planar        uint16
+		isBigEndian   bool
+		near          int
+		interleave    int
+	}{
+		{8, 8, 1, 0, false, 0, 0},
+		{16, 12, 1, 0, false, 0, 0},
+		{16, 16, 1, 0, false, 0, 0},
+		{16, 16, 1, 0, true, 0, 0},
+		{8, 8, 3, 0, false, 0, jpegLSInterleaveNone},
+		{8, 8, 3, 0, false, 0, jpegLSInterleaveLine},
+		{8, 8, 3, 1, false, 0, jpegLSInterleaveSample},
+		{8, 8, 1, 0, false, 2, 0},
+		{16, 12, 1, 0, false, 3, 0},
+		{8, 8, 3, 0, false, 1, jpegLSInterleaveLine},
+		{8, 8, 3, 0, false, 2, jpegLSInterleaveSample},
+	}
+	rows := 23
+	columns := 37
+	r := rand.New(rand.NewSource(1))
+	for _, c := range cases {
+		var di DcmImage
+		di.Rows = uint32(rows)
+		di.Columns = uint32(columns)
+		di.BitsAllocated = c.bitsAllocated
+		di.BitsStored = c.bitsStored
+		di.SamplesPerPixel = c.samples
+		di.PlanarConfiguration = c.planar
+		di.IsBigEndian = c.isBigEndian
+
+		// smooth areas for the run mode, noise and edges for the regular mode
+		maxval := 1<<c.bitsStored - 1
+		samples := make([]int, rows*columns*int(c.samples))
+		for i := range samples {
+			p := i / int(c.samples)
+			x := p % columns
+			y := p / columns
+			switch {
+			case y < 5:
+				samples[i] = maxval / 3
+			case x < 10:
+				samples[i] = (x*y*97 + i%int(c.samples)*1000) % (maxval + 1)
+			default:
+				samples[i] = r.Intn(maxval + 1)
+			}
+		}
+		bytesPerSample := int(c.bitsAllocated) / 8
+		src := make([]byte, len(samples)*bytesPerSample)
+		for i, v := range samples {
+			pos := i * bytesPerSample
+			if c.planar == 1 {
+				pos = (i%int(c.samples)*rows*columns + i/int(c.samples)) * bytesPerSample
+			}
+			switch {
+			case bytesPerSample == 1:
+				src[pos] = byte(v)
+			case c.isBigEndian:
+				binary.BigEndian.PutUint16(src[pos:], uint16(v))
+			default:
+				binary.LittleEndian.PutUint16(src[pos:], uint16(v))
+			}
+		}
+
+		codec := jpegLSCodec{near: c.near, interleave: c.interleave}
+		encoded, err := codec.Encode(src, di)
+		if err != nil {
+			t.Errorf("Encode() %+v: %s", c, err.Error())
+			continue
+		}
+		got, err := codec.Decode(encoded, &di)
+		if err != nil {
+			t.Errorf("Decode() %+v: %s", c, err.Error())
+			continue
+		}
+		if len(got) != len(samples)*bytesPerSample {
+			t.Errorf("Decode() %+v, want %d bytes got %d", c, len(samples)*bytesPerSample, len(got))
+			continue
+		}
+		for i, v := range samples {
+			d := int(got[i*bytesPerSample])
+			if bytesPerSample == 2 {
+				d = int(binary.LittleEndian.Uint16(got[i*bytesPerSample:]))
+			}
+			if d-v > c.near || v-d > c.near {
+				t.Errorf("Decode() %+v, sample %d want %d got %d", c, i, v, d)
+				break
+			}
+		}
+	}
+}
+
+func TestJPEGLSCodecErrors(t *testing.T) {
+	var di DcmImage
+	di.Rows = 4
+	di.Columns = 4
+	di.BitsAllocated = 8
+	di.BitsStored = 8
+	di.SamplesPerPixel = 1
+	encoded, err := jpegLSCodec{}.Encode(make([]byte, 16), di)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// LSE with a mapping table
+	mapping := append([]byte(nil), encoded[:2]...)
+	mapping = append(mapping, 0xFF, 0xF8, 0x00, 0x05, 0x02, 0x01, 0x01)
+	mapping = append(mapping, encoded[2:]...)
+	cases := []struct {
+		name string
+		in   []byte
+	}{
+		{"empty", nil},
+		{"missing SOI", encoded[2:]},
+		{"missing EOI", encoded[:len(encoded)-2]},
+		{"mapping table", mapping},
+		{"missing SOF55", append([]byte{0xFF, 0xD8}, encoded[len(encoded)-2:]...)},
+	}
+	for _, c := range cases {
+		d := di
+		_, err := jpegLSCodec{}.Decode(c.in, &d)
+		if err == nil {
+			t.Errorf("Decode() %s should fail", c.name)
+		}
+	}
+
+	d := di
+	d.Columns = 8
+	_, err = jpegLSCodec{}.Decode(encoded, &d)
+	if err == nil {
+		t.Errorf("Decode() should fail if the image size does not match")
+	}
+
+	_, err = jpegLSCodec{}.Encode(make([]byte, 8), di)
+	if err == nil {
+		t.Errorf("Encode() should fail if the frame is short")
+	}
+	_, err = jpegLSCodec{near: 200}.Encode(make([]byte, 16), di)
+	if err == nil {
+		t.Errorf("Encode() should fail if NEAR is too large")
+	}
+}
+
+func TestJPEGLSEncodeParams(t *testing.T) {
+	cases := []struct {
+		uid    string
+		params EncodeParams
+		near   int
+		method string
+	}{
+		{"1.2.840.10008.1.2.4.80", EncodeParams{}, 0, ""},
+		{"1.2.840.10008.1.2.4.80", EncodeParams{JPEGLSNear: 5}, 0, ""},
+		{"1.2.840.10008.1.2.4.81", EncodeParams{}, jpegLSDefaultNear, "ISO_14495_1"},
+		{"1.2.840.10008.1.2.4.81", EncodeParams{JPEGLSNear: 5}, 5, "ISO_14495_1"},
+	}
+	for _, c := range cases {
+		encoder, err := NewEncoder(c.uid, c.params)
+		if err != nil {
+			t.Errorf("NewEncoder(%s): %s", c.uid, err.Error())
+			continue
+		}
+		codec, ok := encoder.(jpegLSCodec)
+		if !ok || codec.near != c.near || codec.interleave != jpegLSInterleaveLine || codec.LossyMethod() != c.method {
+			t.Errorf("NewEncoder(%s, %+v), want NEAR %d with line interleave got %+v", c.uid, c.params, c.near, encoder)
+		}
+	}
+	// the registered codec is not changed
+	encoder, _ := FindEncoder("1.2.840.10008.1.2.4.81")
+	if codec := encoder.(jpegLSCodec); codec.near != jpegLSDefaultNear {
+		t.Errorf("FindEncoder(), want NEAR %d got %d", jpegLSDefaultNear, codec.near)
+	}
+}
@@ -7,7 +7,7 @@ import (
 )
 
 // ConvertToJPG convert dicom file to jpg file
-func (di DcmImage) ConvertToJPG(filepath string, frame int) error {
+func (di *DcmImage) ConvertToJPG(filepath string, frame int) error {
 	m, err := di.convertToImage(frame)
 	if err != nil {
 		return err
@@ -6,7 +6,7 @@ import (
 )
 
 // ConvertToPNG convert dicom file to png file.
-func (di DcmImage) ConvertToPNG(filepath string, frame int) error {
+func (di *DcmImage) ConvertToPNG(filepath string, frame int) error {
 	m, err := di.convertToImage(frame)
 	if err != nil {
 		return err
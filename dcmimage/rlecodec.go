@@ -0,0 +1,195 @@
+package dcmimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+func init() {
+	// RLE Lossless
+	RegisterCodec("1.2.840.10008.1.2.5", rleCodec{})
+}
+
+// the RLE header contains the number of segments and 15 offsets
+const rleHeaderSize = 64
+
+// rleCodec decodes and encodes the RLE Lossless compression defined in Annex G of PS3.5.
+// The pixel data is split into segments, one for each byte of each sample from the most
+// significant byte, and each row of a segment is compressed with the PackBits scheme.
+type rleCodec struct{}
+
+// rleLayout gets the number of bytes per sample and the number of segments.
+func rleLayout(di DcmImage) (int, int, error) {
+	if di.BitsAllocated == 0 || di.BitsAllocated%8 != 0 {
+		str := fmt.Sprintf("rleCodec: not supported BitsAllocated %d", di.BitsAllocated)
+		return 0, 0, errors.New(str)
+	}
+	bytesPerSample := int(di.BitsAllocated) / 8
+	samples := int(di.SamplesPerPixel)
+	if samples == 0 {
+		samples = 1
+	}
+	num := bytesPerSample * samples
+	if num > 15 {
+		str := fmt.Sprintf("rleCodec: %d segments are more than 15", num)
+		return 0, 0, errors.New(str)
+	}
+	return bytesPerSample, num, nil
+}
+
+// Decode decodes the RLE segments into interleaved samples in little endian.
+func (rleCodec) Decode(src []byte, di *DcmImage) ([]byte, error) {
+	bytesPerSample, num, err := rleLayout(*di)
+	if err != nil {
+		return nil, err
+	}
+	if len(src) < rleHeaderSize {
+		return nil, errors.New("rleCodec: the RLE header is too short")
+	}
+	n := int(binary.LittleEndian.Uint32(src))
+	if n != num {
+		str := fmt.Sprintf("rleCodec: the number of segments is %d, want %d", n, num)
+		return nil, errors.New(str)
+	}
+	var offsets []int
+	for i := 0; i < n; i++ {
+		offset := int(binary.LittleEndian.Uint32(src[4+4*i:]))
+		if offset < rleHeaderSize || offset > len(src) || (i > 0 && offset < offsets[i-1]) {
+			str := fmt.Sprintf("rleCodec: invalid offset %d of segment %d", offset, i)
+			return nil, errors.New(str)
+		}
+		offsets = append(offsets, offset)
+	}
+
+	pixels := int(di.Rows) * int(di.Columns)
+	// the number of bytes of a pixel is the number of segments
+	pixelSize := num
+	result := make([]byte, pixels*pixelSize)
+	segment := make([]byte, pixels)
+	for i := 0; i < n; i++ {
+		end := len(src)
+		if i+1 < n {
+			end = offsets[i+1]
+		}
+		err := rleDecodeSegment(src[offsets[i]:end], segment)
+		if err != nil {
+			return nil, err
+		}
+		// the segments of a sample start from the most significant byte
+		sample := i / bytesPerSample
+		pos := sample*bytesPerSample + bytesPerSample - 1 - i%bytesPerSample
+		for p := 0; p < pixels; p++ {
+			result[p*pixelSize+pos] = segment[p]
+		}
+	}
+	di.PlanarConfiguration = 0
+	return result, nil
+}
+
+// rleDecodeSegment decodes a PackBits segment into dst. The padding byte at the end is ignored.
+func rleDecodeSegment(src []byte, dst []byte) error {
+	i := 0
+	j := 0
+	for i < len(src) && j < len(dst) {
+		n := int(int8(src[i]))
+		i++
+		switch {
+		case n >= 0:
+			// literal run of n+1 bytes
+			if i+n+1 > len(src) || j+n+1 > len(dst) {
+				return errors.New("rleCodec: the literal run is out of range")
+			}
+			copy(dst[j:], src[i:i+n+1])
+			i += n + 1
+			j += n + 1
+		case n != -128:
+			// replicate run of the next byte -n+1 times
+			if i >= len(src) || j-n+1 > len(dst) {
+				return errors.New("rleCodec: the replicate run is out of range")
+			}
+			for k := 0; k < -n+1; k++ {
+				dst[j+k] = src[i]
+			}
+			i++
+			j += -n + 1
+		}
+	}
+	if j != len(dst) {
+		str := fmt.Sprintf("rleCodec: the segment is decoded into %d bytes, want %d", j, len(dst))
+		return errors.New(str)
+	}
+	return nil
+}
+
+// Encode encodes the native pixel data of a frame into RLE segments.
+func (rleCodec) Encode(src []byte, di DcmImage) ([]byte, error) {
+	bytesPerSample, num, err := rleLayout(di)
+	if err != nil {
+		return nil, err
+	}
+	rows := int(di.Rows)
+	columns := int(di.Columns)
+	samples := num / bytesPerSample
+	if len(src) < rows*columns*num {
+		str := fmt.Sprintf("rleCodec: the frame is %d bytes, want %d", len(src), rows*columns*num)
+		return nil, errors.New(str)
+	}
+
+	result := make([]byte, rleHeaderSize)
+	binary.LittleEndian.PutUint32(result, uint32(num))
+	row := make([]byte, columns)
+	for i := 0; i < num; i++ {
+		binary.LittleEndian.PutUint32(result[4+4*i:], uint32(len(result)))
+		sample := i / bytesPerSample
+		// the most significant byte is the first segment of a sample
+		b := i % bytesPerSample
+		if !di.IsBigEndian {
+			b = bytesPerSample - 1 - b
+		}
+		for y := 0; y < rows; y++ {
+			for x := 0; x < columns; x++ {
+				var pos int
+				if di.PlanarConfiguration == 1 {
+					pos = (sample*rows*columns + y*columns + x) * bytesPerSample
+				} else {
+					pos = ((y*columns+x)*samples + sample) * bytesPerSample
+				}
+				row[x] = src[pos+b]
+			}
+			// each row is encoded separately
+			result = rleEncodeRow(result, row)
+		}
+		if len(result)%2 != 0 {
+			result = append(result, 0)
+		}
+	}
+	return result, nil
+}
+
+// rleEncodeRow appends the PackBits encoding of the row to dst.
+func rleEncodeRow(dst []byte, row []byte) []byte {
+	i := 0
+	for i < len(row) {
+		run := 1
+		for i+run < len(row) && run < 128 && row[i+run] == row[i] {
+			run++
+		}
+		if run > 1 {
+			dst = append(dst, byte(1-run), row[i])
+			i += run
+			continue
+		}
+		// the literal run ends before 3 identical bytes
+		start := i
+		for i < len(row) && i-start < 128 {
+			if i+2 < len(row) && row[i] == row[i+1] && row[i] == row[i+2] {
+				break
+			}
+			i++
+		}
+		dst = append(dst, byte(i-start-1))
+		dst = append(dst, row[start:i]...)
+	}
+	return dst
+}
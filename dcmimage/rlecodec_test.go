@@ -0,0 +1,183 @@
+package dcmimage
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRLEDecodeSegment(t *testing.T) {
+	cases := []struct {
+		in   []byte
+		want []byte
+	}{
+		// literal run, replicate run, no-op
+		{[]byte{0x02, 0x01, 0x02, 0x03, 0xFE, 0x07, 0x80, 0x00, 0x09}, []byte{0x01, 0x02, 0x03, 0x07, 0x07, 0x07, 0x09}},
+		// the padding byte at the end is ignored
+		{[]byte{0xFD, 0xAA, 0x00}, []byte{0xAA, 0xAA, 0xAA, 0xAA}},
+		{[]byte{0x81, 0x00}, make([]byte, 128)},
+	}
+	for _, c := range cases {
+		got := make([]byte, len(c.want))
+		err := rleDecodeSegment(c.in, got)
+		if err != nil {
+			t.Errorf("rleDecodeSegment(% X): %s", c.in, err.Error())
+			continue
+		}
+		if !bytes.Equal(got, c.want) {
+			t.Errorf("rleDecodeSegment(% X), want % X got % X", c.in, c.want, got)
+		}
+	}
+
+	errCases := [][]byte{
+		{0x05, 0x01, 0x02},
+		{0xFD},
+		{0x00, 0x01},
+	}
+	for _, c := range errCases {
+		err := rleDecodeSegment(c, make([]byte, 4))
+		if err == nil {
+			t.Errorf("rleDecodeSegment(% X) should fail", c)
+		}
+	}
+}
+
+func TestRLEEncodeRow(t *testing.T) {
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = byte(i % 7)
+	}
+	cases := []struct {
+		in   []byte
+		want []byte
+	}{
+		{[]byte{0x01}, []byte{0x00, 0x01}},
+		{[]byte{0x01, 0x02, 0x03, 0x03, 0x03, 0x03}, []byte{0x01, 0x01, 0x02, 0xFD, 0x03}},
+		{[]byte{0x05, 0x05, 0x06}, []byte{0xFF, 0x05, 0x00, 0x06}},
+		{make([]byte, 130), []byte{0x81, 0x00, 0xFF, 0x00}},
+		{long, nil},
+	}
+	for _, c := range cases {
+		got := rleEncodeRow(nil, c.in)
+		if c.want != nil && !bytes.Equal(got, c.want) {
+			t.Errorf("rleEncodeRow(% X), want % X got % X", c.in, c.want, got)
+		}
+		decoded := make([]byte, len(c.in))
+		err := rleDecodeSegment(got, decoded)
+		if err != nil || !bytes.Equal(decoded, c.in) {
+			t.Errorf("rleEncodeRow(% X) can not be decoded (%v)", c.in, err)
+		}
+	}
+}
+
+func TestRLECodec(t *testing.T) {
+	cases := []struct {
+		bitsAllocated uint16
+		samples       uint16
+		planar        uint16
+		isBigEndian   bool
+	}{
+		{8, 1, 0, false},
+		{16, 1, 0, false},
+		{16, 1, 0, true},
+		{32, 1, 0, false},
+		{8, 3, 0, false},
+		{8, 3, 1, false},
+		{16, 3, 0, false},
+	}
+	rows := 5
+	columns := 13
+	for _, c := range cases {
+		var di DcmImage
+		di.Rows = uint32(rows)
+		di.Columns = uint32(columns)
+		di.BitsAllocated = c.bitsAllocated
+		di.SamplesPerPixel = c.samples
+		di.PlanarConfiguration = c.planar
+		di.IsBigEndian = c.isBigEndian
+
+		bytesPerSample := int(c.bitsAllocated) / 8
+		pixelSize := bytesPerSample * int(c.samples)
+		src := make([]byte, rows*columns*pixelSize)
+		for i := range src {
+			if i%5 < 3 {
+				src[i] = byte(i * 31 / 7)
+			} else {
+				src[i] = 0x80
+			}
+		}
+
+		// the decoded samples are interleaved in little endian
+		want := make([]byte, len(src))
+		for p := 0; p < rows*columns; p++ {
+			for s := 0; s < int(c.samples); s++ {
+				for b := 0; b < bytesPerSample; b++ {
+					from := (p*int(c.samples) + s) * bytesPerSample
+					if c.planar == 1 {
+						from = (s*rows*columns + p) * bytesPerSample
+					}
+					if c.isBigEndian {
+						from += bytesPerSample - 1 - b
+					} else {
+						from += b
+					}
+					want[p*pixelSize+s*bytesPerSample+b] = src[from]
+				}
+			}
+		}
+
+		encoded, err := rleCodec{}.Encode(src, di)
+		if err != nil {
+			t.Errorf("Encode() %+v: %s", c, err.Error())
+			continue
+		}
+		if len(encoded)%2 != 0 {
+			t.Errorf("Encode() %+v, the length %d is odd", c, len(encoded))
+		}
+		got, err := rleCodec{}.Decode(encoded, &di)
+		if err != nil {
+			t.Errorf("Decode() %+v: %s", c, err.Error())
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Decode() %+v, the pixel data is changed", c)
+		}
+	}
+}
+
+func TestRLECodecErrors(t *testing.T) {
+	var di DcmImage
+	di.Rows = 2
+	di.Columns = 2
+	di.BitsAllocated = 16
+	di.SamplesPerPixel = 1
+	encoded, err := rleCodec{}.Encode(make([]byte, 8), di)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name          string
+		in            []byte
+		bitsAllocated uint16
+		samples       uint16
+	}{
+		{"short header", encoded[:32], 16, 1},
+		{"number of segments", encoded, 8, 1},
+		{"more than 15 segments", encoded, 32, 4},
+		{"BitsAllocated", encoded, 12, 1},
+	}
+	for _, c := range cases {
+		d := di
+		d.BitsAllocated = c.bitsAllocated
+		d.SamplesPerPixel = c.samples
+		_, err := rleCodec{}.Decode(c.in, &d)
+		if err == nil {
+			t.Errorf("Decode() %s should fail", c.name)
+		}
+	}
+
+	_, err = rleCodec{}.Encode(make([]byte, 4), di)
+	if err == nil {
+		t.Errorf("Encode() should fail if the frame is too short")
+	}
+}
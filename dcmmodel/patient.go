@@ -7,11 +7,15 @@ type Patient struct {
 	PatientID        string `orm:"unique;column(patientid)"`
 	PatientBirthDate string `orm:"column(patientbirthdate)"`
 	PatientSex       string `orm:"column(patientsex)"`
+
+	// Name is PatientName parsed into the component groups
+	Name core.PersonName `orm:"-"`
 	//	Study            []Study `orm:"-"`
 }
 
 func (this *Patient) Parse(dataset core.DcmDataset) {
 	this.PatientName = dataset.GetElementValue(core.DCMPatientName)
+	this.Name = core.ParsePersonName(this.PatientName)
 	this.PatientID = dataset.GetElementValue(core.DCMPatientID)
 	this.PatientBirthDate = dataset.GetElementValue(core.DCMPatientBirthDate)
 	this.PatientSex = dataset.GetElementValue(core.DCMPatientSex)
@@ -21,3 +25,8 @@ func (this *Patient) Parse(dataset core.DcmDataset) {
 		this.Study = append(this.Study, s)
 	*/
 }
+
+// DisplayName gets the patient name to display, e.g. "Dr John Doe" for "Doe^John^^Dr".
+func (this Patient) DisplayName() string {
+	return this.Name.Formatted()
+}
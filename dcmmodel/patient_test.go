@@ -48,3 +48,18 @@ func TestPatientParse(t *testing.T) {
 	}
 
 }
+
+func TestPatientParseName(t *testing.T) {
+	var dataset core.DcmDataset
+	dataset.Set(core.DCMSpecificCharacterSet, "", "\\ISO 2022 IR 87")
+	dataset.Set(core.DCMPatientName, "", "Yamada^Tarou^^Dr=山田^太郎=やまだ^たろう")
+
+	var p Patient
+	p.Parse(dataset)
+	if p.Name.Alphabetic.Family != "Yamada" || p.Name.Ideographic.Given != "太郎" || p.Name.Phonetic.Family != "やまだ" {
+		t.Errorf("Patient.Parse(), got name %+v", p.Name)
+	}
+	if p.DisplayName() != "Dr Tarou Yamada" {
+		t.Errorf("DisplayName(), want 'Dr Tarou Yamada' got '%s'", p.DisplayName())
+	}
+}
@@ -11,6 +11,9 @@ type Slice struct {
 	ContentDate          string `orm:"column(contentdate)"`
 	ContentTime          string `orm:"column(contenttime)"`
 
+	// ContentDateTime is ContentDate and ContentTime parsed with the timezone of the data set
+	ContentDateTime core.DcmDateTime `orm:"-"`
+
 	//pixel
 	SamplesPerPixel           string `orm:"column(samplesperpixel)"`
 	PhotometricInterpretation string `orm:"column(photometricinterpretation)"`
@@ -34,6 +37,7 @@ func (this *Slice) Parse(dataset core.DcmDataset) {
 	this.PatientOrientation = dataset.GetElementValue(core.DCMPatientOrientation)
 	this.ContentDate = dataset.GetElementValue(core.DCMContentDate)
 	this.ContentTime = dataset.GetElementValue(core.DCMContentTime)
+	this.ContentDateTime, _ = dataset.GetDateAndTime(core.DCMContentDate, core.DCMContentTime)
 
 	this.SamplesPerPixel = dataset.GetElementValue(core.DCMSamplesPerPixel)
 	this.PhotometricInterpretation = dataset.GetElementValue(core.DCMPhotometricInterpretation)
@@ -9,6 +9,9 @@ type Study struct {
 	ReferringPhysicianName string `orm:"column(referringphysicianname)"`
 	StudyID                string `orm:"column(studyid)"`
 	AccessionNumber        string `orm:"column(accessionnumber)"`
+
+	// DateTime is StudyDate and StudyTime parsed with the timezone of the data set
+	DateTime core.DcmDateTime `orm:"-"`
 	//	Series                 []Series `orm:"-"`
 }
 
@@ -16,6 +19,7 @@ func (this *Study) Parse(dataset core.DcmDataset) {
 	this.StudyInstanceUID = dataset.GetElementValue(core.DCMStudyInstanceUID)
 	this.StudyDate = dataset.GetElementValue(core.DCMStudyDate)
 	this.StudyTime = dataset.GetElementValue(core.DCMStudyTime)
+	this.DateTime, _ = dataset.GetDateAndTime(core.DCMStudyDate, core.DCMStudyTime)
 	this.ReferringPhysicianName = dataset.GetElementValue(core.DCMReferringPhysicianName)
 	this.StudyID = dataset.GetElementValue(core.DCMStudyID)
 	this.AccessionNumber = dataset.GetElementValue(core.DCMAccessionNumber)
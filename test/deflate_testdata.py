@@ -0,0 +1,67 @@
+#!/usr/bin/env python3
+# This program writes data/US-RGB-8-esopecho_deflated.dcm, the data set of
+# data/US-RGB-8-esopecho.dcm compressed by zlib in the Deflated Explicit VR Little
+# Endian transfer syntax, to test reading a deflated file not written by godcm. The
+# meta information is copied except the transfer syntax, and the deflated bit stream
+# is padded to an even length as PS3.5 A.5 requires.
+import struct
+import zlib
+
+SOURCE = "data/US-RGB-8-esopecho.dcm"
+TARGET = "data/US-RGB-8-esopecho_deflated.dcm"
+DEFLATED = b"1.2.840.10008.1.2.1.99"
+
+
+def read_meta(data):
+    """Returns the elements of the meta information group and the end of the group."""
+    assert data[128:132] == b"DICM"
+    pos = 132
+    elements = []
+    while pos < len(data):
+        group, element = struct.unpack_from("<HH", data, pos)
+        if group != 0x0002:
+            break
+        vr = data[pos + 4:pos + 6]
+        if vr in (b"OB", b"OW", b"OF", b"SQ", b"UT", b"UN"):
+            length = struct.unpack_from("<I", data, pos + 8)[0]
+            header = 12
+        else:
+            length = struct.unpack_from("<H", data, pos + 6)[0]
+            header = 8
+        value = data[pos + header:pos + header + length]
+        elements.append((group, element, vr, value))
+        pos += header + length
+    return elements, pos
+
+
+def encode_element(group, element, vr, value):
+    if vr in (b"OB", b"OW", b"OF", b"SQ", b"UT", b"UN"):
+        return struct.pack("<HH2sHI", group, element, vr, 0, len(value)) + value
+    return struct.pack("<HH2sH", group, element, vr, len(value)) + value
+
+
+def main():
+    with open(SOURCE, "rb") as f:
+        data = f.read()
+    elements, end = read_meta(data)
+
+    meta = b""
+    for group, element, vr, value in elements:
+        if element == 0x0000:
+            continue
+        if element == 0x0010:
+            value = DEFLATED
+        meta += encode_element(group, element, vr, value)
+    meta = encode_element(0x0002, 0x0000, b"UL", struct.pack("<I", len(meta))) + meta
+
+    compressor = zlib.compressobj(9, zlib.DEFLATED, -15)
+    dataset = compressor.compress(data[end:]) + compressor.flush()
+    if len(dataset) % 2 != 0:
+        dataset += b"\x00"
+
+    with open(TARGET, "wb") as f:
+        f.write(data[:132] + meta + dataset)
+
+
+if __name__ == "__main__":
+    main()